@@ -0,0 +1,681 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"io"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// enumTables maps enumeration types to their symbolic names.
+// This lets the assembler accept enumerants by name.
+var enumTables = map[reflect.Type]*enumTable{
+	reflect.TypeOf(SourceLanguage(0)):             sourceLanguageNames,
+	reflect.TypeOf(ExecutionModel(0)):             executionModelNames,
+	reflect.TypeOf(AddressingModel(0)):            addressingModelNames,
+	reflect.TypeOf(MemoryModel(0)):                memoryModelNames,
+	reflect.TypeOf(ExecutionMode(0)):              executionModeNames,
+	reflect.TypeOf(StorageClass(0)):               storageClassNames,
+	reflect.TypeOf(Dim(0)):                        dimNames,
+	reflect.TypeOf(SamplerAddressingMode(0)):      samplerAddressingModeNames,
+	reflect.TypeOf(SamplerFilterMode(0)):          samplerFilterModeNames,
+	reflect.TypeOf(ImageFormat(0)):                imageFormatNames,
+	reflect.TypeOf(ImageChannelOrder(0)):          imageChannelOrderNames,
+	reflect.TypeOf(ImageChannelDataType(0)):       imageChannelDataTypeNames,
+	reflect.TypeOf(ImageOperands(0)):              imageOperandsNames,
+	reflect.TypeOf(FPFastMathMode(0)):             fpFastMathModeNames,
+	reflect.TypeOf(FPRoundingMode(0)):             fpRoundingModeNames,
+	reflect.TypeOf(LinkageType(0)):                linkageTypeNames,
+	reflect.TypeOf(AccessQualifier(0)):            accessQualifierNames,
+	reflect.TypeOf(FunctionParameterAttribute(0)): functionParameterAttributeNames,
+	reflect.TypeOf(Decoration(0)):                 decorationNames,
+	reflect.TypeOf(BuiltIn(0)):                    builtInNames,
+	reflect.TypeOf(SelectionControl(0)):           selectionControlNames,
+	reflect.TypeOf(LoopControl(0)):                loopControlNames,
+	reflect.TypeOf(FunctionControl(0)):            functionControlNames,
+	reflect.TypeOf(MemorySemantics(0)):            memorySemanticsNames,
+	reflect.TypeOf(MemoryAccess(0)):               memoryAccessNames,
+	reflect.TypeOf(Scope(0)):                      scopeNames,
+	reflect.TypeOf(GroupOperation(0)):             groupOperationNames,
+	reflect.TypeOf(KernelEnqueueFlags(0)):         kernelEnqueueFlagsNames,
+	reflect.TypeOf(KernelProfilingInfo(0)):        kernelProfilingInfoNames,
+	reflect.TypeOf(Capability(0)):                 capabilityNames,
+}
+
+// Assemble parses SPIR-V assembly text into a module.
+//
+// The text consists of instructions in the form produced by Disassemble:
+//
+//	%result = OpName %type operand...
+//
+// Ids may be numeric (%12) or symbolic (%main). Numeric ids keep their
+// value, symbolic ids are assigned the lowest free values in order of
+// their first appearance. Enumerant operands accept symbolic names or
+// numbers. Comments start with ';' and run until the end of the line.
+//
+// The module header's Bound is set to one more than the largest id in use.
+// Returns a *SyntaxError if the text is malformed. Like Load, it returns
+// an ErrorList of *LayoutError values for an OpSwitch whose selector is
+// wider than 32 bits.
+func Assemble(r io.Reader) (*Module, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := lex(data)
+	if err != nil {
+		return nil, err
+	}
+
+	asm := newAssembler(tokens)
+	mod := NewModule()
+
+	for len(asm.tokens) > 0 {
+		instr, err := asm.instruction()
+		if err != nil {
+			return nil, err
+		}
+
+		mod.Code = append(mod.Code, instr)
+	}
+
+	err = mod.verifySwitchSelectors()
+	if err != nil {
+		return nil, err
+	}
+
+	mod.Header.Bound = uint32(asm.max) + 1
+	return mod, nil
+}
+
+// tokenType defines the type of a lexical token.
+type tokenType uint8
+
+// Known token types.
+const (
+	tokIdent  tokenType = iota // OpName, Uniform, InLine|Pure
+	tokId                      // %name, %12
+	tokNumber                  // 12, -1, 0x1f, 1.5e3
+	tokString                  // "foo"
+	tokEquals                  // =
+)
+
+// token defines a single lexical token in assembly text.
+type token struct {
+	typ    tokenType
+	text   string // Source text; unquoted for strings, without '%' for ids.
+	line   int
+	column int
+}
+
+// errorf returns a syntax error at the position of t.
+func (t *token) errorf(msg string, argv ...interface{}) error {
+	return NewSyntaxError(t.line, t.column, msg, argv...)
+}
+
+// isOpcodeName returns true if the token names an instruction.
+func (t *token) isOpcodeName() bool {
+	return t.typ == tokIdent && len(t.text) > 2 && strings.HasPrefix(t.text, "Op") &&
+		t.text[2] >= 'A' && t.text[2] <= 'Z'
+}
+
+// lex splits the given text into tokens.
+func lex(data []byte) ([]token, error) {
+	var out []token
+
+	line, col := 1, 1
+	for i := 0; i < len(data); {
+		c := data[i]
+
+		switch {
+		case c == '\n':
+			i++
+			line++
+			col = 1
+			continue
+
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+			col++
+			continue
+
+		case c == ';':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			continue
+		}
+
+		tok := token{line: line, column: col}
+		start := i
+
+		switch {
+		case c == '=':
+			tok.typ = tokEquals
+			i++
+
+		case c == '%':
+			i++
+			for i < len(data) && isIdentChar(data[i]) {
+				i++
+			}
+
+			if i == start+1 {
+				return nil, tok.errorf("expected id name after '%%'")
+			}
+
+			tok.typ = tokId
+			tok.text = string(data[start+1 : i])
+
+		case c == '"':
+			var str []byte
+
+			for i++; i < len(data) && data[i] != '"'; i++ {
+				if data[i] == '\\' && i+1 < len(data) {
+					i++
+				}
+				if data[i] == '\n' {
+					// Columns after the literal are counted from
+					// the start of its last line.
+					line++
+					col = 1
+					start = i + 1
+				}
+				str = append(str, data[i])
+			}
+
+			if i >= len(data) {
+				return nil, tok.errorf("unterminated string literal")
+			}
+
+			i++
+			tok.typ = tokString
+			tok.text = string(str)
+
+		case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+			for i++; i < len(data) && isNumberChar(data[i]); i++ {
+			}
+
+			tok.typ = tokNumber
+			tok.text = string(data[start:i])
+
+		case isIdentChar(c):
+			for i < len(data) && (isIdentChar(data[i]) || data[i] == '|') {
+				i++
+			}
+
+			tok.typ = tokIdent
+			tok.text = string(data[start:i])
+
+		default:
+			return nil, tok.errorf("unexpected character %q", c)
+		}
+
+		col += i - start
+		out = append(out, tok)
+	}
+
+	return out, nil
+}
+
+// isNumberChar returns true if c may appear in a numeric literal.
+// This is deliberately lenient; the literal is validated when it is parsed.
+func isNumberChar(c byte) bool {
+	return isIdentChar(c) || c == '.' || c == '-' || c == '+'
+}
+
+// assembler holds the state needed to turn tokens into instructions.
+type assembler struct {
+	tokens   []token
+	ids      map[string]Id      // Symbolic id names mapped to their values.
+	reserved map[Id]bool        // Numeric ids in use.
+	types    map[Id]Instruction // Type declarations, for literal parsing.
+	next     Id                 // Next candidate for symbolic id values.
+	max      Id                 // Largest id in use.
+}
+
+func newAssembler(tokens []token) *assembler {
+	asm := &assembler{
+		tokens:   tokens,
+		ids:      make(map[string]Id),
+		reserved: make(map[Id]bool),
+		types:    make(map[Id]Instruction),
+		next:     1,
+	}
+
+	// Numeric ids keep their value, so they must be known before any
+	// symbolic ids are assigned.
+	for _, tok := range tokens {
+		if tok.typ != tokId {
+			continue
+		}
+
+		v, err := strconv.ParseUint(tok.text, 10, 32)
+		if err == nil {
+			asm.reserved[Id(v)] = true
+		}
+	}
+
+	return asm
+}
+
+// instruction parses the next instruction.
+func (a *assembler) instruction() (Instruction, error) {
+	var result *token
+
+	if len(a.tokens) > 1 && a.tokens[0].typ == tokId && a.tokens[1].typ == tokEquals {
+		result = &a.tokens[0]
+		a.tokens = a.tokens[2:]
+
+		if len(a.tokens) == 0 {
+			return nil, result.errorf("expected instruction after '='")
+		}
+	}
+
+	op := &a.tokens[0]
+	if !op.isOpcodeName() {
+		return nil, op.errorf("expected instruction name; found %q", op.text)
+	}
+
+	a.tokens = a.tokens[1:]
+
+	opcode, ok := DefaultDialect.Lookup(op.text)
+	if !ok {
+		return nil, op.errorf("unknown instruction %s", op.text)
+	}
+
+	// Collect the operands. They run until the next instruction.
+	var operands []token
+
+	for len(a.tokens) > 0 {
+		tok := a.tokens[0]
+
+		if tok.isOpcodeName() ||
+			(tok.typ == tokId && len(a.tokens) > 1 && a.tokens[1].typ == tokEquals) {
+			break
+		}
+
+		operands = append(operands, tok)
+		a.tokens = a.tokens[1:]
+	}
+
+	instr, _ := DefaultDialect.New(opcode)
+	err := a.operands(op, result, instr, operands)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := instr.(type) {
+	case *OpTypeInt:
+		a.types[v.ResultId] = v
+	case *OpTypeFloat:
+		a.types[v.ResultId] = v
+	}
+
+	return instr, nil
+}
+
+// operands fills the fields of instr from the given result and operands.
+func (a *assembler) operands(op, result *token, instr Instruction, argv []token) error {
+	rv := reflect.Indirect(reflect.ValueOf(instr))
+	rt := rv.Type()
+
+	var haveResult bool
+
+	for n := 0; n < rv.NumField(); n++ {
+		fv := rv.Field(n)
+		ft := rt.Field(n)
+
+		if ft.Name == "ResultId" {
+			if result == nil {
+				return op.errorf("%s requires a result id", op.text)
+			}
+
+			fv.SetUint(uint64(a.id(result)))
+			haveResult = true
+			continue
+		}
+
+		tag := ft.Tag.Get("spirv")
+		if len(argv) == 0 {
+			if hasFieldOption(tag, "optional") || fv.Kind() == reflect.Slice {
+				continue
+			}
+
+			return op.errorf("%s: missing operand %s", op.text, ft.Name)
+		}
+
+		var err error
+		argv, err = a.field(instr, ft.Name, fv, argv)
+		if err != nil {
+			return err
+		}
+	}
+
+	if result != nil && !haveResult {
+		return result.errorf("%s does not produce a result id", op.text)
+	}
+
+	if len(argv) > 0 {
+		return argv[0].errorf("%s: unexpected operand %q", op.text, argv[0].text)
+	}
+
+	return nil
+}
+
+// field parses the value for a single struct field. It returns the
+// remaining, unused operands.
+func (a *assembler) field(instr Instruction, name string, rv reflect.Value, argv []token) ([]token, error) {
+	switch v := instr.(type) {
+	case *OpConstant:
+		if name == "Value" {
+			return a.literal(v.ResultType, &v.Value, argv)
+		}
+	case *OpSpecConstant:
+		if name == "Value" {
+			return a.literal(v.ResultType, &v.Value, argv)
+		}
+	case *OpDecorate:
+		if name == "Argv" {
+			return a.decorationArgs(v.Decoration, &v.Argv, argv)
+		}
+	case *OpMemberDecorate:
+		if name == "Argv" {
+			return a.decorationArgs(v.Decoration, &v.Argv, argv)
+		}
+	case *OpSwitch:
+		if name == "Target" {
+			return a.switchTargets(&v.Target, argv)
+		}
+	}
+
+	if rv.Kind() == reflect.Slice {
+		out := reflect.MakeSlice(rv.Type(), len(argv), len(argv))
+
+		for n := range argv {
+			err := a.value(out.Index(n), &argv[n])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		rv.Set(out)
+		return nil, nil
+	}
+
+	return argv[1:], a.value(rv, &argv[0])
+}
+
+// value parses a single operand into rv.
+func (a *assembler) value(rv reflect.Value, tok *token) error {
+	switch rv.Interface().(type) {
+	case Id:
+		if tok.typ != tokId {
+			return tok.errorf("expected id; found %q", tok.text)
+		}
+
+		rv.SetUint(uint64(a.id(tok)))
+		return nil
+
+	case String:
+		if tok.typ != tokString {
+			return tok.errorf("expected string literal; found %q", tok.text)
+		}
+
+		rv.SetString(tok.text)
+		return nil
+	}
+
+	if table, ok := enumTables[rv.Type()]; ok {
+		if tok.typ != tokIdent && tok.typ != tokNumber {
+			return tok.errorf("expected %s; found %q", rv.Type().Name(), tok.text)
+		}
+
+		v, ok := table.Value(tok.text)
+		if !ok {
+			return tok.errorf("unknown %s %q", rv.Type().Name(), tok.text)
+		}
+
+		rv.SetUint(uint64(v))
+		return nil
+	}
+
+	if rv.Kind() == reflect.Uint32 {
+		v, err := parseWord(tok)
+		if err != nil {
+			return err
+		}
+
+		rv.SetUint(uint64(v))
+		return nil
+	}
+
+	return tok.errorf("unsupported operand type %v", rv.Type())
+}
+
+// id returns the numeric value for the given id token.
+func (a *assembler) id(tok *token) Id {
+	v, err := strconv.ParseUint(tok.text, 10, 32)
+	if err == nil {
+		return a.use(Id(v))
+	}
+
+	id, ok := a.ids[tok.text]
+	if ok {
+		return id
+	}
+
+	for a.reserved[a.next] {
+		a.next++
+	}
+
+	id = a.next
+	a.next++
+	a.ids[tok.text] = id
+	return a.use(id)
+}
+
+// use records id as being in use and returns it.
+func (a *assembler) use(id Id) Id {
+	if id > a.max {
+		a.max = id
+	}
+	return id
+}
+
+// literal parses a numeric constant value. The value is interpreted
+// according to the given type. The remaining operands are returned.
+func (a *assembler) literal(typ Id, out *[]uint32, argv []token) ([]token, error) {
+	tok := &argv[0]
+	if tok.typ != tokNumber {
+		return nil, tok.errorf("expected numeric literal; found %q", tok.text)
+	}
+
+	switch t := a.types[typ].(type) {
+	case *OpTypeInt:
+		if t.Width > 32 {
+			var v uint64
+			var err error
+
+			if t.Signedness == 1 && strings.HasPrefix(tok.text, "-") {
+				var s int64
+				s, err = strconv.ParseInt(tok.text, 0, 64)
+				v = uint64(s)
+			} else {
+				v, err = strconv.ParseUint(tok.text, 0, 64)
+			}
+
+			if err != nil {
+				return nil, tok.errorf("invalid integer literal %q", tok.text)
+			}
+
+			*out = []uint32{uint32(v), uint32(v >> 32)}
+			return argv[1:], nil
+		}
+
+		if t.Signedness == 1 && strings.HasPrefix(tok.text, "-") {
+			v, err := strconv.ParseInt(tok.text, 0, 32)
+			if err != nil {
+				return nil, tok.errorf("invalid integer literal %q", tok.text)
+			}
+
+			*out = []uint32{uint32(v)}
+			return argv[1:], nil
+		}
+
+	case *OpTypeFloat:
+		if t.Width != 32 && t.Width != 64 {
+			break
+		}
+
+		// Raw bit patterns are written as plain hexadecimal numbers.
+		if isHexWord(tok.text) {
+			v, err := strconv.ParseUint(tok.text, 0, int(t.Width))
+			if err != nil {
+				return nil, tok.errorf("invalid floating point literal %q", tok.text)
+			}
+
+			*out = splitWords(v, t.Width)
+			return argv[1:], nil
+		}
+
+		f, err := strconv.ParseFloat(tok.text, int(t.Width))
+		if err != nil {
+			return nil, tok.errorf("invalid floating point literal %q", tok.text)
+		}
+
+		if t.Width == 32 {
+			*out = []uint32{math.Float32bits(float32(f))}
+		} else {
+			*out = splitWords(math.Float64bits(f), 64)
+		}
+
+		return argv[1:], nil
+	}
+
+	// Unknown types take their value as a list of plain words.
+	words := make([]uint32, len(argv))
+
+	for n := range argv {
+		v, err := parseWord(&argv[n])
+		if err != nil {
+			return nil, err
+		}
+		words[n] = v
+	}
+
+	*out = words
+	return nil, nil
+}
+
+// decorationArgs parses the arguments for the given decoration.
+func (a *assembler) decorationArgs(dec Decoration, out *[]uint32, argv []token) ([]token, error) {
+	table := decorationArgTable(dec)
+	words := make([]uint32, 0, len(argv))
+
+	for n := range argv {
+		tok := &argv[n]
+
+		// String arguments, like the name in LinkageAttributes,
+		// occupy as many words as they need.
+		if tok.typ == tokString {
+			str := String(tok.text)
+			buf := make([]uint32, str.EncodedLen())
+			str.Encode(buf)
+			words = append(words, buf...)
+			continue
+		}
+
+		if table != nil {
+			v, ok := table.Value(tok.text)
+			if !ok {
+				return nil, tok.errorf("unknown argument %q for decoration %v", tok.text, dec)
+			}
+
+			words = append(words, v)
+			continue
+		}
+
+		v, err := parseWord(tok)
+		if err != nil {
+			return nil, err
+		}
+
+		words = append(words, v)
+	}
+
+	*out = words
+	return nil, nil
+}
+
+// switchTargets parses the (literal, label) pairs of an OpSwitch.
+func (a *assembler) switchTargets(out *[]uint32, argv []token) ([]token, error) {
+	words := make([]uint32, len(argv))
+
+	for n := range argv {
+		tok := &argv[n]
+
+		if n%2 == 1 {
+			if tok.typ != tokId {
+				return nil, tok.errorf("expected label id; found %q", tok.text)
+			}
+
+			words[n] = uint32(a.id(tok))
+			continue
+		}
+
+		v, err := parseWord(tok)
+		if err != nil {
+			return nil, err
+		}
+
+		words[n] = v
+	}
+
+	*out = words
+	return nil, nil
+}
+
+// parseWord parses an unsigned 32-bit numeric literal.
+// Negative values are accepted and stored in two's complement form.
+func parseWord(tok *token) (uint32, error) {
+	if tok.typ != tokNumber {
+		return 0, tok.errorf("expected numeric literal; found %q", tok.text)
+	}
+
+	if strings.HasPrefix(tok.text, "-") {
+		v, err := strconv.ParseInt(tok.text, 0, 32)
+		if err != nil {
+			return 0, tok.errorf("invalid integer literal %q", tok.text)
+		}
+		return uint32(v), nil
+	}
+
+	v, err := strconv.ParseUint(tok.text, 0, 32)
+	if err != nil {
+		return 0, tok.errorf("invalid integer literal %q", tok.text)
+	}
+
+	return uint32(v), nil
+}
+
+// isHexWord returns true if s is a plain hexadecimal integer, as opposed
+// to a hexadecimal floating point value.
+func isHexWord(s string) bool {
+	return strings.HasPrefix(s, "0x") && !strings.ContainsAny(s, ".pP")
+}
+
+// splitWords splits v into words of the given bit width, low-order
+// words first.
+func splitWords(v uint64, width uint32) []uint32 {
+	if width <= 32 {
+		return []uint32{uint32(v)}
+	}
+	return []uint32{uint32(v), uint32(v >> 32)}
+}
@@ -0,0 +1,144 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAssembleRawRoundtrip(t *testing.T) {
+	want := testDisassemblyModule()
+
+	var buf bytes.Buffer
+	err := Disassemble(&buf, want, DisassembleOptions{RawIds: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	have, err := Assemble(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("roundtrip mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+}
+
+func TestAssembleFriendlyRoundtrip(t *testing.T) {
+	var want bytes.Buffer
+	err := Disassemble(&want, testDisassemblyModule(), DisassembleOptions{NoHeader: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mod, err := Assemble(bytes.NewReader(want.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var have bytes.Buffer
+	err = Disassemble(&have, mod, DisassembleOptions{NoHeader: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if have.String() != want.String() {
+		t.Fatalf("roundtrip mismatch:\nHave:\n%s\nWant:\n%s", have.String(), want.String())
+	}
+}
+
+func TestAssemble(t *testing.T) {
+	src := `
+; A comment.
+%3 = OpTypeInt 64 1
+%long_n1 = OpConstant %3 -1 ; Trailing comment.
+%double = OpTypeFloat 64
+%pi = OpConstant %double 3.5
+OpDecorate %pi SpecId 7
+OpSwitch %3 %default 1 %a 2 %b
+OpName %pi "p\"i"
+`
+
+	mod, err := Assemble(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := InstructionList{
+		&OpTypeInt{ResultId: 3, Width: 64, Signedness: 1},
+		&OpConstant{ResultType: 3, ResultId: 1, Value: []uint32{0xffffffff, 0xffffffff}},
+		&OpTypeFloat{ResultId: 2, Width: 64},
+		&OpConstant{ResultType: 2, ResultId: 4, Value: []uint32{0, 0x400c0000}},
+		&OpDecorate{Target: 4, Decoration: DecorationSpecId, Argv: []uint32{7}},
+		&OpSwitch{Selector: 3, Default: 5, Target: []uint32{1, 6, 2, 7}},
+		&OpName{Target: 4, Name: `p"i`},
+	}
+
+	if !reflect.DeepEqual(mod.Code, want) {
+		t.Fatalf("code mismatch:\nHave: %v\nWant: %v", mod.Code, want)
+	}
+
+	if mod.Header.Bound != 8 {
+		t.Fatalf("bound mismatch:\nHave: %d\nWant: %d", mod.Header.Bound, 8)
+	}
+}
+
+type AssembleErrorTest struct {
+	in   string
+	want error
+}
+
+func TestAssembleErrors(t *testing.T) {
+	for i, st := range []AssembleErrorTest{
+		{
+			in:   "OpFoo %1",
+			want: NewSyntaxError(1, 1, "unknown instruction OpFoo"),
+		},
+		{
+			in:   "OpTypeVoid",
+			want: NewSyntaxError(1, 1, "OpTypeVoid requires a result id"),
+		},
+		{
+			in:   "\n%1 = OpReturn",
+			want: NewSyntaxError(2, 1, "OpReturn does not produce a result id"),
+		},
+		{
+			in:   "OpMemoryModel Logical Foo",
+			want: NewSyntaxError(1, 23, "unknown MemoryModel \"Foo\""),
+		},
+		{
+			in:   "OpMemoryModel Logical",
+			want: NewSyntaxError(1, 1, "OpMemoryModel: missing operand MemoryModel"),
+		},
+		{
+			in:   "  OpReturn 1",
+			want: NewSyntaxError(1, 12, "OpReturn: unexpected operand \"1\""),
+		},
+		{
+			in:   "OpName %1 \"foo",
+			want: NewSyntaxError(1, 11, "unterminated string literal"),
+		},
+		{
+			in:   "OpName %1 \"foo\nbar\" x",
+			want: NewSyntaxError(2, 6, "OpName: unexpected operand \"x\""),
+		},
+		{
+			in:   "%1 = OpTypeInt 32 x",
+			want: NewSyntaxError(1, 19, "expected numeric literal; found \"x\""),
+		},
+		{
+			in:   "Uniform",
+			want: NewSyntaxError(1, 1, "expected instruction name; found \"Uniform\""),
+		},
+	} {
+		_, err := Assemble(strings.NewReader(st.in))
+		if !reflect.DeepEqual(err, st.want) {
+			t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v", i, err, st.want)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "fmt"
+
+// LayoutRules defines the rules by which the memory layout of a buffer
+// block is computed.
+type LayoutRules int
+
+// Known layout rules.
+const (
+	// LayoutStd140 rounds the alignment of arrays, matrices and structs
+	// up to 16 bytes. It is used for uniform buffers.
+	LayoutStd140 LayoutRules = iota
+
+	// LayoutStd430 is like LayoutStd140, without the rounding up to
+	// 16 bytes. It is used for storage buffers.
+	LayoutStd430
+
+	// LayoutScalar aligns every type to the size of its scalar components.
+	LayoutScalar
+)
+
+func (r LayoutRules) String() string {
+	switch r {
+	case LayoutStd140:
+		return "std140"
+	case LayoutStd430:
+		return "std430"
+	case LayoutScalar:
+		return "scalar"
+	}
+	return fmt.Sprintf("LayoutRules(%d)", int(r))
+}
+
+// BlockLayout describes the memory layout of a value in a buffer block.
+// All sizes and offsets are in bytes.
+type BlockLayout struct {
+	Type *Type
+
+	// Name is the name of a struct member, as given by OpMemberName.
+	Name string
+
+	// Offset is the offset of a struct member from the start of the
+	// struct. It is 0 for all other values.
+	Offset uint32
+
+	// Size is the number of bytes occupied by the value. It is 0 for
+	// runtime arrays and for arrays whose length is not an integer
+	// constant.
+	Size uint32
+
+	// Align is the base alignment of the value.
+	Align uint32
+
+	// Stride is the array stride of an array, or the matrix stride
+	// of a matrix.
+	Stride uint32
+
+	// RowMajor is true for matrices which are stored row by row.
+	RowMajor bool
+
+	// Elem describes the elements of an array, or the columns of a matrix.
+	// For row major matrices, it describes the rows. Their type is not
+	// declared in the module, so it has no id.
+	Elem *BlockLayout
+
+	// Members describes the members of a struct, in declaration order.
+	Members []*BlockLayout
+}
+
+// NewBlockLayout computes the memory layout of the type with the given id,
+// according to the given rules.
+//
+// Offset, ArrayStride, MatrixStride and RowMajor decorations are honored
+// where present, including those applied through decoration groups.
+// Missing offsets and strides are computed from the rules.
+//
+// Returns an ErrorList of *LayoutError values if an explicit offset or
+// stride violates the rules, or if the type contains values which can not
+// be stored in a buffer. Returns ErrNotType if id does not refer to a type.
+func NewBlockLayout(m *Module, id Id, rules LayoutRules) (*BlockLayout, error) {
+	l := &blockLayouter{
+		r:     newReflector(m),
+		rules: rules,
+		addrs: make(map[Id]int),
+	}
+
+	for addr, instr := range m.Code {
+		if _, ok := typeKindOf(instr.Opcode()); ok {
+			rid, _ := ResultId(instr)
+			l.addrs[rid] = addr
+		}
+	}
+
+	t := l.r.types.Type(id)
+	if t == nil {
+		return nil, ErrNotType
+	}
+
+	out := l.layout(t, 0, false, l.addrs[id])
+
+	if err := l.errs.err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// blockLayouter computes the memory layout of types.
+type blockLayouter struct {
+	r     *reflector
+	rules LayoutRules
+	addrs map[Id]int // Addresses of type declarations.
+	errs  ErrorList
+}
+
+// layout computes the layout of t. The matrix stride and row major flag
+// are those of the struct member which holds t. They apply to t, if it
+// is a matrix, or to the matrices in t, if it is an array.
+//
+// The address is used to report t being undeclared.
+func (l *blockLayouter) layout(t *Type, mstride uint32, rowMajor bool, addr int) *BlockLayout {
+	if t == nil {
+		l.errs = append(l.errs, NewLayoutError(addr, "undeclared type can not be laid out"))
+		return &BlockLayout{Align: 1}
+	}
+
+	if a, ok := l.addrs[t.Id]; ok {
+		addr = a
+	}
+
+	switch t.Kind {
+	case TypeInt, TypeFloat:
+		if n := t.Width / 8; n > 0 && t.Width%8 == 0 {
+			return &BlockLayout{Type: t, Size: n, Align: n}
+		}
+
+	case TypeVector:
+		return l.vector(t, addr)
+
+	case TypeMatrix:
+		return l.matrix(t, mstride, rowMajor, addr)
+
+	case TypeArray, TypeRuntimeArray:
+		return l.array(t, mstride, rowMajor, addr)
+
+	case TypeStruct:
+		return l.structure(t, addr)
+	}
+
+	l.errs = append(l.errs, NewLayoutError(addr, "type %s can not be stored in a buffer", t))
+	return &BlockLayout{Type: t, Align: 1}
+}
+
+// vector computes the layout of a vector type.
+func (l *blockLayouter) vector(t *Type, addr int) *BlockLayout {
+	c := l.layout(t.Elem, 0, false, addr)
+
+	out := &BlockLayout{
+		Type:  t,
+		Size:  c.Size * t.Len,
+		Align: c.Align,
+	}
+
+	if l.rules != LayoutScalar {
+		if t.Len == 2 {
+			out.Align *= 2
+		} else {
+			out.Align *= 4
+		}
+	}
+
+	return out
+}
+
+// matrix computes the layout of a matrix type. It is laid out as an array
+// of its columns, or of its rows if it is row major.
+func (l *blockLayouter) matrix(t *Type, mstride uint32, rowMajor bool, addr int) *BlockLayout {
+	vt, count := t.Elem, t.Len
+
+	if rowMajor && t.Elem != nil {
+		vt = &Type{Kind: TypeVector, Elem: t.Elem.Elem, Len: t.Len}
+		count = t.Elem.Len
+	}
+
+	v := l.layout(vt, 0, false, addr)
+
+	out := &BlockLayout{
+		Type:     t,
+		Align:    l.roundAlign(v.Align),
+		RowMajor: rowMajor,
+		Elem:     v,
+	}
+
+	out.Stride = roundUp(v.Size, out.Align)
+
+	if mstride != 0 {
+		l.checkStride(addr, "matrix", t, mstride, v.Size, out.Align)
+		out.Stride = mstride
+	}
+
+	out.Size = out.Stride * count
+	return out
+}
+
+// array computes the layout of an array or runtime array type.
+func (l *blockLayouter) array(t *Type, mstride uint32, rowMajor bool, addr int) *BlockLayout {
+	e := l.layout(t.Elem, mstride, rowMajor, addr)
+
+	out := &BlockLayout{
+		Type:  t,
+		Align: l.roundAlign(e.Align),
+		Elem:  e,
+	}
+
+	out.Stride = roundUp(e.Size, out.Align)
+
+	if argv := l.r.decos[t.Id][DecorationArrayStride]; len(argv) > 0 {
+		l.checkStride(addr, "array", t, argv[0], e.Size, out.Align)
+		out.Stride = argv[0]
+	}
+
+	if t.Kind == TypeArray {
+		out.Size = out.Stride * t.Len
+	}
+
+	return out
+}
+
+// structure computes the layout of a struct type.
+func (l *blockLayouter) structure(t *Type, addr int) *BlockLayout {
+	out := &BlockLayout{
+		Type:    t,
+		Align:   1,
+		Members: make([]*BlockLayout, len(t.Members)),
+	}
+
+	var end uint32
+
+	for i, mt := range t.Members {
+		decos := l.r.memberDecos[t.Id][uint32(i)]
+
+		var mstride uint32
+		if argv := decos[DecorationMatrixStride]; len(argv) > 0 {
+			mstride = argv[0]
+		}
+
+		_, rowMajor := decos[DecorationRowMajor]
+
+		ml := l.layout(mt, mstride, rowMajor, addr)
+		ml.Name = l.r.memberNames[t.Id][uint32(i)]
+		ml.Offset = roundUp(end, ml.Align)
+
+		if argv := decos[DecorationOffset]; len(argv) > 0 {
+			switch {
+			case argv[0]%ml.Align != 0:
+				l.errs = append(l.errs, NewLayoutError(addr,
+					"member %d of struct %d: offset %d is not a multiple of its alignment %d",
+					i, t.Id, argv[0], ml.Align))
+			case argv[0] < end:
+				l.errs = append(l.errs, NewLayoutError(addr,
+					"member %d of struct %d: offset %d overlaps the previous member",
+					i, t.Id, argv[0]))
+			}
+
+			ml.Offset = argv[0]
+		}
+
+		if ml.Offset+ml.Size > end {
+			end = ml.Offset + ml.Size
+		}
+
+		if ml.Align > out.Align {
+			out.Align = ml.Align
+		}
+
+		out.Members[i] = ml
+	}
+
+	out.Align = l.roundAlign(out.Align)
+	out.Size = roundUp(end, out.Align)
+	return out
+}
+
+// checkStride reports a stride which is smaller than the elements it
+// separates, or which is not a multiple of their alignment.
+func (l *blockLayouter) checkStride(addr int, kind string, t *Type, stride, size, align uint32) {
+	switch {
+	case stride%align != 0:
+		l.errs = append(l.errs, NewLayoutError(addr,
+			"%s %d: stride %d is not a multiple of its alignment %d", kind, t.Id, stride, align))
+	case stride < size:
+		l.errs = append(l.errs, NewLayoutError(addr,
+			"%s %d: stride %d is smaller than its element size %d", kind, t.Id, stride, size))
+	}
+}
+
+// roundAlign returns the alignment of an array, matrix or struct with
+// members aligned to n bytes.
+func (l *blockLayouter) roundAlign(n uint32) uint32 {
+	if l.rules == LayoutStd140 {
+		return roundUp(n, 16)
+	}
+	return n
+}
+
+// roundUp rounds n up to a multiple of align.
+func roundUp(n, align uint32) uint32 {
+	if align == 0 {
+		return n
+	}
+	return (n + align - 1) / align * align
+}
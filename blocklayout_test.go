@@ -0,0 +1,210 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testBlockLayoutPrefix = `
+	     OpCapability Shader
+	     OpMemoryModel Logical GLSL450
+`
+
+// testBlockLayoutTypes declares the struct:
+//
+//	struct Block {
+//		vec3 a;
+//		float b;
+//		mat2x3 c;
+//		float d[3];
+//		struct { float x; vec2 y; } e;
+//		float f;
+//	}
+const testBlockLayoutTypes = `
+	%1 = OpTypeFloat 32
+	%2 = OpTypeVector %1 2
+	%3 = OpTypeVector %1 3
+	%4 = OpTypeMatrix %3 2
+	%5 = OpTypeInt 32 0
+	%6 = OpConstant %5 3
+	%7 = OpTypeArray %1 %6
+	%8 = OpTypeStruct %1 %2
+	%9 = OpTypeStruct %3 %1 %4 %7 %8 %1
+`
+
+const testBlockLayoutNames = `
+	     OpMemberName %8 0 "x"
+	     OpMemberName %8 1 "y"
+	     OpMemberName %9 0 "a"
+	     OpMemberName %9 1 "b"
+	     OpMemberName %9 2 "c"
+	     OpMemberName %9 3 "d"
+	     OpMemberName %9 4 "e"
+	     OpMemberName %9 5 "f"
+`
+
+// testLayoutEntry describes a single node in a BlockLayout tree.
+type testLayoutEntry struct {
+	Path   string
+	Offset uint32
+	Size   uint32
+	Align  uint32
+	Stride uint32
+}
+
+// flattenBlockLayout lists the nodes of the given tree in depth first order.
+// Members are named after their parent, elements are suffixed with "[]".
+func flattenBlockLayout(out []testLayoutEntry, path string, l *BlockLayout) []testLayoutEntry {
+	out = append(out, testLayoutEntry{path, l.Offset, l.Size, l.Align, l.Stride})
+
+	if l.Elem != nil {
+		out = flattenBlockLayout(out, path+"[]", l.Elem)
+	}
+
+	for _, m := range l.Members {
+		out = flattenBlockLayout(out, strings.TrimPrefix(path+"."+m.Name, "."), m)
+	}
+
+	return out
+}
+
+func TestNewBlockLayout(t *testing.T) {
+	for i, st := range []struct {
+		in    string
+		rules LayoutRules
+		want  []testLayoutEntry
+	}{
+		{
+			in:    testBlockLayoutNames + testBlockLayoutTypes,
+			rules: LayoutStd140,
+			want: []testLayoutEntry{
+				{"", 0, 128, 16, 0},
+				{"a", 0, 12, 16, 0},
+				{"b", 12, 4, 4, 0},
+				{"c", 16, 32, 16, 16},
+				{"c[]", 0, 12, 16, 0},
+				{"d", 48, 48, 16, 16},
+				{"d[]", 0, 4, 4, 0},
+				{"e", 96, 16, 16, 0},
+				{"e.x", 0, 4, 4, 0},
+				{"e.y", 8, 8, 8, 0},
+				{"f", 112, 4, 4, 0},
+			},
+		},
+		{
+			in:    testBlockLayoutNames + testBlockLayoutTypes,
+			rules: LayoutStd430,
+			want: []testLayoutEntry{
+				{"", 0, 96, 16, 0},
+				{"a", 0, 12, 16, 0},
+				{"b", 12, 4, 4, 0},
+				{"c", 16, 32, 16, 16},
+				{"c[]", 0, 12, 16, 0},
+				{"d", 48, 12, 4, 4},
+				{"d[]", 0, 4, 4, 0},
+				{"e", 64, 16, 8, 0},
+				{"e.x", 0, 4, 4, 0},
+				{"e.y", 8, 8, 8, 0},
+				{"f", 80, 4, 4, 0},
+			},
+		},
+		{
+			in:    testBlockLayoutNames + testBlockLayoutTypes,
+			rules: LayoutScalar,
+			want: []testLayoutEntry{
+				{"", 0, 68, 4, 0},
+				{"a", 0, 12, 4, 0},
+				{"b", 12, 4, 4, 0},
+				{"c", 16, 24, 4, 12},
+				{"c[]", 0, 12, 4, 0},
+				{"d", 40, 12, 4, 4},
+				{"d[]", 0, 4, 4, 0},
+				{"e", 52, 12, 4, 0},
+				{"e.x", 0, 4, 4, 0},
+				{"e.y", 4, 8, 4, 0},
+				{"f", 64, 4, 4, 0},
+			},
+		},
+		{
+			// Explicit offsets and strides take precedence. The
+			// stride of a row major matrix separates its rows.
+			in: `
+				     OpMemberDecorate %9 0 RowMajor
+				     OpMemberDecorate %9 0 MatrixStride 16
+				     OpMemberDecorate %9 0 Offset 0
+				     OpMemberDecorate %9 1 Offset 64
+				     OpDecorate %50 ArrayStride 8
+				%50 = OpDecorationGroup
+				     OpGroupDecorate %50 %7
+				%1 = OpTypeFloat 32
+				%3 = OpTypeVector %1 3
+				%4 = OpTypeMatrix %3 2
+				%5 = OpTypeInt 32 0
+				%6 = OpConstant %5 3
+				%7 = OpTypeArray %1 %6
+				%9 = OpTypeStruct %4 %7
+			`,
+			rules: LayoutStd430,
+			want: []testLayoutEntry{
+				{"", 0, 88, 8, 0},
+				{"", 0, 48, 8, 16},
+				{"[]", 0, 8, 8, 0},
+				{"", 64, 24, 4, 8},
+				{"[]", 0, 4, 4, 0},
+			},
+		},
+	} {
+		m, err := Assemble(strings.NewReader(testBlockLayoutPrefix + st.in))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		l, err := NewBlockLayout(m, 9, st.rules)
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		have := flattenBlockLayout(nil, "", l)
+		if !reflect.DeepEqual(have, st.want) {
+			t.Fatalf("case %d: layout mismatch:\nHave: %v\nWant: %v", i, have, st.want)
+		}
+	}
+}
+
+func TestNewBlockLayoutErrors(t *testing.T) {
+	m, err := Assemble(strings.NewReader(testBlockLayoutPrefix + `
+		     OpMemberDecorate %9 0 Offset 4
+		     OpMemberDecorate %9 1 Offset 0
+		     OpDecorate %7 ArrayStride 2
+		%1 = OpTypeFloat 32
+		%2 = OpTypeVector %1 2
+		%5 = OpTypeInt 32 0
+		%6 = OpConstant %5 3
+		%7 = OpTypeArray %1 %6
+		%8 = OpTypeBool
+		%9 = OpTypeStruct %2 %7 %8
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := ErrorList{
+		NewLayoutError(11, "member 0 of struct 9: offset 4 is not a multiple of its alignment 8"),
+		NewLayoutError(9, "array 7: stride 2 is not a multiple of its alignment 4"),
+		NewLayoutError(11, "member 1 of struct 9: offset 0 overlaps the previous member"),
+		NewLayoutError(10, "type bool can not be stored in a buffer"),
+	}
+
+	_, have := NewBlockLayout(m, 9, LayoutStd430)
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+
+	if _, err := NewBlockLayout(m, 6, LayoutStd430); err != ErrNotType {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", err, ErrNotType)
+	}
+}
@@ -0,0 +1,97 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// verifyIdBound checks that all result ids and operand ids satisfy
+// 0 < id < Header.Bound, as defined in chapter 2.3 of the specification.
+//
+// All violations are returned as an ErrorList of *LayoutError values.
+func (m *Module) verifyIdBound() error {
+	var errs ErrorList
+	var operands []Id
+
+	bound := Id(m.Header.Bound)
+
+	check := func(addr int, id Id) {
+		switch {
+		case id == 0:
+			errs = append(errs, NewLayoutError(addr, "id 0 is not a valid id"))
+		case id >= bound:
+			errs = append(errs, NewLayoutError(addr, "id %d is not below the id bound %d", id, bound))
+		}
+	}
+
+	for addr, instr := range m.Code {
+		id, ok := ResultId(instr)
+		if ok {
+			check(addr, id)
+		}
+
+		operands = appendOperands(operands[:0], instr)
+		for _, id := range operands {
+			check(addr, id)
+		}
+	}
+
+	return errs.err()
+}
+
+// maxId returns the largest result id or operand id in the module.
+func (m *Module) maxId() Id {
+	var max Id
+	var operands []Id
+
+	for _, instr := range m.Code {
+		id, ok := ResultId(instr)
+		if ok && id > max {
+			max = id
+		}
+
+		operands = appendOperands(operands[:0], instr)
+		for _, id := range operands {
+			if id > max {
+				max = id
+			}
+		}
+	}
+
+	return max
+}
+
+// RecomputeBound sets Header.Bound to one more than the largest id
+// used in the module. This is the smallest valid bound.
+func (m *Module) RecomputeBound() {
+	m.Header.Bound = uint32(m.maxId()) + 1
+}
+
+// IdAllocator hands out ids which are not yet used in a module.
+type IdAllocator struct {
+	m    *Module
+	next Id
+}
+
+// IdAllocator returns an allocator for new ids in the module.
+// The first id it hands out is the larger of Header.Bound and
+// one more than the largest id used in the module.
+func (m *Module) IdAllocator() *IdAllocator {
+	next := m.maxId() + 1
+	if Id(m.Header.Bound) > next {
+		next = Id(m.Header.Bound)
+	}
+
+	return &IdAllocator{m: m, next: next}
+}
+
+// Next returns a fresh id. The module's Header.Bound is raised
+// to include it.
+func (a *IdAllocator) Next() Id {
+	id := a.next
+	a.next++
+
+	if a.m.Header.Bound < uint32(a.next) {
+		a.m.Header.Bound = uint32(a.next)
+	}
+
+	return id
+}
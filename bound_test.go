@@ -0,0 +1,117 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestModuleVerifyIdBound(t *testing.T) {
+	for i, st := range []struct {
+		bound uint32
+		code  InstructionList
+		want  error
+	}{
+		{
+			bound: 4,
+			code: InstructionList{
+				&OpTypeInt{ResultId: 1, Width: 32},
+				&OpConstant{ResultType: 1, ResultId: 3, Value: []uint32{1}},
+				&OpSource{SourceLanguage: SourceLanguageGLSL, Version: 450},
+			},
+		},
+		{
+			bound: 3,
+			code: InstructionList{
+				&OpTypeInt{ResultId: 1, Width: 32},
+				&OpConstant{ResultType: 1, ResultId: 3, Value: []uint32{1}},
+				&OpIAdd{ResultType: 1, ResultId: 2, Operand1: 0, Operand2: 7},
+			},
+			want: ErrorList{
+				NewLayoutError(1, "id 3 is not below the id bound 3"),
+				NewLayoutError(2, "id 0 is not a valid id"),
+				NewLayoutError(2, "id 7 is not below the id bound 3"),
+			},
+		},
+	} {
+		m := NewModule()
+		m.Header.Bound = st.bound
+		m.Code = st.code
+
+		have := m.verifyIdBound()
+		if !reflect.DeepEqual(have, st.want) {
+			t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v", i, have, st.want)
+		}
+	}
+}
+
+func TestModuleRecomputeBound(t *testing.T) {
+	m := NewModule()
+	m.Code = InstructionList{
+		&OpTypeInt{ResultId: 1, Width: 32},
+		&OpConstant{ResultType: 1, ResultId: 3, Value: []uint32{1}},
+		&OpName{Target: 9},
+	}
+
+	var out bytes.Buffer
+	err := m.Save(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Header.Bound != 10 {
+		t.Fatalf("bound mismatch:\nHave: %d\nWant: %d", m.Header.Bound, 10)
+	}
+
+	modb, err := Load(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if modb.Header.Bound != 10 {
+		t.Fatalf("saved bound mismatch:\nHave: %d\nWant: %d", modb.Header.Bound, 10)
+	}
+
+	// A larger bound is kept by Save, but not by RecomputeBound.
+	m.Header.Bound = 20
+
+	err = m.Save(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Header.Bound != 20 {
+		t.Fatalf("bound mismatch:\nHave: %d\nWant: %d", m.Header.Bound, 20)
+	}
+
+	m.RecomputeBound()
+
+	if m.Header.Bound != 10 {
+		t.Fatalf("bound mismatch:\nHave: %d\nWant: %d", m.Header.Bound, 10)
+	}
+}
+
+func TestIdAllocator(t *testing.T) {
+	m := NewModule()
+	m.Header.Bound = 2
+	m.Code = InstructionList{
+		&OpTypeInt{ResultId: 1, Width: 32},
+		&OpConstant{ResultType: 1, ResultId: 3, Value: []uint32{1}},
+	}
+
+	a := m.IdAllocator()
+
+	for i, want := range []Id{4, 5, 6} {
+		have := a.Next()
+		if have != want {
+			t.Fatalf("case %d: id mismatch:\nHave: %d\nWant: %d", i, have, want)
+		}
+	}
+
+	if m.Header.Bound != 7 {
+		t.Fatalf("bound mismatch:\nHave: %d\nWant: %d", m.Header.Bound, 7)
+	}
+}
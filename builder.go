@@ -0,0 +1,478 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// Builder constructs a module programmatically.
+//
+// It allocates ids, reuses existing declarations of types and constants
+// and places each instruction in the section of the logical layout it
+// belongs in, regardless of the order in which they are added. Adding
+// an instruction where it does not belong panics with ErrBuilderPlacement.
+type Builder struct {
+	next         Id
+	sections     [sectionFunctionDeclaration][]Instruction
+	decls        map[string]Id // Types and constants, by instructionKey.
+	capabilities map[Capability]bool
+	imports      map[String]Id
+	functions    []*FunctionBuilder
+}
+
+// NewBuilder creates a builder for a module with the given
+// addressing and memory model.
+func NewBuilder(am AddressingModel, mm MemoryModel) *Builder {
+	b := &Builder{
+		next:         1,
+		decls:        make(map[string]Id),
+		capabilities: make(map[Capability]bool),
+		imports:      make(map[String]Id),
+	}
+
+	b.Add(&OpMemoryModel{AddressingModel: am, MemoryModel: mm})
+	return b
+}
+
+// Id returns a fresh id.
+func (b *Builder) Id() Id {
+	id := b.next
+	b.next++
+	return id
+}
+
+// assign gives the instruction a fresh result id, if it defines one
+// which has not been set yet. Returns the instruction's result id.
+func (b *Builder) assign(instr Instruction) Id {
+	id, ok := ResultId(instr)
+	if ok && id == 0 {
+		id = b.Id()
+		SetResultId(instr, id)
+	}
+	return id
+}
+
+// Add adds an instruction which belongs outside of functions to the
+// appropriate section of the module. If it defines a result id which
+// is 0, a fresh id is assigned. Returns the result id, or 0 if the
+// instruction defines none.
+//
+// Types and constants are not reused; use Type and Constant for that.
+func (b *Builder) Add(instr Instruction) Id {
+	section, ok := layoutSectionOf(instr.Opcode())
+	if !ok || section >= sectionFunctionDeclaration {
+		panic(ErrBuilderPlacement)
+	}
+
+	id := b.assign(instr)
+	b.sections[section] = append(b.sections[section], instr)
+	return id
+}
+
+// Module returns the module built so far. Functions without blocks are
+// declarations, which precede all function definitions. The header's
+// bound is set to one more than the largest allocated id.
+func (b *Builder) Module() *Module {
+	m := NewModule()
+
+	for _, code := range b.sections {
+		m.Code = append(m.Code, code...)
+	}
+
+	for _, body := range []bool{false, true} {
+		for _, fn := range b.functions {
+			if (len(fn.blocks) > 0) == body {
+				m.Code = fn.appendCode(m.Code)
+			}
+		}
+	}
+
+	m.Header.Bound = uint32(b.next)
+	return m
+}
+
+// Capability declares the given capability. Each capability is
+// declared only once.
+func (b *Builder) Capability(c Capability) {
+	if !b.capabilities[c] {
+		b.capabilities[c] = true
+		b.Add(&OpCapability{Capability: c})
+	}
+}
+
+// Extension declares the use of the named extension.
+func (b *Builder) Extension(name String) {
+	b.Add(&OpExtension{Name: name})
+}
+
+// ExtInstImport imports the named extended instruction set and returns
+// its id. Each set is imported only once.
+func (b *Builder) ExtInstImport(name String) Id {
+	id, ok := b.imports[name]
+	if !ok {
+		id = b.Add(&OpExtInstImport{Name: name})
+		b.imports[name] = id
+	}
+	return id
+}
+
+// EntryPoint declares fn as an entry point with the given execution model
+// and name. Interface lists the global variables it uses for input and
+// output.
+func (b *Builder) EntryPoint(model ExecutionModel, fn *FunctionBuilder, name String, iface ...Id) {
+	b.Add(&OpEntryPoint{
+		ExecutionModel: model,
+		EntryPoint:     fn.Id,
+		Name:           name,
+		Interface:      iface,
+	})
+}
+
+// ExecutionMode declares an execution mode for the given entry point.
+func (b *Builder) ExecutionMode(fn *FunctionBuilder, mode ExecutionMode, argv ...uint32) {
+	b.Add(&OpExecutionMode{EntryPoint: fn.Id, Mode: mode, Argv: argv})
+}
+
+// Name assigns a debug name to the given id.
+func (b *Builder) Name(target Id, name String) {
+	b.Add(&OpName{Target: target, Name: name})
+}
+
+// MemberName assigns a debug name to a member of the given struct type.
+func (b *Builder) MemberName(typ Id, member uint32, name String) {
+	b.Add(&OpMemberName{Type: typ, Member: member, Name: name})
+}
+
+// Decorate applies a decoration to the given id.
+func (b *Builder) Decorate(target Id, d Decoration, argv ...uint32) {
+	b.Add(&OpDecorate{Target: target, Decoration: d, Argv: argv})
+}
+
+// MemberDecorate applies a decoration to a member of the given struct type.
+func (b *Builder) MemberDecorate(typ Id, member uint32, d Decoration, argv ...uint32) {
+	b.Add(&OpMemberDecorate{StructType: typ, Member: member, Decoration: d, Argv: argv})
+}
+
+// Type adds the given type declaration, unless the same type has been
+// declared already. Returns the id of the type. Struct types are always
+// added, since they are distinct from all other types.
+func (b *Builder) Type(instr Instruction) Id {
+	return b.declare(instr, typeKey)
+}
+
+// Constant adds the given constant, unless the same constant has been
+// declared already. Returns the id of the constant. Specialization
+// constants are always added.
+func (b *Builder) Constant(instr Instruction) Id {
+	return b.declare(instr, func(instr Instruction) (string, bool) {
+		switch instr.Opcode() {
+		case opcodeConstantTrue, opcodeConstantFalse, opcodeConstant,
+			opcodeConstantComposite, opcodeConstantNull:
+			// The result id follows the result type.
+			return instructionKey(instr, 2)
+		}
+		return "", false
+	})
+}
+
+// declare adds the given declaration, unless one with the same key
+// exists already. Returns the id of the declaration.
+func (b *Builder) declare(instr Instruction, key func(Instruction) (string, bool)) Id {
+	// The key does not include the result id,
+	// so it may still be 0 here.
+	k, ok := key(instr)
+	if !ok {
+		return b.Add(instr)
+	}
+
+	id, dup := b.decls[k]
+	if !dup {
+		id = b.Add(instr)
+		b.decls[k] = id
+	}
+
+	return id
+}
+
+// TypeVoid returns the void type.
+func (b *Builder) TypeVoid() Id { return b.Type(&OpTypeVoid{}) }
+
+// TypeBool returns the boolean type.
+func (b *Builder) TypeBool() Id { return b.Type(&OpTypeBool{}) }
+
+// TypeInt returns the integer type with the given width and signedness.
+func (b *Builder) TypeInt(width uint32, signed bool) Id {
+	var s uint32
+	if signed {
+		s = 1
+	}
+	return b.Type(&OpTypeInt{Width: width, Signedness: s})
+}
+
+// TypeFloat returns the float type with the given width.
+func (b *Builder) TypeFloat(width uint32) Id {
+	return b.Type(&OpTypeFloat{Width: width})
+}
+
+// TypeVector returns the vector type with n components of the given type.
+func (b *Builder) TypeVector(component Id, n uint32) Id {
+	return b.Type(&OpTypeVector{ComponentType: component, ComponentCount: n})
+}
+
+// TypeMatrix returns the matrix type with n columns of the given type.
+func (b *Builder) TypeMatrix(column Id, n uint32) Id {
+	return b.Type(&OpTypeMatrix{ColumnType: column, ColumnCount: n})
+}
+
+// TypeArray returns the array type with elements of the given type.
+// Length is the id of an integer constant.
+func (b *Builder) TypeArray(elem, length Id) Id {
+	return b.Type(&OpTypeArray{ElementType: elem, Length: length})
+}
+
+// TypeRuntimeArray returns the run-time array type with elements
+// of the given type.
+func (b *Builder) TypeRuntimeArray(elem Id) Id {
+	return b.Type(&OpTypeRuntimeArray{ElementType: elem})
+}
+
+// TypeStruct declares a new struct type with the given member types.
+func (b *Builder) TypeStruct(members ...Id) Id {
+	return b.Type(&OpTypeStruct{Members: members})
+}
+
+// TypePointer returns the type of pointers to the given type,
+// in the given storage class.
+func (b *Builder) TypePointer(sc StorageClass, elem Id) Id {
+	return b.Type(&OpTypePointer{StorageClass: sc, Type: elem})
+}
+
+// TypeFunction returns the function type with the given return
+// and parameter types.
+func (b *Builder) TypeFunction(ret Id, params ...Id) Id {
+	return b.Type(&OpTypeFunction{ReturnType: ret, Parameters: params})
+}
+
+// ConstantBool returns a boolean constant of the given type.
+func (b *Builder) ConstantBool(typ Id, v bool) Id {
+	if v {
+		return b.Constant(&OpConstantTrue{ResultType: typ})
+	}
+	return b.Constant(&OpConstantFalse{ResultType: typ})
+}
+
+// ConstantValue returns a scalar constant of the given numerical type.
+// The value is given as its words, low-order word first.
+func (b *Builder) ConstantValue(typ Id, value ...uint32) Id {
+	return b.Constant(&OpConstant{ResultType: typ, Value: value})
+}
+
+// ConstantComposite returns a composite constant of the given type.
+func (b *Builder) ConstantComposite(typ Id, constituents ...Id) Id {
+	return b.Constant(&OpConstantComposite{ResultType: typ, Constituents: constituents})
+}
+
+// Variable declares a global variable with the given pointer type and
+// storage class. Init is the id of its initializer, or 0 if there is none.
+func (b *Builder) Variable(typ Id, sc StorageClass, init Id) Id {
+	return b.Add(&OpVariable{ResultType: typ, StorageClass: sc, Initializer: init})
+}
+
+// FunctionBuilder constructs a single function.
+type FunctionBuilder struct {
+	b      *Builder
+	Id     Id   // Result id of the function.
+	Params []Id // Result ids of the parameters.
+
+	decl      *OpFunction
+	params    []Instruction
+	variables []Instruction
+	blocks    []*BlockBuilder
+}
+
+// Function adds a function with the given return and parameter types.
+// It is a declaration, unless blocks are added to it.
+func (b *Builder) Function(ret Id, control FunctionControl, params ...Id) *FunctionBuilder {
+	fn := &FunctionBuilder{
+		b: b,
+		decl: &OpFunction{
+			ResultType:      ret,
+			FunctionControl: control,
+			FunctionType:    b.TypeFunction(ret, params...),
+		},
+	}
+
+	fn.Id = b.assign(fn.decl)
+
+	for _, typ := range params {
+		p := &OpFunctionParameter{ResultType: typ}
+		fn.Params = append(fn.Params, b.assign(p))
+		fn.params = append(fn.params, p)
+	}
+
+	b.functions = append(b.functions, fn)
+	return fn
+}
+
+// Variable declares a variable in the Function storage class, with the
+// given pointer type. Init is the id of its initializer, or 0 if there
+// is none. Variables are placed at the start of the first block.
+func (fn *FunctionBuilder) Variable(typ Id, init Id) Id {
+	v := &OpVariable{ResultType: typ, StorageClass: StorageClassFunction, Initializer: init}
+	fn.variables = append(fn.variables, v)
+	return fn.b.assign(v)
+}
+
+// Block adds a new block to the function. The first block is the
+// function's entry block.
+func (fn *FunctionBuilder) Block() *BlockBuilder {
+	blk := &BlockBuilder{b: fn.b, Label: fn.b.Id()}
+	fn.blocks = append(fn.blocks, blk)
+	return blk
+}
+
+// appendCode appends the code for the function to out.
+func (fn *FunctionBuilder) appendCode(out []Instruction) []Instruction {
+	out = append(out, fn.decl)
+	out = append(out, fn.params...)
+
+	for i, blk := range fn.blocks {
+		out = append(out, &OpLabel{ResultId: blk.Label})
+
+		if i == 0 {
+			out = append(out, fn.variables...)
+		}
+
+		out = append(out, blk.code...)
+	}
+
+	return append(out, &OpFunctionEnd{})
+}
+
+// BlockBuilder constructs a single block in a function.
+type BlockBuilder struct {
+	b     *Builder
+	Label Id // Result id of the block's label.
+	code  []Instruction
+}
+
+// Add appends an instruction to the block. If it defines a result id
+// which is 0, a fresh id is assigned. Returns the result id, or 0 if
+// the instruction defines none.
+//
+// Labels, function boundaries and instructions which belong outside
+// of functions can not be added.
+func (blk *BlockBuilder) Add(instr Instruction) Id {
+	switch opcode := instr.Opcode(); opcode {
+	case opcodeLabel, opcodeFunction, opcodeFunctionParameter, opcodeFunctionEnd, opcodeVariable:
+		panic(ErrBuilderPlacement)
+
+	case opcodeLine, opcodeNoLine, opcodeUndef:
+
+	default:
+		if _, ok := layoutSectionOf(opcode); ok {
+			panic(ErrBuilderPlacement)
+		}
+	}
+
+	id := blk.b.assign(instr)
+	blk.code = append(blk.code, instr)
+	return id
+}
+
+// IAdd adds two integer values of the given type.
+func (blk *BlockBuilder) IAdd(typ, a, b Id) Id {
+	return blk.Add(&OpIAdd{ResultType: typ, Operand1: a, Operand2: b})
+}
+
+// ISub subtracts integer value b from a.
+func (blk *BlockBuilder) ISub(typ, a, b Id) Id {
+	return blk.Add(&OpISub{ResultType: typ, Operand1: a, Operand2: b})
+}
+
+// IMul multiplies two integer values of the given type.
+func (blk *BlockBuilder) IMul(typ, a, b Id) Id {
+	return blk.Add(&OpIMul{ResultType: typ, Operand1: a, Operand2: b})
+}
+
+// FAdd adds two float values of the given type.
+func (blk *BlockBuilder) FAdd(typ, a, b Id) Id {
+	return blk.Add(&OpFAdd{ResultType: typ, Operand1: a, Operand2: b})
+}
+
+// FSub subtracts float value b from a.
+func (blk *BlockBuilder) FSub(typ, a, b Id) Id {
+	return blk.Add(&OpFSub{ResultType: typ, Operand1: a, Operand2: b})
+}
+
+// FMul multiplies two float values of the given type.
+func (blk *BlockBuilder) FMul(typ, a, b Id) Id {
+	return blk.Add(&OpFMul{ResultType: typ, Operand1: a, Operand2: b})
+}
+
+// FDiv divides float value a by b.
+func (blk *BlockBuilder) FDiv(typ, a, b Id) Id {
+	return blk.Add(&OpFDiv{ResultType: typ, Operand1: a, Operand2: b})
+}
+
+// Load loads a value of the given type through a pointer.
+func (blk *BlockBuilder) Load(typ, ptr Id) Id {
+	return blk.Add(&OpLoad{ResultType: typ, Pointer: ptr})
+}
+
+// Store stores a value through a pointer.
+func (blk *BlockBuilder) Store(ptr, value Id) {
+	blk.Add(&OpStore{Pointer: ptr, Object: value})
+}
+
+// AccessChain returns a pointer of the given type to an element
+// of the composite object pointed to by base.
+func (blk *BlockBuilder) AccessChain(typ, base Id, indices ...Id) Id {
+	return blk.Add(&OpAccessChain{ResultType: typ, Base: base, Indices: indices})
+}
+
+// CompositeConstruct constructs a composite value of the given type.
+func (blk *BlockBuilder) CompositeConstruct(typ Id, constituents ...Id) Id {
+	return blk.Add(&OpCompositeConstruct{ResultType: typ, Constituents: constituents})
+}
+
+// CompositeExtract extracts a part of a composite value.
+func (blk *BlockBuilder) CompositeExtract(typ, composite Id, indices ...uint32) Id {
+	return blk.Add(&OpCompositeExtract{ResultType: typ, Composite: composite, Indices: indices})
+}
+
+// FunctionCall calls the given function.
+func (blk *BlockBuilder) FunctionCall(fn *FunctionBuilder, args ...Id) Id {
+	return blk.Add(&OpFunctionCall{ResultType: fn.decl.ResultType, Function: fn.Id, Argv: args})
+}
+
+// SelectionMerge declares the merge block of a selection construct.
+// It must directly precede a BranchConditional.
+func (blk *BlockBuilder) SelectionMerge(merge *BlockBuilder, control SelectionControl) {
+	blk.Add(&OpSelectionMerge{MergeBlock: merge.Label, SelectionControl: control})
+}
+
+// LoopMerge declares the merge block and continue target of a loop.
+// It must directly precede a Branch or BranchConditional.
+func (blk *BlockBuilder) LoopMerge(merge, cont *BlockBuilder, control LoopControl) {
+	blk.Add(&OpLoopMerge{MergeBlock: merge.Label, ContinueTarget: cont.Label, LoopControl: control})
+}
+
+// Branch ends the block with a branch to the given block.
+func (blk *BlockBuilder) Branch(target *BlockBuilder) {
+	blk.Add(&OpBranch{TargetLabel: target.Label})
+}
+
+// BranchConditional ends the block with a branch to t if cond is true,
+// or to f otherwise.
+func (blk *BlockBuilder) BranchConditional(cond Id, t, f *BlockBuilder) {
+	blk.Add(&OpBranchConditional{Condition: cond, TrueLabel: t.Label, FalseLabel: f.Label})
+}
+
+// Return ends the block with a return from a void function.
+func (blk *BlockBuilder) Return() {
+	blk.Add(&OpReturn{})
+}
+
+// ReturnValue ends the block with a return of the given value.
+func (blk *BlockBuilder) ReturnValue(v Id) {
+	blk.Add(&OpReturnValue{Value: v})
+}
@@ -0,0 +1,106 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder(AddressingModelLogical, MemoryModelGLSL450)
+
+	void := b.TypeVoid()
+	main := b.Function(void, FunctionControlNone)
+	b.EntryPoint(ExecutionModelFragment, main, "main")
+	b.ExecutionMode(main, ExecutionModeOriginUpperLeft)
+	b.Name(main.Id, "main")
+	b.Capability(CapabilityShader)
+	b.Capability(CapabilityShader)
+
+	i32 := b.TypeInt(32, true)
+	if b.TypeInt(32, true) != i32 {
+		t.Fatalf("TypeInt returned a new id for the same type")
+	}
+
+	one := b.ConstantValue(i32, 1)
+	if b.ConstantValue(i32, 1) != one {
+		t.Fatalf("ConstantValue returned a new id for the same constant")
+	}
+
+	ptr := b.TypePointer(StorageClassFunction, i32)
+	v := main.Variable(ptr, 0)
+
+	entry := main.Block()
+	exit := main.Block()
+
+	sum := entry.IAdd(i32, one, one)
+	entry.Store(v, sum)
+	entry.Branch(exit)
+
+	exit.Load(i32, v)
+	exit.Return()
+
+	m := b.Module()
+
+	err := m.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := Assemble(strings.NewReader(`
+		     OpCapability Shader
+		     OpMemoryModel Logical GLSL450
+		     OpEntryPoint Fragment %3 "main"
+		     OpExecutionMode %3 OriginUpperLeft
+		     OpName %3 "main"
+		%1 = OpTypeVoid
+		%2 = OpTypeFunction %1
+		%4 = OpTypeInt 32 1
+		%5 = OpConstant %4 1
+		%6 = OpTypePointer Function %4
+		%3 = OpFunction %1 None %2
+		%8 = OpLabel
+		%7 = OpVariable %6 Function
+		%10 = OpIAdd %4 %5 %5
+		     OpStore %7 %10
+		     OpBranch %9
+		%9 = OpLabel
+		%11 = OpLoad %4 %7
+		     OpReturn
+		     OpFunctionEnd
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(m, want) {
+		t.Fatalf("module mismatch:\nHave: %v\nWant: %v", m.Code, want.Code)
+	}
+}
+
+func TestBuilderPlacement(t *testing.T) {
+	b := NewBuilder(AddressingModelLogical, MemoryModelGLSL450)
+	blk := b.Function(b.TypeVoid(), FunctionControlNone).Block()
+
+	for i, fn := range []func(){
+		func() { b.Add(&OpIAdd{}) },
+		func() { b.Add(&OpFunction{}) },
+		func() { blk.Add(&OpTypeInt{}) },
+		func() { blk.Add(&OpLabel{}) },
+		func() { blk.Add(&OpVariable{}) },
+	} {
+		func() {
+			defer func() {
+				err := recover()
+				if err != ErrBuilderPlacement {
+					t.Fatalf("case %d: panic mismatch:\nHave: %v\nWant: %v", i, err, ErrBuilderPlacement)
+				}
+			}()
+
+			fn()
+		}()
+	}
+}
@@ -0,0 +1,245 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// Block defines a basic block in a function's control flow graph.
+type Block struct {
+	// Label is the result id of the block's OpLabel instruction.
+	Label Id
+
+	// Address is the index of the OpLabel instruction in the
+	// function's instruction list.
+	Address int
+
+	// Code holds the block's instructions, from the OpLabel up to
+	// and including the terminating instruction.
+	Code InstructionList
+
+	// Successors lists the blocks this block may branch to.
+	// Predecessors lists the blocks which may branch to this one.
+	// Each block appears at most once in either list.
+	Successors   []*Block
+	Predecessors []*Block
+}
+
+// Terminator returns the instruction which ends the block.
+func (b *Block) Terminator() Instruction {
+	return b.Code[len(b.Code)-1]
+}
+
+// CFG defines the control flow graph for a single function.
+type CFG struct {
+	// Blocks holds all blocks in the order in which they appear
+	// in the function. The first one is the entry block.
+	Blocks []*Block
+
+	labels map[Id]*Block
+}
+
+// NewCFG builds the control flow graph for the given function.
+//
+// The instruction list is expected to hold a single function, as returned
+// by InstructionList.Functions. Edges are derived from the targets of
+// each block's terminating instruction. Merge and continue targets of
+// structured control flow do not define edges.
+//
+// A function declaration without any blocks yields an empty graph.
+// Returns a *LayoutError, with an address relative to the start of the
+// given list, if the blocks are malformed.
+func NewCFG(fn InstructionList) (*CFG, error) {
+	c := &CFG{
+		labels: make(map[Id]*Block),
+	}
+
+	var cur *Block
+
+	for addr, instr := range fn {
+		opcode := instr.Opcode()
+
+		if cur == nil {
+			switch opcode {
+			case opcodeLabel:
+				label := instr.(*OpLabel).ResultId
+
+				_, ok := c.labels[label]
+				if ok {
+					return nil, NewLayoutError(addr, "duplicate block label %d", label)
+				}
+
+				cur = &Block{Label: label, Address: addr}
+				c.labels[label] = cur
+				c.Blocks = append(c.Blocks, cur)
+
+			case opcodeFunction, opcodeFunctionParameter, opcodeFunctionEnd,
+				opcodeLine, opcodeNoLine:
+
+			default:
+				return nil, NewLayoutError(addr, "instruction outside of a block")
+			}
+
+			continue
+		}
+
+		switch {
+		case opcode == opcodeLabel, opcode == opcodeFunctionEnd:
+			return nil, NewLayoutError(cur.Address, "block %d has no terminator", cur.Label)
+
+		case isTerminator(opcode):
+			cur.Code = fn[cur.Address : addr+1]
+			cur = nil
+		}
+	}
+
+	if cur != nil {
+		return nil, NewLayoutError(cur.Address, "block %d has no terminator", cur.Label)
+	}
+
+	for _, b := range c.Blocks {
+		for _, label := range branchTargets(b.Terminator()) {
+			target, ok := c.labels[label]
+			if !ok {
+				return nil, NewLayoutError(b.Address+len(b.Code)-1,
+					"branch to unknown label %d", label)
+			}
+
+			if !hasBlock(b.Successors, target) {
+				b.Successors = append(b.Successors, target)
+				target.Predecessors = append(target.Predecessors, b)
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// Entry returns the function's entry block.
+// Returns nil if the function has no blocks.
+func (c *CFG) Entry() *Block {
+	if len(c.Blocks) == 0 {
+		return nil
+	}
+	return c.Blocks[0]
+}
+
+// Block returns the block with the given label.
+// Returns nil if there is no such block.
+func (c *CFG) Block(label Id) *Block {
+	return c.labels[label]
+}
+
+// Postorder returns all blocks which are reachable from the entry block,
+// in depth-first postorder. Successors are visited in the order in which
+// they appear in the terminating instruction.
+func (c *CFG) Postorder() []*Block {
+	entry := c.Entry()
+	if entry == nil {
+		return nil
+	}
+
+	out := make([]*Block, 0, len(c.Blocks))
+	seen := make(map[*Block]bool, len(c.Blocks))
+
+	var visit func(*Block)
+	visit = func(b *Block) {
+		seen[b] = true
+
+		for _, s := range b.Successors {
+			if !seen[s] {
+				visit(s)
+			}
+		}
+
+		out = append(out, b)
+	}
+
+	visit(entry)
+	return out
+}
+
+// ReversePostorder returns all blocks which are reachable from the entry
+// block, in reverse depth-first postorder. Each block appears before its
+// successors, except where the successor is reached through a back edge.
+func (c *CFG) ReversePostorder() []*Block {
+	out := c.Postorder()
+
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+
+	return out
+}
+
+// function defines the control flow of a single function in a module.
+type function struct {
+	start int // Address of the OpFunction instruction.
+	end   int // Address of the OpFunctionEnd instruction.
+	cfg   *CFG
+	dom   *DomTree
+}
+
+// functions builds the control flow graph and dominator tree for
+// each function in the module. Returns an error if the module holds
+// an OpSwitch with a selector wider than 32 bits, or if a function's
+// blocks are malformed.
+func (m *Module) functions() ([]*function, error) {
+	if err := m.verifySwitchSelectors(); err != nil {
+		return nil, err
+	}
+
+	fstart, fend := m.Code.functionRanges()
+	out := make([]*function, len(fstart))
+
+	for i, fs := range fstart {
+		cfg, err := NewCFG(m.Code[fs : fend[i]+1])
+		if err != nil {
+			if le, ok := err.(*LayoutError); ok {
+				le.Address += fs
+			}
+			return nil, err
+		}
+
+		out[i] = &function{
+			start: fs,
+			end:   fend[i],
+			cfg:   cfg,
+			dom:   NewDomTree(cfg),
+		}
+	}
+
+	return out, nil
+}
+
+// branchTargets returns the labels targeted by the given terminator.
+func branchTargets(instr Instruction) []Id {
+	switch v := instr.(type) {
+	case *OpBranch:
+		return []Id{v.TargetLabel}
+
+	case *OpBranchConditional:
+		return []Id{v.TrueLabel, v.FalseLabel}
+
+	case *OpSwitch:
+		out := []Id{v.Default}
+
+		// Targets are (literal, label) pairs. Wider literals are
+		// rejected by verifySwitchSelectors.
+		for i := 1; i < len(v.Target); i += 2 {
+			out = append(out, Id(v.Target[i]))
+		}
+
+		return out
+	}
+
+	return nil
+}
+
+// hasBlock returns true if set contains b.
+func hasBlock(set []*Block, b *Block) bool {
+	for _, v := range set {
+		if v == b {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,295 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type CFGTest struct {
+	src    string
+	blocks []Id        // Block labels in order of appearance.
+	succ   map[Id][]Id // Successors by block label.
+	pred   map[Id][]Id // Predecessors by block label.
+	rpo    []Id        // Block labels in reverse postorder.
+	err    error
+}
+
+func TestCFG(t *testing.T) {
+	for i, st := range []CFGTest{
+		{
+			// Function declaration.
+			src: `
+				%1 = OpFunction %2 None %3
+				     OpFunctionEnd`,
+		},
+		{
+			src: `
+				%1 = OpFunction %2 None %3
+				%4 = OpFunctionParameter %2
+				%10 = OpLabel
+				     OpBranch %11
+				%11 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+			blocks: []Id{10, 11},
+			succ:   map[Id][]Id{10: {11}},
+			pred:   map[Id][]Id{11: {10}},
+			rpo:    []Id{10, 11},
+		},
+		{
+			// Loop with a back edge.
+			src: `
+				%1 = OpFunction %2 None %3
+				%10 = OpLabel
+				     OpBranch %11
+				%11 = OpLabel
+				     OpLoopMerge %13 %12 None
+				     OpBranchConditional %5 %12 %13
+				%12 = OpLabel
+				     OpBranch %11
+				%13 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+			blocks: []Id{10, 11, 12, 13},
+			succ:   map[Id][]Id{10: {11}, 11: {12, 13}, 12: {11}},
+			pred:   map[Id][]Id{11: {10, 12}, 12: {11}, 13: {11}},
+			rpo:    []Id{10, 11, 13, 12},
+		},
+		{
+			// Switch with a repeated target.
+			src: `
+				%1 = OpFunction %2 None %3
+				%10 = OpLabel
+				     OpSelectionMerge %13 None
+				     OpSwitch %5 %13 1 %11 2 %12 3 %11
+				%11 = OpLabel
+				     OpBranch %13
+				%12 = OpLabel
+				     OpBranch %13
+				%13 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+			blocks: []Id{10, 11, 12, 13},
+			succ:   map[Id][]Id{10: {13, 11, 12}, 11: {13}, 12: {13}},
+			pred:   map[Id][]Id{11: {10}, 12: {10}, 13: {10, 11, 12}},
+			rpo:    []Id{10, 12, 11, 13},
+		},
+		{
+			// Unreachable block.
+			src: `
+				%1 = OpFunction %2 None %3
+				%10 = OpLabel
+				     OpReturn
+				%11 = OpLabel
+				     OpBranch %10
+				     OpFunctionEnd`,
+			blocks: []Id{10, 11},
+			succ:   map[Id][]Id{11: {10}},
+			pred:   map[Id][]Id{10: {11}},
+			rpo:    []Id{10},
+		},
+		{
+			src: `
+				%1 = OpFunction %2 None %3
+				%10 = OpLabel
+				%11 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+			err: NewLayoutError(1, "block 10 has no terminator"),
+		},
+		{
+			src: `
+				%1 = OpFunction %2 None %3
+				%10 = OpLabel
+				     OpNop
+				     OpFunctionEnd`,
+			err: NewLayoutError(1, "block 10 has no terminator"),
+		},
+		{
+			src: `
+				%1 = OpFunction %2 None %3
+				%10 = OpLabel
+				     OpReturn
+				     OpNop
+				     OpFunctionEnd`,
+			err: NewLayoutError(3, "instruction outside of a block"),
+		},
+		{
+			src: `
+				%1 = OpFunction %2 None %3
+				%10 = OpLabel
+				     OpBranch %10
+				%10 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+			err: NewLayoutError(3, "duplicate block label 10"),
+		},
+		{
+			src: `
+				%1 = OpFunction %2 None %3
+				%10 = OpLabel
+				     OpBranchConditional %5 %10 %20
+				     OpFunctionEnd`,
+			err: NewLayoutError(2, "branch to unknown label 20"),
+		},
+	} {
+		mod, err := Assemble(strings.NewReader(st.src))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		cfg, err := NewCFG(mod.Code)
+		if !reflect.DeepEqual(err, st.err) {
+			t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v", i, err, st.err)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		have := blockLabels(cfg.Blocks)
+		if !reflect.DeepEqual(have, st.blocks) {
+			t.Fatalf("case %d: blocks mismatch:\nHave: %v\nWant: %v", i, have, st.blocks)
+		}
+
+		for _, b := range cfg.Blocks {
+			if cfg.Block(b.Label) != b {
+				t.Fatalf("case %d: block %d lookup mismatch", i, b.Label)
+			}
+
+			if b.Code[0] != mod.Code[b.Address] || b.Terminator() != mod.Code[b.Address+len(b.Code)-1] {
+				t.Fatalf("case %d: block %d code mismatch", i, b.Label)
+			}
+
+			have := blockLabels(b.Successors)
+			if !reflect.DeepEqual(have, st.succ[b.Label]) {
+				t.Fatalf("case %d: block %d successors mismatch:\nHave: %v\nWant: %v",
+					i, b.Label, have, st.succ[b.Label])
+			}
+
+			have = blockLabels(b.Predecessors)
+			if !reflect.DeepEqual(have, st.pred[b.Label]) {
+				t.Fatalf("case %d: block %d predecessors mismatch:\nHave: %v\nWant: %v",
+					i, b.Label, have, st.pred[b.Label])
+			}
+		}
+
+		have = blockLabels(cfg.ReversePostorder())
+		if !reflect.DeepEqual(have, st.rpo) {
+			t.Fatalf("case %d: reverse postorder mismatch:\nHave: %v\nWant: %v", i, have, st.rpo)
+		}
+	}
+}
+
+func TestInstructionListBlocks(t *testing.T) {
+	for i, st := range []struct {
+		src  string
+		want [][]Id // Result ids of each block's instructions.
+	}{
+		{
+			src: `
+				%1 = OpFunction %2 None %3
+				%10 = OpLabel
+				     OpSelectionMerge %12 None
+				     OpBranchConditional %5 %11 %12
+				%11 = OpLabel
+				%20 = OpIAdd %6 %7 %7
+				     OpKill
+				%12 = OpLabel
+				     OpReturn
+				     OpFunctionEnd
+				%30 = OpFunction %2 None %3
+				%31 = OpLabel
+				     OpUnreachable
+				     OpFunctionEnd`,
+			want: [][]Id{{10, 0, 0}, {11, 20, 0}, {12, 0}, {31, 0}},
+		},
+		{
+			src: `
+				%10 = OpLabel
+				     OpBranch %11
+				%11 = OpLabel
+				     OpReturnValue %5`,
+			want: [][]Id{{10, 0}, {11, 0}},
+		},
+		{
+			src: `
+				%1 = OpFunction %2 None %3
+				%10 = OpLabel
+				     OpFunctionEnd`,
+		},
+	} {
+		mod, err := Assemble(strings.NewReader(st.src))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		var have [][]Id
+		for _, b := range mod.Code.Blocks() {
+			var ids []Id
+			for _, instr := range b {
+				id, _ := ResultId(instr)
+				ids = append(ids, id)
+			}
+			have = append(have, ids)
+		}
+
+		if !reflect.DeepEqual(have, st.want) {
+			t.Fatalf("case %d: blocks mismatch:\nHave: %v\nWant: %v", i, have, st.want)
+		}
+	}
+}
+
+func TestModuleFunctionsWideSwitch(t *testing.T) {
+	m, err := Assemble(strings.NewReader(`
+		%1 = OpTypeInt 32 0
+		%2 = OpTypeVoid
+		%3 = OpTypeFunction %2
+		%4 = OpUndef %1
+		%10 = OpFunction %2 None %3
+		%11 = OpLabel
+		     OpSelectionMerge %14 None
+		     OpSwitch %4 %14 1 %12 2 %13
+		%12 = OpLabel
+		     OpBranch %14
+		%13 = OpLabel
+		     OpBranch %14
+		%14 = OpLabel
+		     OpReturn
+		     OpFunctionEnd`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A 64-bit selector makes each case literal two words wide.
+	m.Code[0].(*OpTypeInt).Width = 64
+	m.Code[7].(*OpSwitch).Target = []uint32{1, 0, 12, 2, 0, 13}
+
+	want := ErrorList{
+		NewLayoutError(7, "OpSwitch selector 4 is 64 bits wide; only selectors of up to 32 bits are supported"),
+	}
+
+	_, have := m.functions()
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("functions error mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+
+	have = m.verifyFunctionStructure()
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("structure error mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+}
+
+// blockLabels returns the labels for the given blocks.
+// Returns nil for an empty list.
+func blockLabels(set []*Block) []Id {
+	var out []Id
+	for _, b := range set {
+		out = append(out, b.Label)
+	}
+	return out
+}
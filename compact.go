@@ -0,0 +1,58 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// CompactIds renumbers all ids in the module densely, starting at 1, in
+// the order in which they are defined. All uses are rewritten to match,
+// including ids in enumerant parameters. Header.Bound is set to one more
+// than the largest new id.
+//
+// Ids which are used, but never defined, are numbered after all defined
+// ids, in the order in which they are first used. The result depends only
+// on the order of the instructions, so compacting a module twice yields
+// the same ids.
+func (m *Module) CompactIds() {
+	ids := make(map[Id]Id)
+	next := Id(1)
+
+	add := func(id Id) {
+		if _, ok := ids[id]; !ok && id != 0 {
+			ids[id] = next
+			next++
+		}
+	}
+
+	for _, instr := range m.Code {
+		id, ok := ResultId(instr)
+		if ok {
+			add(id)
+		}
+	}
+
+	var operands []Id
+	for _, instr := range m.Code {
+		operands = appendOperands(operands[:0], instr)
+		for _, id := range operands {
+			add(id)
+		}
+	}
+
+	rewrite := func(id Id) Id {
+		if n, ok := ids[id]; ok {
+			return n
+		}
+		return id
+	}
+
+	for _, instr := range m.Code {
+		id, ok := ResultId(instr)
+		if ok {
+			SetResultId(instr, rewrite(id))
+		}
+
+		RewriteOperands(instr, rewrite)
+	}
+
+	m.Header.Bound = uint32(next)
+}
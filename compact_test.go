@@ -0,0 +1,68 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestModuleCompactIds(t *testing.T) {
+	m, err := Assemble(strings.NewReader(`
+		      OpCapability Shader
+		      OpMemoryModel Logical GLSL450
+		      OpEntryPoint Fragment %40 "main"
+		      OpName %40 "main"
+		      OpDecorate %31 SpecId 7
+		%10 = OpTypeVoid
+		%12 = OpTypeFunction %10
+		%20 = OpTypeInt 32 1
+		%31 = OpSpecConstant %20 3
+		%40 = OpFunction %10 None %12
+		%41 = OpLabel
+		%50 = OpIAdd %20 %31 %99 ; %99 is not defined.
+		      OpBranch %60
+		%60 = OpLabel
+		      OpReturn
+		      OpFunctionEnd
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := Assemble(strings.NewReader(`
+		     OpCapability Shader
+		     OpMemoryModel Logical GLSL450
+		     OpEntryPoint Fragment %5 "main"
+		     OpName %5 "main"
+		     OpDecorate %4 SpecId 7
+		%1 = OpTypeVoid
+		%2 = OpTypeFunction %1
+		%3 = OpTypeInt 32 1
+		%4 = OpSpecConstant %3 3
+		%5 = OpFunction %1 None %2
+		%6 = OpLabel
+		%7 = OpIAdd %3 %4 %9
+		     OpBranch %8
+		%8 = OpLabel
+		     OpReturn
+		     OpFunctionEnd
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.CompactIds()
+
+	if !reflect.DeepEqual(m, want) {
+		t.Fatalf("module mismatch:\nHave: %v\nWant: %v", m, want)
+	}
+
+	m.CompactIds()
+
+	if !reflect.DeepEqual(m, want) {
+		t.Fatalf("second pass mismatch:\nHave: %v\nWant: %v", m, want)
+	}
+}
@@ -1,65 +1,117 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
 package spirv
 
 import "errors"
 
 var (
-	ErrInvalidAccessQualifier       = errors.New("invalid AccessQualifier value")
-	ErrInvalidAddressingModel       = errors.New("invalid AddressingModel value")
-	ErrInvalidDimensionality        = errors.New("invalid Dimensionality value")
-	ErrInvalidExecutionMode         = errors.New("invalid ExecutionMode value")
-	ErrInvalidExecutionModel        = errors.New("invalid ExecutionModel value")
-	ErrInvalidFPFastMathMode        = errors.New("invalid FPFastMathMode value")
-	ErrInvalidKernelProfilingInfo   = errors.New("invalid KernelProfilingInfo value")
-	ErrInvalidKernelEnqueueFlag     = errors.New("invalid KernelEnqueueFlag value")
-	ErrInvalidGroupOperation        = errors.New("invalid GroupOperation value")
-	ErrInvalidExecutionScope        = errors.New("invalid ExecutionScope value")
-	ErrInvalidMemoryAccess          = errors.New("invalid MemoryAccess value")
-	ErrInvalidMemorySemantic        = errors.New("invalid MemorySemantic value")
-	ErrInvalidFunctionControlMask   = errors.New("invalid FunctionControlMask value")
-	ErrInvalidLoopControl           = errors.New("invalid LoopControl value")
-	ErrInvalidSelectionControl      = errors.New("invalid SelectionControl value")
-	ErrInvalidBuiltin               = errors.New("invalid Builtin value")
-	ErrInvalidDecoration            = errors.New("invalid Decoration value")
-	ErrInvalidFunctionParameter     = errors.New("invalid FunctionParameter value")
-	ErrInvalidStorageClass          = errors.New("invalid StorageClass value")
-	ErrInvalidSourceLanguage        = errors.New("invalid SourceLanguage value")
-	ErrInvalidSamplerFilterMode     = errors.New("invalid SamplerFilterMode value")
-	ErrInvalidSamplerAddressingMode = errors.New("invalid SamplerAddressingMode value")
-	ErrInvalidMemoryModel           = errors.New("invalid MemoryModel value")
-	ErrInvalidLinkageType           = errors.New("invalid LinkageType value")
-	ErrInvalidFPRoundingMode        = errors.New("invalid FPRoundingMode value")
+	ErrInvalidSourceLanguage             = errors.New("invalid SourceLanguage value")
+	ErrInvalidExecutionModel             = errors.New("invalid ExecutionModel value")
+	ErrInvalidAddressingModel            = errors.New("invalid AddressingModel value")
+	ErrInvalidMemoryModel                = errors.New("invalid MemoryModel value")
+	ErrInvalidExecutionMode              = errors.New("invalid ExecutionMode value")
+	ErrInvalidStorageClass               = errors.New("invalid StorageClass value")
+	ErrInvalidDim                        = errors.New("invalid Dim value")
+	ErrInvalidSamplerAddressingMode      = errors.New("invalid SamplerAddressingMode value")
+	ErrInvalidSamplerFilterMode          = errors.New("invalid SamplerFilterMode value")
+	ErrInvalidImageFormat                = errors.New("invalid ImageFormat value")
+	ErrInvalidImageChannelOrder          = errors.New("invalid ImageChannelOrder value")
+	ErrInvalidImageChannelDataType       = errors.New("invalid ImageChannelDataType value")
+	ErrInvalidImageOperands              = errors.New("invalid ImageOperands value")
+	ErrInvalidFPFastMathMode             = errors.New("invalid FPFastMathMode value")
+	ErrInvalidFPRoundingMode             = errors.New("invalid FPRoundingMode value")
+	ErrInvalidLinkageType                = errors.New("invalid LinkageType value")
+	ErrInvalidAccessQualifier            = errors.New("invalid AccessQualifier value")
+	ErrInvalidFunctionParameterAttribute = errors.New("invalid FunctionParameterAttribute value")
+	ErrInvalidDecoration                 = errors.New("invalid Decoration value")
+	ErrInvalidBuiltIn                    = errors.New("invalid BuiltIn value")
+	ErrInvalidSelectionControl           = errors.New("invalid SelectionControl value")
+	ErrInvalidLoopControl                = errors.New("invalid LoopControl value")
+	ErrInvalidFunctionControl            = errors.New("invalid FunctionControl value")
+	ErrInvalidMemorySemantics            = errors.New("invalid MemorySemantics value")
+	ErrInvalidMemoryAccess               = errors.New("invalid MemoryAccess value")
+	ErrInvalidScope                      = errors.New("invalid Scope value")
+	ErrInvalidGroupOperation             = errors.New("invalid GroupOperation value")
+	ErrInvalidKernelEnqueueFlags         = errors.New("invalid KernelEnqueueFlags value")
+	ErrInvalidKernelProfilingInfo        = errors.New("invalid KernelProfilingInfo value")
+	ErrInvalidCapability                 = errors.New("invalid Capability value")
 )
 
-// verifyBitFlag returns true if v is a valid bit flag in the
-// given range. This includes combinations of all possible values.
-func verifyBitFlag(v uint32, none bool, mask uint32) bool {
-	return v == (v&mask) && (none || v != 0)
+type SourceLanguage uint32
+
+func (v SourceLanguage) Verify() error {
+	if sourceLanguageNames.valid(uint32(v)) {
+		return nil
+	}
+	return ErrInvalidSourceLanguage
 }
 
-type AccessQualifier uint32
+// Source Languages define a source language constant.
+const (
+	SourceLanguageUnknown    = 0
+	SourceLanguageESSL       = 1
+	SourceLanguageGLSL       = 2
+	SourceLanguageOpenCL_C   = 3
+	SourceLanguageOpenCL_CPP = 4
+)
 
-func (v AccessQualifier) Verify() error {
-	if v >= AccessQualifierReadOnly && v <= AccessQualifierReadWrite {
+var sourceLanguageNames = &enumTable{
+	names: map[uint32]string{
+		SourceLanguageUnknown:    "Unknown",
+		SourceLanguageESSL:       "ESSL",
+		SourceLanguageGLSL:       "GLSL",
+		SourceLanguageOpenCL_C:   "OpenCL_C",
+		SourceLanguageOpenCL_CPP: "OpenCL_CPP",
+	},
+}
+
+func (v SourceLanguage) String() string { return sourceLanguageNames.String(uint32(v)) }
+
+type ExecutionModel uint32
+
+func (v ExecutionModel) Verify() error {
+	if executionModelNames.valid(uint32(v)) {
 		return nil
 	}
-	return ErrInvalidAccessQualifier
+	return ErrInvalidExecutionModel
 }
 
-// Access Qualifiers define the access permissions of OpTypeSampler
-// and OpTypePipe object.
+// Execution Models define a single execution model.
+// This is used in the EntryPoint instruction to determine what stage of the
+// pipeline a given set of instructions belongs to.
 const (
-	AccessQualifierReadOnly  = 0 // A read-only object.
-	AccessQualifierWriteOnly = 1 // A write-only object.
-	AccessQualifierReadWrite = 2 // A readable and writable object.
+	ExecutionModelVertex                 = 0 // Vertex shading stage
+	ExecutionModelTessellationControl    = 1 // Tessellation control (or hull) shading stage.
+	ExecutionModelTessellationEvaluation = 2 // Tessellation evaluation (or domain) shading stage
+	ExecutionModelGeometry               = 3 // Geometry shading stage.
+	ExecutionModelFragment               = 4 // Fragment shading stage.
+	ExecutionModelGLCompute              = 5 // Graphical compute shading stage.
+	ExecutionModelKernel                 = 6 // Compute kernel.
 )
 
+var executionModelNames = &enumTable{
+	names: map[uint32]string{
+		ExecutionModelVertex:                 "Vertex",
+		ExecutionModelTessellationControl:    "TessellationControl",
+		ExecutionModelTessellationEvaluation: "TessellationEvaluation",
+		ExecutionModelGeometry:               "Geometry",
+		ExecutionModelFragment:               "Fragment",
+		ExecutionModelGLCompute:              "GLCompute",
+		ExecutionModelKernel:                 "Kernel",
+	},
+}
+
+func (v ExecutionModel) String() string { return executionModelNames.String(uint32(v)) }
+
 type AddressingModel uint32
 
 func (v AddressingModel) Verify() error {
-	if v >= AddressingModeLogical && v <= AddressingModePhysical64 {
+	if addressingModelNames.valid(uint32(v)) {
 		return nil
 	}
 	return ErrInvalidAddressingModel
@@ -67,34 +119,51 @@ func (v AddressingModel) Verify() error {
 
 // Addressing Modes define an existing addressing mode.
 const (
-	AddressingModeLogical    = 0
-	AddressingModePhysical32 = 1
-	AddressingModePhysical64 = 2
+	AddressingModelLogical    = 0
+	AddressingModelPhysical32 = 1
+	AddressingModelPhysical64 = 2
 )
 
-type Dimensionality uint32
+var addressingModelNames = &enumTable{
+	names: map[uint32]string{
+		AddressingModelLogical:    "Logical",
+		AddressingModelPhysical32: "Physical32",
+		AddressingModelPhysical64: "Physical64",
+	},
+}
+
+func (v AddressingModel) String() string { return addressingModelNames.String(uint32(v)) }
 
-func (v Dimensionality) Verify() error {
-	if v >= Dim1D && v <= DimBuffer {
+type MemoryModel uint32
+
+func (v MemoryModel) Verify() error {
+	if memoryModelNames.valid(uint32(v)) {
 		return nil
 	}
-	return ErrInvalidDimensionality
+	return ErrInvalidMemoryModel
 }
 
-// Dimensionalities define the dimensionality of a texture.
+// Memory Models define an existing memory model.
 const (
-	Dim1D     = 0
-	Dim2D     = 1
-	Dim3D     = 2
-	DimCube   = 3
-	DimRect   = 4
-	DimBuffer = 5
+	MemoryModelSimple  = 0 // No shared memory consistency issues.
+	MemoryModelGLSL450 = 1 // Memory model needed by later versions of GLSL and ESSL. Works across multiple versions.
+	MemoryModelOpenCL  = 2
 )
 
+var memoryModelNames = &enumTable{
+	names: map[uint32]string{
+		MemoryModelSimple:  "Simple",
+		MemoryModelGLSL450: "GLSL450",
+		MemoryModelOpenCL:  "OpenCL",
+	},
+}
+
+func (v MemoryModel) String() string { return memoryModelNames.String(uint32(v)) }
+
 type ExecutionMode uint32
 
 func (v ExecutionMode) Verify() error {
-	if v >= ExecutionModeInvocations && v <= ExecutionModeContractionOff {
+	if executionModeNames.valid(uint32(v)) {
 		return nil
 	}
 	return ErrInvalidExecutionMode
@@ -148,46 +217,43 @@ const (
 	// toward the right and downward. Only valid with the Fragment Execution Model.
 	ExecutionModeOriginUpperLeft = 7
 
+	ExecutionModeOriginLowerLeft = 8
+
 	// Fragment tests are to be performed before fragment shader execution.
 	// Only valid with the Fragment Execution Model.
-	ExecutionModeEarlyFragmentTests = 8
+	ExecutionModeEarlyFragmentTests = 9
 
 	// Requests the tessellation primitive generator to generate a point for
 	// each distinct vertex in the subdivided primitive, rather than to
 	// generate lines or triangles. Only valid with one of the tessellation
 	// Execution Models.
-	ExecutionModePointMode = 9
+	ExecutionModePointMode = 10
 
 	// This stage will run in transform feedback-capturing mode and this module
 	// is responsible for describing the transform-feedback setup.
 	// See the XfbBuffer, Offset, and Stride Decorations.
-	ExecutionModeXFB = 10
+	ExecutionModeXfb = 11
 
 	// This mode must be declared if this module potentially changes the
 	// fragment’s depth. Only valid with the Fragment Execution Model.
-	ExecutionModeDepthReplacing = 11
-
-	// TODO: this should probably be removed. Depth testing will always be
-	// performed after the shader has executed. Only valid with the Fragment
-	// Execution Model.
-	ExecutionModeDepthAny = 12
+	ExecutionModeDepthReplacing = 12
 
 	// External optimizations may assume depth modifications will leave the
 	// fragment’s depth as greater than or equal to the fragment’s interpolated
 	// depth value (given by the z component of the FragCoord Built-In
 	// decorated variable). Only valid with the Fragment Execution Model.
-	ExecutionModeDepthGreater = 13
+	ExecutionModeDepthGreater = 14
 
 	// External optimizations may assume depth modifications leave the
 	// fragment’s depth less than the fragment’s interpolated depth
 	// value, (given by the z component of the FragCoord Built-In decorated
 	// variable). Only valid with the Fragment Execution Model.
-	ExecutionModeDepthLess = 14
+	ExecutionModeDepthLess = 15
 
 	// External optimizations may assume this stage did not modify the
 	// fragment’s depth. However, DepthReplacing mode must accurately
 	// represent depth modification. Only valid with the Fragment Execution Model.
-	ExecutionModeDepthUnchanged = 15
+	ExecutionModeDepthUnchanged = 16
 
 	// Indicates the work-group size in the x, y, and z dimensions. Only valid
 	// with the GLCompute or Kernel Execution Models.
@@ -198,7 +264,7 @@ const (
 	//   [1]: y size
 	//   [2]: z size
 	//
-	ExecutionModeLocalSize = 16
+	ExecutionModeLocalSize = 17
 
 	// A hint to the compiler, which indicates the most likely to be used
 	// work-group size in the x, y, and z dimensions. Only valid with the
@@ -210,35 +276,26 @@ const (
 	//   [1]: y size
 	//   [2]: z size
 	//
-	ExecutionModeLocalSizeHint = 17
+	ExecutionModeLocalSizeHint = 18
 
 	// Stage input primitive is points. Only valid with the Geometry Execution Model.
-	ExecutionModeInputPoints = 18
+	ExecutionModeInputPoints = 19
 
 	// Stage input primitive is lines. Only valid with the Geometry Execution Model.
-	ExecutionModeInputLines = 19
+	ExecutionModeInputLines = 20
 
 	// Stage input primitive is lines adjacency. Only valid with the Geometry
 	// Execution Model.
-	ExecutionModeInputLinesAdjacency = 20
+	ExecutionModeInputLinesAdjacency = 21
 
-	// For a geometry stage, input primitive is triangles. For a tessellation
-	// stage, requests the tessellation primitive generator to generate
-	// triangles. Only valid with the Geometry or one of the tessellation
-	// Execution Models.
-	ExecutionModeInputTriangles = 21
+	ExecutionModeTriangles = 22
 
 	// Geometry stage input primitive is triangles adjacency. Only valid with
 	// the Geometry Execution Model.
-	ExecutionModeInputTrianglesAdjacency = 22
+	ExecutionModeInputTrianglesAdjacency = 23
 
-	// Requests the tessellation primitive generator to generate quads.
-	// Only valid with one of the tessellation Execution Models.
-	ExecutionModeInputQuads = 23
-
-	// Requests the tessellation primitive generator to generate isolines.
-	// Only valid with one of the tessellation Execution Models
-	ExecutionModeInputIsolines = 24
+	ExecutionModeQuads    = 24
+	ExecutionModeIsolines = 25
 
 	// For a geometry stage, the maximum number of vertices the shader will
 	// ever emit in a single invocation. For a tessellation-control stage,
@@ -251,19 +308,19 @@ const (
 	//
 	//   [0]: Vertex count
 	//
-	ExecutionModeOutputVertices = 25
+	ExecutionModeOutputVertices = 26
 
 	// Stage output primitive is points. Only valid with the Geometry
 	// Execution Model.
-	ExecutionModeOutputPoints = 26
+	ExecutionModeOutputPoints = 27
 
 	// Stage output primitive is line strip. Only valid with the Geometry
 	// Execution Model.
-	ExecutionModeOutputLinestrip = 27
+	ExecutionModeOutputLineStrip = 28
 
 	// Stage output primitive is triangle strip. Only valid with the
 	// Geometry Execution Model.
-	ExecutionModeOutputTrianglestrip = 28
+	ExecutionModeOutputTriangleStrip = 29
 
 	// A hint to the compiler, which indicates that most operations used
 	// in the entry point are explicitly vectorized using a particular
@@ -273,136 +330,162 @@ const (
 	//
 	//   [0]: Vector type
 	//
-	ExecutionModeVecTypeHint = 29
+	ExecutionModeVecTypeHint = 30
 
 	// Indicates that floating-point-expressions contraction is disallowed.
 	// Only valid with the Kernel Execution Model.
-	ExecutionModeContractionOff = 30
+	ExecutionModeContractionOff = 31
 )
 
-type ExecutionModel uint32
-
-func (v ExecutionModel) Verify() error {
-	if v >= ExecutionModelVertex && v <= ExecutionModelKernel {
-		return nil
-	}
-	return ErrInvalidExecutionModel
+var executionModeNames = &enumTable{
+	names: map[uint32]string{
+		ExecutionModeInvocations:             "Invocations",
+		ExecutionModeSpacingEqual:            "SpacingEqual",
+		ExecutionModeSpacingFractionalEven:   "SpacingFractionalEven",
+		ExecutionModeSpacingFractionalOdd:    "SpacingFractionalOdd",
+		ExecutionModeVertexOrderCw:           "VertexOrderCw",
+		ExecutionModeVertexOrderCcw:          "VertexOrderCcw",
+		ExecutionModePixelCenterInteger:      "PixelCenterInteger",
+		ExecutionModeOriginUpperLeft:         "OriginUpperLeft",
+		ExecutionModeOriginLowerLeft:         "OriginLowerLeft",
+		ExecutionModeEarlyFragmentTests:      "EarlyFragmentTests",
+		ExecutionModePointMode:               "PointMode",
+		ExecutionModeXfb:                     "Xfb",
+		ExecutionModeDepthReplacing:          "DepthReplacing",
+		ExecutionModeDepthGreater:            "DepthGreater",
+		ExecutionModeDepthLess:               "DepthLess",
+		ExecutionModeDepthUnchanged:          "DepthUnchanged",
+		ExecutionModeLocalSize:               "LocalSize",
+		ExecutionModeLocalSizeHint:           "LocalSizeHint",
+		ExecutionModeInputPoints:             "InputPoints",
+		ExecutionModeInputLines:              "InputLines",
+		ExecutionModeInputLinesAdjacency:     "InputLinesAdjacency",
+		ExecutionModeTriangles:               "Triangles",
+		ExecutionModeInputTrianglesAdjacency: "InputTrianglesAdjacency",
+		ExecutionModeQuads:                   "Quads",
+		ExecutionModeIsolines:                "Isolines",
+		ExecutionModeOutputVertices:          "OutputVertices",
+		ExecutionModeOutputPoints:            "OutputPoints",
+		ExecutionModeOutputLineStrip:         "OutputLineStrip",
+		ExecutionModeOutputTriangleStrip:     "OutputTriangleStrip",
+		ExecutionModeVecTypeHint:             "VecTypeHint",
+		ExecutionModeContractionOff:          "ContractionOff",
+	},
 }
 
-// Execution Models define a single execution model.
-// This is used in the EntryPoint instruction to determine what stage of the
-// pipeline a given set of instructions belongs to.
-const (
-	ExecutionModelVertex                 = 0 // Vertex shading stage
-	ExecutionModelTessellationControl    = 1 // Tessellation control (or hull) shading stage.
-	ExecutionModelTessellationEvaluation = 2 // Tessellation evaluation (or domain) shading stage
-	ExecutionModelGeometry               = 3 // Geometry shading stage.
-	ExecutionModelFragment               = 4 // Fragment shading stage.
-	ExecutionModelGLCompute              = 5 // Graphical compute shading stage.
-	ExecutionModelKernel                 = 6 // Compute kernel.
-)
+func (v ExecutionMode) String() string { return executionModeNames.String(uint32(v)) }
 
-type FPFastMathMode uint32
+type StorageClass uint32
 
-func (v FPFastMathMode) Verify() error {
-	if verifyBitFlag(uint32(v), true, FPFastMathModeNotInf|FPFastMathModeNSZ|FPFastMathModeAllowRecip|FPFastMathModeFast) {
+func (v StorageClass) Verify() error {
+	if storageClassNames.valid(uint32(v)) {
 		return nil
 	}
-	return ErrInvalidFPFastMathMode
+	return ErrInvalidStorageClass
 }
 
-// FPFastMathModes define bitflags which enable fast math operations
-// which are otherwise unsafe.
+// Storage Classes define a class of storage for declared variables
+// (does not include intermediate values).
 const (
-	// Assume parameters and result are not NaN.
-	FPFastMathModeNotNaN = 0
+	// Shared externally, read-only memory, visible across all instantiation
+	// or work groups. Graphics uniform memory. OpenCL Constant memory
+	StorageClassUniformConstant = 0
 
-	// Assume parameters and result are not +/- Inf.
-	FPFastMathModeNotInf = 2
+	// Input from pipeline. Read only
+	StorageClassInput = 1
 
-	// Treat the sign of a zero parameter or result as insignificant.
-	FPFastMathModeNSZ = 4
+	// Shared externally, visible across all instantiations or work groups
+	StorageClassUniform = 2
 
-	// Allow the usage of reciprocal rather than perform a division.
-	FPFastMathModeAllowRecip = 8
+	// Output to pipeline.
+	StorageClassOutput = 3
 
-	// Allow algebraic transformations according to real-number associative
-	// and distributive algebra. This flag implies all the others.
-	FPFastMathModeFast = 16
-)
+	// Shared across all work items within a work group. OpenGL "shared".
+	// OpenCL local memory.
+	StorageClassWorkgroup = 4
 
-type FPRoundingMode uint32
+	// Visible across all work items of all work groups. OpenCL global memory.
+	StorageClassCrossWorkgroup = 5
 
-func (v FPRoundingMode) Verify() error {
-	if v >= FPRoundingModeRTE && v <= FPRoundingModeRTN {
-		return nil
-	}
-	return ErrInvalidFPRoundingMode
-}
+	// Private to a work-item and is not visible to another work-item.
+	// OpenCL private memory.
+	StorageClassPrivate = 6
 
-// FPRoundingModes associate a rounding mode with a floating-point
-// conversion instruction.
-//
-// By default:
-//
-//    - Conversions from floating-point to integer types use the
-//      round-toward-zero rounding mode.
-//    - Conversions to floating-point types use the round-to-nearest-even
-//      rounding mode.
-//
-const (
-	FPRoundingModeRTE = 0 // Round to nearest even.
-	FPRoundingModeRTZ = 1 // Round towards zero.
-	FPRoundingModeRTP = 2 // Round towards positive infinity.
-	FPRoundingModeRTN = 3 // Round towards negative infinity.
-)
+	// A variable local to a function.
+	StorageClassFunction = 7
 
-type LinkageType uint32
+	// A generic pointer, which overloads StoragePrivate, StorageLocal,
+	// StorageGlobal. not a real storage class.
+	StorageClassGeneric = 8
 
-func (v LinkageType) Verify() error {
-	if v >= LinkageTypeExport && v <= LinkageTypeImport {
-		return nil
-	}
-	return ErrInvalidLinkageType
-}
+	StorageClassPushConstant = 9
 
-// Linkage Types associate a linkage type with functions or global
-// variables. By default, functions and global variables are private
-// to a module and cannot be accessed by other modules.
-const (
-	LinkageTypeExport = 0 // Accessible by other modules as well.
-	LinkageTypeImport = 1 // Declaration for a global identifier that exists in another module.
+	// For holding atomic counters.
+	StorageClassAtomicCounter = 10
+
+	StorageClassImage = 11
 )
 
-type MemoryModel uint32
+var storageClassNames = &enumTable{
+	names: map[uint32]string{
+		StorageClassUniformConstant: "UniformConstant",
+		StorageClassInput:           "Input",
+		StorageClassUniform:         "Uniform",
+		StorageClassOutput:          "Output",
+		StorageClassWorkgroup:       "Workgroup",
+		StorageClassCrossWorkgroup:  "CrossWorkgroup",
+		StorageClassPrivate:         "Private",
+		StorageClassFunction:        "Function",
+		StorageClassGeneric:         "Generic",
+		StorageClassPushConstant:    "PushConstant",
+		StorageClassAtomicCounter:   "AtomicCounter",
+		StorageClassImage:           "Image",
+	},
+}
 
-func (v MemoryModel) Verify() error {
-	if v >= MemoryModelSimple && v <= MemoryModelOpenCL21 {
+func (v StorageClass) String() string { return storageClassNames.String(uint32(v)) }
+
+type Dim uint32
+
+func (v Dim) Verify() error {
+	if dimNames.valid(uint32(v)) {
 		return nil
 	}
-	return ErrInvalidMemoryModel
+	return ErrInvalidDim
 }
 
-// Memory Models define an existing memory model.
+// Dims define the dimensionality of an image.
 const (
-	MemoryModelSimple   = 0 // No shared memory consistency issues.
-	MemoryModelGLSL450  = 1 // Memory model needed by later versions of GLSL and ESSL. Works across multiple versions.
-	MemoryModelOpenCL12 = 2 // OpenCL 1.2 memory model.
-	MemoryModelOpenCL20 = 3 // OpenCL 2.0 memory model.
-	MemoryModelOpenCL21 = 4 // OpenCL 2.1 memory model.
+	Dim1D          = 0
+	Dim2D          = 1
+	Dim3D          = 2
+	DimCube        = 3
+	DimRect        = 4
+	DimBuffer      = 5
+	DimSubpassData = 6
 )
 
+var dimNames = &enumTable{
+	names: map[uint32]string{
+		Dim1D:          "1D",
+		Dim2D:          "2D",
+		Dim3D:          "3D",
+		DimCube:        "Cube",
+		DimRect:        "Rect",
+		DimBuffer:      "Buffer",
+		DimSubpassData: "SubpassData",
+	},
+}
+
+func (v Dim) String() string { return dimNames.String(uint32(v)) }
+
 type SamplerAddressingMode uint32
 
 func (v SamplerAddressingMode) Verify() error {
-	switch v {
-	case SamplerAddressingModeNone, SamplerAddressingModeClampEdge,
-		SamplerAddressingModeClamp, SamplerAddressingModeRepeat,
-		SamplerAddressingModeRepeatMirrored:
+	if samplerAddressingModeNames.valid(uint32(v)) {
 		return nil
-	default:
-		return ErrInvalidSamplerAddressingMode
 	}
+	return ErrInvalidSamplerAddressingMode
 }
 
 // Sampler Addressing Modes define the addressing mode of read image
@@ -412,165 +495,518 @@ const (
 	// location inside the image, otherwise the results are undefined.
 	SamplerAddressingModeNone = 0
 
-	// Out-of-range image coordinates are clamped to the extent.
-	SamplerAddressingModeClampEdge = 2
+	SamplerAddressingModeClampToEdge = 1
 
 	// Out-of-range image coordinates will return a border color.
-	SamplerAddressingModeClamp = 4
+	SamplerAddressingModeClamp = 2
 
 	// Out-of-range image coordinates are wrapped to the valid range.
 	// Can only be used with normalized coordinates.
-	SamplerAddressingModeRepeat = 6
+	SamplerAddressingModeRepeat = 3
 
 	// Flip the image coordinate at every integer junction.
 	// Can only be used with normalized coordinates.
-	SamplerAddressingModeRepeatMirrored = 8
+	SamplerAddressingModeRepeatMirrored = 4
 )
 
+var samplerAddressingModeNames = &enumTable{
+	names: map[uint32]string{
+		SamplerAddressingModeNone:           "None",
+		SamplerAddressingModeClampToEdge:    "ClampToEdge",
+		SamplerAddressingModeClamp:          "Clamp",
+		SamplerAddressingModeRepeat:         "Repeat",
+		SamplerAddressingModeRepeatMirrored: "RepeatMirrored",
+	},
+}
+
+func (v SamplerAddressingMode) String() string { return samplerAddressingModeNames.String(uint32(v)) }
+
 type SamplerFilterMode uint32
 
 func (v SamplerFilterMode) Verify() error {
-	switch v {
-	case SamplerFilterModeNearest, SamplerFilterModeLinear:
+	if samplerFilterModeNames.valid(uint32(v)) {
 		return nil
-	default:
-		return ErrInvalidSamplerFilterMode
 	}
+	return ErrInvalidSamplerFilterMode
 }
 
 // Sampler Filter Modes define the filter mode of read image
 // extended instructions.
 const (
 	// Use filter nearset mode when performing a read image operation.
-	SamplerFilterModeNearest = 16
+	SamplerFilterModeNearest = 0
 
 	// Use filter linear mode when performing a read image operation.
-	SamplerFilterModeLinear = 32
+	SamplerFilterModeLinear = 1
 )
 
-type SourceLanguage uint32
+var samplerFilterModeNames = &enumTable{
+	names: map[uint32]string{
+		SamplerFilterModeNearest: "Nearest",
+		SamplerFilterModeLinear:  "Linear",
+	},
+}
 
-func (v SourceLanguage) Verify() error {
-	if v >= SourceLanguageUnknown && v <= SourceLanguageOpenCL {
+func (v SamplerFilterMode) String() string { return samplerFilterModeNames.String(uint32(v)) }
+
+type ImageFormat uint32
+
+func (v ImageFormat) Verify() error {
+	if imageFormatNames.valid(uint32(v)) {
 		return nil
 	}
-	return ErrInvalidSourceLanguage
+	return ErrInvalidImageFormat
 }
 
-// Source Languages define a source language constant.
+// Image Formats declare the image format used by OpTypeImage.
 const (
-	SourceLanguageUnknown = 0
-	SourceLanguageESSL    = 1
-	SourceLanguageGLSL    = 2
-	SourceLanguageOpenCL  = 3
+	ImageFormatUnknown      = 0
+	ImageFormatRgba32f      = 1
+	ImageFormatRgba16f      = 2
+	ImageFormatR32f         = 3
+	ImageFormatRgba8        = 4
+	ImageFormatRgba8Snorm   = 5
+	ImageFormatRg32f        = 6
+	ImageFormatRg16f        = 7
+	ImageFormatR11fG11fB10f = 8
+	ImageFormatR16f         = 9
+	ImageFormatRgba16       = 10
+	ImageFormatRgb10A2      = 11
+	ImageFormatRg16         = 12
+	ImageFormatRg8          = 13
+	ImageFormatR16          = 14
+	ImageFormatR8           = 15
+	ImageFormatRgba16Snorm  = 16
+	ImageFormatRg16Snorm    = 17
+	ImageFormatRg8Snorm     = 18
+	ImageFormatR16Snorm     = 19
+	ImageFormatR8Snorm      = 20
+	ImageFormatRgba32i      = 21
+	ImageFormatRgba16i      = 22
+	ImageFormatRgba8i       = 23
+	ImageFormatR32i         = 24
+	ImageFormatRg32i        = 25
+	ImageFormatRg16i        = 26
+	ImageFormatRg8i         = 27
+	ImageFormatR16i         = 28
+	ImageFormatR8i          = 29
+	ImageFormatRgba32ui     = 30
+	ImageFormatRgba16ui     = 31
+	ImageFormatRgba8ui      = 32
+	ImageFormatR32ui        = 33
+	ImageFormatRgb10a2ui    = 34
+	ImageFormatRg32ui       = 35
+	ImageFormatRg16ui       = 36
+	ImageFormatRg8ui        = 37
+	ImageFormatR16ui        = 38
+	ImageFormatR8ui         = 39
 )
 
-type StorageClass uint32
+var imageFormatNames = &enumTable{
+	names: map[uint32]string{
+		ImageFormatUnknown:      "Unknown",
+		ImageFormatRgba32f:      "Rgba32f",
+		ImageFormatRgba16f:      "Rgba16f",
+		ImageFormatR32f:         "R32f",
+		ImageFormatRgba8:        "Rgba8",
+		ImageFormatRgba8Snorm:   "Rgba8Snorm",
+		ImageFormatRg32f:        "Rg32f",
+		ImageFormatRg16f:        "Rg16f",
+		ImageFormatR11fG11fB10f: "R11fG11fB10f",
+		ImageFormatR16f:         "R16f",
+		ImageFormatRgba16:       "Rgba16",
+		ImageFormatRgb10A2:      "Rgb10A2",
+		ImageFormatRg16:         "Rg16",
+		ImageFormatRg8:          "Rg8",
+		ImageFormatR16:          "R16",
+		ImageFormatR8:           "R8",
+		ImageFormatRgba16Snorm:  "Rgba16Snorm",
+		ImageFormatRg16Snorm:    "Rg16Snorm",
+		ImageFormatRg8Snorm:     "Rg8Snorm",
+		ImageFormatR16Snorm:     "R16Snorm",
+		ImageFormatR8Snorm:      "R8Snorm",
+		ImageFormatRgba32i:      "Rgba32i",
+		ImageFormatRgba16i:      "Rgba16i",
+		ImageFormatRgba8i:       "Rgba8i",
+		ImageFormatR32i:         "R32i",
+		ImageFormatRg32i:        "Rg32i",
+		ImageFormatRg16i:        "Rg16i",
+		ImageFormatRg8i:         "Rg8i",
+		ImageFormatR16i:         "R16i",
+		ImageFormatR8i:          "R8i",
+		ImageFormatRgba32ui:     "Rgba32ui",
+		ImageFormatRgba16ui:     "Rgba16ui",
+		ImageFormatRgba8ui:      "Rgba8ui",
+		ImageFormatR32ui:        "R32ui",
+		ImageFormatRgb10a2ui:    "Rgb10a2ui",
+		ImageFormatRg32ui:       "Rg32ui",
+		ImageFormatRg16ui:       "Rg16ui",
+		ImageFormatRg8ui:        "Rg8ui",
+		ImageFormatR16ui:        "R16ui",
+		ImageFormatR8ui:         "R8ui",
+	},
+}
 
-func (v StorageClass) Verify() error {
-	if v >= StorageClassUniformConstant && v <= StorageClassAtomicCounter {
+func (v ImageFormat) String() string { return imageFormatNames.String(uint32(v)) }
+
+type ImageChannelOrder uint32
+
+func (v ImageChannelOrder) Verify() error {
+	if imageChannelOrderNames.valid(uint32(v)) {
 		return nil
 	}
-	return ErrInvalidStorageClass
+	return ErrInvalidImageChannelOrder
 }
 
-// Storage Classes define a class of storage for declared variables
-// (does not include intermediate values).
+// Image Channel Orders are the channel orders returned by
+// OpImageQueryOrder.
 const (
-	// Shared externally, read-only memory, visible across all instantiation
-	// or work groups. Graphics uniform memory. OpenCL Constant memory
-	StorageClassUniformConstant = 0
+	ImageChannelOrderR            = 0
+	ImageChannelOrderA            = 1
+	ImageChannelOrderRG           = 2
+	ImageChannelOrderRA           = 3
+	ImageChannelOrderRGB          = 4
+	ImageChannelOrderRGBA         = 5
+	ImageChannelOrderBGRA         = 6
+	ImageChannelOrderARGB         = 7
+	ImageChannelOrderIntensity    = 8
+	ImageChannelOrderLuminance    = 9
+	ImageChannelOrderRx           = 10
+	ImageChannelOrderRGx          = 11
+	ImageChannelOrderRGBx         = 12
+	ImageChannelOrderDepth        = 13
+	ImageChannelOrderDepthStencil = 14
+	ImageChannelOrdersRGB         = 15
+	ImageChannelOrdersRGBx        = 16
+	ImageChannelOrdersRGBA        = 17
+	ImageChannelOrdersBGRA        = 18
+)
 
-	// Input from pipeline. Read only
-	StorageClassInput = 1
+var imageChannelOrderNames = &enumTable{
+	names: map[uint32]string{
+		ImageChannelOrderR:            "R",
+		ImageChannelOrderA:            "A",
+		ImageChannelOrderRG:           "RG",
+		ImageChannelOrderRA:           "RA",
+		ImageChannelOrderRGB:          "RGB",
+		ImageChannelOrderRGBA:         "RGBA",
+		ImageChannelOrderBGRA:         "BGRA",
+		ImageChannelOrderARGB:         "ARGB",
+		ImageChannelOrderIntensity:    "Intensity",
+		ImageChannelOrderLuminance:    "Luminance",
+		ImageChannelOrderRx:           "Rx",
+		ImageChannelOrderRGx:          "RGx",
+		ImageChannelOrderRGBx:         "RGBx",
+		ImageChannelOrderDepth:        "Depth",
+		ImageChannelOrderDepthStencil: "DepthStencil",
+		ImageChannelOrdersRGB:         "sRGB",
+		ImageChannelOrdersRGBx:        "sRGBx",
+		ImageChannelOrdersRGBA:        "sRGBA",
+		ImageChannelOrdersBGRA:        "sBGRA",
+	},
+}
 
-	// Shared externally, visible across all instantiations or work groups
-	StorageClassUniform = 2
+func (v ImageChannelOrder) String() string { return imageChannelOrderNames.String(uint32(v)) }
 
-	// Output to pipeline.
-	StorageClassOutput = 3
+type ImageChannelDataType uint32
 
-	// Shared across all work items within a work group. OpenGL "shared".
-	// OpenCL local memory.
-	StorageClassWorkgroupLocal = 4
+func (v ImageChannelDataType) Verify() error {
+	if imageChannelDataTypeNames.valid(uint32(v)) {
+		return nil
+	}
+	return ErrInvalidImageChannelDataType
+}
 
-	// Visible across all work items of all work groups. OpenCL global memory.
-	StorageClassWorkgroupGlobal = 5
+// Image Channel Data Types are the channel data types returned
+// by OpImageQueryFormat.
+const (
+	ImageChannelDataTypeSnormInt8        = 0
+	ImageChannelDataTypeSnormInt16       = 1
+	ImageChannelDataTypeUnormInt8        = 2
+	ImageChannelDataTypeUnormInt16       = 3
+	ImageChannelDataTypeUnormShort565    = 4
+	ImageChannelDataTypeUnormShort555    = 5
+	ImageChannelDataTypeUnormInt101010   = 6
+	ImageChannelDataTypeSignedInt8       = 7
+	ImageChannelDataTypeSignedInt16      = 8
+	ImageChannelDataTypeSignedInt32      = 9
+	ImageChannelDataTypeUnsignedInt8     = 10
+	ImageChannelDataTypeUnsignedInt16    = 11
+	ImageChannelDataTypeUnsignedInt32    = 12
+	ImageChannelDataTypeHalfFloat        = 13
+	ImageChannelDataTypeFloat            = 14
+	ImageChannelDataTypeUnormInt24       = 15
+	ImageChannelDataTypeUnormInt101010_2 = 16
+)
 
-	// Accessible across functions within a module, non-IO (not visible outside
-	// the module).
-	StorageClassPrivateGlobal = 6
+var imageChannelDataTypeNames = &enumTable{
+	names: map[uint32]string{
+		ImageChannelDataTypeSnormInt8:        "SnormInt8",
+		ImageChannelDataTypeSnormInt16:       "SnormInt16",
+		ImageChannelDataTypeUnormInt8:        "UnormInt8",
+		ImageChannelDataTypeUnormInt16:       "UnormInt16",
+		ImageChannelDataTypeUnormShort565:    "UnormShort565",
+		ImageChannelDataTypeUnormShort555:    "UnormShort555",
+		ImageChannelDataTypeUnormInt101010:   "UnormInt101010",
+		ImageChannelDataTypeSignedInt8:       "SignedInt8",
+		ImageChannelDataTypeSignedInt16:      "SignedInt16",
+		ImageChannelDataTypeSignedInt32:      "SignedInt32",
+		ImageChannelDataTypeUnsignedInt8:     "UnsignedInt8",
+		ImageChannelDataTypeUnsignedInt16:    "UnsignedInt16",
+		ImageChannelDataTypeUnsignedInt32:    "UnsignedInt32",
+		ImageChannelDataTypeHalfFloat:        "HalfFloat",
+		ImageChannelDataTypeFloat:            "Float",
+		ImageChannelDataTypeUnormInt24:       "UnormInt24",
+		ImageChannelDataTypeUnormInt101010_2: "UnormInt101010_2",
+	},
+}
 
-	// A variable local to a function.
-	StorageClassFunction = 7
+func (v ImageChannelDataType) String() string { return imageChannelDataTypeNames.String(uint32(v)) }
 
-	// A generic pointer, which overloads StoragePrivate, StorageLocal,
-	// StorageGlobal. not a real storage class.
-	StorageClassGeneric = 8
+type ImageOperands uint32
 
-	// Private to a work-item and is not visible to another work-item.
-	// OpenCL private memory.
-	StorageClassPrivate = 9
+func (v ImageOperands) Verify() error {
+	if imageOperandsNames.valid(uint32(v)) {
+		return nil
+	}
+	return ErrInvalidImageOperands
+}
 
-	// For holding atomic counters.
-	StorageClassAtomicCounter = 10
+// Image Operands define bitflags for additional operands to sampling,
+// or getting texels from, an image. Each set bit adds one or more <id>
+// operands to the instruction, in the order of the bits.
+const (
+	ImageOperandsNone         = 0
+	ImageOperandsBias         = 0x1
+	ImageOperandsLod          = 0x2
+	ImageOperandsGrad         = 0x4
+	ImageOperandsConstOffset  = 0x8
+	ImageOperandsOffset       = 0x10
+	ImageOperandsConstOffsets = 0x20
+	ImageOperandsSample       = 0x40
+	ImageOperandsMinLod       = 0x80
+)
+
+var imageOperandsNames = &enumTable{
+	flags: true,
+	names: map[uint32]string{
+		ImageOperandsNone:         "None",
+		ImageOperandsBias:         "Bias",
+		ImageOperandsLod:          "Lod",
+		ImageOperandsGrad:         "Grad",
+		ImageOperandsConstOffset:  "ConstOffset",
+		ImageOperandsOffset:       "Offset",
+		ImageOperandsConstOffsets: "ConstOffsets",
+		ImageOperandsSample:       "Sample",
+		ImageOperandsMinLod:       "MinLod",
+	},
+}
+
+func (v ImageOperands) String() string { return imageOperandsNames.String(uint32(v)) }
+
+type FPFastMathMode uint32
+
+func (v FPFastMathMode) Verify() error {
+	if fpFastMathModeNames.valid(uint32(v)) {
+		return nil
+	}
+	return ErrInvalidFPFastMathMode
+}
+
+// FPFastMathModes define bitflags which enable fast math operations
+// which are otherwise unsafe.
+const (
+	FPFastMathModeNone = 0
+
+	// Assume parameters and result are not NaN.
+	FPFastMathModeNotNaN = 0x1
+
+	// Assume parameters and result are not +/- Inf.
+	FPFastMathModeNotInf = 0x2
+
+	// Treat the sign of a zero parameter or result as insignificant.
+	FPFastMathModeNSZ = 0x4
+
+	// Allow the usage of reciprocal rather than perform a division.
+	FPFastMathModeAllowRecip = 0x8
+
+	// Allow algebraic transformations according to real-number associative
+	// and distributive algebra. This flag implies all the others.
+	FPFastMathModeFast = 0x10
+)
+
+var fpFastMathModeNames = &enumTable{
+	flags: true,
+	names: map[uint32]string{
+		FPFastMathModeNone:       "None",
+		FPFastMathModeNotNaN:     "NotNaN",
+		FPFastMathModeNotInf:     "NotInf",
+		FPFastMathModeNSZ:        "NSZ",
+		FPFastMathModeAllowRecip: "AllowRecip",
+		FPFastMathModeFast:       "Fast",
+	},
+}
+
+func (v FPFastMathMode) String() string { return fpFastMathModeNames.String(uint32(v)) }
+
+type FPRoundingMode uint32
+
+func (v FPRoundingMode) Verify() error {
+	if fpRoundingModeNames.valid(uint32(v)) {
+		return nil
+	}
+	return ErrInvalidFPRoundingMode
+}
+
+// FPRoundingModes associate a rounding mode with a floating-point
+// conversion instruction.
+//
+// By default:
+//
+//   - Conversions from floating-point to integer types use the
+//     round-toward-zero rounding mode.
+//   - Conversions to floating-point types use the round-to-nearest-even
+//     rounding mode.
+const (
+	FPRoundingModeRTE = 0 // Round to nearest even.
+	FPRoundingModeRTZ = 1 // Round towards zero.
+	FPRoundingModeRTP = 2 // Round towards positive infinity.
+	FPRoundingModeRTN = 3 // Round towards negative infinity.
+)
+
+var fpRoundingModeNames = &enumTable{
+	names: map[uint32]string{
+		FPRoundingModeRTE: "RTE",
+		FPRoundingModeRTZ: "RTZ",
+		FPRoundingModeRTP: "RTP",
+		FPRoundingModeRTN: "RTN",
+	},
+}
+
+func (v FPRoundingMode) String() string { return fpRoundingModeNames.String(uint32(v)) }
+
+type LinkageType uint32
+
+func (v LinkageType) Verify() error {
+	if linkageTypeNames.valid(uint32(v)) {
+		return nil
+	}
+	return ErrInvalidLinkageType
+}
+
+// Linkage Types associate a linkage type with functions or global
+// variables. By default, functions and global variables are private
+// to a module and cannot be accessed by other modules.
+const (
+	LinkageTypeExport = 0 // Accessible by other modules as well.
+	LinkageTypeImport = 1 // Declaration for a global identifier that exists in another module.
+)
+
+var linkageTypeNames = &enumTable{
+	names: map[uint32]string{
+		LinkageTypeExport: "Export",
+		LinkageTypeImport: "Import",
+	},
+}
+
+func (v LinkageType) String() string { return linkageTypeNames.String(uint32(v)) }
+
+type AccessQualifier uint32
+
+func (v AccessQualifier) Verify() error {
+	if accessQualifierNames.valid(uint32(v)) {
+		return nil
+	}
+	return ErrInvalidAccessQualifier
+}
+
+// Access Qualifiers define the access permissions of OpTypeImage
+// and OpTypePipe objects.
+const (
+	AccessQualifierReadOnly  = 0 // A read-only object.
+	AccessQualifierWriteOnly = 1 // A write-only object.
+	AccessQualifierReadWrite = 2 // A readable and writable object.
 )
 
-type FunctionParameter uint32
+var accessQualifierNames = &enumTable{
+	names: map[uint32]string{
+		AccessQualifierReadOnly:  "ReadOnly",
+		AccessQualifierWriteOnly: "WriteOnly",
+		AccessQualifierReadWrite: "ReadWrite",
+	},
+}
+
+func (v AccessQualifier) String() string { return accessQualifierNames.String(uint32(v)) }
+
+type FunctionParameterAttribute uint32
 
-func (v FunctionParameter) Verify() error {
-	if v >= FunctionParamAttrZext && v <= FunctionParamAttrNoReadWrite {
+func (v FunctionParameterAttribute) Verify() error {
+	if functionParameterAttributeNames.valid(uint32(v)) {
 		return nil
 	}
-	return ErrInvalidFunctionParameter
+	return ErrInvalidFunctionParameterAttribute
 }
 
 // Function Parameter Attributes add additional information to the return type
 // and to each parameter of a function.
 const (
 	// Value should be zero extended if needed.
-	FunctionParamAttrZext = 0
+	FunctionParameterAttributeZext = 0
 
 	// Value should be sign extended if needed.
-	FunctionParamAttrSext = 1
+	FunctionParameterAttributeSext = 1
 
 	// This indicates that the pointer parameter should really be passed by
 	// value to the function. Only valid for pointer parameters (not
 	// for ret value)
-	FunctionParamAttrByVal = 2
+	FunctionParameterAttributeByVal = 2
 
 	// Indicates that the pointer parameter specifies the address of a
 	// structure that is the return value of the function in the source
 	// program. Only applicable to the first parameter which must be a
 	// pointer parameters.
-	FunctionParamAttrSret = 3
+	FunctionParameterAttributeSret = 3
 
 	// Indicates that the memory pointed by a pointer parameter is not
 	// accessed via pointer values which are not derived from this pointer
 	// parameter. Only valid for pointer parameters. Not valid on return values
-	FunctionParamAttrNoAlias = 4
+	FunctionParameterAttributeNoAlias = 4
 
 	// The callee does not make a copy of the pointer parameter into a
 	// location that is accessible after returning from the callee. Only
 	// valid for pointer parameters. Not valid on return values.
-	FunctionParamAttrNoCapture = 5
-
-	// To be determined.
-	FunctionParamAttrSVM = 6
+	FunctionParameterAttributeNoCapture = 5
 
 	// Can only read the memory pointed by a pointer parameter.
 	// Only valid for pointer parameters. Not valid on return values.
-	FunctionParamAttrNoWrite = 7
+	FunctionParameterAttributeNoWrite = 6
 
 	// Cannot dereference the memory pointed by a pointer parameter.
 	// Only valid for pointer parameters. Not valid on return values.
-	FunctionParamAttrNoReadWrite = 8
+	FunctionParameterAttributeNoReadWrite = 7
 )
 
+var functionParameterAttributeNames = &enumTable{
+	names: map[uint32]string{
+		FunctionParameterAttributeZext:        "Zext",
+		FunctionParameterAttributeSext:        "Sext",
+		FunctionParameterAttributeByVal:       "ByVal",
+		FunctionParameterAttributeSret:        "Sret",
+		FunctionParameterAttributeNoAlias:     "NoAlias",
+		FunctionParameterAttributeNoCapture:   "NoCapture",
+		FunctionParameterAttributeNoWrite:     "NoWrite",
+		FunctionParameterAttributeNoReadWrite: "NoReadWrite",
+	},
+}
+
+func (v FunctionParameterAttribute) String() string {
+	return functionParameterAttributeNames.String(uint32(v))
+}
+
 type Decoration uint32
 
 func (v Decoration) Verify() error {
-	if v >= DecorationPrecisionLow && v <= DecorationSpecId {
+	if decorationNames.valid(uint32(v)) {
 		return nil
 	}
 	return ErrInvalidDecoration
@@ -578,14 +1014,15 @@ func (v Decoration) Verify() error {
 
 // Decorations are used by OpDecorate and OpMemberDecorate
 const (
-	// Apply as described in the ES Precision section.
-	DecorationPrecisionLow = 0
-
-	// Apply as described in the ES Precision section.
-	DecorationPrecisionMedium = 1
+	DecorationRelaxedPrecision = 0
 
-	// Apply as described in the ES Precision section.
-	DecorationPrecisionHigh = 2
+	// Apply to a specialization constant. Forms the API linkage for
+	// setting a specialized value. See specialization.
+	//
+	// Arguments:
+	//  - Literal Number: Specialization Constant ID
+	//
+	DecorationSpecId = 1
 
 	// Apply to a structure type to establish it is a non-SSBO-like
 	// shader-interface block.
@@ -593,7 +1030,7 @@ const (
 	// TODO: can this be removed? Probably doesn’t add anything over a
 	// nonwritable structure in the UniformConstant or Uniform storage class.
 	// With a Binding and DescriptorSet decoration.
-	DecorationBlock = 3
+	DecorationBlock = 2
 
 	// Apply to a structure type to establish it is an SSBO-like
 	// shader-interface block.
@@ -601,56 +1038,61 @@ const (
 	// TODO: can this be removed? Probably doesn’t add anything over a
 	// structure in the UniformConstant or Uniform storage class.
 	// With a Binding and DescriptorSet decoration.
-	DecorationBufferBlock = 4
+	DecorationBufferBlock = 3
 
 	// Apply to a variable or a member of a structure. Must decorate an
 	// entity whose type is a matrix. Indicates that components within a
 	// row are contiguous in memory.
-	DecorationRowMajor = 5
+	DecorationRowMajor = 4
 
 	// Apply to a variable or a member of a structure. Must decorate an
 	// entity whose type is a matrix. Indicates that components within a
 	// column are contiguous in memory.
-	DecorationColMajor = 6
+	DecorationColMajor = 5
 
-	// Apply to a structure type to get GLSL shared memory layout.
-	DecorationGLSLShared = 7
-
-	// Apply to a structure type to get GLSL std140 memory layout.
-	DecorationLSLStd140 = 8
+	DecorationArrayStride  = 6
+	DecorationMatrixStride = 7
 
-	// Apply to a structure type to get GLSL std430 memory layout.
-	DecorationGLSLStd430 = 9
+	// Apply to a structure type to get GLSL shared memory layout.
+	DecorationGLSLShared = 8
 
 	// Apply to a structure type to get GLSL packed memory layout.
-	DecorationGLSLPacked = 10
+	DecorationGLSLPacked = 9
 
-	// Apply to a variable or a member of a structure. Indicates that
-	// perspective-correct interpolation must be used. Only valid for the
-	// Input and Output Storage Classes.
-	DecorationSmooth = 11
+	// Marks a structure type as "packed", indicating that the alignment
+	// of the structure is one and that there is no padding between
+	// structure members.
+	DecorationCPacked = 10
+
+	// Apply to a variable or a member of a structure.
+	// Indicates which built-in variable the entity represents.
+	//
+	// Arguments:
+	//  - See Built-In
+	//
+	DecorationBuiltIn = 11
 
 	// Apply to a variable or a member of a structure. Indicates that linear,
 	// non-perspective correct interpolation must be used. Only valid for
 	// the Input and Output Storage Classes.
-	DecorationNoperspective = 12
+	DecorationNoPerspective = 13
 
 	// Apply to a variable or a member of a structure. Indicates no
 	// interpolation will be done. The non-interpolated value will come
 	// from a vertex, as described in the API specification. Only valid
 	// for the Input and Output Storage Classes.
-	DecorationFlat = 13
+	DecorationFlat = 14
 
 	// Apply to a variable or a member of a structure. Indicates a tessellation
 	// patch. Only valid for the Input and Output Storage Classes.
-	DecorationPatch = 14
+	DecorationPatch = 15
 
 	// Apply to a variable or a member of a structure. When used with
 	// multi-sampling rasterization, allows a single interpolation location
 	// for an entire pixel. The interpolation location must lie in both
 	// the pixel and in the primitive being rasterized. Only valid for the
 	// Input and Output Storage Classes.
-	DecorationCentroid = 15
+	DecorationCentroid = 16
 
 	// Apply to a variable or a member of a structure. When used with
 	// multi-sampling rasterization, requires per-sample interpolation.
@@ -658,62 +1100,50 @@ const (
 	// The interpolation locations must be the locations of the samples
 	// lying in both the pixel and in the primitive being rasterized.
 	// Only valid for the Input and Output Storage Classes.
-	DecorationSample = 16
+	DecorationSample = 17
 
 	// Apply to a variable, to indicate expressions computing its value
 	// be done invariant with respect to other modules computing the
 	// same expressions
-	DecorationInvariant = 17
+	DecorationInvariant = 18
 
 	// Apply to a variable, to indicate the compiler may compile as if there
 	// is no aliasing. See the Aliasing section for more detail.
-	DecorationRestrict = 18
+	DecorationRestrict = 19
 
 	// Apply to a variable, to indicate the compiler is to generate accesses
 	// to the variable that work correctly in the presence of aliasing.
 	// See the Aliasing section for more detail.
-	DecorationAliased = 19
+	DecorationAliased = 20
 
 	// Apply to a variable, to indicate the memory holding the variable is
 	// volatile. See the Memory Model section for more detail.
-	DecorationVolatile = 20
+	DecorationVolatile = 21
 
 	// Indicates that a global variable is constant and will never be modified.
 	// Only allowed on global variables
-	DecorationConstant = 21
+	DecorationConstant = 22
 
 	// Apply to a variable, to indicate the memory holding the variable is
 	// coherent. See the Memory Model section for more detail.
-	DecorationCoherent = 22
+	DecorationCoherent = 23
 
 	// Apply to a variable, to indicate the memory holding the variable is
 	// not writable, and that this module does not write to it.
-	DecorationNonwritable = 23
+	DecorationNonWritable = 24
 
 	// Apply to a variable, to indicate the memory holding the variable is
 	// not readable, and that this module does not read from it
-	DecorationNonreadable = 24
+	DecorationNonReadable = 25
 
 	// Apply to a variable or a member of a structure. Asserts that the
 	// value backing the decorated <id> is dynamically uniform across all
 	// instantiations that might run in parallel.
-	DecorationUniform = 25
-
-	// Apply to a variable to indicate that it is known that this
-	// module does not read or write it. Useful for establishing
-	// interface.
-	//
-	// TODO: consider removing this?
-	DecorationNoStaticUse = 26
-
-	// Marks a structure type as "packed", indicating that the alignment
-	// of the structure is one and that there is no padding between
-	// structure members.
-	DecorationCPacked = 27
+	DecorationUniform = 26
 
 	// Indicates that a conversion to an integer type is saturated.
 	// Only valid for conversion instructions to integer type.
-	DecorationFPSaturatedConversion = 28
+	DecorationSaturatedConversion = 28
 
 	// Apply to a variable or a member of a structure. Indicates the stream
 	// number to put an output on. Only valid for the Output Storage
@@ -784,13 +1214,6 @@ const (
 	//
 	DecorationOffset = 35
 
-	// TODO: This can probably be removed.
-	//
-	// Arguments:
-	//  - Declared alignment
-	//
-	DecorationAlignment = 36
-
 	// Apply to a variable or a member of a structure. Indicates which
 	// transform-feedback buffer an output is written to. Only valid for
 	// the Output Storage Classes of vertex processing Execution Models.
@@ -798,69 +1221,100 @@ const (
 	// Arguments:
 	//  - XFB Buffer number
 	//
-	DecorationXfbBuffer = 37
+	DecorationXfbBuffer = 36
 
-	// The stride, in bytes, of array elements or transform-feedback
-	// buffer vertices.
-	//
-	// Arguments:
-	//  - Stride
-	//
-	DecorationStride = 38
-
-	// Apply to a variable or a member of a structure.
-	// Indicates which built-in variable the entity represents.
-	//
-	// Arguments:
-	//  - See Built-In
-	//
-	DecorationBuiltIn = 39
+	DecorationXfbStride = 37
 
 	// Indicates a function return value or parameter attribute.
 	//
 	// Arguments:
 	//  - function parameter attribute
 	//
-	DecorationFuncParamAttr = 40
+	DecorationFuncParamAttr = 38
 
 	// Indicates a floating-point rounding mode
 	//
 	// Arguments:
 	//  - floating-point rounding mode
 	//
-	DecorationFPRoundingMode = 41
+	DecorationFPRoundingMode = 39
 
 	// Indicates a floating-point fast math flag
 	//
 	// Arguments:
 	//  - fast-math mode
 	//
-	DecorationFPFastMathMode = 42
+	DecorationFPFastMathMode = 40
 
-	// Indicate a linkage type. Only valid on an OpFunction or a
-	// module scope OpVariable.
-	//
-	// Arguments:
-	//  - linkage type
-	//
-	DecorationLinkageType = 43
+	DecorationLinkageAttributes    = 41
+	DecorationNoContraction        = 42
+	DecorationInputAttachmentIndex = 43
 
-	// Apply to a specialization constant. Forms the API linkage for
-	// setting a specialized value. See specialization.
+	// TODO: This can probably be removed.
 	//
 	// Arguments:
-	//  - Literal Number: Specialization Constant ID
+	//  - Declared alignment
 	//
-	DecorationSpecId = 44
+	DecorationAlignment = 44
 )
 
-type Builtin uint32
+var decorationNames = &enumTable{
+	names: map[uint32]string{
+		DecorationRelaxedPrecision:     "RelaxedPrecision",
+		DecorationSpecId:               "SpecId",
+		DecorationBlock:                "Block",
+		DecorationBufferBlock:          "BufferBlock",
+		DecorationRowMajor:             "RowMajor",
+		DecorationColMajor:             "ColMajor",
+		DecorationArrayStride:          "ArrayStride",
+		DecorationMatrixStride:         "MatrixStride",
+		DecorationGLSLShared:           "GLSLShared",
+		DecorationGLSLPacked:           "GLSLPacked",
+		DecorationCPacked:              "CPacked",
+		DecorationBuiltIn:              "BuiltIn",
+		DecorationNoPerspective:        "NoPerspective",
+		DecorationFlat:                 "Flat",
+		DecorationPatch:                "Patch",
+		DecorationCentroid:             "Centroid",
+		DecorationSample:               "Sample",
+		DecorationInvariant:            "Invariant",
+		DecorationRestrict:             "Restrict",
+		DecorationAliased:              "Aliased",
+		DecorationVolatile:             "Volatile",
+		DecorationConstant:             "Constant",
+		DecorationCoherent:             "Coherent",
+		DecorationNonWritable:          "NonWritable",
+		DecorationNonReadable:          "NonReadable",
+		DecorationUniform:              "Uniform",
+		DecorationSaturatedConversion:  "SaturatedConversion",
+		DecorationStream:               "Stream",
+		DecorationLocation:             "Location",
+		DecorationComponent:            "Component",
+		DecorationIndex:                "Index",
+		DecorationBinding:              "Binding",
+		DecorationDescriptorSet:        "DescriptorSet",
+		DecorationOffset:               "Offset",
+		DecorationXfbBuffer:            "XfbBuffer",
+		DecorationXfbStride:            "XfbStride",
+		DecorationFuncParamAttr:        "FuncParamAttr",
+		DecorationFPRoundingMode:       "FPRoundingMode",
+		DecorationFPFastMathMode:       "FPFastMathMode",
+		DecorationLinkageAttributes:    "LinkageAttributes",
+		DecorationNoContraction:        "NoContraction",
+		DecorationInputAttachmentIndex: "InputAttachmentIndex",
+		DecorationAlignment:            "Alignment",
+	},
+}
+
+func (v Decoration) String() string { return decorationNames.String(uint32(v)) }
+
+type BuiltIn uint32
 
-func (v Builtin) Verify() error {
-	if v >= BuiltinPosition && v <= BuiltinSubgroupLocalInvocationId {
+func (v BuiltIn) Verify() error {
+	if builtInNames.valid(uint32(v)) {
 		return nil
 	}
-	return ErrInvalidBuiltin
+	return ErrInvalidBuiltIn
 }
 
 // Builtins define a builtin operation.
@@ -873,54 +1327,101 @@ func (v Builtin) Verify() error {
 // These have the semantics described by their originating API and
 // high-level language environments.
 const (
-	BuiltinPosition                  = 0
-	BuiltinPointSize                 = 1
-	BuiltinClipVertex                = 2
-	BuiltinClipDistance              = 3
-	BuiltinCullDistance              = 4
-	BuiltinVertexId                  = 5
-	BuiltinInstanceId                = 6
-	BuiltinPrimitiveId               = 7
-	BuiltinInvocationId              = 8
-	BuiltinLayer                     = 9
-	BuiltinViewportIndex             = 10
-	BuiltinTessLevelOuter            = 11
-	BuiltinTessLevelInner            = 12
-	BuiltinTessCoord                 = 13
-	BuiltinPatchVertices             = 14
-	BuiltinFragCoord                 = 15
-	BuiltinPointCoord                = 16
-	BuiltinFrontFacing               = 17
-	BuiltinSampleId                  = 18
-	BuiltinSamplePosition            = 19
-	BuiltinSampleMask                = 20
-	BuiltinFragColor                 = 21
-	BuiltinFragDepth                 = 22
-	BuiltinHelperInvocation          = 23
-	BuiltinNumWorkgroups             = 24
-	BuiltinWorkgroupSize             = 25
-	BuiltinWorkgroupId               = 26
-	BuiltinLocalInvocationId         = 27
-	BuiltinGlobalInvocationId        = 28
-	BuiltinLocalInvocationIndex      = 29
-	BuiltinWorkDim                   = 30
-	BuiltinGlobalSize                = 31
-	BuiltinEnqueuedWorkgroupSize     = 32
-	BuiltinGlobalOffset              = 33
-	BuiltinGlobalLinearId            = 34
-	BuiltinWorkgroupLinearId         = 35
-	BuiltinSubgroupSize              = 36
-	BuiltinSubgroupMaxSize           = 37
-	BuiltinNumSubgroups              = 38
-	BuiltinNumEnqueuedSubgroups      = 39
-	BuiltinSubgroupId                = 40
-	BuiltinSubgroupLocalInvocationId = 41
+	BuiltInPosition                  = 0
+	BuiltInPointSize                 = 1
+	BuiltInClipDistance              = 3
+	BuiltInCullDistance              = 4
+	BuiltInVertexId                  = 5
+	BuiltInInstanceId                = 6
+	BuiltInPrimitiveId               = 7
+	BuiltInInvocationId              = 8
+	BuiltInLayer                     = 9
+	BuiltInViewportIndex             = 10
+	BuiltInTessLevelOuter            = 11
+	BuiltInTessLevelInner            = 12
+	BuiltInTessCoord                 = 13
+	BuiltInPatchVertices             = 14
+	BuiltInFragCoord                 = 15
+	BuiltInPointCoord                = 16
+	BuiltInFrontFacing               = 17
+	BuiltInSampleId                  = 18
+	BuiltInSamplePosition            = 19
+	BuiltInSampleMask                = 20
+	BuiltInFragDepth                 = 22
+	BuiltInHelperInvocation          = 23
+	BuiltInNumWorkgroups             = 24
+	BuiltInWorkgroupSize             = 25
+	BuiltInWorkgroupId               = 26
+	BuiltInLocalInvocationId         = 27
+	BuiltInGlobalInvocationId        = 28
+	BuiltInLocalInvocationIndex      = 29
+	BuiltInWorkDim                   = 30
+	BuiltInGlobalSize                = 31
+	BuiltInEnqueuedWorkgroupSize     = 32
+	BuiltInGlobalOffset              = 33
+	BuiltInGlobalLinearId            = 34
+	BuiltInSubgroupSize              = 36
+	BuiltInSubgroupMaxSize           = 37
+	BuiltInNumSubgroups              = 38
+	BuiltInNumEnqueuedSubgroups      = 39
+	BuiltInSubgroupId                = 40
+	BuiltInSubgroupLocalInvocationId = 41
+	BuiltInVertexIndex               = 42
+	BuiltInInstanceIndex             = 43
 )
 
+var builtInNames = &enumTable{
+	names: map[uint32]string{
+		BuiltInPosition:                  "Position",
+		BuiltInPointSize:                 "PointSize",
+		BuiltInClipDistance:              "ClipDistance",
+		BuiltInCullDistance:              "CullDistance",
+		BuiltInVertexId:                  "VertexId",
+		BuiltInInstanceId:                "InstanceId",
+		BuiltInPrimitiveId:               "PrimitiveId",
+		BuiltInInvocationId:              "InvocationId",
+		BuiltInLayer:                     "Layer",
+		BuiltInViewportIndex:             "ViewportIndex",
+		BuiltInTessLevelOuter:            "TessLevelOuter",
+		BuiltInTessLevelInner:            "TessLevelInner",
+		BuiltInTessCoord:                 "TessCoord",
+		BuiltInPatchVertices:             "PatchVertices",
+		BuiltInFragCoord:                 "FragCoord",
+		BuiltInPointCoord:                "PointCoord",
+		BuiltInFrontFacing:               "FrontFacing",
+		BuiltInSampleId:                  "SampleId",
+		BuiltInSamplePosition:            "SamplePosition",
+		BuiltInSampleMask:                "SampleMask",
+		BuiltInFragDepth:                 "FragDepth",
+		BuiltInHelperInvocation:          "HelperInvocation",
+		BuiltInNumWorkgroups:             "NumWorkgroups",
+		BuiltInWorkgroupSize:             "WorkgroupSize",
+		BuiltInWorkgroupId:               "WorkgroupId",
+		BuiltInLocalInvocationId:         "LocalInvocationId",
+		BuiltInGlobalInvocationId:        "GlobalInvocationId",
+		BuiltInLocalInvocationIndex:      "LocalInvocationIndex",
+		BuiltInWorkDim:                   "WorkDim",
+		BuiltInGlobalSize:                "GlobalSize",
+		BuiltInEnqueuedWorkgroupSize:     "EnqueuedWorkgroupSize",
+		BuiltInGlobalOffset:              "GlobalOffset",
+		BuiltInGlobalLinearId:            "GlobalLinearId",
+		BuiltInSubgroupSize:              "SubgroupSize",
+		BuiltInSubgroupMaxSize:           "SubgroupMaxSize",
+		BuiltInNumSubgroups:              "NumSubgroups",
+		BuiltInNumEnqueuedSubgroups:      "NumEnqueuedSubgroups",
+		BuiltInSubgroupId:                "SubgroupId",
+		BuiltInSubgroupLocalInvocationId: "SubgroupLocalInvocationId",
+		BuiltInVertexIndex:               "VertexIndex",
+		BuiltInInstanceIndex:             "InstanceIndex",
+	},
+}
+
+func (v BuiltIn) String() string { return builtInNames.String(uint32(v)) }
+
 type SelectionControl uint32
 
 func (v SelectionControl) Verify() error {
-	if v >= SelectionControlNoControl && v <= SelectionControlDontFlatten {
+	if selectionControlNames.valid(uint32(v)) {
 		return nil
 	}
 	return ErrInvalidSelectionControl
@@ -930,21 +1431,32 @@ func (v SelectionControl) Verify() error {
 // of flow control structures.
 const (
 	// No control requested.
-	SelectionControlNoControl = 0
+	SelectionControlNone = 0
 
 	// Strong request, to the extent possible, to remove the flow
 	// control for this selection.
-	SelectionControlFlatten = 1
+	SelectionControlFlatten = 0x1
 
 	// Strong request, to the extent possible, to keep this
 	// selection as flow control.
-	SelectionControlDontFlatten = 2
+	SelectionControlDontFlatten = 0x2
 )
 
+var selectionControlNames = &enumTable{
+	flags: true,
+	names: map[uint32]string{
+		SelectionControlNone:        "None",
+		SelectionControlFlatten:     "Flatten",
+		SelectionControlDontFlatten: "DontFlatten",
+	},
+}
+
+func (v SelectionControl) String() string { return selectionControlNames.String(uint32(v)) }
+
 type LoopControl uint32
 
 func (v LoopControl) Verify() error {
-	if v >= LoopControlNoControl && v <= LoopControlDontUnroll {
+	if loopControlNames.valid(uint32(v)) {
 		return nil
 	}
 	return ErrInvalidLoopControl
@@ -954,117 +1466,148 @@ func (v LoopControl) Verify() error {
 // loop constructs.
 const (
 	// No control requested.
-	LoopControlNoControl = 0
+	LoopControlNone = 0
 
 	// Strong request, to the extent possible, to unroll or unwind this loop.
-	LoopControlUnroll = 1
+	LoopControlUnroll = 0x1
 
 	// Strong request, to the extent possible, to keep this loop as a loop,
 	// without unrolling.
-	LoopControlDontUnroll = 2
+	LoopControlDontUnroll = 0x2
 )
 
-type FunctionControlMask uint32
+var loopControlNames = &enumTable{
+	flags: true,
+	names: map[uint32]string{
+		LoopControlNone:       "None",
+		LoopControlUnroll:     "Unroll",
+		LoopControlDontUnroll: "DontUnroll",
+	},
+}
 
-func (v FunctionControlMask) Verify() error {
-	if verifyBitFlag(
-		uint32(v),
-		false,
-		FunctionControlMaskInLine|FunctionControlMaskDontInline|FunctionControlMaskPure|FunctionControlMaskConst,
-	) {
+func (v LoopControl) String() string { return loopControlNames.String(uint32(v)) }
+
+type FunctionControl uint32
+
+func (v FunctionControl) Verify() error {
+	if functionControlNames.valid(uint32(v)) {
 		return nil
 	}
-	return ErrInvalidFunctionControlMask
+	return ErrInvalidFunctionControl
 }
 
-// Function Control Masks define bitmask hints for function optimisations.
+// Function Controls define bitmask hints for function optimisations.
 const (
+	FunctionControlNone = 0
+
 	// Strong request, to the extent possible, to inline the function.
-	FunctionControlMaskInLine = 1
+	FunctionControlInline = 0x1
 
 	// Strong request, to the extent possible, to not inline the function.
-	FunctionControlMaskDontInline = 2
+	FunctionControlDontInline = 0x2
 
 	// Compiler can assume this function has no side effect, but might
 	// read global memory or read through dereferenced function parameters.
 	// Always computes the same result for the same argument values.
-	FunctionControlMaskPure = 4
+	FunctionControlPure = 0x4
 
 	// Compiler can assume this function has no side effects, and will not
 	// access global memory or dereference function parameters. Always
 	// computes the same result for the same argument values.
-	FunctionControlMaskConst = 8
+	FunctionControlConst = 0x8
 )
 
-type MemorySemantic uint32
-
-func (v MemorySemantic) Verify() error {
-	if verifyBitFlag(
-		uint32(v),
-		false,
-		MemorySemanticRelaxed|
-			MemorySemanticSequentiallyConsistent|
-			MemorySemanticAcquire|
-			MemorySemanticRelease|
-			MemorySemanticUniformMemory|
-			MemorySemanticSubgroupMemory|
-			MemorySemanticWorkgroupLocalMemory|
-			MemorySemanticWorkgroupGlobalMemory|
-			MemorySemanticAtomicCounterMemory|
-			MemorySemanticImageMemory,
-	) {
+var functionControlNames = &enumTable{
+	flags: true,
+	names: map[uint32]string{
+		FunctionControlNone:       "None",
+		FunctionControlInline:     "Inline",
+		FunctionControlDontInline: "DontInline",
+		FunctionControlPure:       "Pure",
+		FunctionControlConst:      "Const",
+	},
+}
+
+func (v FunctionControl) String() string { return functionControlNames.String(uint32(v)) }
+
+type MemorySemantics uint32
+
+func (v MemorySemantics) Verify() error {
+	if memorySemanticsNames.valid(uint32(v)) {
 		return nil
 	}
-	return ErrInvalidMemorySemantic
+	return ErrInvalidMemorySemantics
 }
 
 // Memory Semantics define bitflag memory classifications and
 // ordering semantics.
 const (
-	// TODO: ...
-	MemorySemanticRelaxed = 1
-
-	// All observers will see this memory access in the same order WRT to
-	// other sequentially-consistent memory accesses from this invocation.
-	MemorySemanticSequentiallyConsistent = 2
+	MemorySemanticsNone = 0
 
 	// All memory operations provided in program order after this memory
 	// operation will execute after this memory operation.
-	MemorySemanticAcquire = 4
+	MemorySemanticsAcquire = 0x2
 
 	// All memory operations provided in program order before this memory
 	// operation will execute before this memory operation.
-	MemorySemanticRelease = 8
+	MemorySemanticsRelease = 0x4
+
+	// Has the properties of both Acquire and Release semantics. It is
+	// used for read-modify-write operations.
+	MemorySemanticsAcquireRelease = 0x8
+
+	// All observers will see this memory access in the same order WRT to
+	// other sequentially-consistent memory accesses from this invocation.
+	MemorySemanticsSequentiallyConsistent = 0x10
 
 	// Filter the memory operations being constrained to just those
 	// accessing Uniform Storage Class memory.
-	MemorySemanticUniformMemory = 16
+	MemorySemanticsUniformMemory = 0x40
 
 	// The memory semantics only have to be correct WRT to this invocation’s
 	// subgroup memory
-	MemorySemanticSubgroupMemory = 32
+	MemorySemanticsSubgroupMemory = 0x80
 
 	// The memory semantics only have to be correct WRT to this invocation’s
 	// local workgroup memory.
-	MemorySemanticWorkgroupLocalMemory = 64
+	MemorySemanticsWorkgroupMemory = 0x100
 
 	// The memory semantics only have to be correct WRT to this invocation’s
 	// global workgroup memory.
-	MemorySemanticWorkgroupGlobalMemory = 128
+	MemorySemanticsCrossWorkgroupMemory = 0x200
 
 	// Filter the memory operations being constrained to just those
 	// accessing AtomicCounter Storage Class memory.
-	MemorySemanticAtomicCounterMemory = 256
+	MemorySemanticsAtomicCounterMemory = 0x400
 
 	// Filter the memory operations being constrained to just those
-	// accessing images (see OpTypeSampler Content).
-	MemorySemanticImageMemory = 512
+	// accessing images (see OpTypeImage).
+	MemorySemanticsImageMemory = 0x800
 )
 
+var memorySemanticsNames = &enumTable{
+	flags: true,
+	names: map[uint32]string{
+		MemorySemanticsNone:                   "None",
+		MemorySemanticsAcquire:                "Acquire",
+		MemorySemanticsRelease:                "Release",
+		MemorySemanticsAcquireRelease:         "AcquireRelease",
+		MemorySemanticsSequentiallyConsistent: "SequentiallyConsistent",
+		MemorySemanticsUniformMemory:          "UniformMemory",
+		MemorySemanticsSubgroupMemory:         "SubgroupMemory",
+		MemorySemanticsWorkgroupMemory:        "WorkgroupMemory",
+		MemorySemanticsCrossWorkgroupMemory:   "CrossWorkgroupMemory",
+		MemorySemanticsAtomicCounterMemory:    "AtomicCounterMemory",
+		MemorySemanticsImageMemory:            "ImageMemory",
+	},
+}
+
+func (v MemorySemantics) String() string { return memorySemanticsNames.String(uint32(v)) }
+
 type MemoryAccess uint32
 
 func (v MemoryAccess) Verify() error {
-	if v >= MemoryAccessVolatile && v <= MemoryAccessAligned {
+	if memoryAccessNames.valid(uint32(v)) {
 		return nil
 	}
 	return ErrInvalidMemoryAccess
@@ -1072,42 +1615,72 @@ func (v MemoryAccess) Verify() error {
 
 // Memory Access defines memory access semantics.
 const (
+	MemoryAccessNone = 0
+
 	// This access cannot be optimized away; it has to be executed.
-	MemoryAccessVolatile = 1
+	MemoryAccessVolatile = 0x1
 
 	// This access has a known alignment, provided as a literal in
 	// the next operand.
-	MemoryAccessAligned = 2
+	MemoryAccessAligned = 0x2
+
+	MemoryAccessNontemporal = 0x4
 )
 
-type ExecutionScope uint32
+var memoryAccessNames = &enumTable{
+	flags: true,
+	names: map[uint32]string{
+		MemoryAccessNone:        "None",
+		MemoryAccessVolatile:    "Volatile",
+		MemoryAccessAligned:     "Aligned",
+		MemoryAccessNontemporal: "Nontemporal",
+	},
+}
+
+func (v MemoryAccess) String() string { return memoryAccessNames.String(uint32(v)) }
 
-func (v ExecutionScope) Verify() error {
-	if v >= ExecutionScopeCrossDevice && v <= ExecutionScopeSubgroup {
+type Scope uint32
+
+func (v Scope) Verify() error {
+	if scopeNames.valid(uint32(v)) {
 		return nil
 	}
-	return ErrInvalidExecutionScope
+	return ErrInvalidScope
 }
 
-// Execution Scopes define the scope of execution.
+// Scopes define the scope of execution or memory operations.
 const (
 	// Everything executing on all the execution devices in the system.
-	ExecutionScopeCrossDevice = 0
+	ScopeCrossDevice = 0
 
 	// Everything executing on the device executing this invocation
-	ExecutionScopeDevice = 1
+	ScopeDevice = 1
 
 	// All invocations for the invoking workgroup.
-	ExecutionScopeWorkgroup = 2
+	ScopeWorkgroup = 2
 
 	// All invocations in the currently executing subgroup.
-	ExecutionScopeSubgroup = 3
+	ScopeSubgroup = 3
+
+	ScopeInvocation = 4
 )
 
+var scopeNames = &enumTable{
+	names: map[uint32]string{
+		ScopeCrossDevice: "CrossDevice",
+		ScopeDevice:      "Device",
+		ScopeWorkgroup:   "Workgroup",
+		ScopeSubgroup:    "Subgroup",
+		ScopeInvocation:  "Invocation",
+	},
+}
+
+func (v Scope) String() string { return scopeNames.String(uint32(v)) }
+
 type GroupOperation uint32
 
 func (v GroupOperation) Verify() error {
-	if v >= GroupOperationReduce && v <= GroupOperationExclusiveScan {
+	if groupOperationNames.valid(uint32(v)) {
 		return nil
 	}
 	return ErrInvalidGroupOperation
@@ -1130,13 +1703,23 @@ const (
 	GroupOperationExclusiveScan = 2
 )
 
-type KernelEnqueueFlag uint32
+var groupOperationNames = &enumTable{
+	names: map[uint32]string{
+		GroupOperationReduce:        "Reduce",
+		GroupOperationInclusiveScan: "InclusiveScan",
+		GroupOperationExclusiveScan: "ExclusiveScan",
+	},
+}
+
+func (v GroupOperation) String() string { return groupOperationNames.String(uint32(v)) }
 
-func (v KernelEnqueueFlag) Verify() error {
-	if v >= KernelEnqueueFlagNoWait && v <= KernelEnqueueFlagWaitWorkGroup {
+type KernelEnqueueFlags uint32
+
+func (v KernelEnqueueFlags) Verify() error {
+	if kernelEnqueueFlagsNames.valid(uint32(v)) {
 		return nil
 	}
-	return ErrInvalidKernelEnqueueFlag
+	return ErrInvalidKernelEnqueueFlags
 }
 
 // Kernel Enqueue Flags specify when the child kernel begins execution.
@@ -1147,7 +1730,7 @@ func (v KernelEnqueueFlag) Verify() error {
 const (
 	// Indicates that the enqueued kernels do not need to wait for the
 	// parent kernel to finish execution before they begin execution.
-	KernelEnqueueFlagNoWait = 0
+	KernelEnqueueFlagsNoWait = 0
 
 	// Indicates that all work-items of the parent kernel must finish
 	// executing and all immediate side effects committed before the
@@ -1156,7 +1739,7 @@ const (
 	// Note: Immediate meaning not side effects resulting from child
 	// kernels. The side effects would include stores to global memory
 	// and pipe reads and writes.
-	KernelEnqueueFlagWaitKernel = 1
+	KernelEnqueueFlagsWaitKernel = 1
 
 	// Indicates that the enqueued kernels wait only for the workgroup that
 	// enqueued the kernels to finish before they begin execution.
@@ -1164,13 +1747,23 @@ const (
 	// Note: This acts as a memory synchronization point between work-items
 	// in a work-group and child kernels enqueued by work-items in the
 	// work-group.
-	KernelEnqueueFlagWaitWorkGroup = 2
+	KernelEnqueueFlagsWaitWorkGroup = 2
 )
 
+var kernelEnqueueFlagsNames = &enumTable{
+	names: map[uint32]string{
+		KernelEnqueueFlagsNoWait:        "NoWait",
+		KernelEnqueueFlagsWaitKernel:    "WaitKernel",
+		KernelEnqueueFlagsWaitWorkGroup: "WaitWorkGroup",
+	},
+}
+
+func (v KernelEnqueueFlags) String() string { return kernelEnqueueFlagsNames.String(uint32(v)) }
+
 type KernelProfilingInfo uint32
 
 func (v KernelProfilingInfo) Verify() error {
-	if v == KernelProfilingInfoCmdExecTime {
+	if kernelProfilingInfoNames.valid(uint32(v)) {
 		return nil
 	}
 	return ErrInvalidKernelProfilingInfo
@@ -1179,5 +1772,149 @@ func (v KernelProfilingInfo) Verify() error {
 // Kernel Profiling Info specifies the profiling information to be queried.
 // Used by OpCaptureEventProfilingInfo.
 const (
-	KernelProfilingInfoCmdExecTime = 1
+	KernelProfilingInfoNone        = 0
+	KernelProfilingInfoCmdExecTime = 0x1
 )
+
+var kernelProfilingInfoNames = &enumTable{
+	flags: true,
+	names: map[uint32]string{
+		KernelProfilingInfoNone:        "None",
+		KernelProfilingInfoCmdExecTime: "CmdExecTime",
+	},
+}
+
+func (v KernelProfilingInfo) String() string { return kernelProfilingInfoNames.String(uint32(v)) }
+
+type Capability uint32
+
+func (v Capability) Verify() error {
+	if capabilityNames.valid(uint32(v)) {
+		return nil
+	}
+	return ErrInvalidCapability
+}
+
+// Capabilities declare the set of features used by a module,
+// through OpCapability.
+const (
+	CapabilityMatrix                            = 0
+	CapabilityShader                            = 1
+	CapabilityGeometry                          = 2
+	CapabilityTessellation                      = 3
+	CapabilityAddresses                         = 4
+	CapabilityLinkage                           = 5
+	CapabilityKernel                            = 6
+	CapabilityVector16                          = 7
+	CapabilityFloat16Buffer                     = 8
+	CapabilityFloat16                           = 9
+	CapabilityFloat64                           = 10
+	CapabilityInt64                             = 11
+	CapabilityInt64Atomics                      = 12
+	CapabilityImageBasic                        = 13
+	CapabilityImageReadWrite                    = 14
+	CapabilityImageMipmap                       = 15
+	CapabilityPipes                             = 17
+	CapabilityGroups                            = 18
+	CapabilityDeviceEnqueue                     = 19
+	CapabilityLiteralSampler                    = 20
+	CapabilityAtomicStorage                     = 21
+	CapabilityInt16                             = 22
+	CapabilityTessellationPointSize             = 23
+	CapabilityGeometryPointSize                 = 24
+	CapabilityImageGatherExtended               = 25
+	CapabilityStorageImageMultisample           = 27
+	CapabilityUniformBufferArrayDynamicIndexing = 28
+	CapabilitySampledImageArrayDynamicIndexing  = 29
+	CapabilityStorageBufferArrayDynamicIndexing = 30
+	CapabilityStorageImageArrayDynamicIndexing  = 31
+	CapabilityClipDistance                      = 32
+	CapabilityCullDistance                      = 33
+	CapabilityImageCubeArray                    = 34
+	CapabilitySampleRateShading                 = 35
+	CapabilityImageRect                         = 36
+	CapabilitySampledRect                       = 37
+	CapabilityGenericPointer                    = 38
+	CapabilityInt8                              = 39
+	CapabilityInputAttachment                   = 40
+	CapabilitySparseResidency                   = 41
+	CapabilityMinLod                            = 42
+	CapabilitySampled1D                         = 43
+	CapabilityImage1D                           = 44
+	CapabilitySampledCubeArray                  = 45
+	CapabilitySampledBuffer                     = 46
+	CapabilityImageBuffer                       = 47
+	CapabilityImageMSArray                      = 48
+	CapabilityStorageImageExtendedFormats       = 49
+	CapabilityImageQuery                        = 50
+	CapabilityDerivativeControl                 = 51
+	CapabilityInterpolationFunction             = 52
+	CapabilityTransformFeedback                 = 53
+	CapabilityGeometryStreams                   = 54
+	CapabilityStorageImageReadWithoutFormat     = 55
+	CapabilityStorageImageWriteWithoutFormat    = 56
+	CapabilityMultiViewport                     = 57
+)
+
+var capabilityNames = &enumTable{
+	names: map[uint32]string{
+		CapabilityMatrix:                            "Matrix",
+		CapabilityShader:                            "Shader",
+		CapabilityGeometry:                          "Geometry",
+		CapabilityTessellation:                      "Tessellation",
+		CapabilityAddresses:                         "Addresses",
+		CapabilityLinkage:                           "Linkage",
+		CapabilityKernel:                            "Kernel",
+		CapabilityVector16:                          "Vector16",
+		CapabilityFloat16Buffer:                     "Float16Buffer",
+		CapabilityFloat16:                           "Float16",
+		CapabilityFloat64:                           "Float64",
+		CapabilityInt64:                             "Int64",
+		CapabilityInt64Atomics:                      "Int64Atomics",
+		CapabilityImageBasic:                        "ImageBasic",
+		CapabilityImageReadWrite:                    "ImageReadWrite",
+		CapabilityImageMipmap:                       "ImageMipmap",
+		CapabilityPipes:                             "Pipes",
+		CapabilityGroups:                            "Groups",
+		CapabilityDeviceEnqueue:                     "DeviceEnqueue",
+		CapabilityLiteralSampler:                    "LiteralSampler",
+		CapabilityAtomicStorage:                     "AtomicStorage",
+		CapabilityInt16:                             "Int16",
+		CapabilityTessellationPointSize:             "TessellationPointSize",
+		CapabilityGeometryPointSize:                 "GeometryPointSize",
+		CapabilityImageGatherExtended:               "ImageGatherExtended",
+		CapabilityStorageImageMultisample:           "StorageImageMultisample",
+		CapabilityUniformBufferArrayDynamicIndexing: "UniformBufferArrayDynamicIndexing",
+		CapabilitySampledImageArrayDynamicIndexing:  "SampledImageArrayDynamicIndexing",
+		CapabilityStorageBufferArrayDynamicIndexing: "StorageBufferArrayDynamicIndexing",
+		CapabilityStorageImageArrayDynamicIndexing:  "StorageImageArrayDynamicIndexing",
+		CapabilityClipDistance:                      "ClipDistance",
+		CapabilityCullDistance:                      "CullDistance",
+		CapabilityImageCubeArray:                    "ImageCubeArray",
+		CapabilitySampleRateShading:                 "SampleRateShading",
+		CapabilityImageRect:                         "ImageRect",
+		CapabilitySampledRect:                       "SampledRect",
+		CapabilityGenericPointer:                    "GenericPointer",
+		CapabilityInt8:                              "Int8",
+		CapabilityInputAttachment:                   "InputAttachment",
+		CapabilitySparseResidency:                   "SparseResidency",
+		CapabilityMinLod:                            "MinLod",
+		CapabilitySampled1D:                         "Sampled1D",
+		CapabilityImage1D:                           "Image1D",
+		CapabilitySampledCubeArray:                  "SampledCubeArray",
+		CapabilitySampledBuffer:                     "SampledBuffer",
+		CapabilityImageBuffer:                       "ImageBuffer",
+		CapabilityImageMSArray:                      "ImageMSArray",
+		CapabilityStorageImageExtendedFormats:       "StorageImageExtendedFormats",
+		CapabilityImageQuery:                        "ImageQuery",
+		CapabilityDerivativeControl:                 "DerivativeControl",
+		CapabilityInterpolationFunction:             "InterpolationFunction",
+		CapabilityTransformFeedback:                 "TransformFeedback",
+		CapabilityGeometryStreams:                   "GeometryStreams",
+		CapabilityStorageImageReadWithoutFormat:     "StorageImageReadWithoutFormat",
+		CapabilityStorageImageWriteWithoutFormat:    "StorageImageWriteWithoutFormat",
+		CapabilityMultiViewport:                     "MultiViewport",
+	},
+}
+
+func (v Capability) String() string { return capabilityNames.String(uint32(v)) }
@@ -10,199 +10,238 @@ type constantTest struct {
 	min    uint32
 	max    uint32
 	err    error
-	even   bool
+
+	// Values inside the [min, max] range which are not valid.
+	invalid []uint32
 }
 
 func TestConstant(t *testing.T) {
 	for _, ct := range []constantTest{
 		{
-			min: AccessQualifierReadOnly,
-			max: AccessQualifierReadWrite,
-			err: ErrInvalidAccessQualifier,
+			min: SourceLanguageUnknown,
+			max: SourceLanguageOpenCL_CPP,
+			err: ErrInvalidSourceLanguage,
 			verify: func(w uint32) error {
-				return AccessQualifier(w).Verify()
+				return SourceLanguage(w).Verify()
 			},
 		},
 		{
-			min: AddressingModeLogical,
-			max: AddressingModePhysical64,
+			min: ExecutionModelVertex,
+			max: ExecutionModelKernel,
+			err: ErrInvalidExecutionModel,
+			verify: func(w uint32) error {
+				return ExecutionModel(w).Verify()
+			},
+		},
+		{
+			min: AddressingModelLogical,
+			max: AddressingModelPhysical64,
 			err: ErrInvalidAddressingModel,
 			verify: func(w uint32) error {
 				return AddressingModel(w).Verify()
 			},
 		},
 		{
-			min: Dim1D,
-			max: DimBuffer,
-			err: ErrInvalidDimensionality,
+			min: MemoryModelSimple,
+			max: MemoryModelOpenCL,
+			err: ErrInvalidMemoryModel,
 			verify: func(w uint32) error {
-				return Dimensionality(w).Verify()
+				return MemoryModel(w).Verify()
 			},
 		},
 		{
-			min: ExecutionModeInvocations,
-			max: ExecutionModeContractionOff,
-			err: ErrInvalidExecutionMode,
+			min:     ExecutionModeInvocations,
+			max:     ExecutionModeContractionOff,
+			err:     ErrInvalidExecutionMode,
+			invalid: []uint32{13},
 			verify: func(w uint32) error {
 				return ExecutionMode(w).Verify()
 			},
 		},
 		{
-			min: ExecutionModelVertex,
-			max: ExecutionModelKernel,
-			err: ErrInvalidExecutionModel,
+			min: StorageClassUniformConstant,
+			max: StorageClassImage,
+			err: ErrInvalidStorageClass,
 			verify: func(w uint32) error {
-				return ExecutionModel(w).Verify()
+				return StorageClass(w).Verify()
 			},
 		},
 		{
-			min: FPFastMathModeNotNaN,
-			max: FPFastMathModeNotNaN | FPFastMathModeNotInf |
-				FPFastMathModeNSZ | FPFastMathModeAllowRecip |
-				FPFastMathModeFast,
-			err: ErrInvalidFPFastMathMode,
+			min: Dim1D,
+			max: DimSubpassData,
+			err: ErrInvalidDim,
 			verify: func(w uint32) error {
-				return FPFastMathMode(w).Verify()
+				return Dim(w).Verify()
 			},
 		},
 		{
-			min: FPRoundingModeRTE,
-			max: FPRoundingModeRTN,
-			err: ErrInvalidFPRoundingMode,
+			min: SamplerAddressingModeNone,
+			max: SamplerAddressingModeRepeatMirrored,
+			err: ErrInvalidSamplerAddressingMode,
 			verify: func(w uint32) error {
-				return FPRoundingMode(w).Verify()
+				return SamplerAddressingMode(w).Verify()
 			},
 		},
 		{
-			min: LinkageTypeExport,
-			max: LinkageTypeImport,
-			err: ErrInvalidLinkageType,
+			min: SamplerFilterModeNearest,
+			max: SamplerFilterModeLinear,
+			err: ErrInvalidSamplerFilterMode,
 			verify: func(w uint32) error {
-				return LinkageType(w).Verify()
+				return SamplerFilterMode(w).Verify()
 			},
 		},
 		{
-			min: MemoryModelSimple,
-			max: MemoryModelOpenCL21,
-			err: ErrInvalidMemoryModel,
+			min: ImageFormatUnknown,
+			max: ImageFormatR8ui,
+			err: ErrInvalidImageFormat,
 			verify: func(w uint32) error {
-				return MemoryModel(w).Verify()
+				return ImageFormat(w).Verify()
 			},
 		},
 		{
-			min:  SamplerAddressingModeNone,
-			max:  SamplerAddressingModeRepeatMirrored,
-			err:  ErrInvalidSamplerAddressingMode,
-			even: true,
+			min: ImageChannelOrderR,
+			max: ImageChannelOrdersBGRA,
+			err: ErrInvalidImageChannelOrder,
 			verify: func(w uint32) error {
-				return SamplerAddressingMode(w).Verify()
+				return ImageChannelOrder(w).Verify()
 			},
 		},
 		{
-			min:  SamplerFilterModeNearest,
-			max:  SamplerFilterModeLinear,
-			err:  ErrInvalidSamplerFilterMode,
-			even: true,
+			min: ImageChannelDataTypeSnormInt8,
+			max: ImageChannelDataTypeUnormInt101010_2,
+			err: ErrInvalidImageChannelDataType,
 			verify: func(w uint32) error {
-				return SamplerFilterMode(w).Verify()
+				return ImageChannelDataType(w).Verify()
 			},
 		},
 		{
-			min: SourceLanguageUnknown,
-			max: SourceLanguageOpenCL,
-			err: ErrInvalidSourceLanguage,
+			min: ImageOperandsNone,
+			max: ImageOperandsBias | ImageOperandsLod | ImageOperandsGrad |
+				ImageOperandsConstOffset | ImageOperandsOffset |
+				ImageOperandsConstOffsets | ImageOperandsSample |
+				ImageOperandsMinLod,
+			err: ErrInvalidImageOperands,
 			verify: func(w uint32) error {
-				return SourceLanguage(w).Verify()
+				return ImageOperands(w).Verify()
 			},
 		},
 		{
-			min: StorageClassUniformConstant,
-			max: StorageClassAtomicCounter,
-			err: ErrInvalidStorageClass,
+			min: FPFastMathModeNone,
+			max: FPFastMathModeNotNaN | FPFastMathModeNotInf |
+				FPFastMathModeNSZ | FPFastMathModeAllowRecip |
+				FPFastMathModeFast,
+			err: ErrInvalidFPFastMathMode,
 			verify: func(w uint32) error {
-				return StorageClass(w).Verify()
+				return FPFastMathMode(w).Verify()
 			},
 		},
 		{
-			min: FunctionParamAttrZext,
-			max: FunctionParamAttrNoReadWrite,
-			err: ErrInvalidFunctionParameter,
+			min: FPRoundingModeRTE,
+			max: FPRoundingModeRTN,
+			err: ErrInvalidFPRoundingMode,
 			verify: func(w uint32) error {
-				return FunctionParameter(w).Verify()
+				return FPRoundingMode(w).Verify()
 			},
 		},
 		{
-			min: DecorationPrecisionLow,
-			max: DecorationSpecId,
-			err: ErrInvalidDecoration,
+			min: LinkageTypeExport,
+			max: LinkageTypeImport,
+			err: ErrInvalidLinkageType,
+			verify: func(w uint32) error {
+				return LinkageType(w).Verify()
+			},
+		},
+		{
+			min: AccessQualifierReadOnly,
+			max: AccessQualifierReadWrite,
+			err: ErrInvalidAccessQualifier,
+			verify: func(w uint32) error {
+				return AccessQualifier(w).Verify()
+			},
+		},
+		{
+			min: FunctionParameterAttributeZext,
+			max: FunctionParameterAttributeNoReadWrite,
+			err: ErrInvalidFunctionParameterAttribute,
+			verify: func(w uint32) error {
+				return FunctionParameterAttribute(w).Verify()
+			},
+		},
+		{
+			min:     DecorationRelaxedPrecision,
+			max:     DecorationAlignment,
+			err:     ErrInvalidDecoration,
+			invalid: []uint32{12, 27},
 			verify: func(w uint32) error {
 				return Decoration(w).Verify()
 			},
 		},
 		{
-			min: BuiltinPosition,
-			max: BuiltinSubgroupLocalInvocationId,
-			err: ErrInvalidBuiltin,
+			min:     BuiltInPosition,
+			max:     BuiltInInstanceIndex,
+			err:     ErrInvalidBuiltIn,
+			invalid: []uint32{2, 21, 35},
 			verify: func(w uint32) error {
-				return Builtin(w).Verify()
+				return BuiltIn(w).Verify()
 			},
 		},
 		{
-			min: SelectionControlNoControl,
-			max: SelectionControlDontFlatten,
+			min: SelectionControlNone,
+			max: SelectionControlFlatten | SelectionControlDontFlatten,
 			err: ErrInvalidSelectionControl,
 			verify: func(w uint32) error {
 				return SelectionControl(w).Verify()
 			},
 		},
 		{
-			min: LoopControlNoControl,
-			max: LoopControlDontUnroll,
+			min: LoopControlNone,
+			max: LoopControlUnroll | LoopControlDontUnroll,
 			err: ErrInvalidLoopControl,
 			verify: func(w uint32) error {
 				return LoopControl(w).Verify()
 			},
 		},
 		{
-			min: FunctionControlMaskInLine,
-			max: FunctionControlMaskInLine | FunctionControlMaskDontInline |
-				FunctionControlMaskPure | FunctionControlMaskConst,
-			err: ErrInvalidFunctionControlMask,
+			min: FunctionControlNone,
+			max: FunctionControlInline | FunctionControlDontInline |
+				FunctionControlPure | FunctionControlConst,
+			err: ErrInvalidFunctionControl,
 			verify: func(w uint32) error {
-				return FunctionControlMask(w).Verify()
+				return FunctionControl(w).Verify()
 			},
 		},
 		{
-			min: MemorySemanticRelaxed,
-			max: MemorySemanticRelaxed |
-				MemorySemanticSequentiallyConsistent |
-				MemorySemanticAcquire |
-				MemorySemanticRelease |
-				MemorySemanticUniformMemory |
-				MemorySemanticSubgroupMemory |
-				MemorySemanticWorkgroupLocalMemory |
-				MemorySemanticWorkgroupGlobalMemory |
-				MemorySemanticAtomicCounterMemory |
-				MemorySemanticImageMemory,
-			err: ErrInvalidMemorySemantic,
+			min: MemorySemanticsNone,
+			max: MemorySemanticsAcquire | MemorySemanticsRelease |
+				MemorySemanticsAcquireRelease |
+				MemorySemanticsSequentiallyConsistent |
+				MemorySemanticsUniformMemory |
+				MemorySemanticsSubgroupMemory |
+				MemorySemanticsWorkgroupMemory |
+				MemorySemanticsCrossWorkgroupMemory |
+				MemorySemanticsAtomicCounterMemory |
+				MemorySemanticsImageMemory,
+			err:     ErrInvalidMemorySemantics,
+			invalid: []uint32{0x1, 0x20},
 			verify: func(w uint32) error {
-				return MemorySemantic(w).Verify()
+				return MemorySemantics(w).Verify()
 			},
 		},
 		{
-			min: MemoryAccessVolatile,
-			max: MemoryAccessAligned,
+			min: MemoryAccessNone,
+			max: MemoryAccessVolatile | MemoryAccessAligned |
+				MemoryAccessNontemporal,
 			err: ErrInvalidMemoryAccess,
 			verify: func(w uint32) error {
 				return MemoryAccess(w).Verify()
 			},
 		},
 		{
-			min: ExecutionScopeCrossDevice,
-			max: ExecutionScopeSubgroup,
-			err: ErrInvalidExecutionScope,
+			min: ScopeCrossDevice,
+			max: ScopeInvocation,
+			err: ErrInvalidScope,
 			verify: func(w uint32) error {
-				return ExecutionScope(w).Verify()
+				return Scope(w).Verify()
 			},
 		},
 		{
@@ -214,21 +253,30 @@ func TestConstant(t *testing.T) {
 			},
 		},
 		{
-			min: KernelEnqueueFlagNoWait,
-			max: KernelEnqueueFlagWaitWorkGroup,
-			err: ErrInvalidKernelEnqueueFlag,
+			min: KernelEnqueueFlagsNoWait,
+			max: KernelEnqueueFlagsWaitWorkGroup,
+			err: ErrInvalidKernelEnqueueFlags,
 			verify: func(w uint32) error {
-				return KernelEnqueueFlag(w).Verify()
+				return KernelEnqueueFlags(w).Verify()
 			},
 		},
 		{
-			min: KernelProfilingInfoCmdExecTime,
+			min: KernelProfilingInfoNone,
 			max: KernelProfilingInfoCmdExecTime,
 			err: ErrInvalidKernelProfilingInfo,
 			verify: func(w uint32) error {
 				return KernelProfilingInfo(w).Verify()
 			},
 		},
+		{
+			min:     CapabilityMatrix,
+			max:     CapabilityMultiViewport,
+			err:     ErrInvalidCapability,
+			invalid: []uint32{16, 26},
+			verify: func(w uint32) error {
+				return Capability(w).Verify()
+			},
+		},
 	} {
 		have := ct.verify(ct.min)
 		if have != nil {
@@ -246,18 +294,14 @@ func TestConstant(t *testing.T) {
 				ct.max, have, ct.err)
 		}
 
-		if !ct.even {
-			continue
-		}
-
-		// SamplerAddressingMode and SamplerFilterMode are not exactly bit flags,
-		// but they are numbered like one; sort of.
-		//
-		// Giving it odd numbers inside the valid range should be an error.
-		have = ct.verify(ct.min + 1)
-		if have != ct.err {
-			t.Fatalf("Odd value mismatch: %d\nHave: %v\nWant: %v",
-				ct.max, have, ct.err)
+		// Not all enumerations are numbered contiguously. Values in the
+		// gaps should be an error.
+		for _, w := range ct.invalid {
+			have = ct.verify(w)
+			if have != ct.err {
+				t.Fatalf("Gap value mismatch: %d\nHave: %v\nWant: %v",
+					w, have, ct.err)
+			}
 		}
 	}
 }
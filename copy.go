@@ -16,5 +16,5 @@ func Copy(v []uint32) []uint32 {
 
 	new := make([]uint32, len(v))
 	copy(new, v)
-	return v
+	return new
 }
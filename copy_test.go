@@ -19,4 +19,8 @@ func testCopy(t *testing.T, want []uint32) {
 	if !reflect.DeepEqual(have, want) {
 		t.Fatalf("copy mismatch:\nHave: %v\nWant: %v", have, want)
 	}
+
+	if len(have) > 0 && &have[0] == &want[0] {
+		t.Fatalf("copy shares memory with its input")
+	}
 }
@@ -0,0 +1,213 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// EliminateDeadCode removes all functions, global variables, types and
+// constants which can not be reached from any OpEntryPoint. Debug names,
+// decorations and execution modes which target removed ids are removed
+// along with them.
+//
+// An id is reachable if it is used by an entry point, by an instruction
+// in a reachable function, or by the declaration of another reachable
+// id. Functions and variables decorated with LinkageAttributes are
+// visible to the linker, so they are always considered reachable.
+//
+// The id bound is left as it is. Use CompactIds to renumber the
+// remaining ids.
+func (m *Module) EliminateDeadCode() {
+	start, end := m.Code.functionRanges()
+	if len(start) != m.Code.Count(opcodeFunction) {
+		return // Malformed functions; we can not tell what is in them.
+	}
+
+	live := m.liveIds(start, end)
+
+	// Ids decorated through a decoration group are live only if the
+	// target is, so the group itself is live if it has any live targets.
+	for _, instr := range m.Code {
+		switch v := instr.(type) {
+		case *OpGroupDecorate:
+			v.Targets = filterIds(v.Targets, live)
+			if len(v.Targets) > 0 {
+				live[v.Group] = true
+			}
+
+		case *OpGroupMemberDecorate:
+			var targets []uint32
+			for i := 0; i+1 < len(v.Targets); i += 2 {
+				if live[Id(v.Targets[i])] {
+					targets = append(targets, v.Targets[i], v.Targets[i+1])
+				}
+			}
+
+			v.Targets = targets
+			if len(v.Targets) > 0 {
+				live[v.Group] = true
+			}
+		}
+	}
+
+	// Look up function liveness before the code is compacted in place.
+	liveFuncs := make([]bool, len(start))
+	for i, s := range start {
+		id, _ := ResultId(m.Code[s])
+		liveFuncs[i] = live[id]
+	}
+
+	code := m.Code[:0]
+	fn := 0
+
+	for addr, instr := range m.Code {
+		for fn < len(start) && addr > end[fn] {
+			fn++
+		}
+
+		if fn < len(start) && addr >= start[fn] {
+			if liveFuncs[fn] {
+				code = append(code, instr)
+			}
+			continue
+		}
+
+		if isLiveDeclaration(instr, live) {
+			code = append(code, instr)
+		}
+	}
+
+	for i := len(code); i < len(m.Code); i++ {
+		m.Code[i] = nil
+	}
+
+	m.Code = code
+}
+
+// liveIds returns the set of ids which are reachable from the entry
+// points and linkage declarations in the module, along with all ids
+// defined in reachable functions. The start and end
+// addresses of all functions are given by functionRanges.
+func (m *Module) liveIds(start, end []int) map[Id]bool {
+	live := make(map[Id]bool)
+	defs := make(map[Id]int)
+	funcs := make(map[Id]int)
+
+	var work []Id
+	var operands []Id
+
+	for i, s := range start {
+		id, _ := ResultId(m.Code[s])
+		funcs[id] = i
+	}
+
+	use := func(instr Instruction) {
+		operands = appendOperands(operands[:0], instr)
+		for _, id := range operands {
+			if !live[id] {
+				live[id] = true
+				work = append(work, id)
+			}
+		}
+	}
+
+	fn := 0
+
+	for addr, instr := range m.Code {
+		for fn < len(start) && addr > end[fn] {
+			fn++
+		}
+
+		if fn < len(start) && addr >= start[fn] {
+			continue
+		}
+
+		if id, ok := ResultId(instr); ok {
+			defs[id] = addr
+			continue
+		}
+
+		switch v := instr.(type) {
+		case *OpName, *OpMemberName, *OpExecutionMode,
+			*OpMemberDecorate, *OpGroupDecorate, *OpGroupMemberDecorate,
+			*OpTypeForwardPointer:
+			// These only refer to ids, they do not keep them alive.
+
+		case *OpDecorate:
+			if v.Decoration == DecorationLinkageAttributes {
+				use(v)
+			}
+
+		default:
+			// Entry points, capabilities, the memory model and
+			// source information are always kept, so everything
+			// they refer to is live.
+			use(v)
+		}
+	}
+
+	for len(work) > 0 {
+		id := work[len(work)-1]
+		work = work[:len(work)-1]
+
+		if i, ok := funcs[id]; ok {
+			for _, instr := range m.Code[start[i] : end[i]+1] {
+				use(instr)
+
+				// Everything defined in a live function is kept, so
+				// names and decorations of unused parameters, labels
+				// and locals must be kept as well.
+				if id, ok := ResultId(instr); ok {
+					live[id] = true
+				}
+			}
+			continue
+		}
+
+		if addr, ok := defs[id]; ok {
+			use(m.Code[addr])
+		}
+	}
+
+	return live
+}
+
+// isLiveDeclaration returns true if the given instruction, which is
+// not part of a function, should be kept in a module with the given
+// set of live ids.
+func isLiveDeclaration(instr Instruction, live map[Id]bool) bool {
+	if id, ok := ResultId(instr); ok {
+		return live[id]
+	}
+
+	switch v := instr.(type) {
+	case *OpName:
+		return live[v.Target]
+	case *OpMemberName:
+		return live[v.Type]
+	case *OpExecutionMode:
+		return live[v.EntryPoint]
+	case *OpDecorate:
+		return live[v.Target]
+	case *OpMemberDecorate:
+		return live[v.StructType]
+	case *OpGroupDecorate:
+		return len(v.Targets) > 0
+	case *OpGroupMemberDecorate:
+		return len(v.Targets) > 0
+	case *OpTypeForwardPointer:
+		return live[v.PointerType]
+	}
+
+	return true
+}
+
+// filterIds returns the ids in the given set which are live.
+// The result shares storage with ids.
+func filterIds(ids []Id, live map[Id]bool) []Id {
+	out := ids[:0]
+	for _, id := range ids {
+		if live[id] {
+			out = append(out, id)
+		}
+	}
+	return out
+}
@@ -0,0 +1,191 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestModuleEliminateDeadCode(t *testing.T) {
+	for i, st := range []struct {
+		in   string
+		want string
+	}{
+		{
+			// The entry point calls %20; %30, %12, %13 and %14
+			// are not used by anything reachable.
+			in: `
+				     OpCapability Shader
+				     OpMemoryModel Logical GLSL450
+				     OpEntryPoint Fragment %10 "main" %15
+				     OpExecutionMode %10 OriginUpperLeft
+				     OpName %10 "main"
+				     OpName %30 "unused"
+				     OpName %14 "unused_var"
+				     OpDecorate %15 Location 0
+				     OpDecorate %14 Location 1
+				     OpDecorate %40 Flat
+				%40 = OpDecorationGroup
+				     OpGroupDecorate %40 %15 %14
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%3 = OpTypeFloat 32
+				%4 = OpTypePointer Output %3
+				%11 = OpConstant %3 1
+				%12 = OpConstant %3 2
+				%13 = OpTypeInt 32 1
+				%15 = OpVariable %4 Output
+				%14 = OpVariable %4 Output
+				%10 = OpFunction %1 None %2
+				%16 = OpLabel
+				%17 = OpFunctionCall %1 %20
+				     OpReturn
+				     OpFunctionEnd
+				%20 = OpFunction %1 None %2
+				%21 = OpLabel
+				     OpStore %15 %11
+				     OpReturn
+				     OpFunctionEnd
+				%30 = OpFunction %1 None %2
+				%31 = OpLabel
+				     OpStore %14 %12
+				     OpReturn
+				     OpFunctionEnd
+			`,
+			want: `
+				     OpCapability Shader
+				     OpMemoryModel Logical GLSL450
+				     OpEntryPoint Fragment %10 "main" %15
+				     OpExecutionMode %10 OriginUpperLeft
+				     OpName %10 "main"
+				     OpDecorate %15 Location 0
+				     OpDecorate %40 Flat
+				%40 = OpDecorationGroup
+				     OpGroupDecorate %40 %15
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%3 = OpTypeFloat 32
+				%4 = OpTypePointer Output %3
+				%11 = OpConstant %3 1
+				%15 = OpVariable %4 Output
+				%10 = OpFunction %1 None %2
+				%16 = OpLabel
+				%17 = OpFunctionCall %1 %20
+				     OpReturn
+				     OpFunctionEnd
+				%20 = OpFunction %1 None %2
+				%21 = OpLabel
+				     OpStore %15 %11
+				     OpReturn
+				     OpFunctionEnd
+			`,
+		},
+		{
+			// Unused parameters, labels and locals of a called
+			// function keep their names and decorations.
+			in: `
+				     OpCapability Shader
+				     OpMemoryModel Logical GLSL450
+				     OpEntryPoint Fragment %10 "main"
+				     OpName %21 "p"
+				     OpName %22 "entry"
+				     OpName %23 "tmp"
+				     OpDecorate %21 FuncParamAttr NoAlias
+				     OpDecorate %23 RelaxedPrecision
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%3 = OpTypeFloat 32
+				%4 = OpTypePointer Function %3
+				%5 = OpTypeFunction %1 %4
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				%12 = OpVariable %4 Function
+				%13 = OpFunctionCall %1 %20 %12
+				     OpReturn
+				     OpFunctionEnd
+				%20 = OpFunction %1 None %5
+				%21 = OpFunctionParameter %4
+				%22 = OpLabel
+				%23 = OpVariable %4 Function
+				     OpReturn
+				     OpFunctionEnd
+			`,
+			want: `
+				     OpCapability Shader
+				     OpMemoryModel Logical GLSL450
+				     OpEntryPoint Fragment %10 "main"
+				     OpName %21 "p"
+				     OpName %22 "entry"
+				     OpName %23 "tmp"
+				     OpDecorate %21 FuncParamAttr NoAlias
+				     OpDecorate %23 RelaxedPrecision
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%3 = OpTypeFloat 32
+				%4 = OpTypePointer Function %3
+				%5 = OpTypeFunction %1 %4
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				%12 = OpVariable %4 Function
+				%13 = OpFunctionCall %1 %20 %12
+				     OpReturn
+				     OpFunctionEnd
+				%20 = OpFunction %1 None %5
+				%21 = OpFunctionParameter %4
+				%22 = OpLabel
+				%23 = OpVariable %4 Function
+				     OpReturn
+				     OpFunctionEnd
+			`,
+		},
+		{
+			// Exported functions are kept, even without entry points.
+			in: `
+				     OpCapability Linkage
+				     OpMemoryModel Logical GLSL450
+				     OpDecorate %10 LinkageAttributes "f" Export
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%3 = OpTypeInt 32 1
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				     OpReturn
+				     OpFunctionEnd
+				%20 = OpFunction %1 None %2
+				%21 = OpLabel
+				     OpReturn
+				     OpFunctionEnd
+			`,
+			want: `
+				     OpCapability Linkage
+				     OpMemoryModel Logical GLSL450
+				     OpDecorate %10 LinkageAttributes "f" Export
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				     OpReturn
+				     OpFunctionEnd
+			`,
+		},
+	} {
+		m, err := Assemble(strings.NewReader(st.in))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		want, err := Assemble(strings.NewReader(st.want))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		m.EliminateDeadCode()
+
+		if !reflect.DeepEqual(m.Code, want.Code) {
+			t.Fatalf("case %d: code mismatch:\nHave: %v\nWant: %v", i, m.Code, want.Code)
+		}
+	}
+}
@@ -13,26 +13,48 @@ import (
 // It reads binary data from a stream and yields sequences
 // of 32-bit words.
 type Decoder struct {
-	r      io.Reader
-	ubuf   []uint32 // Scratch buffer for instruction decoding.
-	bbuf   [4]byte  // Scratch buffer for the word reader.
-	endian Endian
+	r       io.Reader
+	dialect *Dialect // Instruction set used to decode instructions.
+	ubuf    []uint32 // Scratch buffer for instruction decoding.
+	bbuf    [4]byte  // Scratch buffer for the word reader.
+	endian  Endian
+	pinned  bool // Dialect was set explicitly.
 }
 
-// NewDecoder creates a new decoder for the given stream and instruction set.
+// NewDecoder creates a new decoder for the given stream.
+// It decodes instructions using the DefaultDialect, until a module
+// header selects a different one.
 func NewDecoder(r io.Reader) *Decoder {
 	return &Decoder{
-		r:      r,
-		endian: LittleEndian,
-		ubuf:   make([]uint32, 16),
+		r:       r,
+		dialect: DefaultDialect,
+		endian:  LittleEndian,
+		ubuf:    make([]uint32, 16),
 	}
 }
 
+// SetDialect sets the dialect used to decode instructions.
+// Once set, the version number in a module header no longer
+// selects the dialect.
+func (d *Decoder) SetDialect(dialect *Dialect) {
+	d.dialect = dialect
+	d.pinned = true
+}
+
+// Dialect returns the dialect used to decode instructions.
+func (d *Decoder) Dialect() *Dialect {
+	return d.dialect
+}
+
 // DecodeHeader reads a module header from the underlying stream.
 //
 // The magic value's byte order will be used to determine the byte order
 // for the entire stream.
 //
+// Unless a dialect was set explicitly, the header's version number
+// selects the dialect for all subsequent instructions. Versions without
+// a registered dialect leave the current one in place.
+//
 // Returns an error if the magic value is invalid.
 func (d *Decoder) DecodeHeader() (Header, error) {
 	var hdr Header
@@ -71,6 +93,14 @@ func (d *Decoder) DecodeHeader() (Header, error) {
 	hdr.GeneratorMagic = d.ubuf[1]
 	hdr.Bound = d.ubuf[2]
 	hdr.Reserved = d.ubuf[3]
+
+	if !d.pinned {
+		dialect := LookupDialect(hdr.Version)
+		if dialect != nil {
+			d.dialect = dialect
+		}
+	}
+
 	return hdr, nil
 }
 
@@ -126,45 +156,16 @@ func (d *Decoder) DecodeInstruction() (Instruction, error) {
 		return nil, err
 	}
 
-	return DecodeInstruction(words)
+	return d.dialect.DecodeInstruction(words)
 }
 
-// DecodeInstructionFromWords decodes an instruction from the given
-// set of words. Additionally, it verifies that the values for each
-// instruction field meet the requirements as defined in the specification.
+// DecodeInstruction decodes an instruction from the given
+// set of words, using the DefaultDialect.
 //
 // Returns an error if there is no matching instruction or the
 // decoding failed.
 func DecodeInstruction(words []uint32) (Instruction, error) {
-	wordCount := words[0] >> 16
-	opcode := words[0] & 0xffff
-
-	if wordCount == 0 {
-		return nil, ErrInvalidInstructionSize
-	}
-
-	if len(words) < int(wordCount) {
-		return nil, ErrInvalidInstructionSize
-	}
-
-	constructor, ok := instructions[opcode]
-	if !ok {
-		return nil, fmt.Errorf("unknown instruction: %08x", opcode)
-	}
-
-	instr := constructor()
-
-	// This instruction is illegal.
-	// FIXME Remove this once instruction validation code is in.
-	switch instr.(type) {
-	case *OpNop:
-		return nil, ErrUnacceptable
-	}
-
-	rv := reflect.ValueOf(instr)
-	_, err := decodeValue(rv, words[1:])
-
-	return instr, err
+	return DefaultDialect.DecodeInstruction(words)
 }
 
 // Next reads exactly len(p) words from the stream.
@@ -194,6 +195,14 @@ func (d *Decoder) read(p []uint32) error {
 	return nil
 }
 
+// decodeReflect decodes the given operand words into the instruction.
+// It is used for instructions which do not implement WordCodec.
+func decodeReflect(i Instruction, argv []uint32) error {
+	rv := reflect.ValueOf(i)
+	_, err := decodeValue(rv, argv)
+	return err
+}
+
 // decodeValue decodes the given words into the specified instruction.
 func decodeValue(rv reflect.Value, argv []uint32) ([]uint32, error) {
 	rv = reflect.Indirect(rv)
@@ -287,3 +296,31 @@ func decodeString(rv reflect.Value, argv []uint32) ([]uint32, error) {
 	rv.SetString(string(str))
 	return argv[size:], nil
 }
+
+// decodeIds returns a copy of the given words as a list of ids.
+// Returns nil if there are no words.
+func decodeIds(argv []uint32) []Id {
+	if len(argv) == 0 {
+		return nil
+	}
+
+	out := make([]Id, len(argv))
+	for i, w := range argv {
+		out[i] = Id(w)
+	}
+
+	return out
+}
+
+// decodeStringWords decodes a string literal from the given words.
+// It returns the string and the words following it.
+func decodeStringWords(argv []uint32) (String, []uint32) {
+	str := DecodeString(argv)
+
+	size := int(str.EncodedLen())
+	if size > len(argv) {
+		size = len(argv)
+	}
+
+	return str, argv[size:]
+}
@@ -0,0 +1,89 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "sort"
+
+// DefUse maps the ids in a module to the instructions which define
+// and use them.
+type DefUse struct {
+	defs map[Id]Def
+	uses map[Id][]Use
+}
+
+// Def describes the definition of an id.
+type Def struct {
+	Instruction Instruction
+	Address     int // Index of the instruction in Module.Code.
+}
+
+// Use describes a single use of an id.
+type Use struct {
+	Instruction Instruction
+	Address     int // Index of the instruction in Module.Code.
+	Operand     int // Index of the id in Operands(Instruction).
+}
+
+// NewDefUse builds the definition-use index for the given module.
+//
+// If an id is defined more than once, only the first definition is
+// kept. Module.Verify reports such modules as invalid.
+func NewDefUse(m *Module) *DefUse {
+	d := &DefUse{
+		defs: make(map[Id]Def),
+		uses: make(map[Id][]Use),
+	}
+
+	var operands []Id
+
+	for addr, instr := range m.Code {
+		id, ok := ResultId(instr)
+		if ok {
+			_, dup := d.defs[id]
+			if !dup {
+				d.defs[id] = Def{instr, addr}
+			}
+		}
+
+		operands = appendOperands(operands[:0], instr)
+
+		for j, id := range operands {
+			d.uses[id] = append(d.uses[id], Use{instr, addr, j})
+		}
+	}
+
+	return d
+}
+
+// Def returns the definition of the given id.
+// Returns false if the id is not defined.
+func (d *DefUse) Def(id Id) (Def, bool) {
+	def, ok := d.defs[id]
+	return def, ok
+}
+
+// Uses returns all uses of the given id, in the order
+// in which they appear in the module.
+func (d *DefUse) Uses(id Id) []Use {
+	return d.uses[id]
+}
+
+// Ids returns all defined ids in ascending order.
+func (d *DefUse) Ids() []Id {
+	out := make([]Id, 0, len(d.defs))
+
+	for id := range d.defs {
+		out = append(out, id)
+	}
+
+	sort.Sort(idSlice(out))
+	return out
+}
+
+// idSlice implements sort.Interface for a slice of ids.
+type idSlice []Id
+
+func (s idSlice) Len() int           { return len(s) }
+func (s idSlice) Less(i, j int) bool { return s[i] < s[j] }
+func (s idSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,94 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testDefUseSource = `
+               OpCapability Shader
+               OpMemoryModel Logical GLSL450
+               OpEntryPoint Fragment %4 "main"
+               OpName %4 "main"
+          %2 = OpTypeVoid
+          %3 = OpTypeFunction %2
+          %6 = OpTypeFloat 32
+          %7 = OpConstant %6 1
+          %4 = OpFunction %2 None %3
+          %5 = OpLabel
+          %8 = OpFAdd %6 %7 %7
+               OpReturn
+               OpFunctionEnd
+`
+
+type DefUseTest struct {
+	id   Id
+	def  int // Address of the definition, or -1 if there is none.
+	uses []Use
+}
+
+func TestDefUse(t *testing.T) {
+	mod, err := Assemble(strings.NewReader(testDefUseSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	du := NewDefUse(mod)
+	code := mod.Code
+
+	for i, st := range []DefUseTest{
+		{
+			id:  4,
+			def: 8,
+			uses: []Use{
+				{code[2], 2, 0},
+				{code[3], 3, 0},
+			},
+		},
+		{
+			id:  2,
+			def: 4,
+			uses: []Use{
+				{code[5], 5, 0},
+				{code[8], 8, 0},
+			},
+		},
+		{
+			id:  7,
+			def: 7,
+			uses: []Use{
+				{code[10], 10, 1},
+				{code[10], 10, 2},
+			},
+		},
+		{
+			id:  8,
+			def: 10,
+		},
+		{
+			id:  9,
+			def: -1,
+		},
+	} {
+		def, ok := du.Def(st.id)
+		if ok != (st.def >= 0) || (ok && (def.Address != st.def || def.Instruction != code[st.def])) {
+			t.Fatalf("case %d: definition mismatch:\nHave: %v, %v\nWant: %v",
+				i, def, ok, st.def)
+		}
+
+		uses := du.Uses(st.id)
+		if !reflect.DeepEqual(uses, st.uses) {
+			t.Fatalf("case %d: uses mismatch:\nHave: %v\nWant: %v", i, uses, st.uses)
+		}
+	}
+
+	have := du.Ids()
+	want := []Id{2, 3, 4, 5, 6, 7, 8}
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("ids mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+}
@@ -0,0 +1,195 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// Dialect defines the set of instructions which belong to a specific
+// version of the SPIR-V specification.
+//
+// The instructions defined in this package make up the DefaultDialect.
+// Other instruction layouts, like the provisional specification which
+// predates version 1.0, can be made available by registering a separate
+// dialect. Refer to the legacy sub-package for an example.
+type Dialect struct {
+	// Name is a human readable name for the dialect.
+	Name string
+
+	// Version is the header version word for modules
+	// written in this dialect.
+	Version uint32
+
+	set   instructionSet    // Maps opcodes to instruction constructors.
+	names map[string]uint32 // Maps instruction names to opcodes.
+}
+
+// NewDialect creates a new, empty dialect with the given name and version.
+func NewDialect(name string, version uint32) *Dialect {
+	return &Dialect{
+		Name:    name,
+		Version: version,
+		set:     make(instructionSet),
+		names:   make(map[string]uint32),
+	}
+}
+
+// DefaultDialect holds the instructions for the specification version
+// this package was written for.
+var DefaultDialect = NewDialect("SPIR-V 1.0", SpecificationVersion)
+
+// Bind registers the given instruction with the dialect.
+//
+// This call panics if the instruction type defined by the constructor
+// is not a pointer type. Additionally, this panics if there is already an
+// entry for the instruction's opcode.
+//
+// All instructions are meant to be registered during package initialisation.
+func (d *Dialect) Bind(fun func() Instruction) {
+	obj := fun()
+	rv := reflect.ValueOf(obj)
+
+	if rv.Kind() != reflect.Ptr {
+		panic(ErrInstructionNotPointer)
+	}
+
+	opcode := obj.Opcode()
+
+	_, ok := d.set[opcode]
+	if ok {
+		panic(ErrDuplicateInstruction)
+	}
+
+	d.set[opcode] = fun
+	d.names[instructionName(obj)] = opcode
+}
+
+// Opcodes returns a sorted list of all opcodes in the dialect.
+func (d *Dialect) Opcodes() []int {
+	return d.set.Opcodes()
+}
+
+// New returns a new, empty instruction for the given opcode.
+// Returns false if the dialect has no such instruction.
+func (d *Dialect) New(opcode uint32) (Instruction, bool) {
+	fun, ok := d.set[opcode]
+	if !ok {
+		return nil, false
+	}
+	return fun(), true
+}
+
+// Lookup returns the opcode for the instruction with the given name.
+// For example: "OpTypeInt". Returns false if the name is not known.
+func (d *Dialect) Lookup(name string) (uint32, bool) {
+	opcode, ok := d.names[name]
+	return opcode, ok
+}
+
+// DecodeInstruction decodes an instruction from the given set of words,
+// using the instructions in this dialect.
+//
+// Returns an error if there is no matching instruction or the
+// decoding failed.
+func (d *Dialect) DecodeInstruction(words []uint32) (Instruction, error) {
+	wordCount := words[0] >> 16
+	opcode := words[0] & 0xffff
+
+	if wordCount == 0 {
+		return nil, ErrInvalidInstructionSize
+	}
+
+	if len(words) < int(wordCount) {
+		return nil, ErrInvalidInstructionSize
+	}
+
+	// This instruction is illegal.
+	// FIXME Remove this once instruction validation code is in.
+	if opcode == opcodeNop {
+		return nil, ErrUnacceptable
+	}
+
+	instr, ok := d.New(opcode)
+	if !ok {
+		return nil, fmt.Errorf("unknown instruction: %08x", opcode)
+	}
+
+	argv := words[1:wordCount]
+
+	wc, ok := instr.(WordCodec)
+	if ok {
+		return instr, wc.DecodeWords(argv)
+	}
+
+	return instr, decodeReflect(instr, argv)
+}
+
+var (
+	dialectLock sync.RWMutex
+	dialects    = map[uint32]*Dialect{
+		SpecificationVersion: DefaultDialect,
+	}
+)
+
+// RegisterDialect makes the given dialect available to decoders
+// which encounter its version number in a module header.
+//
+// This panics if a dialect with the same version is already registered.
+func RegisterDialect(d *Dialect) {
+	dialectLock.Lock()
+	defer dialectLock.Unlock()
+
+	_, ok := dialects[d.Version]
+	if ok {
+		panic(ErrDuplicateDialect)
+	}
+
+	dialects[d.Version] = d
+}
+
+// LookupDialect returns the dialect for the given header version word.
+//
+// Versions which have no dialect of their own, but are newer than the
+// SpecificationVersion, yield the DefaultDialect. Returns nil if there
+// is no suitable dialect.
+func LookupDialect(version uint32) *Dialect {
+	dialectLock.RLock()
+	defer dialectLock.RUnlock()
+
+	d, ok := dialects[version]
+	if ok {
+		return d
+	}
+
+	if version >= SpecificationVersion {
+		return DefaultDialect
+	}
+
+	return nil
+}
+
+// Dialects returns all registered dialects, ordered by version.
+func Dialects() []*Dialect {
+	dialectLock.RLock()
+	defer dialectLock.RUnlock()
+
+	out := make([]*Dialect, 0, len(dialects))
+	for _, d := range dialects {
+		out = append(out, d)
+	}
+
+	sort.Sort(dialectSlice(out))
+	return out
+}
+
+// dialectSlice implements sort.Interface for a slice of dialects.
+type dialectSlice []*Dialect
+
+func (s dialectSlice) Len() int           { return len(s) }
+func (s dialectSlice) Less(i, j int) bool { return s[i].Version < s[j].Version }
+func (s dialectSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,97 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// testDialectInstruction is an instruction for a made-up dialect.
+// It shares its opcode with OpUndef, but has a different layout.
+type testDialectInstruction struct {
+	A uint32
+}
+
+func (c *testDialectInstruction) Opcode() uint32 { return opcodeUndef }
+func (c *testDialectInstruction) Optional() bool { return false }
+func (c *testDialectInstruction) Verify() error  { return nil }
+
+var testDialect = NewDialect("test", 98)
+
+func init() {
+	testDialect.Bind(func() Instruction { return &testDialectInstruction{} })
+	RegisterDialect(testDialect)
+}
+
+type DialectTest struct {
+	version uint32
+	want    *Dialect
+}
+
+func TestLookupDialect(t *testing.T) {
+	for i, st := range []DialectTest{
+		{98, testDialect},
+		{99, nil},
+		{SpecificationVersion, DefaultDialect},
+		{0x00010100, DefaultDialect},
+	} {
+		have := LookupDialect(st.version)
+		if have != st.want {
+			t.Fatalf("case %d: dialect mismatch:\nHave: %v\nWant: %v", i, have, st.want)
+		}
+	}
+}
+
+func TestDecoderDialect(t *testing.T) {
+	data := testWordReader([]uint32{
+		MagicLE, 98, 0, 2, 0,
+		0x00020001, 1,
+	}).Bytes()
+
+	// The header selects the dialect.
+	dec := NewDecoder(bytes.NewReader(data))
+
+	_, err := dec.DecodeHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	have, err := dec.DecodeInstruction()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &testDialectInstruction{A: 1}
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("decode mismatch:\nHave: %T(%+v)\nWant: %T(%+v)", have, have, want, want)
+	}
+
+	// An explicitly set dialect is not overridden by the header.
+	dec = NewDecoder(bytes.NewReader(data))
+	dec.SetDialect(DefaultDialect)
+
+	_, err = dec.DecodeHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = dec.DecodeInstruction()
+	if err != ErrMissingInstructionArgs {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", err, ErrMissingInstructionArgs)
+	}
+}
+
+func TestDialectLookupName(t *testing.T) {
+	opcode, ok := DefaultDialect.Lookup("OpTypeImage")
+	if !ok || opcode != opcodeTypeImage {
+		t.Fatalf("opcode mismatch:\nHave: %d\nWant: %d", opcode, opcodeTypeImage)
+	}
+
+	_, ok = DefaultDialect.Lookup("OpTextureSample")
+	if ok {
+		t.Fatalf("expected unknown instruction to fail")
+	}
+}
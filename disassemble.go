@@ -0,0 +1,501 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DisassembleOptions controls the output of Disassemble.
+// The zero value yields the canonical spirv-dis style output.
+type DisassembleOptions struct {
+	// RawIds prints all ids in their numeric form. By default, ids are
+	// given friendly names derived from OpName instructions and from the
+	// type and constant declarations they refer to.
+	RawIds bool
+
+	// NoHeader omits the comment block describing the module header.
+	NoHeader bool
+
+	// NoIndent disables the alignment of instructions into a single column.
+	NoIndent bool
+}
+
+// disassembleIndent defines the column at which instruction names start,
+// when indentation is enabled.
+const disassembleIndent = 15
+
+// Disassemble writes the given module as SPIR-V assembly text to w.
+//
+// Each instruction is written on its own line in the form:
+//
+//	%result = OpName %type operand...
+//
+// Id operands are prefixed with '%'. Enumerants are written by their
+// symbolic names and string literals are quoted.
+//
+// Like Load, it returns an ErrorList of *LayoutError values for an
+// OpSwitch whose selector is wider than 32 bits.
+func Disassemble(w io.Writer, m *Module, opts DisassembleOptions) error {
+	err := m.verifySwitchSelectors()
+	if err != nil {
+		return err
+	}
+
+	d := newDisassembler(m, opts)
+	bw := bufio.NewWriter(w)
+
+	if !opts.NoHeader {
+		d.header(bw, m.Header)
+	}
+
+	for _, instr := range m.Code {
+		bw.WriteString(d.instruction(instr))
+		bw.WriteByte('\n')
+	}
+
+	return bw.Flush()
+}
+
+// disassembler holds the state needed to turn instructions into text.
+type disassembler struct {
+	opts  DisassembleOptions
+	names map[Id]string      // Friendly names for ids.
+	types map[Id]Instruction // Type declarations, for literal formatting.
+}
+
+func newDisassembler(m *Module, opts DisassembleOptions) *disassembler {
+	d := &disassembler{
+		opts:  opts,
+		names: make(map[Id]string),
+		types: make(map[Id]Instruction),
+	}
+
+	for _, instr := range m.Code {
+		switch v := instr.(type) {
+		case *OpTypeInt:
+			d.types[v.ResultId] = v
+		case *OpTypeFloat:
+			d.types[v.ResultId] = v
+		}
+	}
+
+	if !opts.RawIds {
+		d.nameIds(m.Code)
+	}
+
+	return d
+}
+
+// header writes the module header as a block of comments.
+func (d *disassembler) header(w *bufio.Writer, hdr Header) {
+	fmt.Fprintf(w, "; SPIR-V\n")
+
+	// Version 1.0 and later encode the major and minor numbers
+	// in separate bytes. The provisional version is a plain number.
+	if hdr.Version >= SpecificationVersion {
+		fmt.Fprintf(w, "; Version: %d.%d\n", hdr.Version>>16&0xff, hdr.Version>>8&0xff)
+	} else {
+		fmt.Fprintf(w, "; Version: %d\n", hdr.Version)
+	}
+
+	fmt.Fprintf(w, "; Generator: 0x%08x\n", hdr.GeneratorMagic)
+	fmt.Fprintf(w, "; Bound: %d\n", hdr.Bound)
+	fmt.Fprintf(w, "; Schema: %d\n", hdr.Reserved)
+}
+
+// instruction returns the textual form of a single instruction.
+func (d *disassembler) instruction(i Instruction) string {
+	rv := reflect.Indirect(reflect.ValueOf(i))
+	rt := rv.Type()
+
+	var result string
+	var operands []string
+
+	for n := 0; n < rv.NumField(); n++ {
+		fv := rv.Field(n)
+		ft := rt.Field(n)
+
+		if ft.Name == "ResultId" {
+			result = d.id(Id(fv.Uint()))
+			continue
+		}
+
+		tag := ft.Tag.Get("spirv")
+		if hasFieldOption(tag, "optional") && valueIsNil(fv) {
+			continue
+		}
+
+		operands = append(operands, d.operand(i, ft.Name, fv)...)
+	}
+
+	line := instructionName(i)
+	if len(operands) > 0 {
+		line += " " + strings.Join(operands, " ")
+	}
+
+	if len(result) > 0 {
+		result += " = "
+	}
+
+	if d.opts.NoIndent {
+		return result + line
+	}
+
+	return fmt.Sprintf("%*s", disassembleIndent, result) + line
+}
+
+// operand returns the textual forms of the given struct field.
+func (d *disassembler) operand(i Instruction, name string, rv reflect.Value) []string {
+	switch v := i.(type) {
+	case *OpConstant:
+		if name == "Value" {
+			return []string{d.literal(v.ResultType, v.Value)}
+		}
+	case *OpSpecConstant:
+		if name == "Value" {
+			return []string{d.literal(v.ResultType, v.Value)}
+		}
+	case *OpDecorate:
+		if name == "Argv" {
+			return decorationArgs(v.Decoration, v.Argv)
+		}
+	case *OpMemberDecorate:
+		if name == "Argv" {
+			return decorationArgs(v.Decoration, v.Argv)
+		}
+	case *OpSwitch:
+		if name == "Target" {
+			return d.switchTargets(v.Target)
+		}
+	}
+
+	return d.value(rv)
+}
+
+// value returns the textual forms of an operand value.
+func (d *disassembler) value(rv reflect.Value) []string {
+	switch v := rv.Interface().(type) {
+	case Id:
+		return []string{d.id(v)}
+	case String:
+		return []string{quoteString(string(v))}
+	case fmt.Stringer:
+		return []string{v.String()}
+	}
+
+	switch rv.Kind() {
+	case reflect.Uint32:
+		return []string{strconv.FormatUint(rv.Uint(), 10)}
+
+	case reflect.Slice, reflect.Array:
+		out := make([]string, 0, rv.Len())
+		for n := 0; n < rv.Len(); n++ {
+			out = append(out, d.value(rv.Index(n))...)
+		}
+		return out
+	}
+
+	return []string{fmt.Sprint(rv.Interface())}
+}
+
+// id returns the textual form of the given id.
+func (d *disassembler) id(id Id) string {
+	name, ok := d.names[id]
+	if ok {
+		return "%" + name
+	}
+	return "%" + strconv.FormatUint(uint64(id), 10)
+}
+
+// switchTargets returns the (literal, label) pairs of an OpSwitch.
+func (d *disassembler) switchTargets(argv []uint32) []string {
+	out := make([]string, 0, len(argv))
+
+	for n, v := range argv {
+		if n%2 == 0 {
+			out = append(out, strconv.FormatUint(uint64(v), 10))
+		} else {
+			out = append(out, d.id(Id(v)))
+		}
+	}
+
+	return out
+}
+
+// literal returns the textual form of a numeric constant value.
+// The value is interpreted according to the given type.
+func (d *disassembler) literal(typ Id, words []uint32) string {
+	switch t := d.types[typ].(type) {
+	case *OpTypeInt:
+		switch {
+		case t.Width <= 32 && len(words) == 1:
+			if t.Signedness == 1 {
+				return strconv.FormatInt(int64(int32(words[0])), 10)
+			}
+			return strconv.FormatUint(uint64(words[0]), 10)
+
+		case t.Width == 64 && len(words) == 2:
+			v := uint64(words[0]) | uint64(words[1])<<32
+			if t.Signedness == 1 {
+				return strconv.FormatInt(int64(v), 10)
+			}
+			return strconv.FormatUint(v, 10)
+		}
+
+	case *OpTypeFloat:
+		switch {
+		case t.Width == 32 && len(words) == 1:
+			f := float64(math.Float32frombits(words[0]))
+			if !math.IsInf(f, 0) && !math.IsNaN(f) {
+				return strconv.FormatFloat(f, 'g', -1, 32)
+			}
+
+		case t.Width == 64 && len(words) == 2:
+			f := math.Float64frombits(uint64(words[0]) | uint64(words[1])<<32)
+			if !math.IsInf(f, 0) && !math.IsNaN(f) {
+				return strconv.FormatFloat(f, 'g', -1, 64)
+			}
+		}
+
+		// Values without a finite decimal representation are written
+		// as their raw bit pattern.
+		if len(words) == 1 {
+			return fmt.Sprintf("0x%08x", words[0])
+		}
+		if len(words) == 2 {
+			return fmt.Sprintf("0x%08x%08x", words[1], words[0])
+		}
+	}
+
+	out := make([]string, len(words))
+	for n, v := range words {
+		out[n] = strconv.FormatUint(uint64(v), 10)
+	}
+
+	return strings.Join(out, " ")
+}
+
+// decorationArgs returns the textual form of the arguments for the
+// given decoration. Enumerant arguments are written by name.
+func decorationArgs(dec Decoration, argv []uint32) []string {
+	// LinkageAttributes is the only decoration with a string argument.
+	// It is followed by the linkage type.
+	if dec == DecorationLinkageAttributes && len(argv) > 1 {
+		last := len(argv) - 1
+		return []string{
+			quoteString(string(DecodeString(argv[:last]))),
+			linkageTypeNames.String(argv[last]),
+		}
+	}
+
+	table := decorationArgTable(dec)
+	out := make([]string, len(argv))
+
+	for n, v := range argv {
+		if table != nil {
+			out[n] = table.String(v)
+		} else {
+			out[n] = strconv.FormatUint(uint64(v), 10)
+		}
+	}
+
+	return out
+}
+
+// decorationArgTable returns the enumeration used by the argument of the
+// given decoration. Returns nil if the argument is a plain number.
+func decorationArgTable(dec Decoration) *enumTable {
+	switch dec {
+	case DecorationBuiltIn:
+		return builtInNames
+	case DecorationFuncParamAttr:
+		return functionParameterAttributeNames
+	case DecorationFPRoundingMode:
+		return fpRoundingModeNames
+	case DecorationFPFastMathMode:
+		return fpFastMathModeNames
+	case DecorationLinkageAttributes:
+		return linkageTypeNames
+	}
+	return nil
+}
+
+// quoteString returns s as a quoted string literal. Only the quote
+// and backslash characters are escaped.
+func quoteString(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	return `"` + s + `"`
+}
+
+// nameIds assigns friendly names to ids. Names come from OpName
+// instructions first. Unnamed types and constants are named after
+// their declaration.
+func (d *disassembler) nameIds(code InstructionList) {
+	used := make(map[string]bool)
+
+	assign := func(id Id, name string) {
+		if _, ok := d.names[id]; ok || len(name) == 0 {
+			return
+		}
+
+		name = sanitizeName(name)
+		unique := name
+
+		for n := 0; used[unique]; n++ {
+			unique = fmt.Sprintf("%s_%d", name, n)
+		}
+
+		used[unique] = true
+		d.names[id] = unique
+	}
+
+	for _, instr := range code {
+		if v, ok := instr.(*OpName); ok {
+			assign(v.Target, string(v.Name))
+		}
+	}
+
+	for _, instr := range code {
+		switch v := instr.(type) {
+		case *OpTypeVoid:
+			assign(v.ResultId, "void")
+		case *OpTypeBool:
+			assign(v.ResultId, "bool")
+		case *OpTypeInt:
+			assign(v.ResultId, intTypeName(v))
+		case *OpTypeFloat:
+			assign(v.ResultId, floatTypeName(v))
+		case *OpTypeVector:
+			assign(v.ResultId, d.derivedName("v%d", v.ComponentCount, v.ComponentType))
+		case *OpTypeMatrix:
+			assign(v.ResultId, d.derivedName("mat%d", v.ColumnCount, v.ColumnType))
+		case *OpTypeRuntimeArray:
+			assign(v.ResultId, d.derivedName("_runtimearr_", v.ElementType))
+		case *OpTypeArray:
+			assign(v.ResultId, d.derivedName("_arr_", v.ElementType, "_", v.Length))
+		case *OpTypePointer:
+			assign(v.ResultId, d.derivedName("_ptr_", v.StorageClass.String(), "_", v.Type))
+		case *OpConstantTrue:
+			assign(v.ResultId, "true")
+		case *OpConstantFalse:
+			assign(v.ResultId, "false")
+		case *OpConstant:
+			assign(v.ResultId, d.constantName(v.ResultType, v.Value))
+		}
+	}
+}
+
+// derivedName builds a name from the given parts. Ids are replaced by
+// their current name. Returns an empty string if any of the ids is unnamed.
+//
+// If the first part is a format string, it is applied to the second part.
+func (d *disassembler) derivedName(parts ...interface{}) string {
+	var out []string
+
+	if f, ok := parts[0].(string); ok && strings.Contains(f, "%") {
+		out = append(out, fmt.Sprintf(f, parts[1]))
+		parts = parts[2:]
+	}
+
+	for _, p := range parts {
+		switch v := p.(type) {
+		case Id:
+			name, ok := d.names[v]
+			if !ok {
+				return ""
+			}
+			out = append(out, name)
+		default:
+			out = append(out, fmt.Sprint(v))
+		}
+	}
+
+	return strings.Join(out, "")
+}
+
+// constantName returns the friendly name for a scalar constant.
+func (d *disassembler) constantName(typ Id, value []uint32) string {
+	tname, ok := d.names[typ]
+	if !ok || d.types[typ] == nil {
+		return ""
+	}
+
+	lit := d.literal(typ, value)
+	if strings.HasPrefix(lit, "0x") || strings.Contains(lit, " ") {
+		return tname
+	}
+
+	lit = strings.Replace(lit, "-", "n", -1)
+	return tname + "_" + lit
+}
+
+// intTypeName returns the friendly name for an integer type.
+func intTypeName(t *OpTypeInt) string {
+	var name string
+
+	switch t.Width {
+	case 8:
+		name = "char"
+	case 16:
+		name = "short"
+	case 32:
+		name = "int"
+	case 64:
+		name = "long"
+	default:
+		name = fmt.Sprintf("int%d", t.Width)
+	}
+
+	if t.Signedness == 0 {
+		return "u" + name
+	}
+	return name
+}
+
+// floatTypeName returns the friendly name for a floating point type.
+func floatTypeName(t *OpTypeFloat) string {
+	switch t.Width {
+	case 16:
+		return "half"
+	case 32:
+		return "float"
+	case 64:
+		return "double"
+	}
+	return fmt.Sprintf("fp%d", t.Width)
+}
+
+// sanitizeName turns the given name into a valid assembly identifier.
+// Any character which is not a letter, digit or underscore is replaced
+// by an underscore. Names may not start with a digit, to avoid conflicts
+// with numeric ids.
+func sanitizeName(name string) string {
+	out := []byte(name)
+
+	for n, c := range out {
+		if !isIdentChar(c) {
+			out[n] = '_'
+		}
+	}
+
+	if len(out) > 0 && out[0] >= '0' && out[0] <= '9' {
+		return "_" + string(out)
+	}
+
+	return string(out)
+}
+
+// isIdentChar returns true if c may appear in an id name.
+func isIdentChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
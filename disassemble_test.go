@@ -0,0 +1,142 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"bytes"
+	"testing"
+)
+
+// testDisassemblyModule returns a small module which covers most
+// operand types.
+func testDisassemblyModule() *Module {
+	mod := NewModule()
+	mod.Header.Bound = 13
+	mod.Code = []Instruction{
+		&OpSource{SourceLanguage: SourceLanguageGLSL, Version: 450},
+		&OpExtInstImport{ResultId: 1, Name: "GLSL.std.450"},
+		&OpMemoryModel{
+			AddressingModel: AddressingModelLogical,
+			MemoryModel:     MemoryModelGLSL450,
+		},
+		&OpEntryPoint{ExecutionModel: ExecutionModelFragment, EntryPoint: 4, Name: "main"},
+		&OpExecutionMode{EntryPoint: 4, Mode: ExecutionModeOriginUpperLeft},
+		&OpName{Target: 4, Name: "main"},
+		&OpName{Target: 9, Name: "out color"},
+		&OpDecorate{Target: 9, Decoration: DecorationBuiltIn, Argv: []uint32{BuiltInFragCoord}},
+		&OpTypeVoid{ResultId: 2},
+		&OpTypeFunction{ResultId: 3, ReturnType: 2},
+		&OpTypeFloat{ResultId: 5, Width: 32},
+		&OpTypeVector{ResultId: 6, ComponentType: 5, ComponentCount: 4},
+		&OpTypePointer{ResultId: 7, StorageClass: StorageClassOutput, Type: 6},
+		&OpConstant{ResultType: 5, ResultId: 8, Value: []uint32{0x3f000000}},
+		&OpVariable{ResultType: 7, ResultId: 9, StorageClass: StorageClassOutput},
+		&OpTypeInt{ResultId: 11, Width: 32, Signedness: 1},
+		&OpConstant{ResultType: 11, ResultId: 12, Value: []uint32{0xfffffffe}},
+		&OpFunction{ResultType: 2, ResultId: 4, FunctionControl: FunctionControlInline | FunctionControlPure, FunctionType: 3},
+		&OpLabel{ResultId: 10},
+		&OpStore{Pointer: 9, Object: 8},
+		&OpReturn{},
+		&OpFunctionEnd{},
+	}
+	return mod
+}
+
+func TestDisassemble(t *testing.T) {
+	want := `; SPIR-V
+; Version: 1.0
+; Generator: 0x00000000
+; Bound: 13
+; Schema: 0
+               OpSource GLSL 450
+          %1 = OpExtInstImport "GLSL.std.450"
+               OpMemoryModel Logical GLSL450
+               OpEntryPoint Fragment %main "main"
+               OpExecutionMode %main OriginUpperLeft
+               OpName %main "main"
+               OpName %out_color "out color"
+               OpDecorate %out_color BuiltIn FragCoord
+       %void = OpTypeVoid
+          %3 = OpTypeFunction %void
+      %float = OpTypeFloat 32
+    %v4float = OpTypeVector %float 4
+%_ptr_Output_v4float = OpTypePointer Output %v4float
+  %float_0_5 = OpConstant %float 0.5
+  %out_color = OpVariable %_ptr_Output_v4float Output
+        %int = OpTypeInt 32 1
+     %int_n2 = OpConstant %int -2
+       %main = OpFunction %void Inline|Pure %3
+         %10 = OpLabel
+               OpStore %out_color %float_0_5
+               OpReturn
+               OpFunctionEnd
+`
+
+	var buf bytes.Buffer
+	err := Disassemble(&buf, testDisassemblyModule(), DisassembleOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if have := buf.String(); have != want {
+		t.Fatalf("disassembly mismatch:\nHave:\n%s\nWant:\n%s", have, want)
+	}
+}
+
+func TestDisassembleRaw(t *testing.T) {
+	want := `OpSource GLSL 450
+%1 = OpExtInstImport "GLSL.std.450"
+OpMemoryModel Logical GLSL450
+OpEntryPoint Fragment %4 "main"
+OpExecutionMode %4 OriginUpperLeft
+OpName %4 "main"
+OpName %9 "out color"
+OpDecorate %9 BuiltIn FragCoord
+%2 = OpTypeVoid
+%3 = OpTypeFunction %2
+%5 = OpTypeFloat 32
+%6 = OpTypeVector %5 4
+%7 = OpTypePointer Output %6
+%8 = OpConstant %5 0.5
+%9 = OpVariable %7 Output
+%11 = OpTypeInt 32 1
+%12 = OpConstant %11 -2
+%4 = OpFunction %2 Inline|Pure %3
+%10 = OpLabel
+OpStore %9 %8
+OpReturn
+OpFunctionEnd
+`
+
+	var buf bytes.Buffer
+	err := Disassemble(&buf, testDisassemblyModule(), DisassembleOptions{
+		RawIds:   true,
+		NoHeader: true,
+		NoIndent: true,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if have := buf.String(); have != want {
+		t.Fatalf("disassembly mismatch:\nHave:\n%s\nWant:\n%s", have, want)
+	}
+}
+
+func TestQuoteString(t *testing.T) {
+	for i, st := range []struct {
+		in, want string
+	}{
+		{"", `""`},
+		{"foo", `"foo"`},
+		{`a "b" c`, `"a \"b\" c"`},
+		{`a\b`, `"a\\b"`},
+	} {
+		have := quoteString(st.in)
+		if have != st.want {
+			t.Fatalf("case %d: quote mismatch:\nHave: %s\nWant: %s", i, have, st.want)
+		}
+	}
+}
@@ -3,8 +3,8 @@
 
 /*
 Package spirv is a Go encoder/decoder for the Vulkan SPIR-V format.
-This is based on the priliminary specification and is subject to
-change as the specification matures.
+This is based on the SPIR-V 1.0 specification. The layout of the
+provisional specification is available through the legacy sub-package.
 
 	Specification:   https://www.khronos.org/registry/spir-v/specs/1.0/SPIRV.pdf
 	Additional info: https://www.khronos.org/registry/spir-v/
@@ -32,6 +32,15 @@ The Encoder and Decoder can be used directly if you wish. They offer working
 with data on a per-instruction basis and if you opt out of deserialization into
 typed structures, you can examine them without any allocation overhead.
 
+Modules can be converted to and from SPIR-V assembly text, in the form used
+by the Khronos spirv-dis and spirv-as tools:
+
+	err := spirv.Disassemble(w, module, spirv.DisassembleOptions{})
+	...
+
+	module, err := spirv.Assemble(r)
+	...
+
 
 About
 
@@ -0,0 +1,240 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// DomTree defines the dominator- or post-dominator tree for the blocks
+// in a control flow graph.
+//
+// Block A dominates block B if every path from the function's entry
+// block to B passes through A. Block A post-dominates block B if every
+// path from B to a function exit passes through A. Function exits are
+// blocks without successors. Every block dominates itself.
+type DomTree struct {
+	blocks   []*Block
+	index    map[*Block]int
+	idom     []int // Index of the immediate dominator; -1 if not in the tree.
+	children [][]*Block
+}
+
+// NewDomTree computes the dominator tree for the given graph.
+// Blocks which are unreachable from the entry block are not part
+// of the tree.
+func NewDomTree(c *CFG) *DomTree {
+	t := newDomTree(c)
+	n := len(t.blocks)
+
+	if n > 0 {
+		t.build(0, func(i int) []int { return t.indices(t.blocks[i].Successors) })
+	}
+
+	return t
+}
+
+// NewPostDomTree computes the post-dominator tree for the given graph.
+// This is a forest: every block which is not post-dominated by another
+// block is a root. This includes all function exits. Blocks from which
+// no exit can be reached, are not part of the tree.
+func NewPostDomTree(c *CFG) *DomTree {
+	t := newDomTree(c)
+	n := len(t.blocks)
+
+	// Node n is a virtual exit node, which joins all exits into
+	// a single tree.
+	var exits []int
+	for i, b := range t.blocks {
+		if len(b.Successors) == 0 {
+			exits = append(exits, i)
+		}
+	}
+
+	t.build(n, func(i int) []int {
+		if i == n {
+			return exits
+		}
+		return t.indices(t.blocks[i].Predecessors)
+	})
+
+	return t
+}
+
+// newDomTree creates an empty tree for the given graph.
+func newDomTree(c *CFG) *DomTree {
+	t := &DomTree{
+		blocks:   c.Blocks,
+		index:    make(map[*Block]int, len(c.Blocks)),
+		children: make([][]*Block, len(c.Blocks)),
+	}
+
+	for i, b := range c.Blocks {
+		t.index[b] = i
+	}
+
+	return t
+}
+
+// Contains returns true if the given block is part of the tree.
+func (t *DomTree) Contains(b *Block) bool {
+	i, ok := t.index[b]
+	return ok && t.idom[i] > -1
+}
+
+// Roots returns the blocks without an immediate dominator, in the
+// order in which they appear in the function.
+func (t *DomTree) Roots() []*Block {
+	var out []*Block
+
+	for i, d := range t.idom[:len(t.blocks)] {
+		if d == i || d == len(t.blocks) {
+			out = append(out, t.blocks[i])
+		}
+	}
+
+	return out
+}
+
+// Idom returns the immediate dominator for the given block.
+// Returns nil if b is a root or is not part of the tree.
+func (t *DomTree) Idom(b *Block) *Block {
+	i, ok := t.index[b]
+	if !ok {
+		return nil
+	}
+
+	d := t.idom[i]
+	if d < 0 || d == i || d == len(t.blocks) {
+		return nil
+	}
+
+	return t.blocks[d]
+}
+
+// Children returns the blocks immediately dominated by b, in the
+// order in which they appear in the function.
+func (t *DomTree) Children(b *Block) []*Block {
+	i, ok := t.index[b]
+	if !ok {
+		return nil
+	}
+	return t.children[i]
+}
+
+// Dominates returns true if a dominates b. This is false if either
+// block is not part of the tree.
+func (t *DomTree) Dominates(a, b *Block) bool {
+	if !t.Contains(a) || !t.Contains(b) {
+		return false
+	}
+
+	for ; b != nil; b = t.Idom(b) {
+		if b == a {
+			return true
+		}
+	}
+
+	return false
+}
+
+// build computes the immediate dominators for all nodes reachable
+// from root. Node indices beyond the last block denote virtual nodes.
+//
+// This uses the iterative algorithm described in "A Simple, Fast
+// Dominance Algorithm" by Cooper, Harvey and Kennedy.
+func (t *DomTree) build(root int, succ func(int) []int) {
+	n := len(t.blocks) + 1
+
+	// Number all nodes in postorder.
+	order := make([]int, 0, n)
+	number := make([]int, n)
+	for i := range number {
+		number[i] = -1
+	}
+
+	var visit func(int)
+	visit = func(i int) {
+		number[i] = 0
+
+		for _, s := range succ(i) {
+			if number[s] < 0 {
+				visit(s)
+			}
+		}
+
+		number[i] = len(order)
+		order = append(order, i)
+	}
+
+	visit(root)
+
+	// Predecessors in the traversed graph.
+	preds := make([][]int, n)
+	for _, i := range order {
+		for _, s := range succ(i) {
+			preds[s] = append(preds[s], i)
+		}
+	}
+
+	idom := make([]int, n)
+	for i := range idom {
+		idom[i] = -1
+	}
+
+	idom[root] = root
+
+	intersect := func(a, b int) int {
+		for a != b {
+			for number[a] < number[b] {
+				a = idom[a]
+			}
+			for number[b] < number[a] {
+				b = idom[b]
+			}
+		}
+		return a
+	}
+
+	for changed := true; changed; {
+		changed = false
+
+		// Visit nodes in reverse postorder, skipping the root.
+		for k := len(order) - 2; k >= 0; k-- {
+			i := order[k]
+			d := -1
+
+			for _, p := range preds[i] {
+				if idom[p] < 0 {
+					continue
+				}
+
+				if d < 0 {
+					d = p
+				} else {
+					d = intersect(p, d)
+				}
+			}
+
+			if idom[i] != d {
+				idom[i] = d
+				changed = true
+			}
+		}
+	}
+
+	t.idom = idom
+
+	for i := range t.blocks {
+		d := idom[i]
+		if d > -1 && d != i && d < len(t.blocks) {
+			t.children[d] = append(t.children[d], t.blocks[i])
+		}
+	}
+}
+
+// indices returns the indices for the given blocks.
+func (t *DomTree) indices(set []*Block) []int {
+	out := make([]int, len(set))
+	for i, b := range set {
+		out[i] = t.index[b]
+	}
+	return out
+}
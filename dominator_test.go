@@ -0,0 +1,139 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type DomTreeTest struct {
+	src   string
+	dom   map[Id]Id // Immediate dominators by block label; 0 for roots.
+	pdom  map[Id]Id // Immediate post-dominators by block label; 0 for roots.
+	roots []Id      // Dominator tree roots.
+	proot []Id      // Post-dominator tree roots.
+}
+
+func TestDomTree(t *testing.T) {
+	for i, st := range []DomTreeTest{
+		{
+			// Function declaration.
+			src: `
+				%1 = OpFunction %2 None %3
+				     OpFunctionEnd`,
+		},
+		{
+			// Loop with an early exit and an unreachable block.
+			src: `
+				%1 = OpFunction %2 None %3
+				%10 = OpLabel
+				     OpBranch %11
+				%11 = OpLabel
+				     OpLoopMerge %14 %13 None
+				     OpBranchConditional %5 %12 %14
+				%12 = OpLabel
+				     OpBranchConditional %5 %13 %15
+				%13 = OpLabel
+				     OpBranch %11
+				%15 = OpLabel
+				     OpReturn
+				%14 = OpLabel
+				     OpReturn
+				%16 = OpLabel
+				     OpBranch %14
+				     OpFunctionEnd`,
+			dom:   map[Id]Id{10: 0, 11: 10, 12: 11, 13: 12, 15: 12, 14: 11},
+			pdom:  map[Id]Id{10: 11, 11: 0, 12: 0, 13: 11, 15: 0, 14: 0, 16: 14},
+			roots: []Id{10},
+			proot: []Id{11, 12, 15, 14},
+		},
+		{
+			// Infinite loop, which can not reach the exit.
+			src: `
+				%1 = OpFunction %2 None %3
+				%10 = OpLabel
+				     OpBranchConditional %5 %11 %12
+				%11 = OpLabel
+				     OpBranch %11
+				%12 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+			dom:   map[Id]Id{10: 0, 11: 10, 12: 10},
+			pdom:  map[Id]Id{10: 12, 12: 0},
+			roots: []Id{10},
+			proot: []Id{12},
+		},
+	} {
+		mod, err := Assemble(strings.NewReader(st.src))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		cfg, err := NewCFG(mod.Code)
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		testDomTree(t, i, "dominator", cfg, NewDomTree(cfg), st.dom, st.roots)
+		testDomTree(t, i, "post-dominator", cfg, NewPostDomTree(cfg), st.pdom, st.proot)
+	}
+}
+
+func testDomTree(t *testing.T, i int, name string, cfg *CFG, tree *DomTree, idom map[Id]Id, roots []Id) {
+	have := blockLabels(tree.Roots())
+	if !reflect.DeepEqual(have, roots) {
+		t.Fatalf("case %d: %s roots mismatch:\nHave: %v\nWant: %v", i, name, have, roots)
+	}
+
+	// dominates returns true if a dominates b, according to idom.
+	dominates := func(a, b Id) bool {
+		_, ok := idom[a]
+		if !ok {
+			return false
+		}
+
+		_, ok = idom[b]
+		for ok && b != 0 {
+			if b == a {
+				return true
+			}
+			b = idom[b]
+		}
+
+		return false
+	}
+
+	for _, b := range cfg.Blocks {
+		want, ok := idom[b.Label]
+		if tree.Contains(b) != ok {
+			t.Fatalf("case %d: %s tree contains block %d: %v", i, name, b.Label, !ok)
+		}
+
+		var have Id
+		if d := tree.Idom(b); d != nil {
+			have = d.Label
+		}
+
+		if have != want {
+			t.Fatalf("case %d: %s of block %d mismatch:\nHave: %d\nWant: %d",
+				i, name, b.Label, have, want)
+		}
+
+		for _, c := range tree.Children(b) {
+			if idom[c.Label] != b.Label {
+				t.Fatalf("case %d: %s tree: unexpected child %d of block %d",
+					i, name, c.Label, b.Label)
+			}
+		}
+
+		for _, a := range cfg.Blocks {
+			if tree.Dominates(a, b) != dominates(a.Label, b.Label) {
+				t.Fatalf("case %d: %s tree: block %d dominates block %d: %v",
+					i, name, a.Label, b.Label, !dominates(a.Label, b.Label))
+			}
+		}
+	}
+}
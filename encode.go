@@ -87,28 +87,61 @@ func (e *Encoder) EncodeInstructionWords(data []uint32) error {
 //
 // This assumes the instruction has been validated and is correct.
 func (e *Encoder) EncodeInstruction(i Instruction) error {
-	// Make sure the scratch buffer has sufficient space.
-	size := EncodedLen(i)
-	if size > len(e.buf) {
-		e.buf = make([]uint32, size)
-	}
-
-	// Encode the instruction arguments.
-	rv := reflect.ValueOf(i)
-	rv = reflect.Indirect(rv)
+	var err error
 
-	argc, err := encodeValue(rv, e.buf[1:])
+	e.buf, err = appendInstruction(e.buf[:0], i)
 	if err != nil {
 		return err
 	}
 
-	argc++
+	// Write the words to the underlying stream.
+	return e.EncodeInstructionWords(e.buf)
+}
+
+// appendInstruction appends the encoded instruction to out, starting
+// with the word holding its word count and opcode.
+func appendInstruction(out []uint32, i Instruction) ([]uint32, error) {
+	start := len(out)
+	out = append(out, 0)
+
+	wc, ok := i.(WordCodec)
+	if ok {
+		out = wc.EncodeWords(out)
+	} else {
+		var err error
+
+		out, err = encodeReflect(out, i)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Set the first instruction word.
-	e.buf[0] = EncodeOpcode(argc, i.Opcode())
+	out[start] = EncodeOpcode(uint32(len(out)-start), i.Opcode())
+	return out, nil
+}
 
-	// Write the words to the underlying stream.
-	return e.EncodeInstructionWords(e.buf[:argc])
+// encodeReflect appends the encoded operands of the given instruction
+// to out. It is used for instructions which do not implement WordCodec.
+func encodeReflect(out []uint32, i Instruction) ([]uint32, error) {
+	rv := reflect.ValueOf(i)
+	rv = reflect.Indirect(rv)
+
+	// Make sure the buffer has sufficient space.
+	start := len(out)
+	size := start + encodedValueLen(rv)
+	if size > cap(out) {
+		tmp := make([]uint32, start, size)
+		copy(tmp, out)
+		out = tmp
+	}
+
+	argc, err := encodeValue(rv, out[start:size])
+	if err != nil {
+		return nil, err
+	}
+
+	return out[:start+int(argc)], nil
 }
 
 // Write writes exactly len(p) words to the underlying stream.
@@ -141,6 +174,11 @@ func (e *Encoder) write(p []uint32) error {
 // EncodedLen returns the number of words the given instruction
 // will occupy once encoded.
 func EncodedLen(i Instruction) int {
+	wc, ok := i.(WordCodec)
+	if ok {
+		return wc.EncodedLen() + 1
+	}
+
 	rv := reflect.ValueOf(i)
 	rv = reflect.Indirect(rv)
 	return encodedValueLen(rv) + 1
@@ -163,8 +201,18 @@ func encodedValueLen(rv reflect.Value) int {
 func encodedStructLen(rv reflect.Value) int {
 	var len int
 
+	rt := rv.Type()
 	for i := 0; i < rv.NumField(); i++ {
-		len += encodedValueLen(rv.Field(i))
+		fv := rv.Field(i)
+		ft := rt.Field(i)
+
+		// Empty optional fields are not encoded.
+		tag := ft.Tag.Get("spirv")
+		if hasFieldOption(tag, "optional") && valueIsNil(fv) {
+			continue
+		}
+
+		len += encodedValueLen(fv)
 	}
 
 	return len
@@ -236,6 +284,9 @@ func valueIsNil(rv reflect.Value) bool {
 
 	case reflect.Uint32:
 		return rv.Uint() == 0
+
+	case reflect.String:
+		return rv.Len() == 0
 	}
 
 	return false
@@ -272,3 +323,22 @@ func encodeSlice(rv reflect.Value, out []uint32) (uint32, error) {
 
 	return index, nil
 }
+
+// appendIds appends the given ids to out.
+func appendIds(out []uint32, ids []Id) []uint32 {
+	for _, id := range ids {
+		out = append(out, uint32(id))
+	}
+	return out
+}
+
+// appendString appends the encoded string literal to out.
+func appendString(out []uint32, s String) []uint32 {
+	start := len(out)
+	for n := s.EncodedLen(); n > 0; n-- {
+		out = append(out, 0)
+	}
+
+	s.Encode(out[start:])
+	return out
+}
@@ -89,20 +89,20 @@ func TestEncodeHeader(t *testing.T) {
 			err:  ErrInvalidVersion,
 		},
 		{
-			in: Header{MagicLE, 99, 1, 255, 0},
+			in: Header{MagicLE, SpecificationVersion, 1, 255, 0},
 			want: []byte{
 				0x03, 0x02, 0x23, 0x07,
-				0x63, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x01, 0x00,
 				0x01, 0x00, 0x00, 0x00,
 				0xff, 0x00, 0x00, 0x00,
 				0x00, 0x00, 0x00, 0x00,
 			},
 		},
 		{
-			in: Header{MagicBE, 99, 1, 255, 0},
+			in: Header{MagicBE, SpecificationVersion, 1, 255, 0},
 			want: []byte{
 				0x07, 0x23, 0x02, 0x03,
-				0x00, 0x00, 0x00, 0x63,
+				0x00, 0x01, 0x00, 0x00,
 				0x00, 0x00, 0x00, 0x01,
 				0x00, 0x00, 0x00, 0xff,
 				0x00, 0x00, 0x00, 0x00,
@@ -0,0 +1,148 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// enumTable maps the values of an enumeration type to their symbolic names,
+// as used by the SPIR-V assembly syntax.
+//
+// Bit flag enumerations are printed as a '|' separated list of the names
+// of all bits which are set.
+type enumTable struct {
+	flags bool
+	names map[uint32]string
+}
+
+// String returns the symbolic name for v. Values without a known name
+// are returned in their numeric form, so they can be parsed back.
+func (t *enumTable) String(v uint32) string {
+	if t.flags {
+		return t.flagString(v)
+	}
+
+	name, ok := t.names[v]
+	if ok {
+		return name
+	}
+
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+// flagString returns the '|' separated names of all bits set in v.
+func (t *enumTable) flagString(v uint32) string {
+	if v == 0 {
+		name, ok := t.names[0]
+		if ok {
+			return name
+		}
+		return "None"
+	}
+
+	var out []string
+
+	for _, bit := range t.values() {
+		if bit == 0 || v&bit != bit {
+			continue
+		}
+
+		out = append(out, t.names[bit])
+		v &^= bit
+	}
+
+	if v != 0 {
+		out = append(out, fmt.Sprintf("0x%x", v))
+	}
+
+	return strings.Join(out, "|")
+}
+
+// Value returns the value for the given symbolic name. For bit flag
+// enumerations, this accepts a '|' separated list of names.
+//
+// Numeric values are accepted in place of a name. Returns false if
+// the name is not known.
+func (t *enumTable) Value(name string) (uint32, bool) {
+	if !t.flags {
+		return t.value(name)
+	}
+
+	if name == "None" {
+		return 0, true
+	}
+
+	var out uint32
+
+	for _, fld := range strings.Split(name, "|") {
+		v, ok := t.value(fld)
+		if !ok {
+			return 0, false
+		}
+		out |= v
+	}
+
+	return out, true
+}
+
+// value returns the value for a single symbolic name.
+func (t *enumTable) value(name string) (uint32, bool) {
+	for v, n := range t.names {
+		if n == name {
+			return v, true
+		}
+	}
+
+	v, err := strconv.ParseUint(name, 0, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	return uint32(v), true
+}
+
+// valid returns true if v is a known value. For bit flag enumerations,
+// this accepts any combination of known bits, including zero.
+func (t *enumTable) valid(v uint32) bool {
+	if !t.flags {
+		_, ok := t.names[v]
+		return ok
+	}
+
+	var mask uint32
+	for bit := range t.names {
+		mask |= bit
+	}
+
+	return verifyBitFlag(v, true, mask)
+}
+
+// verifyBitFlag returns true if v is a valid bit flag in the
+// given range. This includes combinations of all possible values.
+func verifyBitFlag(v uint32, none bool, mask uint32) bool {
+	return v == (v&mask) && (none || v != 0)
+}
+
+// values returns all known values in ascending order.
+func (t *enumTable) values() []uint32 {
+	out := make([]uint32, 0, len(t.names))
+
+	for v := range t.names {
+		out = append(out, v)
+	}
+
+	sort.Sort(wordSlice(out))
+	return out
+}
+
+// wordSlice implements sort.Interface for a slice of words.
+type wordSlice []uint32
+
+func (s wordSlice) Len() int           { return len(s) }
+func (s wordSlice) Less(i, j int) bool { return s[i] < s[j] }
+func (s wordSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,39 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "testing"
+
+type EnumTest struct {
+	table *enumTable
+	value uint32
+	name  string
+}
+
+func TestEnumTable(t *testing.T) {
+	for i, st := range []EnumTest{
+		{storageClassNames, StorageClassUniform, "Uniform"},
+		{storageClassNames, 123, "123"},
+		{functionControlNames, 0, "None"},
+		{functionControlNames, FunctionControlInline, "Inline"},
+		{functionControlNames, FunctionControlInline | FunctionControlConst, "Inline|Const"},
+		{functionControlNames, FunctionControlPure | 0x100, "Pure|0x100"},
+		{dimNames, Dim3D, "3D"},
+	} {
+		have := st.table.String(st.value)
+		if have != st.name {
+			t.Fatalf("case %d: name mismatch:\nHave: %s\nWant: %s", i, have, st.name)
+		}
+
+		value, ok := st.table.Value(have)
+		if !ok || value != st.value {
+			t.Fatalf("case %d: value mismatch for %q:\nHave: %d\nWant: %d",
+				i, have, value, st.value)
+		}
+	}
+
+	if _, ok := storageClassNames.Value("Foo"); ok {
+		t.Fatalf("expected unknown name to fail")
+	}
+}
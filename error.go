@@ -6,6 +6,7 @@ package spirv
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 var (
@@ -15,11 +16,17 @@ var (
 	ErrUnacceptable           = errors.New("use of this instruction is not allowed")
 	ErrInstructionNotPointer  = errors.New("value from Codec.New is not a pointer type")
 	ErrDuplicateInstruction   = errors.New("duplicate opcode being registered")
+	ErrDuplicateDialect       = errors.New("duplicate dialect version being registered")
 	ErrInvalidMagicValue      = errors.New("Header: invalid magic value")
 	ErrInvalidVersion         = errors.New("Header: invalid version number")
 	ErrMemoryModel            = errors.New("a module must define one and only one OpMemoryModel")
 	ErrEntrypoint             = errors.New("a module must define at least one OpEntrypoint")
-	ErrExecutionMode          = errors.New("a module must define at least one OpExecutionMode")
+	ErrBuilderPlacement       = errors.New("Builder: instruction does not belong here")
+	ErrNotType                = errors.New("id does not refer to a type declaration")
+
+	// Deprecated: modules are no longer required to define an
+	// OpExecutionMode, so this error is never returned.
+	ErrExecutionMode = errors.New("a module must define at least one OpExecutionMode")
 )
 
 // LayoutError defines an error in a module's structural layout.
@@ -40,3 +47,65 @@ func NewLayoutError(addr int, msg string, argv ...interface{}) *LayoutError {
 func (e *LayoutError) Error() string {
 	return fmt.Sprintf("at $%08x: %s", e.Address, e.Msg)
 }
+
+// LinkError defines an error in combining the modules passed to Link.
+type LinkError struct {
+	Msg     string
+	Module  int // Index of the module in the arguments to Link.
+	Address int // Address of the offending instruction in that module.
+}
+
+// NewLinkError creates a new link error for the given module index,
+// address and formatted message.
+func NewLinkError(mod, addr int, msg string, argv ...interface{}) *LinkError {
+	return &LinkError{
+		Msg:     fmt.Sprintf(msg, argv...),
+		Module:  mod,
+		Address: addr,
+	}
+}
+
+func (e *LinkError) Error() string {
+	return fmt.Sprintf("module %d at $%08x: %s", e.Module, e.Address, e.Msg)
+}
+
+// SyntaxError defines an error in SPIR-V assembly text.
+type SyntaxError struct {
+	Msg    string
+	Line   int
+	Column int
+}
+
+// NewSyntaxError creates a new syntax error for the given source position
+// and formatted message.
+func NewSyntaxError(line, col int, msg string, argv ...interface{}) *SyntaxError {
+	return &SyntaxError{
+		Msg:    fmt.Sprintf(msg, argv...),
+		Line:   line,
+		Column: col,
+	}
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Msg)
+}
+
+// ErrorList defines a list of errors. It is returned by checks which
+// report every problem they find, instead of just the first one.
+type ErrorList []error
+
+func (e ErrorList) Error() string {
+	msg := make([]string, len(e))
+	for i, err := range e {
+		msg[i] = err.Error()
+	}
+	return strings.Join(msg, "\n")
+}
+
+// err returns the list as an error, or nil if it is empty.
+func (e ErrorList) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
@@ -0,0 +1,969 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "math"
+
+// FoldConstants evaluates arithmetic, bitwise, relational, logical,
+// conversion and composite instructions whose operands are constants.
+// Each of them is replaced with an OpConstant, OpConstantTrue,
+// OpConstantFalse or OpConstantComposite declaration, which keeps the
+// instruction's result id. If an identical constant exists already,
+// all uses of the result are replaced with it instead. Folded results
+// are themselves constants, so chains of instructions are folded in
+// a single call.
+//
+// Integers and floats are evaluated with the width and signedness of
+// their OpTypeInt and OpTypeFloat declarations. Floats must be 32 or 64
+// bits wide. Integer arithmetic wraps around. Instructions whose result
+// is undefined, like integer division by zero, or shifts by the width
+// of the base or more, are left alone. Specialization constants are
+// never folded, since their values are not known until later.
+//
+// Returns the number of instructions which were folded.
+func (m *Module) FoldConstants() int {
+	f := newFolder(m)
+	folded := make(map[int]bool)
+
+	rewrite := func(id Id) Id {
+		if c, ok := f.canon[id]; ok {
+			return c
+		}
+		return id
+	}
+
+	for addr, instr := range m.Code {
+		id, ok := ResultId(instr)
+		if !ok {
+			continue
+		}
+
+		// Operands are defined before they are used, so
+		// they have been folded already.
+		RewriteOperands(instr, rewrite)
+
+		f.resultId = id
+		c, ok := f.fold(instr)
+		if !ok {
+			continue
+		}
+
+		if c != id {
+			f.canon[id] = c
+		}
+
+		folded[addr] = true
+	}
+
+	if len(folded) == 0 {
+		return 0
+	}
+
+	// New declarations go at the end of the type section.
+	insert := m.Code.Index(opcodeFunction)
+	if insert == -1 {
+		insert = len(m.Code)
+	}
+
+	code := make(InstructionList, 0, len(m.Code)+len(f.added)-len(folded))
+
+	for addr, instr := range m.Code {
+		if addr == insert {
+			code = append(code, f.added...)
+		}
+
+		if folded[addr] || f.targetsFolded(instr) {
+			continue
+		}
+
+		RewriteOperands(instr, rewrite)
+		code = append(code, instr)
+	}
+
+	if insert == len(m.Code) {
+		code = append(code, f.added...)
+	}
+
+	m.Code = code
+	return len(folded)
+}
+
+// foldValue defines the value of a constant. Scalars are stored as
+// their bits, truncated to the width of their type. Booleans are
+// 0 or 1. Composites are stored as the ids of their constituents.
+type foldValue struct {
+	typ   *Type
+	bits  uint64
+	elems []Id
+}
+
+// folder evaluates instructions over constant operands.
+type folder struct {
+	types  *TypeTable
+	ids    *IdAllocator
+	values map[Id]*foldValue
+	decls  map[string]Id // Constant ids, by instructionKey.
+	added  []Instruction // New constant declarations.
+	canon  map[Id]Id     // Replacements for folded result ids.
+
+	// resultId is the result id of the instruction being folded.
+	// It is reused for the constant which replaces it.
+	resultId Id
+}
+
+// newFolder creates a folder which knows all constants in the module.
+func newFolder(m *Module) *folder {
+	f := &folder{
+		types:  NewTypeTable(m),
+		ids:    m.IdAllocator(),
+		values: make(map[Id]*foldValue),
+		decls:  make(map[string]Id),
+		canon:  make(map[Id]Id),
+	}
+
+	for _, instr := range m.Code {
+		f.record(instr)
+	}
+
+	return f
+}
+
+// record makes the value of the given constant declaration known.
+// Other instructions are ignored.
+func (f *folder) record(instr Instruction) {
+	var v *foldValue
+
+	switch c := instr.(type) {
+	case *OpConstantTrue:
+		v = f.scalar(c.ResultType, 1)
+	case *OpConstantFalse:
+		v = f.scalar(c.ResultType, 0)
+	case *OpConstantNull:
+		v = f.scalar(c.ResultType, 0)
+
+	case *OpConstant:
+		var bits uint64
+		if len(c.Value) > 0 {
+			bits = uint64(c.Value[0])
+		}
+		if len(c.Value) > 1 {
+			bits |= uint64(c.Value[1]) << 32
+		}
+		v = f.scalar(c.ResultType, bits)
+
+	case *OpConstantComposite:
+		if t := f.types.Type(c.ResultType); t.isComposite() {
+			v = &foldValue{typ: t, elems: c.Constituents}
+		}
+	}
+
+	if v == nil {
+		return
+	}
+
+	id, _ := ResultId(instr)
+	f.values[id] = v
+
+	key, ok := instructionKey(instr, 2)
+	if _, dup := f.decls[key]; ok && !dup {
+		f.decls[key] = id
+	}
+}
+
+// scalar returns the value of a scalar constant of the given type.
+// Returns nil if the type is not a boolean, integer or float of
+// at most 64 bits.
+func (f *folder) scalar(typ Id, bits uint64) *foldValue {
+	t := f.types.Type(typ)
+	if !t.is(TypeBool) && !t.is(TypeInt) && !t.is(TypeFloat) {
+		return nil
+	}
+
+	if t.Width > 64 {
+		return nil
+	}
+
+	return &foldValue{typ: t, bits: truncate(t, bits)}
+}
+
+// targetsFolded returns true if instr is a debug name or decoration
+// of a result which has been replaced by another constant.
+func (f *folder) targetsFolded(instr Instruction) bool {
+	var target Id
+
+	switch v := instr.(type) {
+	case *OpName:
+		target = v.Target
+	case *OpDecorate:
+		target = v.Target
+	default:
+		return false
+	}
+
+	_, ok := f.canon[target]
+	return ok
+}
+
+// declare returns the id of a constant with the given value. A new
+// declaration with the given id is added if there is none yet.
+// A fresh id is allocated if id is 0.
+func (f *folder) declare(v *foldValue, id Id) Id {
+	var instr Instruction
+
+	switch {
+	case v.elems != nil:
+		instr = &OpConstantComposite{ResultType: v.typ.Id, Constituents: v.elems}
+	case v.typ.Kind == TypeBool && v.bits != 0:
+		instr = &OpConstantTrue{ResultType: v.typ.Id}
+	case v.typ.Kind == TypeBool:
+		instr = &OpConstantFalse{ResultType: v.typ.Id}
+	default:
+		instr = &OpConstant{ResultType: v.typ.Id, Value: constantWords(v.typ, v.bits)}
+	}
+
+	key, _ := instructionKey(instr, 2)
+	if c, ok := f.decls[key]; ok {
+		return c
+	}
+
+	if id == 0 {
+		id = f.ids.Next()
+	}
+
+	SetResultId(instr, id)
+	f.decls[key] = id
+	f.values[id] = v
+	f.added = append(f.added, instr)
+	return id
+}
+
+// components returns the component type and values of a scalar
+// or vector constant.
+func (f *folder) components(id Id) (*Type, []uint64, bool) {
+	v, ok := f.values[id]
+	if !ok {
+		return nil, nil, false
+	}
+
+	if v.elems == nil {
+		return v.typ, []uint64{v.bits}, true
+	}
+
+	if !v.typ.is(TypeVector) {
+		return nil, nil, false
+	}
+
+	out := make([]uint64, len(v.elems))
+	for i, e := range v.elems {
+		c, ok := f.values[e]
+		if !ok || c.elems != nil {
+			return nil, nil, false
+		}
+		out[i] = c.bits
+	}
+
+	return v.typ.Elem, out, true
+}
+
+// constant returns the id of a scalar or vector constant of the given
+// type, holding the given component values. Vectors are declared with
+// the result id of the current instruction, as are scalars.
+func (f *folder) constant(typ Id, bits []uint64) (Id, bool) {
+	t := f.types.Type(typ)
+	st := t.scalar()
+
+	if st == nil || uint32(len(bits)) != t.components() {
+		return 0, false
+	}
+
+	if !t.is(TypeVector) {
+		return f.declare(&foldValue{typ: t, bits: truncate(t, bits[0])}, f.resultId), true
+	}
+
+	elems := make([]Id, len(bits))
+	for i, b := range bits {
+		elems[i] = f.declare(&foldValue{typ: st, bits: truncate(st, b)}, 0)
+	}
+
+	return f.declare(&foldValue{typ: t, elems: elems}, f.resultId), true
+}
+
+// unaryFunc evaluates one component of a unary operation. t is the
+// component type of the operand, rt that of the result.
+type unaryFunc func(t, rt *Type, x uint64) (uint64, bool)
+
+// binaryFunc evaluates one component of a binary operation. t is the
+// component type of the first operand.
+type binaryFunc func(t *Type, x, y uint64) (uint64, bool)
+
+// unary applies op to each component of a.
+func (f *folder) unary(rt, a Id, op unaryFunc) (Id, bool) {
+	t, xs, ok := f.components(a)
+	if !ok {
+		return 0, false
+	}
+
+	res := f.types.Type(rt).scalar()
+	if res == nil {
+		return 0, false
+	}
+
+	out := make([]uint64, len(xs))
+	for i, x := range xs {
+		if out[i], ok = op(t, res, x); !ok {
+			return 0, false
+		}
+	}
+
+	return f.constant(rt, out)
+}
+
+// binary applies op to each pair of components of a and b.
+func (f *folder) binary(rt, a, b Id, op binaryFunc) (Id, bool) {
+	t, xs, ok := f.components(a)
+	if !ok {
+		return 0, false
+	}
+
+	_, ys, ok := f.components(b)
+	if !ok || len(xs) != len(ys) {
+		return 0, false
+	}
+
+	out := make([]uint64, len(xs))
+	for i := range xs {
+		if out[i], ok = op(t, xs[i], ys[i]); !ok {
+			return 0, false
+		}
+	}
+
+	return f.constant(rt, out)
+}
+
+// reduce combines the components of a boolean vector with OR, if all
+// is false, or with AND otherwise.
+func (f *folder) reduce(rt, a Id, all bool) (Id, bool) {
+	_, xs, ok := f.components(a)
+	if !ok {
+		return 0, false
+	}
+
+	r := all
+	for _, x := range xs {
+		if all {
+			r = r && x != 0
+		} else {
+			r = r || x != 0
+		}
+	}
+
+	return f.constant(rt, []uint64{boolBits(r)})
+}
+
+// fold evaluates the given instruction. Returns the id of the constant
+// or value which replaces its result, or false if it can not be folded.
+func (f *folder) fold(instr Instruction) (Id, bool) {
+	switch v := instr.(type) {
+	case *OpSNegate:
+		return f.unary(v.ResultType, v.Operand, intUnary(func(x int64) int64 { return -x }))
+	case *OpNot:
+		return f.unary(v.ResultType, v.Operand, intUnary(func(x int64) int64 { return ^x }))
+	case *OpFNegate:
+		return f.unary(v.ResultType, v.Operand, foldFNegate)
+
+	case *OpIAdd:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intBinary(func(x, y uint64) uint64 { return x + y }))
+	case *OpISub:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intBinary(func(x, y uint64) uint64 { return x - y }))
+	case *OpIMul:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intBinary(func(x, y uint64) uint64 { return x * y }))
+	case *OpUDiv:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, foldUDiv)
+	case *OpSDiv:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, foldSDiv)
+	case *OpUMod:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, foldUMod)
+	case *OpSRem:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, foldSRem)
+	case *OpSMod:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, foldSMod)
+
+	case *OpFAdd:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatBinary(func(x, y float64) float64 { return x + y }))
+	case *OpFSub:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatBinary(func(x, y float64) float64 { return x - y }))
+	case *OpFMul:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatBinary(func(x, y float64) float64 { return x * y }))
+	case *OpFDiv:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatBinary(func(x, y float64) float64 { return x / y }))
+	case *OpFRem:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatBinary(math.Mod))
+	case *OpFMod:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatBinary(floatMod))
+
+	case *OpShiftRightLogical:
+		return f.binary(v.ResultType, v.Base, v.Shift, shift(func(t *Type, x uint64, n uint) uint64 { return x >> n }))
+	case *OpShiftRightArithmetic:
+		return f.binary(v.ResultType, v.Base, v.Shift, shift(func(t *Type, x uint64, n uint) uint64 { return uint64(signExtend(t, x) >> n) }))
+	case *OpShiftLeftLogical:
+		return f.binary(v.ResultType, v.Base, v.Shift, shift(func(t *Type, x uint64, n uint) uint64 { return x << n }))
+	case *OpBitwiseOr:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intBinary(func(x, y uint64) uint64 { return x | y }))
+	case *OpBitwiseXor:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intBinary(func(x, y uint64) uint64 { return x ^ y }))
+	case *OpBitwiseAnd:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intBinary(func(x, y uint64) uint64 { return x & y }))
+
+	case *OpConvertFToU:
+		return f.unary(v.ResultType, v.FloatValue, foldConvertFToU)
+	case *OpConvertFToS:
+		return f.unary(v.ResultType, v.FloatValue, foldConvertFToS)
+	case *OpConvertSToF:
+		return f.unary(v.ResultType, v.SignedValue, foldConvertSToF)
+	case *OpConvertUToF:
+		return f.unary(v.ResultType, v.UnsignedValue, foldConvertUToF)
+	case *OpUConvert:
+		return f.unary(v.ResultType, v.UnsignedValue, func(t, rt *Type, x uint64) (uint64, bool) { return x, true })
+	case *OpSConvert:
+		return f.unary(v.ResultType, v.SignedValue, func(t, rt *Type, x uint64) (uint64, bool) { return uint64(signExtend(t, x)), true })
+	case *OpFConvert:
+		return f.unary(v.ResultType, v.FloatValue, foldFConvert)
+	case *OpBitcast:
+		return f.unary(v.ResultType, v.Operand, foldBitcast)
+
+	case *OpAny:
+		return f.reduce(v.ResultType, v.Vector, false)
+	case *OpAll:
+		return f.reduce(v.ResultType, v.Vector, true)
+	case *OpIsNan:
+		return f.unary(v.ResultType, v.X, floatTest(func(x float64) bool { return math.IsNaN(x) }))
+	case *OpIsInf:
+		return f.unary(v.ResultType, v.X, floatTest(func(x float64) bool { return math.IsInf(x, 0) }))
+	case *OpIsFinite:
+		return f.unary(v.ResultType, v.X, floatTest(func(x float64) bool { return !math.IsNaN(x) && !math.IsInf(x, 0) }))
+	case *OpIsNormal:
+		return f.unary(v.ResultType, v.X, foldIsNormal)
+	case *OpSignBitSet:
+		return f.unary(v.ResultType, v.X, func(t, rt *Type, x uint64) (uint64, bool) { return x >> (t.Width - 1) & 1, true })
+	case *OpLessOrGreater:
+		return f.binary(v.ResultType, v.X, v.Y, floatCompare(true, func(x, y float64) bool { return x < y || x > y }))
+	case *OpOrdered:
+		return f.binary(v.ResultType, v.X, v.Y, floatCompare(false, func(x, y float64) bool { return !math.IsNaN(x) && !math.IsNaN(y) }))
+	case *OpUnordered:
+		return f.binary(v.ResultType, v.X, v.Y, floatCompare(false, func(x, y float64) bool { return math.IsNaN(x) || math.IsNaN(y) }))
+
+	case *OpLogicalEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intCompare(func(x, y uint64) bool { return x == y }))
+	case *OpLogicalNotEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intCompare(func(x, y uint64) bool { return x != y }))
+	case *OpLogicalOr:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intBinary(func(x, y uint64) uint64 { return x | y }))
+	case *OpLogicalAnd:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intBinary(func(x, y uint64) uint64 { return x & y }))
+	case *OpLogicalNot:
+		return f.unary(v.ResultType, v.Operand, func(t, rt *Type, x uint64) (uint64, bool) { return x ^ 1, true })
+	case *OpSelect:
+		return f.foldSelect(v)
+
+	case *OpIEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intCompare(func(x, y uint64) bool { return x == y }))
+	case *OpINotEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intCompare(func(x, y uint64) bool { return x != y }))
+	case *OpUGreaterThan:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intCompare(func(x, y uint64) bool { return x > y }))
+	case *OpSGreaterThan:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, signedCompare(func(x, y int64) bool { return x > y }))
+	case *OpUGreaterThanEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intCompare(func(x, y uint64) bool { return x >= y }))
+	case *OpSGreaterThanEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, signedCompare(func(x, y int64) bool { return x >= y }))
+	case *OpULessThan:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intCompare(func(x, y uint64) bool { return x < y }))
+	case *OpSLessThan:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, signedCompare(func(x, y int64) bool { return x < y }))
+	case *OpULessThanEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, intCompare(func(x, y uint64) bool { return x <= y }))
+	case *OpSLessThanEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, signedCompare(func(x, y int64) bool { return x <= y }))
+
+	case *OpFOrdEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatCompare(true, func(x, y float64) bool { return x == y }))
+	case *OpFUnordEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatCompare(false, func(x, y float64) bool { return x == y }))
+	case *OpFOrdNotEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatCompare(true, func(x, y float64) bool { return x != y }))
+	case *OpFUnordNotEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatCompare(false, func(x, y float64) bool { return x != y }))
+	case *OpFOrdLessThan:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatCompare(true, func(x, y float64) bool { return x < y }))
+	case *OpFUnordLessThan:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatCompare(false, func(x, y float64) bool { return x < y }))
+	case *OpFOrdGreaterThan:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatCompare(true, func(x, y float64) bool { return x > y }))
+	case *OpFUnordGreaterThan:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatCompare(false, func(x, y float64) bool { return x > y }))
+	case *OpFOrdLessThanEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatCompare(true, func(x, y float64) bool { return x <= y }))
+	case *OpFUnordLessThanEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatCompare(false, func(x, y float64) bool { return x <= y }))
+	case *OpFOrdGreaterThanEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatCompare(true, func(x, y float64) bool { return x >= y }))
+	case *OpFUnordGreaterThanEqual:
+		return f.binary(v.ResultType, v.Operand1, v.Operand2, floatCompare(false, func(x, y float64) bool { return x >= y }))
+
+	case *OpVectorShuffle:
+		return f.foldVectorShuffle(v)
+	case *OpCompositeConstruct:
+		return f.foldCompositeConstruct(v)
+	case *OpCompositeExtract:
+		return f.foldCompositeExtract(v)
+	case *OpCompositeInsert:
+		if _, ok := f.values[v.Object]; !ok {
+			return 0, false
+		}
+		return f.insert(v.Composite, v.Object, v.Indices, f.resultId)
+	}
+
+	return 0, false
+}
+
+// foldSelect picks Object1 or Object2. With a scalar condition, the
+// objects need not be constants.
+func (f *folder) foldSelect(v *OpSelect) (Id, bool) {
+	c, ok := f.values[v.Condition]
+	if !ok {
+		return 0, false
+	}
+
+	if c.elems == nil {
+		if c.bits != 0 {
+			return v.Object1, true
+		}
+		return v.Object2, true
+	}
+
+	_, cs, ok := f.components(v.Condition)
+	if !ok {
+		return 0, false
+	}
+
+	a, b := f.values[v.Object1], f.values[v.Object2]
+	if a == nil || b == nil || len(a.elems) != len(cs) || len(b.elems) != len(cs) {
+		return 0, false
+	}
+
+	elems := make([]Id, len(cs))
+	for i, c := range cs {
+		if c != 0 {
+			elems[i] = a.elems[i]
+		} else {
+			elems[i] = b.elems[i]
+		}
+	}
+
+	return f.composite(v.ResultType, elems)
+}
+
+func (f *folder) foldVectorShuffle(v *OpVectorShuffle) (Id, bool) {
+	a, b := f.values[v.Vector1], f.values[v.Vector2]
+	if a == nil || b == nil || a.elems == nil || b.elems == nil {
+		return 0, false
+	}
+
+	all := append(a.elems[:len(a.elems):len(a.elems)], b.elems...)
+	elems := make([]Id, len(v.Components))
+
+	for i, c := range v.Components {
+		// This includes 0xffffffff, which selects an undefined component.
+		if int(c) >= len(all) {
+			return 0, false
+		}
+		elems[i] = all[c]
+	}
+
+	return f.composite(v.ResultType, elems)
+}
+
+// foldCompositeConstruct declares the constituents as a constant.
+// Vector constituents of vectors are flattened into their components.
+func (f *folder) foldCompositeConstruct(v *OpCompositeConstruct) (Id, bool) {
+	t := f.types.Type(v.ResultType)
+	if !t.isComposite() {
+		return 0, false
+	}
+
+	var elems []Id
+
+	for _, id := range v.Constituents {
+		c, ok := f.values[id]
+		if !ok {
+			return 0, false
+		}
+
+		if t.Kind == TypeVector && c.typ.is(TypeVector) {
+			elems = append(elems, c.elems...)
+		} else {
+			elems = append(elems, id)
+		}
+	}
+
+	if t.Kind == TypeVector && uint32(len(elems)) != t.Len {
+		return 0, false
+	}
+
+	return f.composite(v.ResultType, elems)
+}
+
+// foldCompositeExtract returns the constituent selected by the indices.
+func (f *folder) foldCompositeExtract(v *OpCompositeExtract) (Id, bool) {
+	id := v.Composite
+
+	for _, i := range v.Indices {
+		c, ok := f.values[id]
+		if !ok || int(i) >= len(c.elems) {
+			return 0, false
+		}
+		id = c.elems[i]
+	}
+
+	return id, true
+}
+
+// insert returns a copy of the composite constant with the given id,
+// where the constituent selected by the indices is replaced by obj.
+// The copy is declared with the given id, or with a fresh one if id is 0.
+func (f *folder) insert(composite, obj Id, indices []uint32, id Id) (Id, bool) {
+	if len(indices) == 0 {
+		return obj, true
+	}
+
+	c, ok := f.values[composite]
+	if !ok || int(indices[0]) >= len(c.elems) {
+		return 0, false
+	}
+
+	elems := append([]Id(nil), c.elems...)
+
+	e, ok := f.insert(elems[indices[0]], obj, indices[1:], 0)
+	if !ok {
+		return 0, false
+	}
+
+	elems[indices[0]] = e
+	return f.declare(&foldValue{typ: c.typ, elems: elems}, id), true
+}
+
+// composite returns the id of a composite constant with the given
+// type and constituents.
+func (f *folder) composite(typ Id, elems []Id) (Id, bool) {
+	t := f.types.Type(typ)
+	if !t.isComposite() {
+		return 0, false
+	}
+	return f.declare(&foldValue{typ: t, elems: elems}, f.resultId), true
+}
+
+// truncate returns x with all bits above the width of t cleared.
+// Booleans are reduced to 0 or 1.
+func truncate(t *Type, x uint64) uint64 {
+	if t.Kind == TypeBool {
+		return boolBits(x != 0)
+	}
+
+	if t.Width == 0 || t.Width >= 64 {
+		return x
+	}
+
+	return x & (1<<t.Width - 1)
+}
+
+// signExtend returns x as a signed integer of the width of t.
+func signExtend(t *Type, x uint64) int64 {
+	if t.Width == 0 || t.Width >= 64 {
+		return int64(x)
+	}
+
+	n := 64 - t.Width
+	return int64(x<<n) >> n
+}
+
+// boolBits returns 1 for true and 0 for false.
+func boolBits(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// constantWords returns the words of an OpConstant of type t with the
+// given bits, low-order word first. Signed integers narrower than 32
+// bits are sign extended to fill their word.
+func constantWords(t *Type, bits uint64) []uint32 {
+	if t.Width > 32 {
+		return []uint32{uint32(bits), uint32(bits >> 32)}
+	}
+
+	if t.Kind == TypeInt && t.Signed {
+		return []uint32{uint32(signExtend(t, bits))}
+	}
+
+	return []uint32{uint32(bits)}
+}
+
+// floatValue returns the value of a float of type t.
+// Returns false if t is not 32 or 64 bits wide.
+func floatValue(t *Type, x uint64) (float64, bool) {
+	switch t.Width {
+	case 32:
+		return float64(math.Float32frombits(uint32(x))), true
+	case 64:
+		return math.Float64frombits(x), true
+	}
+	return 0, false
+}
+
+// floatBits returns the bits of x, rounded to a float of type t.
+// The type must be 32 or 64 bits wide.
+func floatBits(t *Type, x float64) uint64 {
+	if t.Width == 32 {
+		return uint64(math.Float32bits(float32(x)))
+	}
+	return math.Float64bits(x)
+}
+
+// intUnary returns a unary integer operation on signed values.
+// The result is truncated to the width of the result type.
+func intUnary(op func(x int64) int64) unaryFunc {
+	return func(t, rt *Type, x uint64) (uint64, bool) {
+		return uint64(op(signExtend(t, x))), true
+	}
+}
+
+// intBinary returns a binary integer operation, whose result is
+// truncated to the width of the result type.
+func intBinary(op func(x, y uint64) uint64) binaryFunc {
+	return func(t *Type, x, y uint64) (uint64, bool) {
+		return op(x, y), true
+	}
+}
+
+// intCompare returns a comparison of unsigned integers or booleans.
+func intCompare(op func(x, y uint64) bool) binaryFunc {
+	return func(t *Type, x, y uint64) (uint64, bool) {
+		return boolBits(op(x, y)), true
+	}
+}
+
+// signedCompare returns a comparison of signed integers.
+func signedCompare(op func(x, y int64) bool) binaryFunc {
+	return func(t *Type, x, y uint64) (uint64, bool) {
+		return boolBits(op(signExtend(t, x), signExtend(t, y))), true
+	}
+}
+
+// shift returns a bit shift. Shifting by the width of the base or
+// more is undefined and is not folded.
+func shift(op func(t *Type, x uint64, n uint) uint64) binaryFunc {
+	return func(t *Type, x, n uint64) (uint64, bool) {
+		if n >= uint64(t.Width) {
+			return 0, false
+		}
+		return op(t, x, uint(n)), true
+	}
+}
+
+func foldUDiv(t *Type, x, y uint64) (uint64, bool) {
+	if y == 0 {
+		return 0, false
+	}
+	return x / y, true
+}
+
+func foldSDiv(t *Type, x, y uint64) (uint64, bool) {
+	if y == 0 {
+		return 0, false
+	}
+	return uint64(signExtend(t, x) / signExtend(t, y)), true
+}
+
+func foldUMod(t *Type, x, y uint64) (uint64, bool) {
+	if y == 0 {
+		return 0, false
+	}
+	return x % y, true
+}
+
+// foldSRem computes the remainder, whose sign matches that of x.
+func foldSRem(t *Type, x, y uint64) (uint64, bool) {
+	if y == 0 {
+		return 0, false
+	}
+	return uint64(signExtend(t, x) % signExtend(t, y)), true
+}
+
+// foldSMod computes the remainder, whose sign matches that of y.
+func foldSMod(t *Type, x, y uint64) (uint64, bool) {
+	if y == 0 {
+		return 0, false
+	}
+
+	a, b := signExtend(t, x), signExtend(t, y)
+	r := a % b
+	if r != 0 && (r < 0) != (b < 0) {
+		r += b
+	}
+
+	return uint64(r), true
+}
+
+// foldFNegate flips the sign bit, which also negates zeroes and NaNs.
+func foldFNegate(t, rt *Type, x uint64) (uint64, bool) {
+	if _, ok := floatValue(t, x); !ok {
+		return 0, false
+	}
+	return x ^ 1<<(t.Width-1), true
+}
+
+// floatBinary returns a binary float operation. The operation is
+// evaluated in double precision and rounded to the width of the type.
+// For the basic arithmetic operations, this yields the correctly
+// rounded 32-bit result.
+func floatBinary(op func(x, y float64) float64) binaryFunc {
+	return func(t *Type, x, y uint64) (uint64, bool) {
+		a, ok := floatValue(t, x)
+		if !ok {
+			return 0, false
+		}
+
+		b, _ := floatValue(t, y)
+		return floatBits(t, op(a, b)), true
+	}
+}
+
+// floatMod computes the remainder, whose sign matches that of y.
+func floatMod(x, y float64) float64 {
+	r := math.Mod(x, y)
+	if r != 0 && math.Signbit(r) != math.Signbit(y) {
+		r += y
+	}
+	return r
+}
+
+// floatCompare returns a float comparison. Ordered comparisons are
+// false if either operand is NaN, unordered ones are true.
+func floatCompare(ordered bool, op func(x, y float64) bool) binaryFunc {
+	return func(t *Type, x, y uint64) (uint64, bool) {
+		a, ok := floatValue(t, x)
+		if !ok {
+			return 0, false
+		}
+
+		b, _ := floatValue(t, y)
+		if math.IsNaN(a) || math.IsNaN(b) {
+			return boolBits(!ordered), true
+		}
+
+		return boolBits(op(a, b)), true
+	}
+}
+
+// floatTest returns a test on a single float.
+func floatTest(op func(x float64) bool) unaryFunc {
+	return func(t, rt *Type, x uint64) (uint64, bool) {
+		a, ok := floatValue(t, x)
+		return boolBits(op(a)), ok
+	}
+}
+
+func foldIsNormal(t, rt *Type, x uint64) (uint64, bool) {
+	a, ok := floatValue(t, x)
+	if !ok {
+		return 0, false
+	}
+
+	min := math.Ldexp(1, -1022)
+	if t.Width == 32 {
+		min = math.Ldexp(1, -126)
+	}
+
+	a = math.Abs(a)
+	return boolBits(a >= min && !math.IsInf(a, 0)), true
+}
+
+// foldConvertFToU converts to an unsigned integer, rounding towards
+// zero. Values which do not fit the result are undefined.
+func foldConvertFToU(t, rt *Type, x uint64) (uint64, bool) {
+	a, ok := floatValue(t, x)
+	if !ok || math.IsNaN(a) {
+		return 0, false
+	}
+
+	a = math.Trunc(a)
+	if a < 0 || a >= math.Ldexp(1, int(rt.Width)) {
+		return 0, false
+	}
+
+	return uint64(a), true
+}
+
+// foldConvertFToS converts to a signed integer, rounding towards
+// zero. Values which do not fit the result are undefined.
+func foldConvertFToS(t, rt *Type, x uint64) (uint64, bool) {
+	a, ok := floatValue(t, x)
+	if !ok || math.IsNaN(a) {
+		return 0, false
+	}
+
+	a = math.Trunc(a)
+	lim := math.Ldexp(1, int(rt.Width)-1)
+	if a < -lim || a >= lim {
+		return 0, false
+	}
+
+	return uint64(int64(a)), true
+}
+
+// foldConvertSToF converts a signed integer with a single rounding
+// to the width of the result.
+func foldConvertSToF(t, rt *Type, x uint64) (uint64, bool) {
+	s := signExtend(t, x)
+
+	switch rt.Width {
+	case 32:
+		return uint64(math.Float32bits(float32(s))), true
+	case 64:
+		return math.Float64bits(float64(s)), true
+	}
+
+	return 0, false
+}
+
+// foldConvertUToF converts an unsigned integer with a single rounding
+// to the width of the result.
+func foldConvertUToF(t, rt *Type, x uint64) (uint64, bool) {
+	switch rt.Width {
+	case 32:
+		return uint64(math.Float32bits(float32(x))), true
+	case 64:
+		return math.Float64bits(float64(x)), true
+	}
+
+	return 0, false
+}
+
+func foldFConvert(t, rt *Type, x uint64) (uint64, bool) {
+	a, ok := floatValue(t, x)
+	if !ok || (rt.Width != 32 && rt.Width != 64) {
+		return 0, false
+	}
+	return floatBits(rt, a), true
+}
+
+// foldBitcast reinterprets the bits of scalars of the same width.
+func foldBitcast(t, rt *Type, x uint64) (uint64, bool) {
+	if t.Kind == TypeBool || rt.Kind == TypeBool || t.Width != rt.Width {
+		return 0, false
+	}
+	return x, true
+}
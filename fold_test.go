@@ -0,0 +1,191 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testFoldPrefix = `
+	     OpCapability Shader
+	     OpMemoryModel Logical GLSL450
+	%1 = OpTypeVoid
+	%2 = OpTypeFunction %1
+	%3 = OpTypeInt 32 1
+	%4 = OpTypeInt 32 0
+	%5 = OpTypeFloat 32
+	%6 = OpTypeFloat 64
+	%7 = OpTypeBool
+	%8 = OpTypeVector %3 2
+	%10 = OpConstant %3 7
+	%11 = OpConstant %3 -3
+	%12 = OpConstant %3 0
+	%13 = OpConstant %5 16777216
+	%14 = OpConstant %5 1
+	%15 = OpConstantComposite %8 %10 %11
+	%16 = OpConstant %6 16777216
+	%17 = OpConstant %6 1
+	%18 = OpConstant %3 2
+	%19 = OpConstant %3 65536
+`
+
+// testFoldModule returns the source of a module with the given extra
+// constant declarations and the body of a single function.
+func testFoldModule(decls, body string) string {
+	return testFoldPrefix + decls + `
+		%90 = OpFunction %1 None %2
+		%91 = OpLabel
+	` + body + `
+		     OpReturn
+		     OpFunctionEnd
+	`
+}
+
+func TestModuleFoldConstants(t *testing.T) {
+	for i, st := range []struct {
+		in     string
+		decls  string
+		want   string
+		folded int
+	}{
+		{
+			// Chains are folded in one go.
+			in: `
+				%20 = OpIAdd %3 %10 %11
+				%21 = OpIMul %3 %20 %10
+				%22 = OpCopyObject %3 %21
+			`,
+			decls: `
+				%20 = OpConstant %3 4
+				%21 = OpConstant %3 28
+			`,
+			want: `
+				%22 = OpCopyObject %3 %21
+			`,
+			folded: 2,
+		},
+		{
+			// Existing constants are reused; integers wrap around.
+			in: `
+				%20 = OpISub %3 %10 %10
+				%21 = OpIMul %3 %19 %19
+				%22 = OpCompositeConstruct %8 %20 %21
+			`,
+			decls: `
+				%22 = OpConstantComposite %8 %12 %12
+			`,
+			folded: 3,
+		},
+		{
+			in: `
+				%20 = OpSDiv %3 %11 %18
+				%21 = OpSMod %3 %11 %18
+				%22 = OpSRem %3 %11 %18
+				%23 = OpShiftRightArithmetic %3 %11 %18
+				%24 = OpShiftRightLogical %3 %11 %18
+				%25 = OpUDiv %3 %10 %12
+				%26 = OpUndef %3
+				%27 = OpIAdd %3 %26 %10
+				%28 = OpCompositeConstruct %8 %22 %23
+			`,
+			decls: `
+				%20 = OpConstant %3 -1
+				%21 = OpConstant %3 1
+				%24 = OpConstant %3 1073741823
+				%28 = OpConstantComposite %8 %20 %20
+			`,
+			want: `
+				%25 = OpUDiv %3 %10 %12
+				%26 = OpUndef %3
+				%27 = OpIAdd %3 %26 %10
+			`,
+			folded: 6,
+		},
+		{
+			// 2^24 + 1 is not representable in 32 bits.
+			in: `
+				%20 = OpFAdd %5 %13 %14
+				%21 = OpFAdd %6 %16 %17
+				%22 = OpFOrdLessThan %7 %13 %20
+				%23 = OpFConvert %6 %13
+				%24 = OpCopyObject %5 %20
+				%25 = OpCopyObject %6 %23
+			`,
+			decls: `
+				%21 = OpConstant %6 16777217
+				%22 = OpConstantFalse %7
+			`,
+			want: `
+				%24 = OpCopyObject %5 %13
+				%25 = OpCopyObject %6 %16
+			`,
+			folded: 4,
+		},
+		{
+			in: `
+				%20 = OpConvertSToF %5 %11
+				%21 = OpConvertFToS %3 %20
+				%22 = OpBitcast %4 %14
+				%23 = OpSLessThan %7 %11 %10
+				%24 = OpULessThan %7 %11 %10
+				%25 = OpSelect %3 %23 %10 %11
+				%26 = OpCopyObject %3 %21
+				%27 = OpCopyObject %3 %25
+			`,
+			decls: `
+				%20 = OpConstant %5 -3
+				%22 = OpConstant %4 1065353216
+				%23 = OpConstantTrue %7
+				%24 = OpConstantFalse %7
+			`,
+			want: `
+				%26 = OpCopyObject %3 %11
+				%27 = OpCopyObject %3 %10
+			`,
+			folded: 6,
+		},
+		{
+			// Vector components get fresh ids.
+			in: `
+				%20 = OpIAdd %8 %15 %15
+				%21 = OpCompositeExtract %3 %20 0
+				%22 = OpVectorShuffle %8 %15 %20 3 0
+				%23 = OpCompositeInsert %8 %18 %15 1
+				%24 = OpCopyObject %3 %21
+			`,
+			decls: `
+				%92 = OpConstant %3 14
+				%93 = OpConstant %3 -6
+				%20 = OpConstantComposite %8 %92 %93
+				%22 = OpConstantComposite %8 %93 %10
+				%23 = OpConstantComposite %8 %10 %18
+			`,
+			want: `
+				%24 = OpCopyObject %3 %92
+			`,
+			folded: 4,
+		},
+	} {
+		m, err := Assemble(strings.NewReader(testFoldModule("", st.in)))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		want, err := Assemble(strings.NewReader(testFoldModule(st.decls, st.want)))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		folded := m.FoldConstants()
+		if folded != st.folded {
+			t.Fatalf("case %d: folded count mismatch:\nHave: %d\nWant: %d", i, folded, st.folded)
+		}
+
+		if !reflect.DeepEqual(m.Code, want.Code) {
+			t.Fatalf("case %d: code mismatch:\nHave: %v\nWant: %v", i, m.Code, want.Code)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// The instruction types, opcodes and enumerations are generated from the
+// machine-readable SPIR-V grammar. Refer to spirv-gen/README.md for details.
+//go:generate go run ./spirv-gen -grammar spirv-gen/spirv.core.grammar.json -out .
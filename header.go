@@ -21,7 +21,9 @@ const (
 )
 
 // Version number of the specification this package was written for.
-const SpecificationVersion = 99
+// This is SPIR-V 1.0. The major version lives in bits 16-23 and the
+// minor version in bits 8-15.
+const SpecificationVersion = 0x00010000
 
 // Header defines the header of a SPIR-V Module.
 type Header struct {
@@ -33,8 +35,8 @@ type Header struct {
 	// byte stream back to a word stream.
 	Magic uint32
 
-	// Version number -- The first public version will be 100.
-	// Uses 99 for pre-release.
+	// Version number. Version 1.0 is encoded as 0x00010000.
+	// The provisional specification used 99.
 	Version uint32
 
 	// Generator’s magic number. It is associated with the tool that generated
@@ -58,7 +60,9 @@ func (h *Header) Verify() error {
 		return ErrInvalidMagicValue
 	}
 
-	if h.Version != SpecificationVersion {
+	// Accept the version we were written for, any later version and
+	// versions for which a dialect has been registered.
+	if LookupDialect(h.Version) == nil {
 		return ErrInvalidVersion
 	}
 
@@ -8,7 +8,8 @@ type Id uint32
 
 // Verify returns an error if this is not a valid Id.
 //
-// TODO implement Id range checking.
+// Ids are checked against the module's Header.Bound by Module.Verify,
+// since their valid range is not known here.
 func (i Id) Verify() error {
 	return nil
 }
@@ -0,0 +1,455 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// InlineOptions defines which function calls are inlined by Module.Inline.
+type InlineOptions struct {
+	// EntryPoints inlines all calls in entry point functions, including
+	// the calls in the code inlined into them. By default, only calls to
+	// functions with the FunctionControlInline flag are inlined.
+	EntryPoints bool
+}
+
+// Inline replaces calls to functions with a copy of the function's body,
+// as selected by opts. Calls to functions with the FunctionControlDontInline
+// flag are never inlined, nor are calls to (mutually) recursive functions,
+// to functions which are only declared, or to functions holding
+// instructions which can not be copied.
+//
+// Functions whose blocks are malformed are left untouched. Calls to them
+// are not inlined, nor are the calls they make. Nothing is inlined if the
+// module holds an OpSwitch with a selector wider than 32 bits.
+//
+// The ids defined by the inlined code are replaced with fresh ones and
+// its function parameters with the call's arguments. Its variables are
+// moved to the first block of the caller. The block holding the call is
+// split in two, at the call: the inlined code branches to the second half
+// wherever it returns. The call's result is the OpPhi of all returned
+// values.
+//
+// Functions with a return before their last block are wrapped in a loop,
+// which runs once, so their returns become structured breaks. Such
+// functions are not inlined if they contain loops themselves.
+//
+// Functions which are no longer called are not removed.
+// Use EliminateDeadCode for that.
+//
+// Returns the number of calls which were inlined.
+func (m *Module) Inline(opts InlineOptions) int {
+	start, end := m.Code.functionRanges()
+	if len(start) == 0 || len(start) != m.Code.Count(opcodeFunction) {
+		return 0
+	}
+
+	if m.verifySwitchSelectors() != nil {
+		return 0 // The control flow graphs can not be built.
+	}
+
+	in := &inliner{
+		ids:   m.IdAllocator(),
+		funcs: make(map[Id]*inlineFunction),
+		void:  make(map[Id]bool),
+	}
+
+	for _, instr := range m.Code.Filter(opcodeTypeVoid) {
+		in.void[instr.(*OpTypeVoid).ResultId] = true
+	}
+
+	order := make([]*inlineFunction, len(start))
+
+	for i, s := range start {
+		fn := newInlineFunction(m.Code[s : end[i]+1])
+		in.funcs[fn.id] = fn
+		order[i] = fn
+	}
+
+	if opts.EntryPoints {
+		for _, instr := range m.Code.Filter(opcodeEntryPoint) {
+			if fn, ok := in.funcs[instr.(*OpEntryPoint).EntryPoint]; ok {
+				fn.all = true
+			}
+		}
+	}
+
+	for _, fn := range order {
+		fn.recursive = in.calls(fn, fn.id, make(map[Id]bool))
+	}
+
+	// Callees are processed before their callers, so the code
+	// inlined into a caller has had its own calls inlined already.
+	var n int
+	done := make(map[Id]bool)
+
+	var visit func(fn *inlineFunction)
+	visit = func(fn *inlineFunction) {
+		if done[fn.id] {
+			return
+		}
+
+		done[fn.id] = true
+
+		for _, id := range fn.callees {
+			if c, ok := in.funcs[id]; ok {
+				visit(c)
+			}
+		}
+
+		n += in.inlineCalls(fn)
+	}
+
+	for _, fn := range order {
+		visit(fn)
+	}
+
+	code := append(InstructionList(nil), m.Code[:start[0]]...)
+	for _, fn := range order {
+		code = append(code, fn.body...)
+	}
+
+	m.Code = code
+	return n
+}
+
+// inlineFunction holds a function definition and what the inliner
+// knows about it.
+type inlineFunction struct {
+	id      Id
+	control FunctionControl
+	body    InstructionList
+	callees []Id
+
+	defined   bool // It has blocks.
+	early     bool // It returns before its last block.
+	loops     bool // It contains a loop.
+	recursive bool // It calls itself, directly or indirectly.
+	all       bool // All calls in it should be inlined.
+	malformed bool // Its blocks are malformed.
+}
+
+// newInlineFunction inspects the given function.
+func newInlineFunction(body InstructionList) *inlineFunction {
+	decl := body[0].(*OpFunction)
+
+	fn := &inlineFunction{
+		id:      decl.ResultId,
+		control: decl.FunctionControl,
+		body:    append(InstructionList(nil), body...),
+	}
+
+	if _, err := NewCFG(body); err != nil {
+		fn.malformed = true
+	}
+
+	returns := 0
+	last := -1
+
+	for i, instr := range body {
+		switch v := instr.(type) {
+		case *OpLabel:
+			fn.defined = true
+		case *OpLoopMerge:
+			fn.loops = true
+		case *OpFunctionCall:
+			fn.callees = append(fn.callees, v.Function)
+		case *OpReturn, *OpReturnValue:
+			returns++
+		}
+
+		if isTerminator(instr.Opcode()) {
+			last = i
+		}
+	}
+
+	if returns > 1 {
+		fn.early = true
+	} else if returns == 1 {
+		switch body[last].(type) {
+		case *OpReturn, *OpReturnValue:
+		default:
+			fn.early = true
+		}
+	}
+
+	return fn
+}
+
+// inliner inlines function calls.
+type inliner struct {
+	ids   *IdAllocator
+	funcs map[Id]*inlineFunction
+	void  map[Id]bool // Ids of void types.
+}
+
+// calls returns true if fn calls the function with the given id,
+// directly or indirectly.
+func (in *inliner) calls(fn *inlineFunction, id Id, seen map[Id]bool) bool {
+	for _, c := range fn.callees {
+		if c == id {
+			return true
+		}
+
+		if seen[c] {
+			continue
+		}
+
+		seen[c] = true
+
+		if callee, ok := in.funcs[c]; ok && in.calls(callee, id, seen) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// inlinable returns true if calls from caller to the function with
+// the given id should be inlined.
+func (in *inliner) inlinable(caller *inlineFunction, id Id) bool {
+	fn, ok := in.funcs[id]
+	if !ok || !fn.defined || fn.recursive || (fn.early && fn.loops) {
+		return false
+	}
+
+	if fn.malformed || caller.malformed {
+		return false
+	}
+
+	if fn.control&FunctionControlDontInline != 0 {
+		return false
+	}
+
+	return caller.all || fn.control&FunctionControlInline != 0
+}
+
+// inlineCalls inlines the selected calls in fn.
+// Returns the number of calls which were inlined.
+func (in *inliner) inlineCalls(fn *inlineFunction) int {
+	var vars []Instruction
+	var n int
+
+	for i := 0; i < len(fn.body); i++ {
+		call, ok := fn.body[i].(*OpFunctionCall)
+		if !ok || !in.inlinable(fn, call.Function) {
+			continue
+		}
+
+		// The code following the call is scanned next, which
+		// includes the inlined code.
+		body, err := in.expand(fn.body, i, call, &vars)
+		if err != nil {
+			continue
+		}
+
+		fn.body = body
+		n++
+	}
+
+	if len(vars) == 0 {
+		return n
+	}
+
+	// Variables must be at the start of the first block.
+	at := fn.body.Index(opcodeLabel) + 1
+	for at < len(fn.body) && fn.body[at].Opcode() == opcodeVariable {
+		at++
+	}
+
+	body := append(InstructionList(nil), fn.body[:at]...)
+	body = append(body, vars...)
+	fn.body = append(body, fn.body[at:]...)
+	return n
+}
+
+// expand replaces the call at the given address in body with the body
+// of the called function. The callee's variables are added to vars.
+// Returns the new body, or an error if the callee can not be copied.
+// Nothing is changed in that case.
+func (in *inliner) expand(body InstructionList, addr int, call *OpFunctionCall, vars *[]Instruction) (InstructionList, error) {
+	callee := in.funcs[call.Function]
+
+	// The callee is copied first, so a failure leaves everything as is.
+	clones := make(InstructionList, len(callee.body))
+	for i, instr := range callee.body {
+		c, err := cloneInstruction(instr)
+		if err != nil {
+			return nil, err
+		}
+		clones[i] = c
+	}
+
+	label := addr
+	for body[label].Opcode() != opcodeLabel {
+		label--
+	}
+
+	term := addr
+	for !isTerminator(body[term].Opcode()) {
+		term++
+	}
+
+	block := body[label].(*OpLabel).ResultId
+	pre := body[label+1 : addr]
+	post := append(InstructionList(nil), body[addr+1:term+1]...)
+
+	out := append(InstructionList(nil), body[:label+1]...)
+
+	// A loop header must stay where it is, since it is the target of
+	// the loop's back edge. Its phis and merge instruction stay with it.
+	if len(post) > 1 {
+		if lm, ok := post[len(post)-2].(*OpLoopMerge); ok {
+			for len(pre) > 0 && pre[0].Opcode() == opcodePhi {
+				out = append(out, pre[0])
+				pre = pre[1:]
+			}
+
+			next := in.ids.Next()
+			out = append(out, lm, &OpBranch{TargetLabel: next}, &OpLabel{ResultId: next})
+			post = append(post[:len(post)-2], post[len(post)-1])
+		}
+	}
+
+	out = append(out, pre...)
+
+	ids := in.remap(callee, call.Argv)
+	remap := func(id Id) Id {
+		if v, ok := ids[id]; ok {
+			return v
+		}
+		return id
+	}
+
+	merge := in.ids.Next()
+	entry := remap(callee.body[callee.body.Index(opcodeLabel)].(*OpLabel).ResultId)
+
+	var header, cont Id
+	var code InstructionList
+	var phi []Id
+	var current Id
+
+	for i, instr := range callee.body {
+		switch v := instr.(type) {
+		case *OpFunction, *OpFunctionParameter, *OpFunctionEnd:
+			continue
+
+		case *OpVariable:
+			c := clones[i].(*OpVariable)
+			c.ResultId = remap(v.ResultId)
+			c.Initializer = 0
+			*vars = append(*vars, c)
+
+			if v.Initializer != 0 {
+				out = append(out, &OpStore{Pointer: c.ResultId, Object: v.Initializer})
+			}
+			continue
+
+		case *OpReturn:
+			code = append(code, &OpBranch{TargetLabel: merge})
+			continue
+
+		case *OpReturnValue:
+			phi = append(phi, remap(v.Value), current)
+			code = append(code, &OpBranch{TargetLabel: merge})
+			continue
+		}
+
+		c := clones[i]
+		RewriteOperands(c, remap)
+
+		if id, ok := ResultId(instr); ok {
+			SetResultId(c, remap(id))
+		}
+
+		if v, ok := c.(*OpLabel); ok {
+			current = v.ResultId
+		}
+
+		code = append(code, c)
+	}
+
+	if callee.early {
+		header, cont = in.ids.Next(), in.ids.Next()
+		out = append(out,
+			&OpBranch{TargetLabel: header},
+			&OpLabel{ResultId: header},
+			&OpLoopMerge{MergeBlock: merge, ContinueTarget: cont, LoopControl: LoopControlNone},
+		)
+	}
+
+	out = append(out, &OpBranch{TargetLabel: entry})
+	out = append(out, code...)
+
+	if callee.early {
+		out = append(out,
+			&OpLabel{ResultId: cont},
+			&OpBranch{TargetLabel: header},
+		)
+	}
+
+	out = append(out, &OpLabel{ResultId: merge})
+
+	// A function which never returns still has to define the result.
+	if rt := callee.body[0].(*OpFunction).ResultType; !in.void[rt] {
+		if len(phi) > 0 {
+			out = append(out, &OpPhi{ResultType: rt, ResultId: call.ResultId, Operands: phi})
+		} else {
+			out = append(out, &OpUndef{ResultType: rt, ResultId: call.ResultId})
+		}
+	}
+
+	out = append(out, post...)
+	out = append(out, body[term+1:]...)
+
+	// The block's successors are now reached from the merge block.
+	for _, instr := range out {
+		v, ok := instr.(*OpPhi)
+		if !ok {
+			continue
+		}
+
+		for i := 1; i < len(v.Operands); i += 2 {
+			if v.Operands[i] == block {
+				v.Operands[i] = merge
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// remap returns fresh ids for all results defined in fn.
+// Its parameters are mapped to the given arguments.
+func (in *inliner) remap(fn *inlineFunction, argv []Id) map[Id]Id {
+	ids := make(map[Id]Id)
+	param := 0
+
+	for _, instr := range fn.body[1:] {
+		id, ok := ResultId(instr)
+		if !ok {
+			continue
+		}
+
+		if instr.Opcode() == opcodeFunctionParameter {
+			if param < len(argv) {
+				ids[id] = argv[param]
+			}
+			param++
+			continue
+		}
+
+		ids[id] = in.ids.Next()
+	}
+
+	return ids
+}
+
+// cloneInstruction returns a deep copy of the given instruction.
+// Returns an error if it can not be encoded and decoded again.
+func cloneInstruction(instr Instruction) (Instruction, error) {
+	words, err := appendInstruction(nil, instr)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeInstruction(words)
+}
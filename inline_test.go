@@ -0,0 +1,401 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestModuleInline(t *testing.T) {
+	for i, st := range []struct {
+		opts    InlineOptions
+		in      string
+		want    string
+		inlined int
+	}{
+		{
+			// Only functions marked Inline are inlined by default.
+			in: `
+				     OpCapability Shader
+				     OpMemoryModel Logical GLSL450
+				     OpEntryPoint Fragment %10 "main"
+				     OpExecutionMode %10 OriginUpperLeft
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%3 = OpTypeInt 32 1
+				%4 = OpTypeFunction %3 %3 %3
+				%5 = OpConstant %3 1
+				%6 = OpConstant %3 2
+				%7 = OpTypePointer Function %3
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				%12 = OpFunctionCall %3 %20 %5 %6
+				%13 = OpIAdd %3 %12 %12
+				%14 = OpFunctionCall %3 %30 %5 %6
+				     OpReturn
+				     OpFunctionEnd
+				%20 = OpFunction %3 Inline %4
+				%21 = OpFunctionParameter %3
+				%22 = OpFunctionParameter %3
+				%23 = OpLabel
+				%24 = OpVariable %7 Function %5
+				%25 = OpIAdd %3 %21 %22
+				     OpStore %24 %25
+				%26 = OpLoad %3 %24
+				     OpReturnValue %26
+				     OpFunctionEnd
+				%30 = OpFunction %3 None %4
+				%31 = OpFunctionParameter %3
+				%32 = OpFunctionParameter %3
+				%33 = OpLabel
+				     OpReturnValue %31
+				     OpFunctionEnd
+			`,
+			want: `
+				     OpCapability Shader
+				     OpMemoryModel Logical GLSL450
+				     OpEntryPoint Fragment %10 "main"
+				     OpExecutionMode %10 OriginUpperLeft
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%3 = OpTypeInt 32 1
+				%4 = OpTypeFunction %3 %3 %3
+				%5 = OpConstant %3 1
+				%6 = OpConstant %3 2
+				%7 = OpTypePointer Function %3
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				%35 = OpVariable %7 Function
+				     OpStore %35 %5
+				     OpBranch %34
+				%34 = OpLabel
+				%36 = OpIAdd %3 %5 %6
+				     OpStore %35 %36
+				%37 = OpLoad %3 %35
+				     OpBranch %38
+				%38 = OpLabel
+				%12 = OpPhi %3 %37 %34
+				%13 = OpIAdd %3 %12 %12
+				%14 = OpFunctionCall %3 %30 %5 %6
+				     OpReturn
+				     OpFunctionEnd
+				%20 = OpFunction %3 Inline %4
+				%21 = OpFunctionParameter %3
+				%22 = OpFunctionParameter %3
+				%23 = OpLabel
+				%24 = OpVariable %7 Function %5
+				%25 = OpIAdd %3 %21 %22
+				     OpStore %24 %25
+				%26 = OpLoad %3 %24
+				     OpReturnValue %26
+				     OpFunctionEnd
+				%30 = OpFunction %3 None %4
+				%31 = OpFunctionParameter %3
+				%32 = OpFunctionParameter %3
+				%33 = OpLabel
+				     OpReturnValue %31
+				     OpFunctionEnd
+			`,
+			inlined: 1,
+		},
+		{
+			// A call in a loop header, to a function with an early return.
+			opts: InlineOptions{EntryPoints: true},
+			in: `
+				     OpCapability Shader
+				     OpMemoryModel Logical GLSL450
+				     OpEntryPoint Fragment %10 "main"
+				     OpExecutionMode %10 OriginUpperLeft
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%3 = OpTypeInt 32 1
+				%4 = OpTypeFunction %3 %3
+				%5 = OpConstant %3 1
+				%6 = OpConstant %3 2
+				%8 = OpTypeBool
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				     OpBranch %12
+				%12 = OpLabel
+				%13 = OpPhi %3 %5 %11 %14 %16
+				%14 = OpFunctionCall %3 %20 %13
+				%15 = OpSLessThan %8 %14 %6
+				     OpLoopMerge %17 %16 None
+				     OpBranchConditional %15 %16 %17
+				%16 = OpLabel
+				     OpBranch %12
+				%17 = OpLabel
+				%18 = OpFunctionCall %1 %30
+				     OpReturn
+				     OpFunctionEnd
+				%20 = OpFunction %3 None %4
+				%21 = OpFunctionParameter %3
+				%22 = OpLabel
+				%23 = OpSLessThan %8 %21 %6
+				     OpSelectionMerge %25 None
+				     OpBranchConditional %23 %24 %25
+				%24 = OpLabel
+				%26 = OpIAdd %3 %21 %5
+				     OpReturnValue %26
+				%25 = OpLabel
+				     OpReturnValue %21
+				     OpFunctionEnd
+				%30 = OpFunction %1 DontInline %2
+				%31 = OpLabel
+				     OpReturn
+				     OpFunctionEnd
+			`,
+			want: `
+				     OpCapability Shader
+				     OpMemoryModel Logical GLSL450
+				     OpEntryPoint Fragment %10 "main"
+				     OpExecutionMode %10 OriginUpperLeft
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%3 = OpTypeInt 32 1
+				%4 = OpTypeFunction %3 %3
+				%5 = OpConstant %3 1
+				%6 = OpConstant %3 2
+				%8 = OpTypeBool
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				     OpBranch %12
+				%12 = OpLabel
+				%13 = OpPhi %3 %5 %11 %14 %16
+				     OpLoopMerge %17 %16 None
+				     OpBranch %32
+				%32 = OpLabel
+				     OpBranch %39
+				%39 = OpLabel
+				     OpLoopMerge %38 %40 None
+				     OpBranch %33
+				%33 = OpLabel
+				%34 = OpSLessThan %8 %13 %6
+				     OpSelectionMerge %37 None
+				     OpBranchConditional %34 %35 %37
+				%35 = OpLabel
+				%36 = OpIAdd %3 %13 %5
+				     OpBranch %38
+				%37 = OpLabel
+				     OpBranch %38
+				%40 = OpLabel
+				     OpBranch %39
+				%38 = OpLabel
+				%14 = OpPhi %3 %36 %35 %13 %37
+				%15 = OpSLessThan %8 %14 %6
+				     OpBranchConditional %15 %16 %17
+				%16 = OpLabel
+				     OpBranch %12
+				%17 = OpLabel
+				%18 = OpFunctionCall %1 %30
+				     OpReturn
+				     OpFunctionEnd
+				%20 = OpFunction %3 None %4
+				%21 = OpFunctionParameter %3
+				%22 = OpLabel
+				%23 = OpSLessThan %8 %21 %6
+				     OpSelectionMerge %25 None
+				     OpBranchConditional %23 %24 %25
+				%24 = OpLabel
+				%26 = OpIAdd %3 %21 %5
+				     OpReturnValue %26
+				%25 = OpLabel
+				     OpReturnValue %21
+				     OpFunctionEnd
+				%30 = OpFunction %1 DontInline %2
+				%31 = OpLabel
+				     OpReturn
+				     OpFunctionEnd
+			`,
+			inlined: 1,
+		},
+		{
+			// Recursive functions are left alone.
+			opts: InlineOptions{EntryPoints: true},
+			in: `
+				     OpCapability Shader
+				     OpMemoryModel Logical GLSL450
+				     OpEntryPoint Fragment %10 "main"
+				     OpExecutionMode %10 OriginUpperLeft
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				%12 = OpFunctionCall %1 %20
+				     OpReturn
+				     OpFunctionEnd
+				%20 = OpFunction %1 Inline %2
+				%21 = OpLabel
+				%22 = OpFunctionCall %1 %20
+				     OpReturn
+				     OpFunctionEnd
+			`,
+			want: `
+				     OpCapability Shader
+				     OpMemoryModel Logical GLSL450
+				     OpEntryPoint Fragment %10 "main"
+				     OpExecutionMode %10 OriginUpperLeft
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				%12 = OpFunctionCall %1 %20
+				     OpReturn
+				     OpFunctionEnd
+				%20 = OpFunction %1 Inline %2
+				%21 = OpLabel
+				%22 = OpFunctionCall %1 %20
+				     OpReturn
+				     OpFunctionEnd
+			`,
+		},
+	} {
+		m, err := Assemble(strings.NewReader(st.in))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		want, err := Assemble(strings.NewReader(st.want))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		inlined := m.Inline(st.opts)
+		if inlined != st.inlined {
+			t.Fatalf("case %d: inlined count mismatch:\nHave: %d\nWant: %d", i, inlined, st.inlined)
+		}
+
+		if !reflect.DeepEqual(m.Code, want.Code) {
+			t.Fatalf("case %d: code mismatch:\nHave: %v\nWant: %v", i, m.Code, want.Code)
+		}
+
+		err = m.Verify()
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+	}
+}
+
+func TestModuleInlineUncopyable(t *testing.T) {
+	const src = `
+		     OpCapability Shader
+		     OpMemoryModel Logical GLSL450
+		     OpEntryPoint Fragment %10 "main"
+		%1 = OpTypeVoid
+		%2 = OpTypeFunction %1
+		%10 = OpFunction %1 None %2
+		%11 = OpLabel
+		%12 = OpFunctionCall %1 %20
+		%13 = OpFunctionCall %1 %20
+		     OpReturn
+		     OpFunctionEnd
+		%20 = OpFunction %1 Inline %2
+		%21 = OpLabel
+		     OpReturn
+		     OpFunctionEnd
+	`
+
+	// The callee holds an instruction which can be encoded, but not
+	// decoded again, so it can not be copied.
+	load := func() *Module {
+		m, err := Assemble(strings.NewReader(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		at := len(m.Code) - 2
+		code := append(InstructionList(nil), m.Code[:at]...)
+		code = append(code, &testReflectIds{ResultType: 1, ResultId: 22, Base: 21})
+		m.Code = append(code, m.Code[at:]...)
+		return m
+	}
+
+	m := load()
+	want := load()
+
+	inlined := m.Inline(InlineOptions{})
+	if inlined != 0 {
+		t.Fatalf("inlined count mismatch:\nHave: %d\nWant: %d", inlined, 0)
+	}
+
+	if !reflect.DeepEqual(m.Code, want.Code) {
+		t.Fatalf("code mismatch:\nHave: %v\nWant: %v", m.Code, want.Code)
+	}
+}
+
+func TestModuleInlineMalformed(t *testing.T) {
+	for i, src := range []string{
+		// The block holding the call has no terminator.
+		`
+			     OpCapability Shader
+			     OpMemoryModel Logical GLSL450
+			     OpEntryPoint Fragment %10 "main"
+			%1 = OpTypeVoid
+			%2 = OpTypeFunction %1
+			%10 = OpFunction %1 None %2
+			%11 = OpLabel
+			%12 = OpFunctionCall %1 %20
+			     OpFunctionEnd
+			%20 = OpFunction %1 Inline %2
+			%21 = OpLabel
+			     OpReturn
+			     OpFunctionEnd
+		`,
+		// The call is outside of a block.
+		`
+			     OpCapability Shader
+			     OpMemoryModel Logical GLSL450
+			     OpEntryPoint Fragment %10 "main"
+			%1 = OpTypeVoid
+			%2 = OpTypeFunction %1
+			%10 = OpFunction %1 None %2
+			%12 = OpFunctionCall %1 %20
+			%11 = OpLabel
+			     OpReturn
+			     OpFunctionEnd
+			%20 = OpFunction %1 Inline %2
+			%21 = OpLabel
+			     OpReturn
+			     OpFunctionEnd
+		`,
+		// The callee branches to an unknown label.
+		`
+			     OpCapability Shader
+			     OpMemoryModel Logical GLSL450
+			     OpEntryPoint Fragment %10 "main"
+			%1 = OpTypeVoid
+			%2 = OpTypeFunction %1
+			%10 = OpFunction %1 None %2
+			%11 = OpLabel
+			%12 = OpFunctionCall %1 %20
+			     OpReturn
+			     OpFunctionEnd
+			%20 = OpFunction %1 Inline %2
+			%21 = OpLabel
+			     OpBranch %22
+			     OpFunctionEnd
+		`,
+	} {
+		m, err := Assemble(strings.NewReader(src))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		want, err := Assemble(strings.NewReader(src))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		inlined := m.Inline(InlineOptions{EntryPoints: true})
+		if inlined != 0 {
+			t.Fatalf("case %d: inlined count mismatch:\nHave: %d\nWant: %d", i, inlined, 0)
+		}
+
+		if !reflect.DeepEqual(m.Code, want.Code) {
+			t.Fatalf("case %d: code mismatch:\nHave: %v\nWant: %v", i, m.Code, want.Code)
+		}
+	}
+}
@@ -0,0 +1,6411 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
+package spirv
+
+func (c *OpNop) EncodedLen() int { return 0 }
+
+func (c *OpNop) EncodeWords(out []uint32) []uint32 { return out }
+
+func (c *OpNop) DecodeWords(argv []uint32) error { return nil }
+
+func (c *OpUndef) EncodedLen() int { return 2 }
+
+func (c *OpUndef) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	return out
+}
+
+func (c *OpUndef) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+
+	return nil
+}
+
+func (c *OpSourceContinued) EncodedLen() int { return int(c.ContinuedSource.EncodedLen()) }
+
+func (c *OpSourceContinued) EncodeWords(out []uint32) []uint32 {
+	out = appendString(out, c.ContinuedSource)
+	return out
+}
+
+func (c *OpSourceContinued) DecodeWords(argv []uint32) error {
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ContinuedSource, _ = decodeStringWords(argv)
+
+	return nil
+}
+
+func (c *OpSource) EncodedLen() int {
+	n := 2
+	if c.File != 0 {
+		n++
+	}
+	if len(c.Source) > 0 {
+		n += int(c.Source.EncodedLen())
+	}
+	return n
+}
+
+func (c *OpSource) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.SourceLanguage), c.Version)
+	if c.File != 0 {
+		out = append(out, uint32(c.File))
+	}
+	if len(c.Source) > 0 {
+		out = appendString(out, c.Source)
+	}
+	return out
+}
+
+func (c *OpSource) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.SourceLanguage = SourceLanguage(argv[0])
+	c.Version = argv[1]
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.File = Id(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Source, _ = decodeStringWords(argv)
+	}
+
+	return nil
+}
+
+func (c *OpSourceExtension) EncodedLen() int { return int(c.Extension.EncodedLen()) }
+
+func (c *OpSourceExtension) EncodeWords(out []uint32) []uint32 {
+	out = appendString(out, c.Extension)
+	return out
+}
+
+func (c *OpSourceExtension) DecodeWords(argv []uint32) error {
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Extension, _ = decodeStringWords(argv)
+
+	return nil
+}
+
+func (c *OpName) EncodedLen() int { return 1 + int(c.Name.EncodedLen()) }
+
+func (c *OpName) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Target))
+	out = appendString(out, c.Name)
+	return out
+}
+
+func (c *OpName) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Target = Id(argv[0])
+	argv = argv[1:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Name, _ = decodeStringWords(argv)
+
+	return nil
+}
+
+func (c *OpMemberName) EncodedLen() int { return 2 + int(c.Name.EncodedLen()) }
+
+func (c *OpMemberName) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Type), c.Member)
+	out = appendString(out, c.Name)
+	return out
+}
+
+func (c *OpMemberName) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Type = Id(argv[0])
+	c.Member = argv[1]
+	argv = argv[2:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Name, _ = decodeStringWords(argv)
+
+	return nil
+}
+
+func (c *OpString) EncodedLen() int { return 1 + int(c.String.EncodedLen()) }
+
+func (c *OpString) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId))
+	out = appendString(out, c.String)
+	return out
+}
+
+func (c *OpString) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	argv = argv[1:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.String, _ = decodeStringWords(argv)
+
+	return nil
+}
+
+func (c *OpLine) EncodedLen() int { return 3 }
+
+func (c *OpLine) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.File), c.Line, c.Column)
+	return out
+}
+
+func (c *OpLine) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.File = Id(argv[0])
+	c.Line = argv[1]
+	c.Column = argv[2]
+
+	return nil
+}
+
+func (c *OpExtension) EncodedLen() int { return int(c.Name.EncodedLen()) }
+
+func (c *OpExtension) EncodeWords(out []uint32) []uint32 {
+	out = appendString(out, c.Name)
+	return out
+}
+
+func (c *OpExtension) DecodeWords(argv []uint32) error {
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Name, _ = decodeStringWords(argv)
+
+	return nil
+}
+
+func (c *OpExtInstImport) EncodedLen() int { return 1 + int(c.Name.EncodedLen()) }
+
+func (c *OpExtInstImport) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId))
+	out = appendString(out, c.Name)
+	return out
+}
+
+func (c *OpExtInstImport) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	argv = argv[1:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Name, _ = decodeStringWords(argv)
+
+	return nil
+}
+
+func (c *OpExtInst) EncodedLen() int { return 4 + len(c.Operands) }
+
+func (c *OpExtInst) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Set), c.Instruction)
+	out = appendIds(out, c.Operands)
+	return out
+}
+
+func (c *OpExtInst) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Set = Id(argv[2])
+	c.Instruction = argv[3]
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		c.Operands = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpMemoryModel) EncodedLen() int { return 2 }
+
+func (c *OpMemoryModel) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.AddressingModel), uint32(c.MemoryModel))
+	return out
+}
+
+func (c *OpMemoryModel) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.AddressingModel = AddressingModel(argv[0])
+	c.MemoryModel = MemoryModel(argv[1])
+
+	return nil
+}
+
+func (c *OpEntryPoint) EncodedLen() int { return 2 + int(c.Name.EncodedLen()) + len(c.Interface) }
+
+func (c *OpEntryPoint) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ExecutionModel), uint32(c.EntryPoint))
+	out = appendString(out, c.Name)
+	out = appendIds(out, c.Interface)
+	return out
+}
+
+func (c *OpEntryPoint) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ExecutionModel = ExecutionModel(argv[0])
+	c.EntryPoint = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Name, argv = decodeStringWords(argv)
+
+	if len(argv) > 0 {
+		c.Interface = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpExecutionMode) EncodedLen() int { return 2 + len(c.Argv) }
+
+func (c *OpExecutionMode) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.EntryPoint), uint32(c.Mode))
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpExecutionMode) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.EntryPoint = Id(argv[0])
+	c.Mode = ExecutionMode(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.Argv = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpCapability) EncodedLen() int { return 1 }
+
+func (c *OpCapability) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Capability))
+	return out
+}
+
+func (c *OpCapability) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Capability = Capability(argv[0])
+
+	return nil
+}
+
+func (c *OpTypeVoid) EncodedLen() int { return 1 }
+
+func (c *OpTypeVoid) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId))
+	return out
+}
+
+func (c *OpTypeVoid) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpTypeBool) EncodedLen() int { return 1 }
+
+func (c *OpTypeBool) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId))
+	return out
+}
+
+func (c *OpTypeBool) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpTypeInt) EncodedLen() int { return 3 }
+
+func (c *OpTypeInt) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId), c.Width, c.Signedness)
+	return out
+}
+
+func (c *OpTypeInt) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.Width = argv[1]
+	c.Signedness = argv[2]
+
+	return nil
+}
+
+func (c *OpTypeFloat) EncodedLen() int { return 2 }
+
+func (c *OpTypeFloat) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId), c.Width)
+	return out
+}
+
+func (c *OpTypeFloat) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.Width = argv[1]
+
+	return nil
+}
+
+func (c *OpTypeVector) EncodedLen() int { return 3 }
+
+func (c *OpTypeVector) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId), uint32(c.ComponentType), c.ComponentCount)
+	return out
+}
+
+func (c *OpTypeVector) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.ComponentType = Id(argv[1])
+	c.ComponentCount = argv[2]
+
+	return nil
+}
+
+func (c *OpTypeMatrix) EncodedLen() int { return 3 }
+
+func (c *OpTypeMatrix) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId), uint32(c.ColumnType), c.ColumnCount)
+	return out
+}
+
+func (c *OpTypeMatrix) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.ColumnType = Id(argv[1])
+	c.ColumnCount = argv[2]
+
+	return nil
+}
+
+func (c *OpTypeImage) EncodedLen() int {
+	n := 8
+	if c.AccessQualifier != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpTypeImage) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId), uint32(c.SampledType), uint32(c.Dim), c.Depth, c.Arrayed, c.MS, c.Sampled, uint32(c.ImageFormat))
+	if c.AccessQualifier != 0 {
+		out = append(out, uint32(c.AccessQualifier))
+	}
+	return out
+}
+
+func (c *OpTypeImage) DecodeWords(argv []uint32) error {
+	if len(argv) < 8 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.SampledType = Id(argv[1])
+	c.Dim = Dim(argv[2])
+	c.Depth = argv[3]
+	c.Arrayed = argv[4]
+	c.MS = argv[5]
+	c.Sampled = argv[6]
+	c.ImageFormat = ImageFormat(argv[7])
+	argv = argv[8:]
+
+	if len(argv) > 0 {
+		c.AccessQualifier = AccessQualifier(argv[0])
+	}
+
+	return nil
+}
+
+func (c *OpTypeSampler) EncodedLen() int { return 1 }
+
+func (c *OpTypeSampler) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId))
+	return out
+}
+
+func (c *OpTypeSampler) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpTypeSampledImage) EncodedLen() int { return 2 }
+
+func (c *OpTypeSampledImage) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId), uint32(c.ImageType))
+	return out
+}
+
+func (c *OpTypeSampledImage) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.ImageType = Id(argv[1])
+
+	return nil
+}
+
+func (c *OpTypeArray) EncodedLen() int { return 3 }
+
+func (c *OpTypeArray) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId), uint32(c.ElementType), uint32(c.Length))
+	return out
+}
+
+func (c *OpTypeArray) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.ElementType = Id(argv[1])
+	c.Length = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpTypeRuntimeArray) EncodedLen() int { return 2 }
+
+func (c *OpTypeRuntimeArray) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId), uint32(c.ElementType))
+	return out
+}
+
+func (c *OpTypeRuntimeArray) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.ElementType = Id(argv[1])
+
+	return nil
+}
+
+func (c *OpTypeStruct) EncodedLen() int { return 1 + len(c.Members) }
+
+func (c *OpTypeStruct) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId))
+	out = appendIds(out, c.Members)
+	return out
+}
+
+func (c *OpTypeStruct) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	argv = argv[1:]
+
+	if len(argv) > 0 {
+		c.Members = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpTypeOpaque) EncodedLen() int { return 1 + int(c.Name.EncodedLen()) }
+
+func (c *OpTypeOpaque) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId))
+	out = appendString(out, c.Name)
+	return out
+}
+
+func (c *OpTypeOpaque) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	argv = argv[1:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Name, _ = decodeStringWords(argv)
+
+	return nil
+}
+
+func (c *OpTypePointer) EncodedLen() int { return 3 }
+
+func (c *OpTypePointer) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId), uint32(c.StorageClass), uint32(c.Type))
+	return out
+}
+
+func (c *OpTypePointer) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.StorageClass = StorageClass(argv[1])
+	c.Type = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpTypeFunction) EncodedLen() int { return 2 + len(c.Parameters) }
+
+func (c *OpTypeFunction) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId), uint32(c.ReturnType))
+	out = appendIds(out, c.Parameters)
+	return out
+}
+
+func (c *OpTypeFunction) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.ReturnType = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.Parameters = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpTypeEvent) EncodedLen() int { return 1 }
+
+func (c *OpTypeEvent) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId))
+	return out
+}
+
+func (c *OpTypeEvent) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpTypeDeviceEvent) EncodedLen() int { return 1 }
+
+func (c *OpTypeDeviceEvent) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId))
+	return out
+}
+
+func (c *OpTypeDeviceEvent) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpTypeReserveId) EncodedLen() int { return 1 }
+
+func (c *OpTypeReserveId) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId))
+	return out
+}
+
+func (c *OpTypeReserveId) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpTypeQueue) EncodedLen() int { return 1 }
+
+func (c *OpTypeQueue) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId))
+	return out
+}
+
+func (c *OpTypeQueue) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpTypePipe) EncodedLen() int { return 2 }
+
+func (c *OpTypePipe) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId), uint32(c.Qualifier))
+	return out
+}
+
+func (c *OpTypePipe) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.Qualifier = AccessQualifier(argv[1])
+
+	return nil
+}
+
+func (c *OpTypeForwardPointer) EncodedLen() int { return 2 }
+
+func (c *OpTypeForwardPointer) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.PointerType), uint32(c.StorageClass))
+	return out
+}
+
+func (c *OpTypeForwardPointer) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.PointerType = Id(argv[0])
+	c.StorageClass = StorageClass(argv[1])
+
+	return nil
+}
+
+func (c *OpConstantTrue) EncodedLen() int { return 2 }
+
+func (c *OpConstantTrue) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	return out
+}
+
+func (c *OpConstantTrue) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+
+	return nil
+}
+
+func (c *OpConstantFalse) EncodedLen() int { return 2 }
+
+func (c *OpConstantFalse) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	return out
+}
+
+func (c *OpConstantFalse) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+
+	return nil
+}
+
+func (c *OpConstant) EncodedLen() int { return 2 + len(c.Value) }
+
+func (c *OpConstant) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	out = append(out, c.Value...)
+	return out
+}
+
+func (c *OpConstant) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.Value = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpConstantComposite) EncodedLen() int { return 2 + len(c.Constituents) }
+
+func (c *OpConstantComposite) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	out = appendIds(out, c.Constituents)
+	return out
+}
+
+func (c *OpConstantComposite) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.Constituents = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpConstantSampler) EncodedLen() int { return 5 }
+
+func (c *OpConstantSampler) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.AddressingMode), c.Param, uint32(c.FilterMode))
+	return out
+}
+
+func (c *OpConstantSampler) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.AddressingMode = SamplerAddressingMode(argv[2])
+	c.Param = argv[3]
+	c.FilterMode = SamplerFilterMode(argv[4])
+
+	return nil
+}
+
+func (c *OpConstantNull) EncodedLen() int { return 2 }
+
+func (c *OpConstantNull) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	return out
+}
+
+func (c *OpConstantNull) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+
+	return nil
+}
+
+func (c *OpSpecConstantTrue) EncodedLen() int { return 2 }
+
+func (c *OpSpecConstantTrue) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	return out
+}
+
+func (c *OpSpecConstantTrue) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+
+	return nil
+}
+
+func (c *OpSpecConstantFalse) EncodedLen() int { return 2 }
+
+func (c *OpSpecConstantFalse) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	return out
+}
+
+func (c *OpSpecConstantFalse) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+
+	return nil
+}
+
+func (c *OpSpecConstant) EncodedLen() int { return 2 + len(c.Value) }
+
+func (c *OpSpecConstant) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	out = append(out, c.Value...)
+	return out
+}
+
+func (c *OpSpecConstant) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.Value = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpSpecConstantComposite) EncodedLen() int { return 2 + len(c.Constituents) }
+
+func (c *OpSpecConstantComposite) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	out = appendIds(out, c.Constituents)
+	return out
+}
+
+func (c *OpSpecConstantComposite) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.Constituents = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpSpecConstantOp) EncodedLen() int { return 3 + len(c.Operands) }
+
+func (c *OpSpecConstantOp) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), c.Operation)
+	out = appendIds(out, c.Operands)
+	return out
+}
+
+func (c *OpSpecConstantOp) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operation = argv[2]
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		c.Operands = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpFunction) EncodedLen() int { return 4 }
+
+func (c *OpFunction) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.FunctionControl), uint32(c.FunctionType))
+	return out
+}
+
+func (c *OpFunction) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.FunctionControl = FunctionControl(argv[2])
+	c.FunctionType = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFunctionParameter) EncodedLen() int { return 2 }
+
+func (c *OpFunctionParameter) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	return out
+}
+
+func (c *OpFunctionParameter) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+
+	return nil
+}
+
+func (c *OpFunctionEnd) EncodedLen() int { return 0 }
+
+func (c *OpFunctionEnd) EncodeWords(out []uint32) []uint32 { return out }
+
+func (c *OpFunctionEnd) DecodeWords(argv []uint32) error { return nil }
+
+func (c *OpFunctionCall) EncodedLen() int { return 3 + len(c.Argv) }
+
+func (c *OpFunctionCall) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Function))
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpFunctionCall) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Function = Id(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpVariable) EncodedLen() int {
+	n := 3
+	if c.Initializer != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpVariable) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.StorageClass))
+	if c.Initializer != 0 {
+		out = append(out, uint32(c.Initializer))
+	}
+	return out
+}
+
+func (c *OpVariable) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.StorageClass = StorageClass(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		c.Initializer = Id(argv[0])
+	}
+
+	return nil
+}
+
+func (c *OpImageTexelPointer) EncodedLen() int { return 5 }
+
+func (c *OpImageTexelPointer) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Image), uint32(c.Coordinate), uint32(c.Sample))
+	return out
+}
+
+func (c *OpImageTexelPointer) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Sample = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpLoad) EncodedLen() int {
+	n := 3 + len(c.Argv)
+	if c.MemoryAccess != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpLoad) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer))
+	if c.MemoryAccess != 0 {
+		out = append(out, uint32(c.MemoryAccess))
+	}
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpLoad) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		c.MemoryAccess = MemoryAccess(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpStore) EncodedLen() int {
+	n := 2 + len(c.Argv)
+	if c.MemoryAccess != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpStore) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Pointer), uint32(c.Object))
+	if c.MemoryAccess != 0 {
+		out = append(out, uint32(c.MemoryAccess))
+	}
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpStore) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pointer = Id(argv[0])
+	c.Object = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.MemoryAccess = MemoryAccess(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpCopyMemory) EncodedLen() int {
+	n := 2 + len(c.Argv)
+	if c.MemoryAccess != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpCopyMemory) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Target), uint32(c.Source))
+	if c.MemoryAccess != 0 {
+		out = append(out, uint32(c.MemoryAccess))
+	}
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpCopyMemory) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Target = Id(argv[0])
+	c.Source = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.MemoryAccess = MemoryAccess(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpCopyMemorySized) EncodedLen() int {
+	n := 3 + len(c.Argv)
+	if c.MemoryAccess != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpCopyMemorySized) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Target), uint32(c.Source), uint32(c.Size))
+	if c.MemoryAccess != 0 {
+		out = append(out, uint32(c.MemoryAccess))
+	}
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpCopyMemorySized) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Target = Id(argv[0])
+	c.Source = Id(argv[1])
+	c.Size = Id(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		c.MemoryAccess = MemoryAccess(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpAccessChain) EncodedLen() int { return 3 + len(c.Indices) }
+
+func (c *OpAccessChain) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Base))
+	out = appendIds(out, c.Indices)
+	return out
+}
+
+func (c *OpAccessChain) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		c.Indices = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpInBoundsAccessChain) EncodedLen() int { return 3 + len(c.Indices) }
+
+func (c *OpInBoundsAccessChain) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Base))
+	out = appendIds(out, c.Indices)
+	return out
+}
+
+func (c *OpInBoundsAccessChain) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		c.Indices = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpPtrAccessChain) EncodedLen() int { return 4 + len(c.Indices) }
+
+func (c *OpPtrAccessChain) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Base), uint32(c.Element))
+	out = appendIds(out, c.Indices)
+	return out
+}
+
+func (c *OpPtrAccessChain) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Element = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		c.Indices = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpArrayLength) EncodedLen() int { return 4 }
+
+func (c *OpArrayLength) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Structure), c.Member)
+	return out
+}
+
+func (c *OpArrayLength) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Structure = Id(argv[2])
+	c.Member = argv[3]
+
+	return nil
+}
+
+func (c *OpGenericPtrMemSemantics) EncodedLen() int { return 3 }
+
+func (c *OpGenericPtrMemSemantics) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer))
+	return out
+}
+
+func (c *OpGenericPtrMemSemantics) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpInBoundsPtrAccessChain) EncodedLen() int { return 4 + len(c.Indices) }
+
+func (c *OpInBoundsPtrAccessChain) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Base), uint32(c.Element))
+	out = appendIds(out, c.Indices)
+	return out
+}
+
+func (c *OpInBoundsPtrAccessChain) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Element = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		c.Indices = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpDecorate) EncodedLen() int { return 2 + len(c.Argv) }
+
+func (c *OpDecorate) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Target), uint32(c.Decoration))
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpDecorate) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Target = Id(argv[0])
+	c.Decoration = Decoration(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.Argv = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpMemberDecorate) EncodedLen() int { return 3 + len(c.Argv) }
+
+func (c *OpMemberDecorate) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.StructType), c.Member, uint32(c.Decoration))
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpMemberDecorate) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.StructType = Id(argv[0])
+	c.Member = argv[1]
+	c.Decoration = Decoration(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		c.Argv = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpDecorationGroup) EncodedLen() int { return 1 }
+
+func (c *OpDecorationGroup) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId))
+	return out
+}
+
+func (c *OpDecorationGroup) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpGroupDecorate) EncodedLen() int { return 1 + len(c.Targets) }
+
+func (c *OpGroupDecorate) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Group))
+	out = appendIds(out, c.Targets)
+	return out
+}
+
+func (c *OpGroupDecorate) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Group = Id(argv[0])
+	argv = argv[1:]
+
+	if len(argv) > 0 {
+		c.Targets = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpGroupMemberDecorate) EncodedLen() int { return 1 + len(c.Targets) }
+
+func (c *OpGroupMemberDecorate) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Group))
+	out = append(out, c.Targets...)
+	return out
+}
+
+func (c *OpGroupMemberDecorate) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Group = Id(argv[0])
+	argv = argv[1:]
+
+	if len(argv) > 0 {
+		c.Targets = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpVectorExtractDynamic) EncodedLen() int { return 4 }
+
+func (c *OpVectorExtractDynamic) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Vector), uint32(c.Index))
+	return out
+}
+
+func (c *OpVectorExtractDynamic) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector = Id(argv[2])
+	c.Index = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpVectorInsertDynamic) EncodedLen() int { return 5 }
+
+func (c *OpVectorInsertDynamic) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Vector), uint32(c.Component), uint32(c.Index))
+	return out
+}
+
+func (c *OpVectorInsertDynamic) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector = Id(argv[2])
+	c.Component = Id(argv[3])
+	c.Index = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpVectorShuffle) EncodedLen() int { return 4 + len(c.Components) }
+
+func (c *OpVectorShuffle) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Vector1), uint32(c.Vector2))
+	out = append(out, c.Components...)
+	return out
+}
+
+func (c *OpVectorShuffle) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector1 = Id(argv[2])
+	c.Vector2 = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		c.Components = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpCompositeConstruct) EncodedLen() int { return 2 + len(c.Constituents) }
+
+func (c *OpCompositeConstruct) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	out = appendIds(out, c.Constituents)
+	return out
+}
+
+func (c *OpCompositeConstruct) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.Constituents = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpCompositeExtract) EncodedLen() int { return 3 + len(c.Indices) }
+
+func (c *OpCompositeExtract) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Composite))
+	out = append(out, c.Indices...)
+	return out
+}
+
+func (c *OpCompositeExtract) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Composite = Id(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		c.Indices = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpCompositeInsert) EncodedLen() int { return 4 + len(c.Indices) }
+
+func (c *OpCompositeInsert) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Object), uint32(c.Composite))
+	out = append(out, c.Indices...)
+	return out
+}
+
+func (c *OpCompositeInsert) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Object = Id(argv[2])
+	c.Composite = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		c.Indices = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpCopyObject) EncodedLen() int { return 3 }
+
+func (c *OpCopyObject) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand))
+	return out
+}
+
+func (c *OpCopyObject) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpTranspose) EncodedLen() int { return 3 }
+
+func (c *OpTranspose) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Matrix))
+	return out
+}
+
+func (c *OpTranspose) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Matrix = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpSampledImage) EncodedLen() int { return 4 }
+
+func (c *OpSampledImage) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Image), uint32(c.Sampler))
+	return out
+}
+
+func (c *OpSampledImage) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.Sampler = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpImageSampleImplicitLod) EncodedLen() int {
+	n := 4 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSampleImplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSampleImplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSampleExplicitLod) EncodedLen() int { return 5 + len(c.Argv) }
+
+func (c *OpImageSampleExplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.ImageOperands))
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSampleExplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.ImageOperands = ImageOperands(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSampleDrefImplicitLod) EncodedLen() int {
+	n := 5 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSampleDrefImplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.Dref))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSampleDrefImplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSampleDrefExplicitLod) EncodedLen() int { return 6 + len(c.Argv) }
+
+func (c *OpImageSampleDrefExplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.Dref), uint32(c.ImageOperands))
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSampleDrefExplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	c.ImageOperands = ImageOperands(argv[5])
+	argv = argv[6:]
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSampleProjImplicitLod) EncodedLen() int {
+	n := 4 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSampleProjImplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSampleProjImplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSampleProjExplicitLod) EncodedLen() int { return 5 + len(c.Argv) }
+
+func (c *OpImageSampleProjExplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.ImageOperands))
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSampleProjExplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.ImageOperands = ImageOperands(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSampleProjDrefImplicitLod) EncodedLen() int {
+	n := 5 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSampleProjDrefImplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.Dref))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSampleProjDrefImplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSampleProjDrefExplicitLod) EncodedLen() int { return 6 + len(c.Argv) }
+
+func (c *OpImageSampleProjDrefExplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.Dref), uint32(c.ImageOperands))
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSampleProjDrefExplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	c.ImageOperands = ImageOperands(argv[5])
+	argv = argv[6:]
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageFetch) EncodedLen() int {
+	n := 4 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageFetch) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Image), uint32(c.Coordinate))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageFetch) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageGather) EncodedLen() int {
+	n := 5 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageGather) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.Component))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageGather) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Component = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageDrefGather) EncodedLen() int {
+	n := 5 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageDrefGather) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.Dref))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageDrefGather) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageRead) EncodedLen() int {
+	n := 4 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageRead) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Image), uint32(c.Coordinate))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageRead) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageWrite) EncodedLen() int {
+	n := 3 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageWrite) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Image), uint32(c.Coordinate), uint32(c.Texel))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageWrite) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Image = Id(argv[0])
+	c.Coordinate = Id(argv[1])
+	c.Texel = Id(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImage) EncodedLen() int { return 3 }
+
+func (c *OpImage) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage))
+	return out
+}
+
+func (c *OpImage) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpImageQueryFormat) EncodedLen() int { return 3 }
+
+func (c *OpImageQueryFormat) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Image))
+	return out
+}
+
+func (c *OpImageQueryFormat) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpImageQueryOrder) EncodedLen() int { return 3 }
+
+func (c *OpImageQueryOrder) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Image))
+	return out
+}
+
+func (c *OpImageQueryOrder) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpImageQuerySizeLod) EncodedLen() int { return 4 }
+
+func (c *OpImageQuerySizeLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Image), uint32(c.LevelOfDetail))
+	return out
+}
+
+func (c *OpImageQuerySizeLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.LevelOfDetail = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpImageQuerySize) EncodedLen() int { return 3 }
+
+func (c *OpImageQuerySize) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Image))
+	return out
+}
+
+func (c *OpImageQuerySize) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpImageQueryLod) EncodedLen() int { return 4 }
+
+func (c *OpImageQueryLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate))
+	return out
+}
+
+func (c *OpImageQueryLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpImageQueryLevels) EncodedLen() int { return 3 }
+
+func (c *OpImageQueryLevels) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Image))
+	return out
+}
+
+func (c *OpImageQueryLevels) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpImageQuerySamples) EncodedLen() int { return 3 }
+
+func (c *OpImageQuerySamples) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Image))
+	return out
+}
+
+func (c *OpImageQuerySamples) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpConvertFToU) EncodedLen() int { return 3 }
+
+func (c *OpConvertFToU) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.FloatValue))
+	return out
+}
+
+func (c *OpConvertFToU) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.FloatValue = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpConvertFToS) EncodedLen() int { return 3 }
+
+func (c *OpConvertFToS) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.FloatValue))
+	return out
+}
+
+func (c *OpConvertFToS) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.FloatValue = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpConvertSToF) EncodedLen() int { return 3 }
+
+func (c *OpConvertSToF) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SignedValue))
+	return out
+}
+
+func (c *OpConvertSToF) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SignedValue = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpConvertUToF) EncodedLen() int { return 3 }
+
+func (c *OpConvertUToF) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.UnsignedValue))
+	return out
+}
+
+func (c *OpConvertUToF) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.UnsignedValue = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpUConvert) EncodedLen() int { return 3 }
+
+func (c *OpUConvert) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.UnsignedValue))
+	return out
+}
+
+func (c *OpUConvert) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.UnsignedValue = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpSConvert) EncodedLen() int { return 3 }
+
+func (c *OpSConvert) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SignedValue))
+	return out
+}
+
+func (c *OpSConvert) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SignedValue = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpFConvert) EncodedLen() int { return 3 }
+
+func (c *OpFConvert) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.FloatValue))
+	return out
+}
+
+func (c *OpFConvert) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.FloatValue = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpQuantizeToF16) EncodedLen() int { return 3 }
+
+func (c *OpQuantizeToF16) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Value))
+	return out
+}
+
+func (c *OpQuantizeToF16) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Value = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpConvertPtrToU) EncodedLen() int { return 3 }
+
+func (c *OpConvertPtrToU) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer))
+	return out
+}
+
+func (c *OpConvertPtrToU) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpSatConvertSToU) EncodedLen() int { return 3 }
+
+func (c *OpSatConvertSToU) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SignedValue))
+	return out
+}
+
+func (c *OpSatConvertSToU) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SignedValue = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpSatConvertUToS) EncodedLen() int { return 3 }
+
+func (c *OpSatConvertUToS) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.UnsignedValue))
+	return out
+}
+
+func (c *OpSatConvertUToS) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.UnsignedValue = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpConvertUToPtr) EncodedLen() int { return 3 }
+
+func (c *OpConvertUToPtr) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.IntegerValue))
+	return out
+}
+
+func (c *OpConvertUToPtr) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.IntegerValue = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpPtrCastToGeneric) EncodedLen() int { return 3 }
+
+func (c *OpPtrCastToGeneric) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer))
+	return out
+}
+
+func (c *OpPtrCastToGeneric) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpGenericCastToPtr) EncodedLen() int { return 3 }
+
+func (c *OpGenericCastToPtr) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer))
+	return out
+}
+
+func (c *OpGenericCastToPtr) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpGenericCastToPtrExplicit) EncodedLen() int { return 4 }
+
+func (c *OpGenericCastToPtrExplicit) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Storage))
+	return out
+}
+
+func (c *OpGenericCastToPtrExplicit) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Storage = StorageClass(argv[3])
+
+	return nil
+}
+
+func (c *OpBitcast) EncodedLen() int { return 3 }
+
+func (c *OpBitcast) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand))
+	return out
+}
+
+func (c *OpBitcast) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpSNegate) EncodedLen() int { return 3 }
+
+func (c *OpSNegate) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand))
+	return out
+}
+
+func (c *OpSNegate) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpFNegate) EncodedLen() int { return 3 }
+
+func (c *OpFNegate) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand))
+	return out
+}
+
+func (c *OpFNegate) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpIAdd) EncodedLen() int { return 4 }
+
+func (c *OpIAdd) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpIAdd) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFAdd) EncodedLen() int { return 4 }
+
+func (c *OpFAdd) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFAdd) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpISub) EncodedLen() int { return 4 }
+
+func (c *OpISub) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpISub) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFSub) EncodedLen() int { return 4 }
+
+func (c *OpFSub) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFSub) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpIMul) EncodedLen() int { return 4 }
+
+func (c *OpIMul) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpIMul) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFMul) EncodedLen() int { return 4 }
+
+func (c *OpFMul) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFMul) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpUDiv) EncodedLen() int { return 4 }
+
+func (c *OpUDiv) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpUDiv) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpSDiv) EncodedLen() int { return 4 }
+
+func (c *OpSDiv) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpSDiv) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFDiv) EncodedLen() int { return 4 }
+
+func (c *OpFDiv) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFDiv) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpUMod) EncodedLen() int { return 4 }
+
+func (c *OpUMod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpUMod) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpSRem) EncodedLen() int { return 4 }
+
+func (c *OpSRem) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpSRem) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpSMod) EncodedLen() int { return 4 }
+
+func (c *OpSMod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpSMod) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFRem) EncodedLen() int { return 4 }
+
+func (c *OpFRem) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFRem) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFMod) EncodedLen() int { return 4 }
+
+func (c *OpFMod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFMod) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpVectorTimesScalar) EncodedLen() int { return 4 }
+
+func (c *OpVectorTimesScalar) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Vector), uint32(c.Scalar))
+	return out
+}
+
+func (c *OpVectorTimesScalar) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector = Id(argv[2])
+	c.Scalar = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpMatrixTimesScalar) EncodedLen() int { return 4 }
+
+func (c *OpMatrixTimesScalar) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Matrix), uint32(c.Scalar))
+	return out
+}
+
+func (c *OpMatrixTimesScalar) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Matrix = Id(argv[2])
+	c.Scalar = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpVectorTimesMatrix) EncodedLen() int { return 4 }
+
+func (c *OpVectorTimesMatrix) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Vector), uint32(c.Matrix))
+	return out
+}
+
+func (c *OpVectorTimesMatrix) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector = Id(argv[2])
+	c.Matrix = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpMatrixTimesVector) EncodedLen() int { return 4 }
+
+func (c *OpMatrixTimesVector) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Matrix), uint32(c.Vector))
+	return out
+}
+
+func (c *OpMatrixTimesVector) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Matrix = Id(argv[2])
+	c.Vector = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpMatrixTimesMatrix) EncodedLen() int { return 4 }
+
+func (c *OpMatrixTimesMatrix) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.LeftMatrix), uint32(c.RightMatrix))
+	return out
+}
+
+func (c *OpMatrixTimesMatrix) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.LeftMatrix = Id(argv[2])
+	c.RightMatrix = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpOuterProduct) EncodedLen() int { return 4 }
+
+func (c *OpOuterProduct) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Vector1), uint32(c.Vector2))
+	return out
+}
+
+func (c *OpOuterProduct) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector1 = Id(argv[2])
+	c.Vector2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpDot) EncodedLen() int { return 4 }
+
+func (c *OpDot) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Vector1), uint32(c.Vector2))
+	return out
+}
+
+func (c *OpDot) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector1 = Id(argv[2])
+	c.Vector2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpIAddCarry) EncodedLen() int { return 4 }
+
+func (c *OpIAddCarry) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpIAddCarry) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpISubBorrow) EncodedLen() int { return 4 }
+
+func (c *OpISubBorrow) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpISubBorrow) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpUMulExtended) EncodedLen() int { return 4 }
+
+func (c *OpUMulExtended) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpUMulExtended) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpSMulExtended) EncodedLen() int { return 4 }
+
+func (c *OpSMulExtended) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpSMulExtended) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpAny) EncodedLen() int { return 3 }
+
+func (c *OpAny) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Vector))
+	return out
+}
+
+func (c *OpAny) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpAll) EncodedLen() int { return 3 }
+
+func (c *OpAll) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Vector))
+	return out
+}
+
+func (c *OpAll) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpIsNan) EncodedLen() int { return 3 }
+
+func (c *OpIsNan) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.X))
+	return out
+}
+
+func (c *OpIsNan) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpIsInf) EncodedLen() int { return 3 }
+
+func (c *OpIsInf) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.X))
+	return out
+}
+
+func (c *OpIsInf) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpIsFinite) EncodedLen() int { return 3 }
+
+func (c *OpIsFinite) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.X))
+	return out
+}
+
+func (c *OpIsFinite) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpIsNormal) EncodedLen() int { return 3 }
+
+func (c *OpIsNormal) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.X))
+	return out
+}
+
+func (c *OpIsNormal) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpSignBitSet) EncodedLen() int { return 3 }
+
+func (c *OpSignBitSet) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.X))
+	return out
+}
+
+func (c *OpSignBitSet) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpLessOrGreater) EncodedLen() int { return 4 }
+
+func (c *OpLessOrGreater) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.X), uint32(c.Y))
+	return out
+}
+
+func (c *OpLessOrGreater) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+	c.Y = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpOrdered) EncodedLen() int { return 4 }
+
+func (c *OpOrdered) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.X), uint32(c.Y))
+	return out
+}
+
+func (c *OpOrdered) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+	c.Y = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpUnordered) EncodedLen() int { return 4 }
+
+func (c *OpUnordered) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.X), uint32(c.Y))
+	return out
+}
+
+func (c *OpUnordered) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+	c.Y = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpLogicalEqual) EncodedLen() int { return 4 }
+
+func (c *OpLogicalEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpLogicalEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpLogicalNotEqual) EncodedLen() int { return 4 }
+
+func (c *OpLogicalNotEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpLogicalNotEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpLogicalOr) EncodedLen() int { return 4 }
+
+func (c *OpLogicalOr) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpLogicalOr) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpLogicalAnd) EncodedLen() int { return 4 }
+
+func (c *OpLogicalAnd) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpLogicalAnd) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpLogicalNot) EncodedLen() int { return 3 }
+
+func (c *OpLogicalNot) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand))
+	return out
+}
+
+func (c *OpLogicalNot) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpSelect) EncodedLen() int { return 5 }
+
+func (c *OpSelect) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Condition), uint32(c.Object1), uint32(c.Object2))
+	return out
+}
+
+func (c *OpSelect) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Condition = Id(argv[2])
+	c.Object1 = Id(argv[3])
+	c.Object2 = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpIEqual) EncodedLen() int { return 4 }
+
+func (c *OpIEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpIEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpINotEqual) EncodedLen() int { return 4 }
+
+func (c *OpINotEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpINotEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpUGreaterThan) EncodedLen() int { return 4 }
+
+func (c *OpUGreaterThan) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpUGreaterThan) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpSGreaterThan) EncodedLen() int { return 4 }
+
+func (c *OpSGreaterThan) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpSGreaterThan) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpUGreaterThanEqual) EncodedLen() int { return 4 }
+
+func (c *OpUGreaterThanEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpUGreaterThanEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpSGreaterThanEqual) EncodedLen() int { return 4 }
+
+func (c *OpSGreaterThanEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpSGreaterThanEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpULessThan) EncodedLen() int { return 4 }
+
+func (c *OpULessThan) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpULessThan) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpSLessThan) EncodedLen() int { return 4 }
+
+func (c *OpSLessThan) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpSLessThan) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpULessThanEqual) EncodedLen() int { return 4 }
+
+func (c *OpULessThanEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpULessThanEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpSLessThanEqual) EncodedLen() int { return 4 }
+
+func (c *OpSLessThanEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpSLessThanEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFOrdEqual) EncodedLen() int { return 4 }
+
+func (c *OpFOrdEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFOrdEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFUnordEqual) EncodedLen() int { return 4 }
+
+func (c *OpFUnordEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFUnordEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFOrdNotEqual) EncodedLen() int { return 4 }
+
+func (c *OpFOrdNotEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFOrdNotEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFUnordNotEqual) EncodedLen() int { return 4 }
+
+func (c *OpFUnordNotEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFUnordNotEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFOrdLessThan) EncodedLen() int { return 4 }
+
+func (c *OpFOrdLessThan) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFOrdLessThan) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFUnordLessThan) EncodedLen() int { return 4 }
+
+func (c *OpFUnordLessThan) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFUnordLessThan) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFOrdGreaterThan) EncodedLen() int { return 4 }
+
+func (c *OpFOrdGreaterThan) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFOrdGreaterThan) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFUnordGreaterThan) EncodedLen() int { return 4 }
+
+func (c *OpFUnordGreaterThan) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFUnordGreaterThan) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFOrdLessThanEqual) EncodedLen() int { return 4 }
+
+func (c *OpFOrdLessThanEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFOrdLessThanEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFUnordLessThanEqual) EncodedLen() int { return 4 }
+
+func (c *OpFUnordLessThanEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFUnordLessThanEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFOrdGreaterThanEqual) EncodedLen() int { return 4 }
+
+func (c *OpFOrdGreaterThanEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFOrdGreaterThanEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpFUnordGreaterThanEqual) EncodedLen() int { return 4 }
+
+func (c *OpFUnordGreaterThanEqual) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpFUnordGreaterThanEqual) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpShiftRightLogical) EncodedLen() int { return 4 }
+
+func (c *OpShiftRightLogical) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Base), uint32(c.Shift))
+	return out
+}
+
+func (c *OpShiftRightLogical) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Shift = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpShiftRightArithmetic) EncodedLen() int { return 4 }
+
+func (c *OpShiftRightArithmetic) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Base), uint32(c.Shift))
+	return out
+}
+
+func (c *OpShiftRightArithmetic) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Shift = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpShiftLeftLogical) EncodedLen() int { return 4 }
+
+func (c *OpShiftLeftLogical) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Base), uint32(c.Shift))
+	return out
+}
+
+func (c *OpShiftLeftLogical) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Shift = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpBitwiseOr) EncodedLen() int { return 4 }
+
+func (c *OpBitwiseOr) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpBitwiseOr) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpBitwiseXor) EncodedLen() int { return 4 }
+
+func (c *OpBitwiseXor) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpBitwiseXor) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpBitwiseAnd) EncodedLen() int { return 4 }
+
+func (c *OpBitwiseAnd) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand1), uint32(c.Operand2))
+	return out
+}
+
+func (c *OpBitwiseAnd) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpNot) EncodedLen() int { return 3 }
+
+func (c *OpNot) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Operand))
+	return out
+}
+
+func (c *OpNot) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpBitFieldInsert) EncodedLen() int { return 6 }
+
+func (c *OpBitFieldInsert) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Base), uint32(c.Insert), uint32(c.Offset), uint32(c.Count))
+	return out
+}
+
+func (c *OpBitFieldInsert) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Insert = Id(argv[3])
+	c.Offset = Id(argv[4])
+	c.Count = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpBitFieldSExtract) EncodedLen() int { return 5 }
+
+func (c *OpBitFieldSExtract) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Base), uint32(c.Offset), uint32(c.Count))
+	return out
+}
+
+func (c *OpBitFieldSExtract) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Offset = Id(argv[3])
+	c.Count = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpBitFieldUExtract) EncodedLen() int { return 5 }
+
+func (c *OpBitFieldUExtract) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Base), uint32(c.Offset), uint32(c.Count))
+	return out
+}
+
+func (c *OpBitFieldUExtract) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Offset = Id(argv[3])
+	c.Count = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpBitReverse) EncodedLen() int { return 3 }
+
+func (c *OpBitReverse) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Base))
+	return out
+}
+
+func (c *OpBitReverse) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpBitCount) EncodedLen() int { return 3 }
+
+func (c *OpBitCount) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Base))
+	return out
+}
+
+func (c *OpBitCount) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpDPdx) EncodedLen() int { return 3 }
+
+func (c *OpDPdx) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.P))
+	return out
+}
+
+func (c *OpDPdx) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpDPdy) EncodedLen() int { return 3 }
+
+func (c *OpDPdy) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.P))
+	return out
+}
+
+func (c *OpDPdy) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpFwidth) EncodedLen() int { return 3 }
+
+func (c *OpFwidth) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.P))
+	return out
+}
+
+func (c *OpFwidth) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpDPdxFine) EncodedLen() int { return 3 }
+
+func (c *OpDPdxFine) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.P))
+	return out
+}
+
+func (c *OpDPdxFine) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpDPdyFine) EncodedLen() int { return 3 }
+
+func (c *OpDPdyFine) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.P))
+	return out
+}
+
+func (c *OpDPdyFine) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpFwidthFine) EncodedLen() int { return 3 }
+
+func (c *OpFwidthFine) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.P))
+	return out
+}
+
+func (c *OpFwidthFine) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpDPdxCoarse) EncodedLen() int { return 3 }
+
+func (c *OpDPdxCoarse) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.P))
+	return out
+}
+
+func (c *OpDPdxCoarse) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpDPdyCoarse) EncodedLen() int { return 3 }
+
+func (c *OpDPdyCoarse) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.P))
+	return out
+}
+
+func (c *OpDPdyCoarse) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpFwidthCoarse) EncodedLen() int { return 3 }
+
+func (c *OpFwidthCoarse) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.P))
+	return out
+}
+
+func (c *OpFwidthCoarse) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpEmitVertex) EncodedLen() int { return 0 }
+
+func (c *OpEmitVertex) EncodeWords(out []uint32) []uint32 { return out }
+
+func (c *OpEmitVertex) DecodeWords(argv []uint32) error { return nil }
+
+func (c *OpEndPrimitive) EncodedLen() int { return 0 }
+
+func (c *OpEndPrimitive) EncodeWords(out []uint32) []uint32 { return out }
+
+func (c *OpEndPrimitive) DecodeWords(argv []uint32) error { return nil }
+
+func (c *OpEmitStreamVertex) EncodedLen() int { return 1 }
+
+func (c *OpEmitStreamVertex) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Stream))
+	return out
+}
+
+func (c *OpEmitStreamVertex) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Stream = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpEndStreamPrimitive) EncodedLen() int { return 1 }
+
+func (c *OpEndStreamPrimitive) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Stream))
+	return out
+}
+
+func (c *OpEndStreamPrimitive) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Stream = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpControlBarrier) EncodedLen() int { return 3 }
+
+func (c *OpControlBarrier) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Execution), uint32(c.Memory), uint32(c.Semantics))
+	return out
+}
+
+func (c *OpControlBarrier) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Execution = Id(argv[0])
+	c.Memory = Id(argv[1])
+	c.Semantics = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpMemoryBarrier) EncodedLen() int { return 2 }
+
+func (c *OpMemoryBarrier) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Memory), uint32(c.Semantics))
+	return out
+}
+
+func (c *OpMemoryBarrier) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Memory = Id(argv[0])
+	c.Semantics = Id(argv[1])
+
+	return nil
+}
+
+func (c *OpAtomicLoad) EncodedLen() int { return 5 }
+
+func (c *OpAtomicLoad) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics))
+	return out
+}
+
+func (c *OpAtomicLoad) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpAtomicStore) EncodedLen() int { return 4 }
+
+func (c *OpAtomicStore) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics), uint32(c.Value))
+	return out
+}
+
+func (c *OpAtomicStore) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pointer = Id(argv[0])
+	c.Scope = Id(argv[1])
+	c.Semantics = Id(argv[2])
+	c.Value = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpAtomicExchange) EncodedLen() int { return 6 }
+
+func (c *OpAtomicExchange) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics), uint32(c.Value))
+	return out
+}
+
+func (c *OpAtomicExchange) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpAtomicCompareExchange) EncodedLen() int { return 8 }
+
+func (c *OpAtomicCompareExchange) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Equal), uint32(c.Unequal), uint32(c.Value), uint32(c.Comparator))
+	return out
+}
+
+func (c *OpAtomicCompareExchange) DecodeWords(argv []uint32) error {
+	if len(argv) < 8 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Equal = Id(argv[4])
+	c.Unequal = Id(argv[5])
+	c.Value = Id(argv[6])
+	c.Comparator = Id(argv[7])
+
+	return nil
+}
+
+func (c *OpAtomicCompareExchangeWeak) EncodedLen() int { return 8 }
+
+func (c *OpAtomicCompareExchangeWeak) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Equal), uint32(c.Unequal), uint32(c.Value), uint32(c.Comparator))
+	return out
+}
+
+func (c *OpAtomicCompareExchangeWeak) DecodeWords(argv []uint32) error {
+	if len(argv) < 8 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Equal = Id(argv[4])
+	c.Unequal = Id(argv[5])
+	c.Value = Id(argv[6])
+	c.Comparator = Id(argv[7])
+
+	return nil
+}
+
+func (c *OpAtomicIIncrement) EncodedLen() int { return 5 }
+
+func (c *OpAtomicIIncrement) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics))
+	return out
+}
+
+func (c *OpAtomicIIncrement) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpAtomicIDecrement) EncodedLen() int { return 5 }
+
+func (c *OpAtomicIDecrement) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics))
+	return out
+}
+
+func (c *OpAtomicIDecrement) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpAtomicIAdd) EncodedLen() int { return 6 }
+
+func (c *OpAtomicIAdd) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics), uint32(c.Value))
+	return out
+}
+
+func (c *OpAtomicIAdd) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpAtomicISub) EncodedLen() int { return 6 }
+
+func (c *OpAtomicISub) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics), uint32(c.Value))
+	return out
+}
+
+func (c *OpAtomicISub) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpAtomicSMin) EncodedLen() int { return 6 }
+
+func (c *OpAtomicSMin) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics), uint32(c.Value))
+	return out
+}
+
+func (c *OpAtomicSMin) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpAtomicUMin) EncodedLen() int { return 6 }
+
+func (c *OpAtomicUMin) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics), uint32(c.Value))
+	return out
+}
+
+func (c *OpAtomicUMin) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpAtomicSMax) EncodedLen() int { return 6 }
+
+func (c *OpAtomicSMax) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics), uint32(c.Value))
+	return out
+}
+
+func (c *OpAtomicSMax) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpAtomicUMax) EncodedLen() int { return 6 }
+
+func (c *OpAtomicUMax) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics), uint32(c.Value))
+	return out
+}
+
+func (c *OpAtomicUMax) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpAtomicAnd) EncodedLen() int { return 6 }
+
+func (c *OpAtomicAnd) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics), uint32(c.Value))
+	return out
+}
+
+func (c *OpAtomicAnd) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpAtomicOr) EncodedLen() int { return 6 }
+
+func (c *OpAtomicOr) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics), uint32(c.Value))
+	return out
+}
+
+func (c *OpAtomicOr) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpAtomicXor) EncodedLen() int { return 6 }
+
+func (c *OpAtomicXor) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics), uint32(c.Value))
+	return out
+}
+
+func (c *OpAtomicXor) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpPhi) EncodedLen() int { return 2 + len(c.Operands) }
+
+func (c *OpPhi) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	out = appendIds(out, c.Operands)
+	return out
+}
+
+func (c *OpPhi) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.Operands = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpLoopMerge) EncodedLen() int { return 3 }
+
+func (c *OpLoopMerge) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.MergeBlock), uint32(c.ContinueTarget), uint32(c.LoopControl))
+	return out
+}
+
+func (c *OpLoopMerge) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.MergeBlock = Id(argv[0])
+	c.ContinueTarget = Id(argv[1])
+	c.LoopControl = LoopControl(argv[2])
+
+	return nil
+}
+
+func (c *OpSelectionMerge) EncodedLen() int { return 2 }
+
+func (c *OpSelectionMerge) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.MergeBlock), uint32(c.SelectionControl))
+	return out
+}
+
+func (c *OpSelectionMerge) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.MergeBlock = Id(argv[0])
+	c.SelectionControl = SelectionControl(argv[1])
+
+	return nil
+}
+
+func (c *OpLabel) EncodedLen() int { return 1 }
+
+func (c *OpLabel) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultId))
+	return out
+}
+
+func (c *OpLabel) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpBranch) EncodedLen() int { return 1 }
+
+func (c *OpBranch) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.TargetLabel))
+	return out
+}
+
+func (c *OpBranch) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.TargetLabel = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpBranchConditional) EncodedLen() int { return 3 + len(c.BranchWeights) }
+
+func (c *OpBranchConditional) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Condition), uint32(c.TrueLabel), uint32(c.FalseLabel))
+	out = append(out, c.BranchWeights...)
+	return out
+}
+
+func (c *OpBranchConditional) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Condition = Id(argv[0])
+	c.TrueLabel = Id(argv[1])
+	c.FalseLabel = Id(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		c.BranchWeights = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpSwitch) EncodedLen() int { return 2 + len(c.Target) }
+
+func (c *OpSwitch) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Selector), uint32(c.Default))
+	out = append(out, c.Target...)
+	return out
+}
+
+func (c *OpSwitch) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Selector = Id(argv[0])
+	c.Default = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.Target = Copy(argv)
+	}
+
+	return nil
+}
+
+func (c *OpKill) EncodedLen() int { return 0 }
+
+func (c *OpKill) EncodeWords(out []uint32) []uint32 { return out }
+
+func (c *OpKill) DecodeWords(argv []uint32) error { return nil }
+
+func (c *OpReturn) EncodedLen() int { return 0 }
+
+func (c *OpReturn) EncodeWords(out []uint32) []uint32 { return out }
+
+func (c *OpReturn) DecodeWords(argv []uint32) error { return nil }
+
+func (c *OpReturnValue) EncodedLen() int { return 1 }
+
+func (c *OpReturnValue) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Value))
+	return out
+}
+
+func (c *OpReturnValue) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Value = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpUnreachable) EncodedLen() int { return 0 }
+
+func (c *OpUnreachable) EncodeWords(out []uint32) []uint32 { return out }
+
+func (c *OpUnreachable) DecodeWords(argv []uint32) error { return nil }
+
+func (c *OpLifetimeStart) EncodedLen() int { return 2 }
+
+func (c *OpLifetimeStart) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Pointer), c.Size)
+	return out
+}
+
+func (c *OpLifetimeStart) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pointer = Id(argv[0])
+	c.Size = argv[1]
+
+	return nil
+}
+
+func (c *OpLifetimeStop) EncodedLen() int { return 2 }
+
+func (c *OpLifetimeStop) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Pointer), c.Size)
+	return out
+}
+
+func (c *OpLifetimeStop) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pointer = Id(argv[0])
+	c.Size = argv[1]
+
+	return nil
+}
+
+func (c *OpGroupAsyncCopy) EncodedLen() int { return 8 }
+
+func (c *OpGroupAsyncCopy) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Destination), uint32(c.Source), uint32(c.NumElements), uint32(c.Stride), uint32(c.Event))
+	return out
+}
+
+func (c *OpGroupAsyncCopy) DecodeWords(argv []uint32) error {
+	if len(argv) < 8 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Destination = Id(argv[3])
+	c.Source = Id(argv[4])
+	c.NumElements = Id(argv[5])
+	c.Stride = Id(argv[6])
+	c.Event = Id(argv[7])
+
+	return nil
+}
+
+func (c *OpGroupWaitEvents) EncodedLen() int { return 3 }
+
+func (c *OpGroupWaitEvents) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Execution), uint32(c.NumEvents), uint32(c.EventsList))
+	return out
+}
+
+func (c *OpGroupWaitEvents) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Execution = Id(argv[0])
+	c.NumEvents = Id(argv[1])
+	c.EventsList = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpGroupAll) EncodedLen() int { return 4 }
+
+func (c *OpGroupAll) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Predicate))
+	return out
+}
+
+func (c *OpGroupAll) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Predicate = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpGroupAny) EncodedLen() int { return 4 }
+
+func (c *OpGroupAny) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Predicate))
+	return out
+}
+
+func (c *OpGroupAny) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Predicate = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpGroupBroadcast) EncodedLen() int { return 5 }
+
+func (c *OpGroupBroadcast) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Value), uint32(c.LocalId))
+	return out
+}
+
+func (c *OpGroupBroadcast) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	c.LocalId = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpGroupIAdd) EncodedLen() int { return 5 }
+
+func (c *OpGroupIAdd) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Operation), uint32(c.X))
+	return out
+}
+
+func (c *OpGroupIAdd) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpGroupFAdd) EncodedLen() int { return 5 }
+
+func (c *OpGroupFAdd) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Operation), uint32(c.X))
+	return out
+}
+
+func (c *OpGroupFAdd) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpGroupFMin) EncodedLen() int { return 5 }
+
+func (c *OpGroupFMin) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Operation), uint32(c.X))
+	return out
+}
+
+func (c *OpGroupFMin) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpGroupUMin) EncodedLen() int { return 5 }
+
+func (c *OpGroupUMin) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Operation), uint32(c.X))
+	return out
+}
+
+func (c *OpGroupUMin) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpGroupSMin) EncodedLen() int { return 5 }
+
+func (c *OpGroupSMin) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Operation), uint32(c.X))
+	return out
+}
+
+func (c *OpGroupSMin) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpGroupFMax) EncodedLen() int { return 5 }
+
+func (c *OpGroupFMax) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Operation), uint32(c.X))
+	return out
+}
+
+func (c *OpGroupFMax) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpGroupUMax) EncodedLen() int { return 5 }
+
+func (c *OpGroupUMax) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Operation), uint32(c.X))
+	return out
+}
+
+func (c *OpGroupUMax) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpGroupSMax) EncodedLen() int { return 5 }
+
+func (c *OpGroupSMax) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Operation), uint32(c.X))
+	return out
+}
+
+func (c *OpGroupSMax) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpReadPipe) EncodedLen() int { return 6 }
+
+func (c *OpReadPipe) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pipe), uint32(c.Pointer), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpReadPipe) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.Pointer = Id(argv[3])
+	c.PacketSize = Id(argv[4])
+	c.PacketAlignment = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpWritePipe) EncodedLen() int { return 6 }
+
+func (c *OpWritePipe) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pipe), uint32(c.Pointer), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpWritePipe) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.Pointer = Id(argv[3])
+	c.PacketSize = Id(argv[4])
+	c.PacketAlignment = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpReservedReadPipe) EncodedLen() int { return 8 }
+
+func (c *OpReservedReadPipe) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pipe), uint32(c.ReserveId), uint32(c.Index), uint32(c.Pointer), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpReservedReadPipe) DecodeWords(argv []uint32) error {
+	if len(argv) < 8 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.ReserveId = Id(argv[3])
+	c.Index = Id(argv[4])
+	c.Pointer = Id(argv[5])
+	c.PacketSize = Id(argv[6])
+	c.PacketAlignment = Id(argv[7])
+
+	return nil
+}
+
+func (c *OpReservedWritePipe) EncodedLen() int { return 8 }
+
+func (c *OpReservedWritePipe) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pipe), uint32(c.ReserveId), uint32(c.Index), uint32(c.Pointer), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpReservedWritePipe) DecodeWords(argv []uint32) error {
+	if len(argv) < 8 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.ReserveId = Id(argv[3])
+	c.Index = Id(argv[4])
+	c.Pointer = Id(argv[5])
+	c.PacketSize = Id(argv[6])
+	c.PacketAlignment = Id(argv[7])
+
+	return nil
+}
+
+func (c *OpReserveReadPipePackets) EncodedLen() int { return 6 }
+
+func (c *OpReserveReadPipePackets) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pipe), uint32(c.NumPackets), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpReserveReadPipePackets) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.NumPackets = Id(argv[3])
+	c.PacketSize = Id(argv[4])
+	c.PacketAlignment = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpReserveWritePipePackets) EncodedLen() int { return 6 }
+
+func (c *OpReserveWritePipePackets) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pipe), uint32(c.NumPackets), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpReserveWritePipePackets) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.NumPackets = Id(argv[3])
+	c.PacketSize = Id(argv[4])
+	c.PacketAlignment = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpCommitReadPipe) EncodedLen() int { return 4 }
+
+func (c *OpCommitReadPipe) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Pipe), uint32(c.ReserveId), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpCommitReadPipe) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pipe = Id(argv[0])
+	c.ReserveId = Id(argv[1])
+	c.PacketSize = Id(argv[2])
+	c.PacketAlignment = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpCommitWritePipe) EncodedLen() int { return 4 }
+
+func (c *OpCommitWritePipe) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Pipe), uint32(c.ReserveId), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpCommitWritePipe) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pipe = Id(argv[0])
+	c.ReserveId = Id(argv[1])
+	c.PacketSize = Id(argv[2])
+	c.PacketAlignment = Id(argv[3])
+
+	return nil
+}
+
+func (c *OpIsValidReserveId) EncodedLen() int { return 3 }
+
+func (c *OpIsValidReserveId) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.ReserveId))
+	return out
+}
+
+func (c *OpIsValidReserveId) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.ReserveId = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpGetNumPipePackets) EncodedLen() int { return 5 }
+
+func (c *OpGetNumPipePackets) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pipe), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpGetNumPipePackets) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.PacketSize = Id(argv[3])
+	c.PacketAlignment = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpGetMaxPipePackets) EncodedLen() int { return 5 }
+
+func (c *OpGetMaxPipePackets) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pipe), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpGetMaxPipePackets) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.PacketSize = Id(argv[3])
+	c.PacketAlignment = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpGroupReserveReadPipePackets) EncodedLen() int { return 7 }
+
+func (c *OpGroupReserveReadPipePackets) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Pipe), uint32(c.NumPackets), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpGroupReserveReadPipePackets) DecodeWords(argv []uint32) error {
+	if len(argv) < 7 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Pipe = Id(argv[3])
+	c.NumPackets = Id(argv[4])
+	c.PacketSize = Id(argv[5])
+	c.PacketAlignment = Id(argv[6])
+
+	return nil
+}
+
+func (c *OpGroupReserveWritePipePackets) EncodedLen() int { return 7 }
+
+func (c *OpGroupReserveWritePipePackets) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Execution), uint32(c.Pipe), uint32(c.NumPackets), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpGroupReserveWritePipePackets) DecodeWords(argv []uint32) error {
+	if len(argv) < 7 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Pipe = Id(argv[3])
+	c.NumPackets = Id(argv[4])
+	c.PacketSize = Id(argv[5])
+	c.PacketAlignment = Id(argv[6])
+
+	return nil
+}
+
+func (c *OpGroupCommitReadPipe) EncodedLen() int { return 5 }
+
+func (c *OpGroupCommitReadPipe) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Execution), uint32(c.Pipe), uint32(c.ReserveId), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpGroupCommitReadPipe) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Execution = Id(argv[0])
+	c.Pipe = Id(argv[1])
+	c.ReserveId = Id(argv[2])
+	c.PacketSize = Id(argv[3])
+	c.PacketAlignment = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpGroupCommitWritePipe) EncodedLen() int { return 5 }
+
+func (c *OpGroupCommitWritePipe) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Execution), uint32(c.Pipe), uint32(c.ReserveId), uint32(c.PacketSize), uint32(c.PacketAlignment))
+	return out
+}
+
+func (c *OpGroupCommitWritePipe) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Execution = Id(argv[0])
+	c.Pipe = Id(argv[1])
+	c.ReserveId = Id(argv[2])
+	c.PacketSize = Id(argv[3])
+	c.PacketAlignment = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpEnqueueMarker) EncodedLen() int { return 6 }
+
+func (c *OpEnqueueMarker) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Queue), uint32(c.NumEvents), uint32(c.WaitEvents), uint32(c.RetEvent))
+	return out
+}
+
+func (c *OpEnqueueMarker) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Queue = Id(argv[2])
+	c.NumEvents = Id(argv[3])
+	c.WaitEvents = Id(argv[4])
+	c.RetEvent = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpEnqueueKernel) EncodedLen() int { return 12 + len(c.LocalSize) }
+
+func (c *OpEnqueueKernel) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Queue), uint32(c.Flags), uint32(c.NDRange), uint32(c.NumEvents), uint32(c.WaitEvents), uint32(c.RetEvent), uint32(c.Invoke), uint32(c.Param), uint32(c.ParamSize), uint32(c.ParamAlign))
+	out = appendIds(out, c.LocalSize)
+	return out
+}
+
+func (c *OpEnqueueKernel) DecodeWords(argv []uint32) error {
+	if len(argv) < 12 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Queue = Id(argv[2])
+	c.Flags = Id(argv[3])
+	c.NDRange = Id(argv[4])
+	c.NumEvents = Id(argv[5])
+	c.WaitEvents = Id(argv[6])
+	c.RetEvent = Id(argv[7])
+	c.Invoke = Id(argv[8])
+	c.Param = Id(argv[9])
+	c.ParamSize = Id(argv[10])
+	c.ParamAlign = Id(argv[11])
+	argv = argv[12:]
+
+	if len(argv) > 0 {
+		c.LocalSize = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpGetKernelNDrangeSubGroupCount) EncodedLen() int { return 7 }
+
+func (c *OpGetKernelNDrangeSubGroupCount) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.NDRange), uint32(c.Invoke), uint32(c.Param), uint32(c.ParamSize), uint32(c.ParamAlign))
+	return out
+}
+
+func (c *OpGetKernelNDrangeSubGroupCount) DecodeWords(argv []uint32) error {
+	if len(argv) < 7 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.NDRange = Id(argv[2])
+	c.Invoke = Id(argv[3])
+	c.Param = Id(argv[4])
+	c.ParamSize = Id(argv[5])
+	c.ParamAlign = Id(argv[6])
+
+	return nil
+}
+
+func (c *OpGetKernelNDrangeMaxSubGroupSize) EncodedLen() int { return 7 }
+
+func (c *OpGetKernelNDrangeMaxSubGroupSize) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.NDRange), uint32(c.Invoke), uint32(c.Param), uint32(c.ParamSize), uint32(c.ParamAlign))
+	return out
+}
+
+func (c *OpGetKernelNDrangeMaxSubGroupSize) DecodeWords(argv []uint32) error {
+	if len(argv) < 7 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.NDRange = Id(argv[2])
+	c.Invoke = Id(argv[3])
+	c.Param = Id(argv[4])
+	c.ParamSize = Id(argv[5])
+	c.ParamAlign = Id(argv[6])
+
+	return nil
+}
+
+func (c *OpGetKernelWorkGroupSize) EncodedLen() int { return 6 }
+
+func (c *OpGetKernelWorkGroupSize) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Invoke), uint32(c.Param), uint32(c.ParamSize), uint32(c.ParamAlign))
+	return out
+}
+
+func (c *OpGetKernelWorkGroupSize) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Invoke = Id(argv[2])
+	c.Param = Id(argv[3])
+	c.ParamSize = Id(argv[4])
+	c.ParamAlign = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) EncodedLen() int { return 6 }
+
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Invoke), uint32(c.Param), uint32(c.ParamSize), uint32(c.ParamAlign))
+	return out
+}
+
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Invoke = Id(argv[2])
+	c.Param = Id(argv[3])
+	c.ParamSize = Id(argv[4])
+	c.ParamAlign = Id(argv[5])
+
+	return nil
+}
+
+func (c *OpRetainEvent) EncodedLen() int { return 1 }
+
+func (c *OpRetainEvent) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Event))
+	return out
+}
+
+func (c *OpRetainEvent) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Event = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpReleaseEvent) EncodedLen() int { return 1 }
+
+func (c *OpReleaseEvent) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Event))
+	return out
+}
+
+func (c *OpReleaseEvent) DecodeWords(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Event = Id(argv[0])
+
+	return nil
+}
+
+func (c *OpCreateUserEvent) EncodedLen() int { return 2 }
+
+func (c *OpCreateUserEvent) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	return out
+}
+
+func (c *OpCreateUserEvent) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+
+	return nil
+}
+
+func (c *OpIsValidEvent) EncodedLen() int { return 3 }
+
+func (c *OpIsValidEvent) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Event))
+	return out
+}
+
+func (c *OpIsValidEvent) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Event = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpSetUserEventStatus) EncodedLen() int { return 2 }
+
+func (c *OpSetUserEventStatus) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Event), uint32(c.Status))
+	return out
+}
+
+func (c *OpSetUserEventStatus) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Event = Id(argv[0])
+	c.Status = Id(argv[1])
+
+	return nil
+}
+
+func (c *OpCaptureEventProfilingInfo) EncodedLen() int { return 3 }
+
+func (c *OpCaptureEventProfilingInfo) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Event), uint32(c.ProfilingInfo), uint32(c.Value))
+	return out
+}
+
+func (c *OpCaptureEventProfilingInfo) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Event = Id(argv[0])
+	c.ProfilingInfo = Id(argv[1])
+	c.Value = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpGetDefaultQueue) EncodedLen() int { return 2 }
+
+func (c *OpGetDefaultQueue) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId))
+	return out
+}
+
+func (c *OpGetDefaultQueue) DecodeWords(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+
+	return nil
+}
+
+func (c *OpBuildNDRange) EncodedLen() int { return 5 }
+
+func (c *OpBuildNDRange) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.GlobalWorkSize), uint32(c.LocalWorkSize), uint32(c.GlobalWorkOffset))
+	return out
+}
+
+func (c *OpBuildNDRange) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.GlobalWorkSize = Id(argv[2])
+	c.LocalWorkSize = Id(argv[3])
+	c.GlobalWorkOffset = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpImageSparseSampleImplicitLod) EncodedLen() int {
+	n := 4 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseSampleImplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSparseSampleImplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSparseSampleExplicitLod) EncodedLen() int { return 5 + len(c.Argv) }
+
+func (c *OpImageSparseSampleExplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.ImageOperands))
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSparseSampleExplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.ImageOperands = ImageOperands(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSparseSampleDrefImplicitLod) EncodedLen() int {
+	n := 5 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseSampleDrefImplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.Dref))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSparseSampleDrefImplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSparseSampleDrefExplicitLod) EncodedLen() int { return 6 + len(c.Argv) }
+
+func (c *OpImageSparseSampleDrefExplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.Dref), uint32(c.ImageOperands))
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSparseSampleDrefExplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	c.ImageOperands = ImageOperands(argv[5])
+	argv = argv[6:]
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSparseSampleProjImplicitLod) EncodedLen() int {
+	n := 4 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseSampleProjImplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSparseSampleProjImplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSparseSampleProjExplicitLod) EncodedLen() int { return 5 + len(c.Argv) }
+
+func (c *OpImageSparseSampleProjExplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.ImageOperands))
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSparseSampleProjExplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.ImageOperands = ImageOperands(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSparseSampleProjDrefImplicitLod) EncodedLen() int {
+	n := 5 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseSampleProjDrefImplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.Dref))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSparseSampleProjDrefImplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSparseSampleProjDrefExplicitLod) EncodedLen() int { return 6 + len(c.Argv) }
+
+func (c *OpImageSparseSampleProjDrefExplicitLod) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.Dref), uint32(c.ImageOperands))
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSparseSampleProjDrefExplicitLod) DecodeWords(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	c.ImageOperands = ImageOperands(argv[5])
+	argv = argv[6:]
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSparseFetch) EncodedLen() int {
+	n := 4 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseFetch) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Image), uint32(c.Coordinate))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSparseFetch) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSparseGather) EncodedLen() int {
+	n := 5 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseGather) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.Component))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSparseGather) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Component = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSparseDrefGather) EncodedLen() int {
+	n := 5 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseDrefGather) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.SampledImage), uint32(c.Coordinate), uint32(c.Dref))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSparseDrefGather) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
+
+func (c *OpImageSparseTexelsResident) EncodedLen() int { return 3 }
+
+func (c *OpImageSparseTexelsResident) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.ResidentCode))
+	return out
+}
+
+func (c *OpImageSparseTexelsResident) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.ResidentCode = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpNoLine) EncodedLen() int { return 0 }
+
+func (c *OpNoLine) EncodeWords(out []uint32) []uint32 { return out }
+
+func (c *OpNoLine) DecodeWords(argv []uint32) error { return nil }
+
+func (c *OpAtomicFlagTestAndSet) EncodedLen() int { return 5 }
+
+func (c *OpAtomicFlagTestAndSet) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics))
+	return out
+}
+
+func (c *OpAtomicFlagTestAndSet) DecodeWords(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Scope = Id(argv[3])
+	c.Semantics = Id(argv[4])
+
+	return nil
+}
+
+func (c *OpAtomicFlagClear) EncodedLen() int { return 3 }
+
+func (c *OpAtomicFlagClear) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.Pointer), uint32(c.Scope), uint32(c.Semantics))
+	return out
+}
+
+func (c *OpAtomicFlagClear) DecodeWords(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pointer = Id(argv[0])
+	c.Scope = Id(argv[1])
+	c.Semantics = Id(argv[2])
+
+	return nil
+}
+
+func (c *OpImageSparseRead) EncodedLen() int {
+	n := 4 + len(c.Argv)
+	if c.ImageOperands != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseRead) EncodeWords(out []uint32) []uint32 {
+	out = append(out, uint32(c.ResultType), uint32(c.ResultId), uint32(c.Image), uint32(c.Coordinate))
+	if c.ImageOperands != 0 {
+		out = append(out, uint32(c.ImageOperands))
+	}
+	out = appendIds(out, c.Argv)
+	return out
+}
+
+func (c *OpImageSparseRead) DecodeWords(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		c.ImageOperands = ImageOperands(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Argv = decodeIds(argv)
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+// testModuleWords returns the instructions in testdata/test.spirv,
+// as lists of words.
+func testModuleWords(tb testing.TB) [][]uint32 {
+	data, err := ioutil.ReadFile("testdata/test.spirv")
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	dec := NewDecoder(bytes.NewReader(data))
+
+	_, err = dec.DecodeHeader()
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	var out [][]uint32
+
+	for {
+		words, err := dec.DecodeInstructionWords()
+		if err != nil {
+			if err == io.EOF {
+				return out
+			}
+			tb.Fatal(err)
+		}
+
+		out = append(out, Copy(words))
+	}
+}
+
+func TestWordCodecImplemented(t *testing.T) {
+	for _, opcode := range DefaultDialect.Opcodes() {
+		instr, _ := DefaultDialect.New(uint32(opcode))
+
+		_, ok := instr.(WordCodec)
+		if !ok {
+			t.Fatalf("%T does not implement WordCodec", instr)
+		}
+	}
+}
+
+func TestWordCodec(t *testing.T) {
+	for i, words := range testModuleWords(t) {
+		have, err := DecodeInstruction(words)
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		want, _ := DefaultDialect.New(have.Opcode())
+
+		err = decodeReflect(want, words[1:])
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		if !reflect.DeepEqual(have, want) {
+			t.Fatalf("case %d: decode mismatch:\nHave: %T(%+v)\nWant: %T(%+v)",
+				i, have, have, want, want)
+		}
+
+		out, err := appendInstruction(nil, have)
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		if !reflect.DeepEqual(out, words) {
+			t.Fatalf("case %d: encode mismatch:\nHave: %v\nWant: %v", i, out, words)
+		}
+	}
+}
+
+// newTestInstructions returns empty instructions for the given
+// list of encoded instructions.
+func newTestInstructions(set [][]uint32) []Instruction {
+	out := make([]Instruction, len(set))
+
+	for i, words := range set {
+		out[i], _ = DefaultDialect.New(words[0] & 0xffff)
+	}
+
+	return out
+}
+
+func BenchmarkDecodeWords(b *testing.B) {
+	set := testModuleWords(b)
+	instr := newTestInstructions(set)
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		for i, words := range set {
+			err := instr[i].(WordCodec).DecodeWords(words[1:])
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkDecodeReflect(b *testing.B) {
+	set := testModuleWords(b)
+	instr := newTestInstructions(set)
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		for i, words := range set {
+			err := decodeReflect(instr[i], words[1:])
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkEncodeWords(b *testing.B) {
+	set := testModuleWords(b)
+	instr := newTestInstructions(set)
+	for i, words := range set {
+		instr[i].(WordCodec).DecodeWords(words[1:])
+	}
+
+	out := make([]uint32, 0, 64)
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		for _, in := range instr {
+			out = in.(WordCodec).EncodeWords(out[:0])
+		}
+	}
+}
+
+func BenchmarkEncodeReflect(b *testing.B) {
+	set := testModuleWords(b)
+	instr := newTestInstructions(set)
+	for i, words := range set {
+		instr[i].(WordCodec).DecodeWords(words[1:])
+	}
+
+	out := make([]uint32, 0, 64)
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		for _, in := range instr {
+			var err error
+
+			out, err = encodeReflect(out[:0], in)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkLoad(b *testing.B) {
+	data, err := ioutil.ReadFile("testdata/test.spirv")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		_, err := Load(bytes.NewReader(data))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSave(b *testing.B) {
+	data, err := ioutil.ReadFile("testdata/test.spirv")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	mod, err := Load(bytes.NewReader(data))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		buf.Reset()
+
+		err := mod.Save(&buf)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,5455 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
+package spirv
+
+func (c *OpNop) resultId() (Id, bool) { return 0, false }
+
+func (c *OpNop) setResultId(id Id) bool { return false }
+
+func (c *OpNop) resultType() (Id, bool) { return 0, false }
+
+func (c *OpNop) appendOperands(out []Id) []Id { return out }
+
+func (c *OpNop) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpUndef) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpUndef) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpUndef) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpUndef) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType)
+}
+
+func (c *OpUndef) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+}
+
+func (c *OpSourceContinued) resultId() (Id, bool) { return 0, false }
+
+func (c *OpSourceContinued) setResultId(id Id) bool { return false }
+
+func (c *OpSourceContinued) resultType() (Id, bool) { return 0, false }
+
+func (c *OpSourceContinued) appendOperands(out []Id) []Id { return out }
+
+func (c *OpSourceContinued) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpSource) resultId() (Id, bool) { return 0, false }
+
+func (c *OpSource) setResultId(id Id) bool { return false }
+
+func (c *OpSource) resultType() (Id, bool) { return 0, false }
+
+func (c *OpSource) appendOperands(out []Id) []Id {
+	if c.File != 0 {
+		out = append(out, c.File)
+	}
+	return out
+}
+
+func (c *OpSource) rewriteOperands(f func(Id) Id) {
+	if c.File != 0 {
+		c.File = f(c.File)
+	}
+}
+
+func (c *OpSourceExtension) resultId() (Id, bool) { return 0, false }
+
+func (c *OpSourceExtension) setResultId(id Id) bool { return false }
+
+func (c *OpSourceExtension) resultType() (Id, bool) { return 0, false }
+
+func (c *OpSourceExtension) appendOperands(out []Id) []Id { return out }
+
+func (c *OpSourceExtension) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpName) resultId() (Id, bool) { return 0, false }
+
+func (c *OpName) setResultId(id Id) bool { return false }
+
+func (c *OpName) resultType() (Id, bool) { return 0, false }
+
+func (c *OpName) appendOperands(out []Id) []Id {
+	return append(out, c.Target)
+}
+
+func (c *OpName) rewriteOperands(f func(Id) Id) {
+	c.Target = f(c.Target)
+}
+
+func (c *OpMemberName) resultId() (Id, bool) { return 0, false }
+
+func (c *OpMemberName) setResultId(id Id) bool { return false }
+
+func (c *OpMemberName) resultType() (Id, bool) { return 0, false }
+
+func (c *OpMemberName) appendOperands(out []Id) []Id {
+	return append(out, c.Type)
+}
+
+func (c *OpMemberName) rewriteOperands(f func(Id) Id) {
+	c.Type = f(c.Type)
+}
+
+func (c *OpString) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpString) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpString) resultType() (Id, bool) { return 0, false }
+
+func (c *OpString) appendOperands(out []Id) []Id { return out }
+
+func (c *OpString) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpLine) resultId() (Id, bool) { return 0, false }
+
+func (c *OpLine) setResultId(id Id) bool { return false }
+
+func (c *OpLine) resultType() (Id, bool) { return 0, false }
+
+func (c *OpLine) appendOperands(out []Id) []Id {
+	return append(out, c.File)
+}
+
+func (c *OpLine) rewriteOperands(f func(Id) Id) {
+	c.File = f(c.File)
+}
+
+func (c *OpExtension) resultId() (Id, bool) { return 0, false }
+
+func (c *OpExtension) setResultId(id Id) bool { return false }
+
+func (c *OpExtension) resultType() (Id, bool) { return 0, false }
+
+func (c *OpExtension) appendOperands(out []Id) []Id { return out }
+
+func (c *OpExtension) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpExtInstImport) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpExtInstImport) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpExtInstImport) resultType() (Id, bool) { return 0, false }
+
+func (c *OpExtInstImport) appendOperands(out []Id) []Id { return out }
+
+func (c *OpExtInstImport) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpExtInst) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpExtInst) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpExtInst) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpExtInst) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.Set)
+	out = append(out, c.Operands...)
+	return out
+}
+
+func (c *OpExtInst) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Set = f(c.Set)
+	for i := range c.Operands {
+		c.Operands[i] = f(c.Operands[i])
+	}
+}
+
+func (c *OpMemoryModel) resultId() (Id, bool) { return 0, false }
+
+func (c *OpMemoryModel) setResultId(id Id) bool { return false }
+
+func (c *OpMemoryModel) resultType() (Id, bool) { return 0, false }
+
+func (c *OpMemoryModel) appendOperands(out []Id) []Id { return out }
+
+func (c *OpMemoryModel) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpEntryPoint) resultId() (Id, bool) { return 0, false }
+
+func (c *OpEntryPoint) setResultId(id Id) bool { return false }
+
+func (c *OpEntryPoint) resultType() (Id, bool) { return 0, false }
+
+func (c *OpEntryPoint) appendOperands(out []Id) []Id {
+	out = append(out, c.EntryPoint)
+	out = append(out, c.Interface...)
+	return out
+}
+
+func (c *OpEntryPoint) rewriteOperands(f func(Id) Id) {
+	c.EntryPoint = f(c.EntryPoint)
+	for i := range c.Interface {
+		c.Interface[i] = f(c.Interface[i])
+	}
+}
+
+func (c *OpExecutionMode) resultId() (Id, bool) { return 0, false }
+
+func (c *OpExecutionMode) setResultId(id Id) bool { return false }
+
+func (c *OpExecutionMode) resultType() (Id, bool) { return 0, false }
+
+func (c *OpExecutionMode) appendOperands(out []Id) []Id {
+	return append(out, c.EntryPoint)
+}
+
+func (c *OpExecutionMode) rewriteOperands(f func(Id) Id) {
+	c.EntryPoint = f(c.EntryPoint)
+}
+
+func (c *OpCapability) resultId() (Id, bool) { return 0, false }
+
+func (c *OpCapability) setResultId(id Id) bool { return false }
+
+func (c *OpCapability) resultType() (Id, bool) { return 0, false }
+
+func (c *OpCapability) appendOperands(out []Id) []Id { return out }
+
+func (c *OpCapability) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpTypeVoid) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeVoid) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeVoid) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeVoid) appendOperands(out []Id) []Id { return out }
+
+func (c *OpTypeVoid) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpTypeBool) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeBool) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeBool) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeBool) appendOperands(out []Id) []Id { return out }
+
+func (c *OpTypeBool) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpTypeInt) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeInt) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeInt) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeInt) appendOperands(out []Id) []Id { return out }
+
+func (c *OpTypeInt) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpTypeFloat) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeFloat) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeFloat) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeFloat) appendOperands(out []Id) []Id { return out }
+
+func (c *OpTypeFloat) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpTypeVector) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeVector) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeVector) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeVector) appendOperands(out []Id) []Id {
+	return append(out, c.ComponentType)
+}
+
+func (c *OpTypeVector) rewriteOperands(f func(Id) Id) {
+	c.ComponentType = f(c.ComponentType)
+}
+
+func (c *OpTypeMatrix) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeMatrix) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeMatrix) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeMatrix) appendOperands(out []Id) []Id {
+	return append(out, c.ColumnType)
+}
+
+func (c *OpTypeMatrix) rewriteOperands(f func(Id) Id) {
+	c.ColumnType = f(c.ColumnType)
+}
+
+func (c *OpTypeImage) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeImage) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeImage) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeImage) appendOperands(out []Id) []Id {
+	return append(out, c.SampledType)
+}
+
+func (c *OpTypeImage) rewriteOperands(f func(Id) Id) {
+	c.SampledType = f(c.SampledType)
+}
+
+func (c *OpTypeSampler) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeSampler) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeSampler) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeSampler) appendOperands(out []Id) []Id { return out }
+
+func (c *OpTypeSampler) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpTypeSampledImage) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeSampledImage) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeSampledImage) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeSampledImage) appendOperands(out []Id) []Id {
+	return append(out, c.ImageType)
+}
+
+func (c *OpTypeSampledImage) rewriteOperands(f func(Id) Id) {
+	c.ImageType = f(c.ImageType)
+}
+
+func (c *OpTypeArray) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeArray) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeArray) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeArray) appendOperands(out []Id) []Id {
+	return append(out, c.ElementType, c.Length)
+}
+
+func (c *OpTypeArray) rewriteOperands(f func(Id) Id) {
+	c.ElementType = f(c.ElementType)
+	c.Length = f(c.Length)
+}
+
+func (c *OpTypeRuntimeArray) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeRuntimeArray) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeRuntimeArray) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeRuntimeArray) appendOperands(out []Id) []Id {
+	return append(out, c.ElementType)
+}
+
+func (c *OpTypeRuntimeArray) rewriteOperands(f func(Id) Id) {
+	c.ElementType = f(c.ElementType)
+}
+
+func (c *OpTypeStruct) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeStruct) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeStruct) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeStruct) appendOperands(out []Id) []Id {
+	out = append(out, c.Members...)
+	return out
+}
+
+func (c *OpTypeStruct) rewriteOperands(f func(Id) Id) {
+	for i := range c.Members {
+		c.Members[i] = f(c.Members[i])
+	}
+}
+
+func (c *OpTypeOpaque) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeOpaque) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeOpaque) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeOpaque) appendOperands(out []Id) []Id { return out }
+
+func (c *OpTypeOpaque) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpTypePointer) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypePointer) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypePointer) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypePointer) appendOperands(out []Id) []Id {
+	return append(out, c.Type)
+}
+
+func (c *OpTypePointer) rewriteOperands(f func(Id) Id) {
+	c.Type = f(c.Type)
+}
+
+func (c *OpTypeFunction) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeFunction) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeFunction) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeFunction) appendOperands(out []Id) []Id {
+	out = append(out, c.ReturnType)
+	out = append(out, c.Parameters...)
+	return out
+}
+
+func (c *OpTypeFunction) rewriteOperands(f func(Id) Id) {
+	c.ReturnType = f(c.ReturnType)
+	for i := range c.Parameters {
+		c.Parameters[i] = f(c.Parameters[i])
+	}
+}
+
+func (c *OpTypeEvent) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeEvent) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeEvent) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeEvent) appendOperands(out []Id) []Id { return out }
+
+func (c *OpTypeEvent) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpTypeDeviceEvent) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeDeviceEvent) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeDeviceEvent) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeDeviceEvent) appendOperands(out []Id) []Id { return out }
+
+func (c *OpTypeDeviceEvent) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpTypeReserveId) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeReserveId) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeReserveId) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeReserveId) appendOperands(out []Id) []Id { return out }
+
+func (c *OpTypeReserveId) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpTypeQueue) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypeQueue) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypeQueue) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeQueue) appendOperands(out []Id) []Id { return out }
+
+func (c *OpTypeQueue) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpTypePipe) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTypePipe) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTypePipe) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypePipe) appendOperands(out []Id) []Id { return out }
+
+func (c *OpTypePipe) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpTypeForwardPointer) resultId() (Id, bool) { return 0, false }
+
+func (c *OpTypeForwardPointer) setResultId(id Id) bool { return false }
+
+func (c *OpTypeForwardPointer) resultType() (Id, bool) { return 0, false }
+
+func (c *OpTypeForwardPointer) appendOperands(out []Id) []Id {
+	return append(out, c.PointerType)
+}
+
+func (c *OpTypeForwardPointer) rewriteOperands(f func(Id) Id) {
+	c.PointerType = f(c.PointerType)
+}
+
+func (c *OpConstantTrue) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpConstantTrue) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpConstantTrue) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpConstantTrue) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType)
+}
+
+func (c *OpConstantTrue) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+}
+
+func (c *OpConstantFalse) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpConstantFalse) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpConstantFalse) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpConstantFalse) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType)
+}
+
+func (c *OpConstantFalse) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+}
+
+func (c *OpConstant) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpConstant) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpConstant) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpConstant) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType)
+}
+
+func (c *OpConstant) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+}
+
+func (c *OpConstantComposite) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpConstantComposite) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpConstantComposite) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpConstantComposite) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType)
+	out = append(out, c.Constituents...)
+	return out
+}
+
+func (c *OpConstantComposite) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	for i := range c.Constituents {
+		c.Constituents[i] = f(c.Constituents[i])
+	}
+}
+
+func (c *OpConstantSampler) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpConstantSampler) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpConstantSampler) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpConstantSampler) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType)
+}
+
+func (c *OpConstantSampler) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+}
+
+func (c *OpConstantNull) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpConstantNull) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpConstantNull) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpConstantNull) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType)
+}
+
+func (c *OpConstantNull) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+}
+
+func (c *OpSpecConstantTrue) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSpecConstantTrue) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSpecConstantTrue) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSpecConstantTrue) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType)
+}
+
+func (c *OpSpecConstantTrue) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+}
+
+func (c *OpSpecConstantFalse) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSpecConstantFalse) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSpecConstantFalse) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSpecConstantFalse) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType)
+}
+
+func (c *OpSpecConstantFalse) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+}
+
+func (c *OpSpecConstant) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSpecConstant) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSpecConstant) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSpecConstant) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType)
+}
+
+func (c *OpSpecConstant) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+}
+
+func (c *OpSpecConstantComposite) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSpecConstantComposite) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSpecConstantComposite) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSpecConstantComposite) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType)
+	out = append(out, c.Constituents...)
+	return out
+}
+
+func (c *OpSpecConstantComposite) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	for i := range c.Constituents {
+		c.Constituents[i] = f(c.Constituents[i])
+	}
+}
+
+func (c *OpSpecConstantOp) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSpecConstantOp) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSpecConstantOp) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSpecConstantOp) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType)
+	out = append(out, c.Operands...)
+	return out
+}
+
+func (c *OpSpecConstantOp) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	for i := range c.Operands {
+		c.Operands[i] = f(c.Operands[i])
+	}
+}
+
+func (c *OpFunction) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFunction) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFunction) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFunction) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.FunctionType)
+}
+
+func (c *OpFunction) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.FunctionType = f(c.FunctionType)
+}
+
+func (c *OpFunctionParameter) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFunctionParameter) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFunctionParameter) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFunctionParameter) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType)
+}
+
+func (c *OpFunctionParameter) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+}
+
+func (c *OpFunctionEnd) resultId() (Id, bool) { return 0, false }
+
+func (c *OpFunctionEnd) setResultId(id Id) bool { return false }
+
+func (c *OpFunctionEnd) resultType() (Id, bool) { return 0, false }
+
+func (c *OpFunctionEnd) appendOperands(out []Id) []Id { return out }
+
+func (c *OpFunctionEnd) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpFunctionCall) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFunctionCall) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFunctionCall) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFunctionCall) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.Function)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpFunctionCall) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Function = f(c.Function)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpVariable) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpVariable) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpVariable) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpVariable) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType)
+	if c.Initializer != 0 {
+		out = append(out, c.Initializer)
+	}
+	return out
+}
+
+func (c *OpVariable) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	if c.Initializer != 0 {
+		c.Initializer = f(c.Initializer)
+	}
+}
+
+func (c *OpImageTexelPointer) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageTexelPointer) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageTexelPointer) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageTexelPointer) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Image, c.Coordinate, c.Sample)
+}
+
+func (c *OpImageTexelPointer) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Image = f(c.Image)
+	c.Coordinate = f(c.Coordinate)
+	c.Sample = f(c.Sample)
+}
+
+func (c *OpLoad) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpLoad) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpLoad) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpLoad) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer)
+}
+
+func (c *OpLoad) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+}
+
+func (c *OpStore) resultId() (Id, bool) { return 0, false }
+
+func (c *OpStore) setResultId(id Id) bool { return false }
+
+func (c *OpStore) resultType() (Id, bool) { return 0, false }
+
+func (c *OpStore) appendOperands(out []Id) []Id {
+	return append(out, c.Pointer, c.Object)
+}
+
+func (c *OpStore) rewriteOperands(f func(Id) Id) {
+	c.Pointer = f(c.Pointer)
+	c.Object = f(c.Object)
+}
+
+func (c *OpCopyMemory) resultId() (Id, bool) { return 0, false }
+
+func (c *OpCopyMemory) setResultId(id Id) bool { return false }
+
+func (c *OpCopyMemory) resultType() (Id, bool) { return 0, false }
+
+func (c *OpCopyMemory) appendOperands(out []Id) []Id {
+	return append(out, c.Target, c.Source)
+}
+
+func (c *OpCopyMemory) rewriteOperands(f func(Id) Id) {
+	c.Target = f(c.Target)
+	c.Source = f(c.Source)
+}
+
+func (c *OpCopyMemorySized) resultId() (Id, bool) { return 0, false }
+
+func (c *OpCopyMemorySized) setResultId(id Id) bool { return false }
+
+func (c *OpCopyMemorySized) resultType() (Id, bool) { return 0, false }
+
+func (c *OpCopyMemorySized) appendOperands(out []Id) []Id {
+	return append(out, c.Target, c.Source, c.Size)
+}
+
+func (c *OpCopyMemorySized) rewriteOperands(f func(Id) Id) {
+	c.Target = f(c.Target)
+	c.Source = f(c.Source)
+	c.Size = f(c.Size)
+}
+
+func (c *OpAccessChain) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAccessChain) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAccessChain) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAccessChain) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.Base)
+	out = append(out, c.Indices...)
+	return out
+}
+
+func (c *OpAccessChain) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Base = f(c.Base)
+	for i := range c.Indices {
+		c.Indices[i] = f(c.Indices[i])
+	}
+}
+
+func (c *OpInBoundsAccessChain) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpInBoundsAccessChain) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpInBoundsAccessChain) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpInBoundsAccessChain) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.Base)
+	out = append(out, c.Indices...)
+	return out
+}
+
+func (c *OpInBoundsAccessChain) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Base = f(c.Base)
+	for i := range c.Indices {
+		c.Indices[i] = f(c.Indices[i])
+	}
+}
+
+func (c *OpPtrAccessChain) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpPtrAccessChain) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpPtrAccessChain) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpPtrAccessChain) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.Base, c.Element)
+	out = append(out, c.Indices...)
+	return out
+}
+
+func (c *OpPtrAccessChain) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Base = f(c.Base)
+	c.Element = f(c.Element)
+	for i := range c.Indices {
+		c.Indices[i] = f(c.Indices[i])
+	}
+}
+
+func (c *OpArrayLength) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpArrayLength) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpArrayLength) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpArrayLength) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Structure)
+}
+
+func (c *OpArrayLength) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Structure = f(c.Structure)
+}
+
+func (c *OpGenericPtrMemSemantics) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGenericPtrMemSemantics) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGenericPtrMemSemantics) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGenericPtrMemSemantics) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer)
+}
+
+func (c *OpGenericPtrMemSemantics) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+}
+
+func (c *OpInBoundsPtrAccessChain) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpInBoundsPtrAccessChain) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpInBoundsPtrAccessChain) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpInBoundsPtrAccessChain) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.Base, c.Element)
+	out = append(out, c.Indices...)
+	return out
+}
+
+func (c *OpInBoundsPtrAccessChain) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Base = f(c.Base)
+	c.Element = f(c.Element)
+	for i := range c.Indices {
+		c.Indices[i] = f(c.Indices[i])
+	}
+}
+
+func (c *OpDecorate) resultId() (Id, bool) { return 0, false }
+
+func (c *OpDecorate) setResultId(id Id) bool { return false }
+
+func (c *OpDecorate) resultType() (Id, bool) { return 0, false }
+
+func (c *OpDecorate) appendOperands(out []Id) []Id {
+	return append(out, c.Target)
+}
+
+func (c *OpDecorate) rewriteOperands(f func(Id) Id) {
+	c.Target = f(c.Target)
+}
+
+func (c *OpMemberDecorate) resultId() (Id, bool) { return 0, false }
+
+func (c *OpMemberDecorate) setResultId(id Id) bool { return false }
+
+func (c *OpMemberDecorate) resultType() (Id, bool) { return 0, false }
+
+func (c *OpMemberDecorate) appendOperands(out []Id) []Id {
+	return append(out, c.StructType)
+}
+
+func (c *OpMemberDecorate) rewriteOperands(f func(Id) Id) {
+	c.StructType = f(c.StructType)
+}
+
+func (c *OpDecorationGroup) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpDecorationGroup) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpDecorationGroup) resultType() (Id, bool) { return 0, false }
+
+func (c *OpDecorationGroup) appendOperands(out []Id) []Id { return out }
+
+func (c *OpDecorationGroup) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpGroupDecorate) resultId() (Id, bool) { return 0, false }
+
+func (c *OpGroupDecorate) setResultId(id Id) bool { return false }
+
+func (c *OpGroupDecorate) resultType() (Id, bool) { return 0, false }
+
+func (c *OpGroupDecorate) appendOperands(out []Id) []Id {
+	out = append(out, c.Group)
+	out = append(out, c.Targets...)
+	return out
+}
+
+func (c *OpGroupDecorate) rewriteOperands(f func(Id) Id) {
+	c.Group = f(c.Group)
+	for i := range c.Targets {
+		c.Targets[i] = f(c.Targets[i])
+	}
+}
+
+func (c *OpGroupMemberDecorate) resultId() (Id, bool) { return 0, false }
+
+func (c *OpGroupMemberDecorate) setResultId(id Id) bool { return false }
+
+func (c *OpGroupMemberDecorate) resultType() (Id, bool) { return 0, false }
+
+func (c *OpGroupMemberDecorate) appendOperands(out []Id) []Id {
+	out = append(out, c.Group)
+	for i := 0; i < len(c.Targets); i += 2 {
+		out = append(out, Id(c.Targets[i]))
+	}
+	return out
+}
+
+func (c *OpGroupMemberDecorate) rewriteOperands(f func(Id) Id) {
+	c.Group = f(c.Group)
+	for i := 0; i < len(c.Targets); i += 2 {
+		c.Targets[i] = uint32(f(Id(c.Targets[i])))
+	}
+}
+
+func (c *OpVectorExtractDynamic) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpVectorExtractDynamic) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpVectorExtractDynamic) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpVectorExtractDynamic) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Vector, c.Index)
+}
+
+func (c *OpVectorExtractDynamic) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Vector = f(c.Vector)
+	c.Index = f(c.Index)
+}
+
+func (c *OpVectorInsertDynamic) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpVectorInsertDynamic) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpVectorInsertDynamic) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpVectorInsertDynamic) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Vector, c.Component, c.Index)
+}
+
+func (c *OpVectorInsertDynamic) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Vector = f(c.Vector)
+	c.Component = f(c.Component)
+	c.Index = f(c.Index)
+}
+
+func (c *OpVectorShuffle) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpVectorShuffle) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpVectorShuffle) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpVectorShuffle) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Vector1, c.Vector2)
+}
+
+func (c *OpVectorShuffle) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Vector1 = f(c.Vector1)
+	c.Vector2 = f(c.Vector2)
+}
+
+func (c *OpCompositeConstruct) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpCompositeConstruct) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpCompositeConstruct) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpCompositeConstruct) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType)
+	out = append(out, c.Constituents...)
+	return out
+}
+
+func (c *OpCompositeConstruct) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	for i := range c.Constituents {
+		c.Constituents[i] = f(c.Constituents[i])
+	}
+}
+
+func (c *OpCompositeExtract) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpCompositeExtract) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpCompositeExtract) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpCompositeExtract) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Composite)
+}
+
+func (c *OpCompositeExtract) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Composite = f(c.Composite)
+}
+
+func (c *OpCompositeInsert) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpCompositeInsert) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpCompositeInsert) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpCompositeInsert) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Object, c.Composite)
+}
+
+func (c *OpCompositeInsert) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Object = f(c.Object)
+	c.Composite = f(c.Composite)
+}
+
+func (c *OpCopyObject) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpCopyObject) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpCopyObject) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpCopyObject) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand)
+}
+
+func (c *OpCopyObject) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand = f(c.Operand)
+}
+
+func (c *OpTranspose) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpTranspose) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpTranspose) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpTranspose) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Matrix)
+}
+
+func (c *OpTranspose) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Matrix = f(c.Matrix)
+}
+
+func (c *OpSampledImage) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSampledImage) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSampledImage) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSampledImage) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Image, c.Sampler)
+}
+
+func (c *OpSampledImage) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Image = f(c.Image)
+	c.Sampler = f(c.Sampler)
+}
+
+func (c *OpImageSampleImplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSampleImplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSampleImplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSampleImplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSampleImplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSampleExplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSampleExplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSampleExplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSampleExplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSampleExplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSampleDrefImplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSampleDrefImplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSampleDrefImplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSampleDrefImplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate, c.Dref)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSampleDrefImplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	c.Dref = f(c.Dref)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSampleDrefExplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSampleDrefExplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSampleDrefExplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSampleDrefExplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate, c.Dref)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSampleDrefExplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	c.Dref = f(c.Dref)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSampleProjImplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSampleProjImplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSampleProjImplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSampleProjImplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSampleProjImplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSampleProjExplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSampleProjExplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSampleProjExplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSampleProjExplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSampleProjExplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSampleProjDrefImplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSampleProjDrefImplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSampleProjDrefImplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSampleProjDrefImplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate, c.Dref)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSampleProjDrefImplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	c.Dref = f(c.Dref)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSampleProjDrefExplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSampleProjDrefExplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSampleProjDrefExplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSampleProjDrefExplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate, c.Dref)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSampleProjDrefExplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	c.Dref = f(c.Dref)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageFetch) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageFetch) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageFetch) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageFetch) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.Image, c.Coordinate)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageFetch) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Image = f(c.Image)
+	c.Coordinate = f(c.Coordinate)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageGather) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageGather) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageGather) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageGather) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate, c.Component)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageGather) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	c.Component = f(c.Component)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageDrefGather) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageDrefGather) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageDrefGather) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageDrefGather) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate, c.Dref)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageDrefGather) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	c.Dref = f(c.Dref)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageRead) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageRead) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageRead) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageRead) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.Image, c.Coordinate)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageRead) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Image = f(c.Image)
+	c.Coordinate = f(c.Coordinate)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageWrite) resultId() (Id, bool) { return 0, false }
+
+func (c *OpImageWrite) setResultId(id Id) bool { return false }
+
+func (c *OpImageWrite) resultType() (Id, bool) { return 0, false }
+
+func (c *OpImageWrite) appendOperands(out []Id) []Id {
+	out = append(out, c.Image, c.Coordinate, c.Texel)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageWrite) rewriteOperands(f func(Id) Id) {
+	c.Image = f(c.Image)
+	c.Coordinate = f(c.Coordinate)
+	c.Texel = f(c.Texel)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImage) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImage) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImage) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImage) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.SampledImage)
+}
+
+func (c *OpImage) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+}
+
+func (c *OpImageQueryFormat) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageQueryFormat) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageQueryFormat) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageQueryFormat) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Image)
+}
+
+func (c *OpImageQueryFormat) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Image = f(c.Image)
+}
+
+func (c *OpImageQueryOrder) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageQueryOrder) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageQueryOrder) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageQueryOrder) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Image)
+}
+
+func (c *OpImageQueryOrder) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Image = f(c.Image)
+}
+
+func (c *OpImageQuerySizeLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageQuerySizeLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageQuerySizeLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageQuerySizeLod) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Image, c.LevelOfDetail)
+}
+
+func (c *OpImageQuerySizeLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Image = f(c.Image)
+	c.LevelOfDetail = f(c.LevelOfDetail)
+}
+
+func (c *OpImageQuerySize) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageQuerySize) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageQuerySize) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageQuerySize) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Image)
+}
+
+func (c *OpImageQuerySize) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Image = f(c.Image)
+}
+
+func (c *OpImageQueryLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageQueryLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageQueryLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageQueryLod) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.SampledImage, c.Coordinate)
+}
+
+func (c *OpImageQueryLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+}
+
+func (c *OpImageQueryLevels) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageQueryLevels) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageQueryLevels) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageQueryLevels) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Image)
+}
+
+func (c *OpImageQueryLevels) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Image = f(c.Image)
+}
+
+func (c *OpImageQuerySamples) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageQuerySamples) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageQuerySamples) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageQuerySamples) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Image)
+}
+
+func (c *OpImageQuerySamples) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Image = f(c.Image)
+}
+
+func (c *OpConvertFToU) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpConvertFToU) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpConvertFToU) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpConvertFToU) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.FloatValue)
+}
+
+func (c *OpConvertFToU) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.FloatValue = f(c.FloatValue)
+}
+
+func (c *OpConvertFToS) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpConvertFToS) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpConvertFToS) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpConvertFToS) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.FloatValue)
+}
+
+func (c *OpConvertFToS) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.FloatValue = f(c.FloatValue)
+}
+
+func (c *OpConvertSToF) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpConvertSToF) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpConvertSToF) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpConvertSToF) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.SignedValue)
+}
+
+func (c *OpConvertSToF) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SignedValue = f(c.SignedValue)
+}
+
+func (c *OpConvertUToF) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpConvertUToF) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpConvertUToF) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpConvertUToF) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.UnsignedValue)
+}
+
+func (c *OpConvertUToF) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.UnsignedValue = f(c.UnsignedValue)
+}
+
+func (c *OpUConvert) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpUConvert) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpUConvert) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpUConvert) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.UnsignedValue)
+}
+
+func (c *OpUConvert) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.UnsignedValue = f(c.UnsignedValue)
+}
+
+func (c *OpSConvert) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSConvert) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSConvert) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSConvert) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.SignedValue)
+}
+
+func (c *OpSConvert) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SignedValue = f(c.SignedValue)
+}
+
+func (c *OpFConvert) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFConvert) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFConvert) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFConvert) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.FloatValue)
+}
+
+func (c *OpFConvert) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.FloatValue = f(c.FloatValue)
+}
+
+func (c *OpQuantizeToF16) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpQuantizeToF16) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpQuantizeToF16) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpQuantizeToF16) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Value)
+}
+
+func (c *OpQuantizeToF16) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Value = f(c.Value)
+}
+
+func (c *OpConvertPtrToU) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpConvertPtrToU) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpConvertPtrToU) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpConvertPtrToU) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer)
+}
+
+func (c *OpConvertPtrToU) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+}
+
+func (c *OpSatConvertSToU) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSatConvertSToU) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSatConvertSToU) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSatConvertSToU) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.SignedValue)
+}
+
+func (c *OpSatConvertSToU) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SignedValue = f(c.SignedValue)
+}
+
+func (c *OpSatConvertUToS) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSatConvertUToS) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSatConvertUToS) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSatConvertUToS) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.UnsignedValue)
+}
+
+func (c *OpSatConvertUToS) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.UnsignedValue = f(c.UnsignedValue)
+}
+
+func (c *OpConvertUToPtr) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpConvertUToPtr) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpConvertUToPtr) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpConvertUToPtr) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.IntegerValue)
+}
+
+func (c *OpConvertUToPtr) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.IntegerValue = f(c.IntegerValue)
+}
+
+func (c *OpPtrCastToGeneric) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpPtrCastToGeneric) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpPtrCastToGeneric) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpPtrCastToGeneric) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer)
+}
+
+func (c *OpPtrCastToGeneric) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+}
+
+func (c *OpGenericCastToPtr) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGenericCastToPtr) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGenericCastToPtr) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGenericCastToPtr) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer)
+}
+
+func (c *OpGenericCastToPtr) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+}
+
+func (c *OpGenericCastToPtrExplicit) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGenericCastToPtrExplicit) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGenericCastToPtrExplicit) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGenericCastToPtrExplicit) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer)
+}
+
+func (c *OpGenericCastToPtrExplicit) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+}
+
+func (c *OpBitcast) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpBitcast) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpBitcast) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpBitcast) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand)
+}
+
+func (c *OpBitcast) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand = f(c.Operand)
+}
+
+func (c *OpSNegate) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSNegate) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSNegate) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSNegate) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand)
+}
+
+func (c *OpSNegate) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand = f(c.Operand)
+}
+
+func (c *OpFNegate) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFNegate) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFNegate) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFNegate) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand)
+}
+
+func (c *OpFNegate) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand = f(c.Operand)
+}
+
+func (c *OpIAdd) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpIAdd) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpIAdd) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpIAdd) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpIAdd) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFAdd) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFAdd) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFAdd) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFAdd) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFAdd) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpISub) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpISub) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpISub) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpISub) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpISub) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFSub) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFSub) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFSub) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFSub) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFSub) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpIMul) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpIMul) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpIMul) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpIMul) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpIMul) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFMul) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFMul) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFMul) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFMul) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFMul) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpUDiv) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpUDiv) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpUDiv) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpUDiv) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpUDiv) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpSDiv) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSDiv) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSDiv) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSDiv) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpSDiv) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFDiv) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFDiv) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFDiv) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFDiv) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFDiv) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpUMod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpUMod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpUMod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpUMod) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpUMod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpSRem) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSRem) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSRem) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSRem) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpSRem) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpSMod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSMod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSMod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSMod) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpSMod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFRem) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFRem) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFRem) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFRem) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFRem) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFMod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFMod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFMod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFMod) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFMod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpVectorTimesScalar) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpVectorTimesScalar) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpVectorTimesScalar) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpVectorTimesScalar) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Vector, c.Scalar)
+}
+
+func (c *OpVectorTimesScalar) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Vector = f(c.Vector)
+	c.Scalar = f(c.Scalar)
+}
+
+func (c *OpMatrixTimesScalar) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpMatrixTimesScalar) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpMatrixTimesScalar) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpMatrixTimesScalar) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Matrix, c.Scalar)
+}
+
+func (c *OpMatrixTimesScalar) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Matrix = f(c.Matrix)
+	c.Scalar = f(c.Scalar)
+}
+
+func (c *OpVectorTimesMatrix) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpVectorTimesMatrix) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpVectorTimesMatrix) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpVectorTimesMatrix) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Vector, c.Matrix)
+}
+
+func (c *OpVectorTimesMatrix) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Vector = f(c.Vector)
+	c.Matrix = f(c.Matrix)
+}
+
+func (c *OpMatrixTimesVector) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpMatrixTimesVector) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpMatrixTimesVector) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpMatrixTimesVector) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Matrix, c.Vector)
+}
+
+func (c *OpMatrixTimesVector) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Matrix = f(c.Matrix)
+	c.Vector = f(c.Vector)
+}
+
+func (c *OpMatrixTimesMatrix) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpMatrixTimesMatrix) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpMatrixTimesMatrix) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpMatrixTimesMatrix) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.LeftMatrix, c.RightMatrix)
+}
+
+func (c *OpMatrixTimesMatrix) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.LeftMatrix = f(c.LeftMatrix)
+	c.RightMatrix = f(c.RightMatrix)
+}
+
+func (c *OpOuterProduct) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpOuterProduct) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpOuterProduct) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpOuterProduct) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Vector1, c.Vector2)
+}
+
+func (c *OpOuterProduct) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Vector1 = f(c.Vector1)
+	c.Vector2 = f(c.Vector2)
+}
+
+func (c *OpDot) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpDot) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpDot) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpDot) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Vector1, c.Vector2)
+}
+
+func (c *OpDot) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Vector1 = f(c.Vector1)
+	c.Vector2 = f(c.Vector2)
+}
+
+func (c *OpIAddCarry) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpIAddCarry) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpIAddCarry) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpIAddCarry) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpIAddCarry) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpISubBorrow) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpISubBorrow) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpISubBorrow) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpISubBorrow) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpISubBorrow) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpUMulExtended) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpUMulExtended) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpUMulExtended) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpUMulExtended) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpUMulExtended) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpSMulExtended) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSMulExtended) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSMulExtended) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSMulExtended) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpSMulExtended) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpAny) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAny) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAny) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAny) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Vector)
+}
+
+func (c *OpAny) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Vector = f(c.Vector)
+}
+
+func (c *OpAll) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAll) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAll) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAll) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Vector)
+}
+
+func (c *OpAll) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Vector = f(c.Vector)
+}
+
+func (c *OpIsNan) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpIsNan) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpIsNan) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpIsNan) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.X)
+}
+
+func (c *OpIsNan) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.X = f(c.X)
+}
+
+func (c *OpIsInf) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpIsInf) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpIsInf) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpIsInf) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.X)
+}
+
+func (c *OpIsInf) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.X = f(c.X)
+}
+
+func (c *OpIsFinite) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpIsFinite) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpIsFinite) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpIsFinite) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.X)
+}
+
+func (c *OpIsFinite) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.X = f(c.X)
+}
+
+func (c *OpIsNormal) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpIsNormal) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpIsNormal) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpIsNormal) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.X)
+}
+
+func (c *OpIsNormal) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.X = f(c.X)
+}
+
+func (c *OpSignBitSet) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSignBitSet) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSignBitSet) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSignBitSet) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.X)
+}
+
+func (c *OpSignBitSet) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.X = f(c.X)
+}
+
+func (c *OpLessOrGreater) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpLessOrGreater) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpLessOrGreater) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpLessOrGreater) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.X, c.Y)
+}
+
+func (c *OpLessOrGreater) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.X = f(c.X)
+	c.Y = f(c.Y)
+}
+
+func (c *OpOrdered) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpOrdered) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpOrdered) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpOrdered) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.X, c.Y)
+}
+
+func (c *OpOrdered) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.X = f(c.X)
+	c.Y = f(c.Y)
+}
+
+func (c *OpUnordered) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpUnordered) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpUnordered) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpUnordered) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.X, c.Y)
+}
+
+func (c *OpUnordered) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.X = f(c.X)
+	c.Y = f(c.Y)
+}
+
+func (c *OpLogicalEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpLogicalEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpLogicalEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpLogicalEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpLogicalEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpLogicalNotEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpLogicalNotEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpLogicalNotEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpLogicalNotEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpLogicalNotEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpLogicalOr) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpLogicalOr) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpLogicalOr) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpLogicalOr) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpLogicalOr) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpLogicalAnd) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpLogicalAnd) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpLogicalAnd) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpLogicalAnd) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpLogicalAnd) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpLogicalNot) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpLogicalNot) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpLogicalNot) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpLogicalNot) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand)
+}
+
+func (c *OpLogicalNot) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand = f(c.Operand)
+}
+
+func (c *OpSelect) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSelect) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSelect) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSelect) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Condition, c.Object1, c.Object2)
+}
+
+func (c *OpSelect) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Condition = f(c.Condition)
+	c.Object1 = f(c.Object1)
+	c.Object2 = f(c.Object2)
+}
+
+func (c *OpIEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpIEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpIEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpIEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpIEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpINotEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpINotEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpINotEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpINotEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpINotEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpUGreaterThan) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpUGreaterThan) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpUGreaterThan) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpUGreaterThan) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpUGreaterThan) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpSGreaterThan) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSGreaterThan) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSGreaterThan) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSGreaterThan) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpSGreaterThan) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpUGreaterThanEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpUGreaterThanEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpUGreaterThanEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpUGreaterThanEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpUGreaterThanEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpSGreaterThanEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSGreaterThanEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSGreaterThanEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSGreaterThanEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpSGreaterThanEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpULessThan) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpULessThan) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpULessThan) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpULessThan) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpULessThan) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpSLessThan) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSLessThan) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSLessThan) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSLessThan) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpSLessThan) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpULessThanEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpULessThanEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpULessThanEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpULessThanEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpULessThanEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpSLessThanEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpSLessThanEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpSLessThanEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpSLessThanEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpSLessThanEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFOrdEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFOrdEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFOrdEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFOrdEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFOrdEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFUnordEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFUnordEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFUnordEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFUnordEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFUnordEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFOrdNotEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFOrdNotEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFOrdNotEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFOrdNotEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFOrdNotEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFUnordNotEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFUnordNotEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFUnordNotEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFUnordNotEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFUnordNotEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFOrdLessThan) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFOrdLessThan) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFOrdLessThan) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFOrdLessThan) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFOrdLessThan) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFUnordLessThan) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFUnordLessThan) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFUnordLessThan) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFUnordLessThan) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFUnordLessThan) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFOrdGreaterThan) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFOrdGreaterThan) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFOrdGreaterThan) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFOrdGreaterThan) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFOrdGreaterThan) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFUnordGreaterThan) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFUnordGreaterThan) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFUnordGreaterThan) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFUnordGreaterThan) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFUnordGreaterThan) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFOrdLessThanEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFOrdLessThanEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFOrdLessThanEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFOrdLessThanEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFOrdLessThanEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFUnordLessThanEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFUnordLessThanEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFUnordLessThanEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFUnordLessThanEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFUnordLessThanEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFOrdGreaterThanEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFOrdGreaterThanEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFOrdGreaterThanEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFOrdGreaterThanEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFOrdGreaterThanEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpFUnordGreaterThanEqual) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFUnordGreaterThanEqual) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFUnordGreaterThanEqual) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFUnordGreaterThanEqual) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpFUnordGreaterThanEqual) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpShiftRightLogical) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpShiftRightLogical) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpShiftRightLogical) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpShiftRightLogical) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Base, c.Shift)
+}
+
+func (c *OpShiftRightLogical) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Base = f(c.Base)
+	c.Shift = f(c.Shift)
+}
+
+func (c *OpShiftRightArithmetic) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpShiftRightArithmetic) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpShiftRightArithmetic) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpShiftRightArithmetic) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Base, c.Shift)
+}
+
+func (c *OpShiftRightArithmetic) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Base = f(c.Base)
+	c.Shift = f(c.Shift)
+}
+
+func (c *OpShiftLeftLogical) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpShiftLeftLogical) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpShiftLeftLogical) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpShiftLeftLogical) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Base, c.Shift)
+}
+
+func (c *OpShiftLeftLogical) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Base = f(c.Base)
+	c.Shift = f(c.Shift)
+}
+
+func (c *OpBitwiseOr) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpBitwiseOr) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpBitwiseOr) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpBitwiseOr) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpBitwiseOr) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpBitwiseXor) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpBitwiseXor) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpBitwiseXor) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpBitwiseXor) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpBitwiseXor) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpBitwiseAnd) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpBitwiseAnd) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpBitwiseAnd) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpBitwiseAnd) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand1, c.Operand2)
+}
+
+func (c *OpBitwiseAnd) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand1 = f(c.Operand1)
+	c.Operand2 = f(c.Operand2)
+}
+
+func (c *OpNot) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpNot) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpNot) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpNot) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Operand)
+}
+
+func (c *OpNot) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Operand = f(c.Operand)
+}
+
+func (c *OpBitFieldInsert) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpBitFieldInsert) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpBitFieldInsert) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpBitFieldInsert) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Base, c.Insert, c.Offset, c.Count)
+}
+
+func (c *OpBitFieldInsert) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Base = f(c.Base)
+	c.Insert = f(c.Insert)
+	c.Offset = f(c.Offset)
+	c.Count = f(c.Count)
+}
+
+func (c *OpBitFieldSExtract) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpBitFieldSExtract) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpBitFieldSExtract) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpBitFieldSExtract) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Base, c.Offset, c.Count)
+}
+
+func (c *OpBitFieldSExtract) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Base = f(c.Base)
+	c.Offset = f(c.Offset)
+	c.Count = f(c.Count)
+}
+
+func (c *OpBitFieldUExtract) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpBitFieldUExtract) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpBitFieldUExtract) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpBitFieldUExtract) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Base, c.Offset, c.Count)
+}
+
+func (c *OpBitFieldUExtract) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Base = f(c.Base)
+	c.Offset = f(c.Offset)
+	c.Count = f(c.Count)
+}
+
+func (c *OpBitReverse) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpBitReverse) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpBitReverse) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpBitReverse) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Base)
+}
+
+func (c *OpBitReverse) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Base = f(c.Base)
+}
+
+func (c *OpBitCount) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpBitCount) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpBitCount) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpBitCount) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Base)
+}
+
+func (c *OpBitCount) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Base = f(c.Base)
+}
+
+func (c *OpDPdx) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpDPdx) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpDPdx) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpDPdx) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.P)
+}
+
+func (c *OpDPdx) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.P = f(c.P)
+}
+
+func (c *OpDPdy) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpDPdy) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpDPdy) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpDPdy) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.P)
+}
+
+func (c *OpDPdy) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.P = f(c.P)
+}
+
+func (c *OpFwidth) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFwidth) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFwidth) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFwidth) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.P)
+}
+
+func (c *OpFwidth) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.P = f(c.P)
+}
+
+func (c *OpDPdxFine) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpDPdxFine) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpDPdxFine) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpDPdxFine) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.P)
+}
+
+func (c *OpDPdxFine) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.P = f(c.P)
+}
+
+func (c *OpDPdyFine) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpDPdyFine) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpDPdyFine) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpDPdyFine) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.P)
+}
+
+func (c *OpDPdyFine) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.P = f(c.P)
+}
+
+func (c *OpFwidthFine) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFwidthFine) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFwidthFine) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFwidthFine) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.P)
+}
+
+func (c *OpFwidthFine) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.P = f(c.P)
+}
+
+func (c *OpDPdxCoarse) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpDPdxCoarse) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpDPdxCoarse) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpDPdxCoarse) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.P)
+}
+
+func (c *OpDPdxCoarse) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.P = f(c.P)
+}
+
+func (c *OpDPdyCoarse) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpDPdyCoarse) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpDPdyCoarse) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpDPdyCoarse) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.P)
+}
+
+func (c *OpDPdyCoarse) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.P = f(c.P)
+}
+
+func (c *OpFwidthCoarse) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpFwidthCoarse) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpFwidthCoarse) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpFwidthCoarse) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.P)
+}
+
+func (c *OpFwidthCoarse) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.P = f(c.P)
+}
+
+func (c *OpEmitVertex) resultId() (Id, bool) { return 0, false }
+
+func (c *OpEmitVertex) setResultId(id Id) bool { return false }
+
+func (c *OpEmitVertex) resultType() (Id, bool) { return 0, false }
+
+func (c *OpEmitVertex) appendOperands(out []Id) []Id { return out }
+
+func (c *OpEmitVertex) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpEndPrimitive) resultId() (Id, bool) { return 0, false }
+
+func (c *OpEndPrimitive) setResultId(id Id) bool { return false }
+
+func (c *OpEndPrimitive) resultType() (Id, bool) { return 0, false }
+
+func (c *OpEndPrimitive) appendOperands(out []Id) []Id { return out }
+
+func (c *OpEndPrimitive) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpEmitStreamVertex) resultId() (Id, bool) { return 0, false }
+
+func (c *OpEmitStreamVertex) setResultId(id Id) bool { return false }
+
+func (c *OpEmitStreamVertex) resultType() (Id, bool) { return 0, false }
+
+func (c *OpEmitStreamVertex) appendOperands(out []Id) []Id {
+	return append(out, c.Stream)
+}
+
+func (c *OpEmitStreamVertex) rewriteOperands(f func(Id) Id) {
+	c.Stream = f(c.Stream)
+}
+
+func (c *OpEndStreamPrimitive) resultId() (Id, bool) { return 0, false }
+
+func (c *OpEndStreamPrimitive) setResultId(id Id) bool { return false }
+
+func (c *OpEndStreamPrimitive) resultType() (Id, bool) { return 0, false }
+
+func (c *OpEndStreamPrimitive) appendOperands(out []Id) []Id {
+	return append(out, c.Stream)
+}
+
+func (c *OpEndStreamPrimitive) rewriteOperands(f func(Id) Id) {
+	c.Stream = f(c.Stream)
+}
+
+func (c *OpControlBarrier) resultId() (Id, bool) { return 0, false }
+
+func (c *OpControlBarrier) setResultId(id Id) bool { return false }
+
+func (c *OpControlBarrier) resultType() (Id, bool) { return 0, false }
+
+func (c *OpControlBarrier) appendOperands(out []Id) []Id {
+	return append(out, c.Execution, c.Memory, c.Semantics)
+}
+
+func (c *OpControlBarrier) rewriteOperands(f func(Id) Id) {
+	c.Execution = f(c.Execution)
+	c.Memory = f(c.Memory)
+	c.Semantics = f(c.Semantics)
+}
+
+func (c *OpMemoryBarrier) resultId() (Id, bool) { return 0, false }
+
+func (c *OpMemoryBarrier) setResultId(id Id) bool { return false }
+
+func (c *OpMemoryBarrier) resultType() (Id, bool) { return 0, false }
+
+func (c *OpMemoryBarrier) appendOperands(out []Id) []Id {
+	return append(out, c.Memory, c.Semantics)
+}
+
+func (c *OpMemoryBarrier) rewriteOperands(f func(Id) Id) {
+	c.Memory = f(c.Memory)
+	c.Semantics = f(c.Semantics)
+}
+
+func (c *OpAtomicLoad) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicLoad) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicLoad) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicLoad) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics)
+}
+
+func (c *OpAtomicLoad) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+}
+
+func (c *OpAtomicStore) resultId() (Id, bool) { return 0, false }
+
+func (c *OpAtomicStore) setResultId(id Id) bool { return false }
+
+func (c *OpAtomicStore) resultType() (Id, bool) { return 0, false }
+
+func (c *OpAtomicStore) appendOperands(out []Id) []Id {
+	return append(out, c.Pointer, c.Scope, c.Semantics, c.Value)
+}
+
+func (c *OpAtomicStore) rewriteOperands(f func(Id) Id) {
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+	c.Value = f(c.Value)
+}
+
+func (c *OpAtomicExchange) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicExchange) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicExchange) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicExchange) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics, c.Value)
+}
+
+func (c *OpAtomicExchange) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+	c.Value = f(c.Value)
+}
+
+func (c *OpAtomicCompareExchange) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicCompareExchange) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicCompareExchange) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicCompareExchange) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Equal, c.Unequal, c.Value, c.Comparator)
+}
+
+func (c *OpAtomicCompareExchange) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Equal = f(c.Equal)
+	c.Unequal = f(c.Unequal)
+	c.Value = f(c.Value)
+	c.Comparator = f(c.Comparator)
+}
+
+func (c *OpAtomicCompareExchangeWeak) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicCompareExchangeWeak) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicCompareExchangeWeak) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicCompareExchangeWeak) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Equal, c.Unequal, c.Value, c.Comparator)
+}
+
+func (c *OpAtomicCompareExchangeWeak) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Equal = f(c.Equal)
+	c.Unequal = f(c.Unequal)
+	c.Value = f(c.Value)
+	c.Comparator = f(c.Comparator)
+}
+
+func (c *OpAtomicIIncrement) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicIIncrement) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicIIncrement) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicIIncrement) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics)
+}
+
+func (c *OpAtomicIIncrement) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+}
+
+func (c *OpAtomicIDecrement) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicIDecrement) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicIDecrement) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicIDecrement) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics)
+}
+
+func (c *OpAtomicIDecrement) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+}
+
+func (c *OpAtomicIAdd) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicIAdd) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicIAdd) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicIAdd) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics, c.Value)
+}
+
+func (c *OpAtomicIAdd) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+	c.Value = f(c.Value)
+}
+
+func (c *OpAtomicISub) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicISub) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicISub) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicISub) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics, c.Value)
+}
+
+func (c *OpAtomicISub) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+	c.Value = f(c.Value)
+}
+
+func (c *OpAtomicSMin) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicSMin) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicSMin) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicSMin) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics, c.Value)
+}
+
+func (c *OpAtomicSMin) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+	c.Value = f(c.Value)
+}
+
+func (c *OpAtomicUMin) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicUMin) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicUMin) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicUMin) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics, c.Value)
+}
+
+func (c *OpAtomicUMin) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+	c.Value = f(c.Value)
+}
+
+func (c *OpAtomicSMax) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicSMax) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicSMax) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicSMax) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics, c.Value)
+}
+
+func (c *OpAtomicSMax) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+	c.Value = f(c.Value)
+}
+
+func (c *OpAtomicUMax) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicUMax) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicUMax) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicUMax) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics, c.Value)
+}
+
+func (c *OpAtomicUMax) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+	c.Value = f(c.Value)
+}
+
+func (c *OpAtomicAnd) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicAnd) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicAnd) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicAnd) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics, c.Value)
+}
+
+func (c *OpAtomicAnd) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+	c.Value = f(c.Value)
+}
+
+func (c *OpAtomicOr) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicOr) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicOr) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicOr) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics, c.Value)
+}
+
+func (c *OpAtomicOr) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+	c.Value = f(c.Value)
+}
+
+func (c *OpAtomicXor) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicXor) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicXor) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicXor) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics, c.Value)
+}
+
+func (c *OpAtomicXor) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+	c.Value = f(c.Value)
+}
+
+func (c *OpPhi) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpPhi) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpPhi) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpPhi) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType)
+	out = append(out, c.Operands...)
+	return out
+}
+
+func (c *OpPhi) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	for i := range c.Operands {
+		c.Operands[i] = f(c.Operands[i])
+	}
+}
+
+func (c *OpLoopMerge) resultId() (Id, bool) { return 0, false }
+
+func (c *OpLoopMerge) setResultId(id Id) bool { return false }
+
+func (c *OpLoopMerge) resultType() (Id, bool) { return 0, false }
+
+func (c *OpLoopMerge) appendOperands(out []Id) []Id {
+	return append(out, c.MergeBlock, c.ContinueTarget)
+}
+
+func (c *OpLoopMerge) rewriteOperands(f func(Id) Id) {
+	c.MergeBlock = f(c.MergeBlock)
+	c.ContinueTarget = f(c.ContinueTarget)
+}
+
+func (c *OpSelectionMerge) resultId() (Id, bool) { return 0, false }
+
+func (c *OpSelectionMerge) setResultId(id Id) bool { return false }
+
+func (c *OpSelectionMerge) resultType() (Id, bool) { return 0, false }
+
+func (c *OpSelectionMerge) appendOperands(out []Id) []Id {
+	return append(out, c.MergeBlock)
+}
+
+func (c *OpSelectionMerge) rewriteOperands(f func(Id) Id) {
+	c.MergeBlock = f(c.MergeBlock)
+}
+
+func (c *OpLabel) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpLabel) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpLabel) resultType() (Id, bool) { return 0, false }
+
+func (c *OpLabel) appendOperands(out []Id) []Id { return out }
+
+func (c *OpLabel) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpBranch) resultId() (Id, bool) { return 0, false }
+
+func (c *OpBranch) setResultId(id Id) bool { return false }
+
+func (c *OpBranch) resultType() (Id, bool) { return 0, false }
+
+func (c *OpBranch) appendOperands(out []Id) []Id {
+	return append(out, c.TargetLabel)
+}
+
+func (c *OpBranch) rewriteOperands(f func(Id) Id) {
+	c.TargetLabel = f(c.TargetLabel)
+}
+
+func (c *OpBranchConditional) resultId() (Id, bool) { return 0, false }
+
+func (c *OpBranchConditional) setResultId(id Id) bool { return false }
+
+func (c *OpBranchConditional) resultType() (Id, bool) { return 0, false }
+
+func (c *OpBranchConditional) appendOperands(out []Id) []Id {
+	return append(out, c.Condition, c.TrueLabel, c.FalseLabel)
+}
+
+func (c *OpBranchConditional) rewriteOperands(f func(Id) Id) {
+	c.Condition = f(c.Condition)
+	c.TrueLabel = f(c.TrueLabel)
+	c.FalseLabel = f(c.FalseLabel)
+}
+
+func (c *OpSwitch) resultId() (Id, bool) { return 0, false }
+
+func (c *OpSwitch) setResultId(id Id) bool { return false }
+
+func (c *OpSwitch) resultType() (Id, bool) { return 0, false }
+
+func (c *OpSwitch) appendOperands(out []Id) []Id {
+	out = append(out, c.Selector, c.Default)
+	for i := 1; i < len(c.Target); i += 2 {
+		out = append(out, Id(c.Target[i]))
+	}
+	return out
+}
+
+func (c *OpSwitch) rewriteOperands(f func(Id) Id) {
+	c.Selector = f(c.Selector)
+	c.Default = f(c.Default)
+	for i := 1; i < len(c.Target); i += 2 {
+		c.Target[i] = uint32(f(Id(c.Target[i])))
+	}
+}
+
+func (c *OpKill) resultId() (Id, bool) { return 0, false }
+
+func (c *OpKill) setResultId(id Id) bool { return false }
+
+func (c *OpKill) resultType() (Id, bool) { return 0, false }
+
+func (c *OpKill) appendOperands(out []Id) []Id { return out }
+
+func (c *OpKill) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpReturn) resultId() (Id, bool) { return 0, false }
+
+func (c *OpReturn) setResultId(id Id) bool { return false }
+
+func (c *OpReturn) resultType() (Id, bool) { return 0, false }
+
+func (c *OpReturn) appendOperands(out []Id) []Id { return out }
+
+func (c *OpReturn) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpReturnValue) resultId() (Id, bool) { return 0, false }
+
+func (c *OpReturnValue) setResultId(id Id) bool { return false }
+
+func (c *OpReturnValue) resultType() (Id, bool) { return 0, false }
+
+func (c *OpReturnValue) appendOperands(out []Id) []Id {
+	return append(out, c.Value)
+}
+
+func (c *OpReturnValue) rewriteOperands(f func(Id) Id) {
+	c.Value = f(c.Value)
+}
+
+func (c *OpUnreachable) resultId() (Id, bool) { return 0, false }
+
+func (c *OpUnreachable) setResultId(id Id) bool { return false }
+
+func (c *OpUnreachable) resultType() (Id, bool) { return 0, false }
+
+func (c *OpUnreachable) appendOperands(out []Id) []Id { return out }
+
+func (c *OpUnreachable) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpLifetimeStart) resultId() (Id, bool) { return 0, false }
+
+func (c *OpLifetimeStart) setResultId(id Id) bool { return false }
+
+func (c *OpLifetimeStart) resultType() (Id, bool) { return 0, false }
+
+func (c *OpLifetimeStart) appendOperands(out []Id) []Id {
+	return append(out, c.Pointer)
+}
+
+func (c *OpLifetimeStart) rewriteOperands(f func(Id) Id) {
+	c.Pointer = f(c.Pointer)
+}
+
+func (c *OpLifetimeStop) resultId() (Id, bool) { return 0, false }
+
+func (c *OpLifetimeStop) setResultId(id Id) bool { return false }
+
+func (c *OpLifetimeStop) resultType() (Id, bool) { return 0, false }
+
+func (c *OpLifetimeStop) appendOperands(out []Id) []Id {
+	return append(out, c.Pointer)
+}
+
+func (c *OpLifetimeStop) rewriteOperands(f func(Id) Id) {
+	c.Pointer = f(c.Pointer)
+}
+
+func (c *OpGroupAsyncCopy) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupAsyncCopy) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupAsyncCopy) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupAsyncCopy) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.Destination, c.Source, c.NumElements, c.Stride, c.Event)
+}
+
+func (c *OpGroupAsyncCopy) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.Destination = f(c.Destination)
+	c.Source = f(c.Source)
+	c.NumElements = f(c.NumElements)
+	c.Stride = f(c.Stride)
+	c.Event = f(c.Event)
+}
+
+func (c *OpGroupWaitEvents) resultId() (Id, bool) { return 0, false }
+
+func (c *OpGroupWaitEvents) setResultId(id Id) bool { return false }
+
+func (c *OpGroupWaitEvents) resultType() (Id, bool) { return 0, false }
+
+func (c *OpGroupWaitEvents) appendOperands(out []Id) []Id {
+	return append(out, c.Execution, c.NumEvents, c.EventsList)
+}
+
+func (c *OpGroupWaitEvents) rewriteOperands(f func(Id) Id) {
+	c.Execution = f(c.Execution)
+	c.NumEvents = f(c.NumEvents)
+	c.EventsList = f(c.EventsList)
+}
+
+func (c *OpGroupAll) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupAll) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupAll) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupAll) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.Predicate)
+}
+
+func (c *OpGroupAll) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.Predicate = f(c.Predicate)
+}
+
+func (c *OpGroupAny) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupAny) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupAny) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupAny) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.Predicate)
+}
+
+func (c *OpGroupAny) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.Predicate = f(c.Predicate)
+}
+
+func (c *OpGroupBroadcast) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupBroadcast) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupBroadcast) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupBroadcast) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.Value, c.LocalId)
+}
+
+func (c *OpGroupBroadcast) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.Value = f(c.Value)
+	c.LocalId = f(c.LocalId)
+}
+
+func (c *OpGroupIAdd) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupIAdd) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupIAdd) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupIAdd) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.X)
+}
+
+func (c *OpGroupIAdd) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.X = f(c.X)
+}
+
+func (c *OpGroupFAdd) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupFAdd) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupFAdd) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupFAdd) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.X)
+}
+
+func (c *OpGroupFAdd) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.X = f(c.X)
+}
+
+func (c *OpGroupFMin) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupFMin) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupFMin) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupFMin) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.X)
+}
+
+func (c *OpGroupFMin) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.X = f(c.X)
+}
+
+func (c *OpGroupUMin) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupUMin) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupUMin) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupUMin) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.X)
+}
+
+func (c *OpGroupUMin) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.X = f(c.X)
+}
+
+func (c *OpGroupSMin) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupSMin) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupSMin) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupSMin) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.X)
+}
+
+func (c *OpGroupSMin) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.X = f(c.X)
+}
+
+func (c *OpGroupFMax) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupFMax) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupFMax) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupFMax) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.X)
+}
+
+func (c *OpGroupFMax) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.X = f(c.X)
+}
+
+func (c *OpGroupUMax) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupUMax) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupUMax) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupUMax) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.X)
+}
+
+func (c *OpGroupUMax) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.X = f(c.X)
+}
+
+func (c *OpGroupSMax) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupSMax) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupSMax) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupSMax) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.X)
+}
+
+func (c *OpGroupSMax) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.X = f(c.X)
+}
+
+func (c *OpReadPipe) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpReadPipe) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpReadPipe) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpReadPipe) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pipe, c.Pointer, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpReadPipe) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pipe = f(c.Pipe)
+	c.Pointer = f(c.Pointer)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpWritePipe) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpWritePipe) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpWritePipe) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpWritePipe) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pipe, c.Pointer, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpWritePipe) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pipe = f(c.Pipe)
+	c.Pointer = f(c.Pointer)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpReservedReadPipe) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpReservedReadPipe) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpReservedReadPipe) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpReservedReadPipe) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pipe, c.ReserveId, c.Index, c.Pointer, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpReservedReadPipe) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pipe = f(c.Pipe)
+	c.ReserveId = f(c.ReserveId)
+	c.Index = f(c.Index)
+	c.Pointer = f(c.Pointer)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpReservedWritePipe) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpReservedWritePipe) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpReservedWritePipe) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpReservedWritePipe) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pipe, c.ReserveId, c.Index, c.Pointer, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpReservedWritePipe) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pipe = f(c.Pipe)
+	c.ReserveId = f(c.ReserveId)
+	c.Index = f(c.Index)
+	c.Pointer = f(c.Pointer)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpReserveReadPipePackets) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpReserveReadPipePackets) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpReserveReadPipePackets) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpReserveReadPipePackets) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pipe, c.NumPackets, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpReserveReadPipePackets) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pipe = f(c.Pipe)
+	c.NumPackets = f(c.NumPackets)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpReserveWritePipePackets) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpReserveWritePipePackets) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpReserveWritePipePackets) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpReserveWritePipePackets) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pipe, c.NumPackets, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpReserveWritePipePackets) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pipe = f(c.Pipe)
+	c.NumPackets = f(c.NumPackets)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpCommitReadPipe) resultId() (Id, bool) { return 0, false }
+
+func (c *OpCommitReadPipe) setResultId(id Id) bool { return false }
+
+func (c *OpCommitReadPipe) resultType() (Id, bool) { return 0, false }
+
+func (c *OpCommitReadPipe) appendOperands(out []Id) []Id {
+	return append(out, c.Pipe, c.ReserveId, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpCommitReadPipe) rewriteOperands(f func(Id) Id) {
+	c.Pipe = f(c.Pipe)
+	c.ReserveId = f(c.ReserveId)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpCommitWritePipe) resultId() (Id, bool) { return 0, false }
+
+func (c *OpCommitWritePipe) setResultId(id Id) bool { return false }
+
+func (c *OpCommitWritePipe) resultType() (Id, bool) { return 0, false }
+
+func (c *OpCommitWritePipe) appendOperands(out []Id) []Id {
+	return append(out, c.Pipe, c.ReserveId, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpCommitWritePipe) rewriteOperands(f func(Id) Id) {
+	c.Pipe = f(c.Pipe)
+	c.ReserveId = f(c.ReserveId)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpIsValidReserveId) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpIsValidReserveId) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpIsValidReserveId) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpIsValidReserveId) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.ReserveId)
+}
+
+func (c *OpIsValidReserveId) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.ReserveId = f(c.ReserveId)
+}
+
+func (c *OpGetNumPipePackets) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGetNumPipePackets) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGetNumPipePackets) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGetNumPipePackets) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pipe, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpGetNumPipePackets) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pipe = f(c.Pipe)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpGetMaxPipePackets) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGetMaxPipePackets) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGetMaxPipePackets) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGetMaxPipePackets) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pipe, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpGetMaxPipePackets) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pipe = f(c.Pipe)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpGroupReserveReadPipePackets) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupReserveReadPipePackets) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupReserveReadPipePackets) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupReserveReadPipePackets) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.Pipe, c.NumPackets, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpGroupReserveReadPipePackets) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.Pipe = f(c.Pipe)
+	c.NumPackets = f(c.NumPackets)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpGroupReserveWritePipePackets) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGroupReserveWritePipePackets) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGroupReserveWritePipePackets) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGroupReserveWritePipePackets) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Execution, c.Pipe, c.NumPackets, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpGroupReserveWritePipePackets) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Execution = f(c.Execution)
+	c.Pipe = f(c.Pipe)
+	c.NumPackets = f(c.NumPackets)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpGroupCommitReadPipe) resultId() (Id, bool) { return 0, false }
+
+func (c *OpGroupCommitReadPipe) setResultId(id Id) bool { return false }
+
+func (c *OpGroupCommitReadPipe) resultType() (Id, bool) { return 0, false }
+
+func (c *OpGroupCommitReadPipe) appendOperands(out []Id) []Id {
+	return append(out, c.Execution, c.Pipe, c.ReserveId, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpGroupCommitReadPipe) rewriteOperands(f func(Id) Id) {
+	c.Execution = f(c.Execution)
+	c.Pipe = f(c.Pipe)
+	c.ReserveId = f(c.ReserveId)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpGroupCommitWritePipe) resultId() (Id, bool) { return 0, false }
+
+func (c *OpGroupCommitWritePipe) setResultId(id Id) bool { return false }
+
+func (c *OpGroupCommitWritePipe) resultType() (Id, bool) { return 0, false }
+
+func (c *OpGroupCommitWritePipe) appendOperands(out []Id) []Id {
+	return append(out, c.Execution, c.Pipe, c.ReserveId, c.PacketSize, c.PacketAlignment)
+}
+
+func (c *OpGroupCommitWritePipe) rewriteOperands(f func(Id) Id) {
+	c.Execution = f(c.Execution)
+	c.Pipe = f(c.Pipe)
+	c.ReserveId = f(c.ReserveId)
+	c.PacketSize = f(c.PacketSize)
+	c.PacketAlignment = f(c.PacketAlignment)
+}
+
+func (c *OpEnqueueMarker) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpEnqueueMarker) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpEnqueueMarker) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpEnqueueMarker) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Queue, c.NumEvents, c.WaitEvents, c.RetEvent)
+}
+
+func (c *OpEnqueueMarker) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Queue = f(c.Queue)
+	c.NumEvents = f(c.NumEvents)
+	c.WaitEvents = f(c.WaitEvents)
+	c.RetEvent = f(c.RetEvent)
+}
+
+func (c *OpEnqueueKernel) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpEnqueueKernel) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpEnqueueKernel) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpEnqueueKernel) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.Queue, c.Flags, c.NDRange, c.NumEvents, c.WaitEvents, c.RetEvent, c.Invoke, c.Param, c.ParamSize, c.ParamAlign)
+	out = append(out, c.LocalSize...)
+	return out
+}
+
+func (c *OpEnqueueKernel) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Queue = f(c.Queue)
+	c.Flags = f(c.Flags)
+	c.NDRange = f(c.NDRange)
+	c.NumEvents = f(c.NumEvents)
+	c.WaitEvents = f(c.WaitEvents)
+	c.RetEvent = f(c.RetEvent)
+	c.Invoke = f(c.Invoke)
+	c.Param = f(c.Param)
+	c.ParamSize = f(c.ParamSize)
+	c.ParamAlign = f(c.ParamAlign)
+	for i := range c.LocalSize {
+		c.LocalSize[i] = f(c.LocalSize[i])
+	}
+}
+
+func (c *OpGetKernelNDrangeSubGroupCount) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGetKernelNDrangeSubGroupCount) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGetKernelNDrangeSubGroupCount) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGetKernelNDrangeSubGroupCount) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.NDRange, c.Invoke, c.Param, c.ParamSize, c.ParamAlign)
+}
+
+func (c *OpGetKernelNDrangeSubGroupCount) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.NDRange = f(c.NDRange)
+	c.Invoke = f(c.Invoke)
+	c.Param = f(c.Param)
+	c.ParamSize = f(c.ParamSize)
+	c.ParamAlign = f(c.ParamAlign)
+}
+
+func (c *OpGetKernelNDrangeMaxSubGroupSize) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGetKernelNDrangeMaxSubGroupSize) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGetKernelNDrangeMaxSubGroupSize) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGetKernelNDrangeMaxSubGroupSize) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.NDRange, c.Invoke, c.Param, c.ParamSize, c.ParamAlign)
+}
+
+func (c *OpGetKernelNDrangeMaxSubGroupSize) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.NDRange = f(c.NDRange)
+	c.Invoke = f(c.Invoke)
+	c.Param = f(c.Param)
+	c.ParamSize = f(c.ParamSize)
+	c.ParamAlign = f(c.ParamAlign)
+}
+
+func (c *OpGetKernelWorkGroupSize) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGetKernelWorkGroupSize) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGetKernelWorkGroupSize) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGetKernelWorkGroupSize) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Invoke, c.Param, c.ParamSize, c.ParamAlign)
+}
+
+func (c *OpGetKernelWorkGroupSize) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Invoke = f(c.Invoke)
+	c.Param = f(c.Param)
+	c.ParamSize = f(c.ParamSize)
+	c.ParamAlign = f(c.ParamAlign)
+}
+
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) resultType() (Id, bool) {
+	return c.ResultType, true
+}
+
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Invoke, c.Param, c.ParamSize, c.ParamAlign)
+}
+
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Invoke = f(c.Invoke)
+	c.Param = f(c.Param)
+	c.ParamSize = f(c.ParamSize)
+	c.ParamAlign = f(c.ParamAlign)
+}
+
+func (c *OpRetainEvent) resultId() (Id, bool) { return 0, false }
+
+func (c *OpRetainEvent) setResultId(id Id) bool { return false }
+
+func (c *OpRetainEvent) resultType() (Id, bool) { return 0, false }
+
+func (c *OpRetainEvent) appendOperands(out []Id) []Id {
+	return append(out, c.Event)
+}
+
+func (c *OpRetainEvent) rewriteOperands(f func(Id) Id) {
+	c.Event = f(c.Event)
+}
+
+func (c *OpReleaseEvent) resultId() (Id, bool) { return 0, false }
+
+func (c *OpReleaseEvent) setResultId(id Id) bool { return false }
+
+func (c *OpReleaseEvent) resultType() (Id, bool) { return 0, false }
+
+func (c *OpReleaseEvent) appendOperands(out []Id) []Id {
+	return append(out, c.Event)
+}
+
+func (c *OpReleaseEvent) rewriteOperands(f func(Id) Id) {
+	c.Event = f(c.Event)
+}
+
+func (c *OpCreateUserEvent) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpCreateUserEvent) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpCreateUserEvent) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpCreateUserEvent) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType)
+}
+
+func (c *OpCreateUserEvent) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+}
+
+func (c *OpIsValidEvent) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpIsValidEvent) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpIsValidEvent) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpIsValidEvent) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Event)
+}
+
+func (c *OpIsValidEvent) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Event = f(c.Event)
+}
+
+func (c *OpSetUserEventStatus) resultId() (Id, bool) { return 0, false }
+
+func (c *OpSetUserEventStatus) setResultId(id Id) bool { return false }
+
+func (c *OpSetUserEventStatus) resultType() (Id, bool) { return 0, false }
+
+func (c *OpSetUserEventStatus) appendOperands(out []Id) []Id {
+	return append(out, c.Event, c.Status)
+}
+
+func (c *OpSetUserEventStatus) rewriteOperands(f func(Id) Id) {
+	c.Event = f(c.Event)
+	c.Status = f(c.Status)
+}
+
+func (c *OpCaptureEventProfilingInfo) resultId() (Id, bool) { return 0, false }
+
+func (c *OpCaptureEventProfilingInfo) setResultId(id Id) bool { return false }
+
+func (c *OpCaptureEventProfilingInfo) resultType() (Id, bool) { return 0, false }
+
+func (c *OpCaptureEventProfilingInfo) appendOperands(out []Id) []Id {
+	return append(out, c.Event, c.ProfilingInfo, c.Value)
+}
+
+func (c *OpCaptureEventProfilingInfo) rewriteOperands(f func(Id) Id) {
+	c.Event = f(c.Event)
+	c.ProfilingInfo = f(c.ProfilingInfo)
+	c.Value = f(c.Value)
+}
+
+func (c *OpGetDefaultQueue) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpGetDefaultQueue) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpGetDefaultQueue) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpGetDefaultQueue) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType)
+}
+
+func (c *OpGetDefaultQueue) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+}
+
+func (c *OpBuildNDRange) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpBuildNDRange) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpBuildNDRange) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpBuildNDRange) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.GlobalWorkSize, c.LocalWorkSize, c.GlobalWorkOffset)
+}
+
+func (c *OpBuildNDRange) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.GlobalWorkSize = f(c.GlobalWorkSize)
+	c.LocalWorkSize = f(c.LocalWorkSize)
+	c.GlobalWorkOffset = f(c.GlobalWorkOffset)
+}
+
+func (c *OpImageSparseSampleImplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSparseSampleImplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSparseSampleImplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSparseSampleImplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSparseSampleImplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSparseSampleExplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSparseSampleExplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSparseSampleExplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSparseSampleExplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSparseSampleExplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSparseSampleDrefImplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSparseSampleDrefImplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSparseSampleDrefImplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSparseSampleDrefImplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate, c.Dref)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSparseSampleDrefImplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	c.Dref = f(c.Dref)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSparseSampleDrefExplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSparseSampleDrefExplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSparseSampleDrefExplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSparseSampleDrefExplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate, c.Dref)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSparseSampleDrefExplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	c.Dref = f(c.Dref)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSparseSampleProjImplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSparseSampleProjImplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSparseSampleProjImplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSparseSampleProjImplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSparseSampleProjImplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSparseSampleProjExplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSparseSampleProjExplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSparseSampleProjExplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSparseSampleProjExplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSparseSampleProjExplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSparseSampleProjDrefImplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSparseSampleProjDrefImplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSparseSampleProjDrefImplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSparseSampleProjDrefImplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate, c.Dref)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSparseSampleProjDrefImplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	c.Dref = f(c.Dref)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSparseSampleProjDrefExplicitLod) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSparseSampleProjDrefExplicitLod) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSparseSampleProjDrefExplicitLod) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSparseSampleProjDrefExplicitLod) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate, c.Dref)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSparseSampleProjDrefExplicitLod) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	c.Dref = f(c.Dref)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSparseFetch) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSparseFetch) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSparseFetch) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSparseFetch) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.Image, c.Coordinate)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSparseFetch) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Image = f(c.Image)
+	c.Coordinate = f(c.Coordinate)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSparseGather) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSparseGather) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSparseGather) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSparseGather) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate, c.Component)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSparseGather) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	c.Component = f(c.Component)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSparseDrefGather) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSparseDrefGather) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSparseDrefGather) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSparseDrefGather) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.SampledImage, c.Coordinate, c.Dref)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSparseDrefGather) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.SampledImage = f(c.SampledImage)
+	c.Coordinate = f(c.Coordinate)
+	c.Dref = f(c.Dref)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
+
+func (c *OpImageSparseTexelsResident) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSparseTexelsResident) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSparseTexelsResident) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSparseTexelsResident) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.ResidentCode)
+}
+
+func (c *OpImageSparseTexelsResident) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.ResidentCode = f(c.ResidentCode)
+}
+
+func (c *OpNoLine) resultId() (Id, bool) { return 0, false }
+
+func (c *OpNoLine) setResultId(id Id) bool { return false }
+
+func (c *OpNoLine) resultType() (Id, bool) { return 0, false }
+
+func (c *OpNoLine) appendOperands(out []Id) []Id { return out }
+
+func (c *OpNoLine) rewriteOperands(f func(Id) Id) {}
+
+func (c *OpAtomicFlagTestAndSet) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpAtomicFlagTestAndSet) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpAtomicFlagTestAndSet) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpAtomicFlagTestAndSet) appendOperands(out []Id) []Id {
+	return append(out, c.ResultType, c.Pointer, c.Scope, c.Semantics)
+}
+
+func (c *OpAtomicFlagTestAndSet) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+}
+
+func (c *OpAtomicFlagClear) resultId() (Id, bool) { return 0, false }
+
+func (c *OpAtomicFlagClear) setResultId(id Id) bool { return false }
+
+func (c *OpAtomicFlagClear) resultType() (Id, bool) { return 0, false }
+
+func (c *OpAtomicFlagClear) appendOperands(out []Id) []Id {
+	return append(out, c.Pointer, c.Scope, c.Semantics)
+}
+
+func (c *OpAtomicFlagClear) rewriteOperands(f func(Id) Id) {
+	c.Pointer = f(c.Pointer)
+	c.Scope = f(c.Scope)
+	c.Semantics = f(c.Semantics)
+}
+
+func (c *OpImageSparseRead) resultId() (Id, bool) { return c.ResultId, true }
+
+func (c *OpImageSparseRead) setResultId(id Id) bool {
+	c.ResultId = id
+	return true
+}
+
+func (c *OpImageSparseRead) resultType() (Id, bool) { return c.ResultType, true }
+
+func (c *OpImageSparseRead) appendOperands(out []Id) []Id {
+	out = append(out, c.ResultType, c.Image, c.Coordinate)
+	out = append(out, c.Argv...)
+	return out
+}
+
+func (c *OpImageSparseRead) rewriteOperands(f func(Id) Id) {
+	c.ResultType = f(c.ResultType)
+	c.Image = f(c.Image)
+	c.Coordinate = f(c.Coordinate)
+	for i := range c.Argv {
+		c.Argv[i] = f(c.Argv[i])
+	}
+}
@@ -11,12 +11,7 @@ type InstructionList []Instruction
 func (set InstructionList) Functions() []InstructionList {
 	var out []InstructionList
 
-	start := set.FilterIndex(opcodeFunction, 0)
-	end := set.FilterIndex(opcodeFunctionEnd, 0)
-
-	if len(start) != len(end) {
-		return nil
-	}
+	start, end := set.functionRanges()
 
 	for i, s := range start {
 		e := end[i]
@@ -26,27 +21,79 @@ func (set InstructionList) Functions() []InstructionList {
 	return out
 }
 
-// Blocks returns all function blocks. This assumes the given set
-// is itself just one function. Otherwise it will return blocks for
-// multiple- or all functions.
+// functionRanges returns the addresses of the OpFunction and matching
+// OpFunctionEnd instructions for each function. Returns nil if they are
+// not properly paired.
+func (set InstructionList) functionRanges() (start, end []int) {
+	start = set.FilterIndex(opcodeFunction, 0)
+	end = set.FilterIndex(opcodeFunctionEnd, 0)
+
+	if len(start) != len(end) {
+		return nil, nil
+	}
+
+	for i := range start {
+		if end[i] < start[i] || (i > 0 && start[i] < end[i-1]) {
+			return nil, nil
+		}
+	}
+
+	return start, end
+}
+
+// Blocks returns the basic blocks of all functions in the set, in the
+// order in which they appear. Each block runs from its OpLabel up to and
+// including its terminating instruction. The set may also hold just the
+// blocks of a single function.
+//
+// Returns nil if any function's blocks are malformed. Use NewCFG for
+// details on the problem, or for the edges between blocks.
 func (set InstructionList) Blocks() []InstructionList {
 	var out []InstructionList
 
-	start := set.FilterIndex(opcodeLabel, 0)
-	end := set.FilterIndex(opcodeBranch, 0)
+	fns := set.Functions()
+	if len(fns) == 0 {
+		fns = []InstructionList{set}
+	}
 
-	if len(start) != len(end) {
-		return nil
+	for _, fn := range fns {
+		cfg, err := NewCFG(fn)
+		if err != nil {
+			return nil
+		}
+
+		for _, b := range cfg.Blocks {
+			out = append(out, b.Code)
+		}
 	}
 
-	for i, s := range start {
-		e := end[i]
-		out = append(out, set[s:e+1])
+	return out
+}
+
+// terminators returns the indices of all block termination instructions.
+// The offset value is added to each index.
+func (set InstructionList) terminators(offset int) []int {
+	out := make([]int, 0, len(set))
+
+	for i, v := range set {
+		if isTerminator(v.Opcode()) {
+			out = append(out, i+offset)
+		}
 	}
 
 	return out
 }
 
+// isTerminator returns true if the given opcode ends a block.
+func isTerminator(opcode uint32) bool {
+	switch opcode {
+	case opcodeBranch, opcodeBranchConditional, opcodeSwitch,
+		opcodeKill, opcodeReturn, opcodeReturnValue, opcodeUnreachable:
+		return true
+	}
+	return false
+}
+
 // First returns the first instruction with the given opcode.
 // Returns nil if it could not be found.
 func (set InstructionList) First(opcode uint32) Instruction {
@@ -114,12 +161,7 @@ func (set InstructionList) Count(opcode uint32) int {
 
 // localVariables returns all local variables defined in all functions.
 func (set InstructionList) localVariables() []int {
-	start := set.FilterIndex(opcodeFunction, 0)
-	end := set.FilterIndex(opcodeFunctionEnd, 0)
-
-	if len(start) != len(end) {
-		return nil
-	}
+	start, end := set.functionRanges()
 
 	var out []int
 
@@ -135,5 +177,9 @@ func (set InstructionList) localVariables() []int {
 // globalVariables returns all global variables defined in the set
 func (set InstructionList) globalVariables() []int {
 	funcIndex := set.Index(opcodeFunction)
+	if funcIndex < 0 {
+		funcIndex = len(set)
+	}
+
 	return set[:funcIndex].FilterIndex(opcodeVariable, 0)
 }
@@ -1,24 +1,11 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
-package spirv
-
-import "fmt"
-
-// OpDecorationGroup represents a collector of decorations from OpDecorate
-// instructions.
-//
-// All such instructions must precede this instruction. Subsequent OpGroupDecorate
-// and OpGroupMemberDecorate instructions can consume the Result <id> to apply
-// multiple decorations to multiple target <id>s. Those are the only
-// instructions allowed to consume the Result <id>.
-type OpDecorationGroup struct {
-	ResultId Id
-}
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
 
-func (c *OpDecorationGroup) Opcode() uint32 { return opcodeDecorationGroup }
-func (c *OpDecorationGroup) Optional() bool { return false }
-func (c *OpDecorationGroup) Verify() error  { return nil }
+package spirv
 
 // OpDecorate represents the OpDecorate instruction.
 // It adds a decoration to another <id>.
@@ -41,41 +28,7 @@ type OpDecorate struct {
 
 func (c *OpDecorate) Opcode() uint32 { return opcodeDecorate }
 func (c *OpDecorate) Optional() bool { return false }
-func (c *OpDecorate) Verify() error {
-	argc := len(c.Argv)
-
-	switch c.Decoration {
-	case DecorationStream, DecorationLocation, DecorationComponent,
-		DecorationIndex, DecorationBinding, DecorationOffset,
-		DecorationAlignment, DecorationXfbBuffer, DecorationStride,
-		DecorationBuiltIn, DecorationFuncParamAttr, DecorationFPRoundingMode,
-		DecorationFPFastMathMode, DecorationSpecId:
-		if argc != 1 {
-			return fmt.Errorf("OpDecorate: Decoration(%d) must have 1 argument", c.Decoration)
-		}
-
-		return nil
-
-	case DecorationLinkageType:
-		if argc != 1 {
-			return fmt.Errorf("OpDecorate: Decoration(%d) must have 1 argument", c.Decoration)
-		}
-
-		err := LinkageType(c.Argv[0]).Verify()
-		if err != nil {
-			return fmt.Errorf("OpDecorate: %v", err)
-		}
-
-		return nil
-	}
-
-	if argc > 0 {
-		return fmt.Errorf("OpDecorate: extraneous arguments for Decoration(%d)",
-			c.Decoration)
-	}
-
-	return nil
-}
+func (c *OpDecorate) Verify() error  { return c.verify() }
 
 // OpMemberDecorate represents the OpMemberDecorate instruction.
 // It adds a decoration to a member of a structure type.
@@ -99,42 +52,23 @@ type OpMemberDecorate struct {
 
 func (c *OpMemberDecorate) Opcode() uint32 { return opcodeMemberDecorate }
 func (c *OpMemberDecorate) Optional() bool { return false }
-func (c *OpMemberDecorate) Verify() error {
-	argc := len(c.Argv)
-
-	switch c.Decoration {
-	case DecorationStream, DecorationLocation, DecorationComponent,
-		DecorationIndex, DecorationBinding, DecorationOffset,
-		DecorationAlignment, DecorationXfbBuffer, DecorationStride,
-		DecorationBuiltIn, DecorationFuncParamAttr, DecorationFPRoundingMode,
-		DecorationFPFastMathMode, DecorationSpecId:
-		if argc != 1 {
-			return fmt.Errorf("OpMemberDecorate: Decoration(%d) must have 1 argument", c.Decoration)
-		}
-
-		return nil
-
-	case DecorationLinkageType:
-		if argc != 1 {
-			return fmt.Errorf("OpMemberDecorate: Decoration(%d) must have 1 argument", c.Decoration)
-		}
-
-		err := LinkageType(c.Argv[0]).Verify()
-		if err != nil {
-			return fmt.Errorf("OpMemberDecorate: %v", err)
-		}
-
-		return nil
-	}
-
-	if argc > 0 {
-		return fmt.Errorf("OpMemberDecorate: extraneous arguments for Decoration(%d)",
-			c.Decoration)
-	}
-
-	return nil
+func (c *OpMemberDecorate) Verify() error  { return c.verify() }
+
+// OpDecorationGroup represents a collector of decorations from OpDecorate
+// instructions.
+//
+// All such instructions must precede this instruction. Subsequent OpGroupDecorate
+// and OpGroupMemberDecorate instructions can consume the Result <id> to apply
+// multiple decorations to multiple target <id>s. Those are the only
+// instructions allowed to consume the Result <id>.
+type OpDecorationGroup struct {
+	ResultId Id
 }
 
+func (c *OpDecorationGroup) Opcode() uint32 { return opcodeDecorationGroup }
+func (c *OpDecorationGroup) Optional() bool { return false }
+func (c *OpDecorationGroup) Verify() error  { return nil }
+
 // OpGroupDecorate represents the OpGroupDecorate instruction.
 // It adds a group of decorations to another <id>.
 type OpGroupDecorate struct {
@@ -155,18 +89,19 @@ type OpGroupMemberDecorate struct {
 	// The <id> of a OpDecorationGroup instruction.
 	Group Id
 
-	// Targets are the target <id>s to decorate with the groups of decorations.
-	Targets []Id
+	// Targets is a list of (<id>, Member) pairs, identifying the
+	// structure members to decorate.
+	Targets []uint32
 }
 
 func (c *OpGroupMemberDecorate) Opcode() uint32 { return opcodeGroupMemberDecorate }
 func (c *OpGroupMemberDecorate) Optional() bool { return false }
-func (c *OpGroupMemberDecorate) Verify() error  { return nil }
+func (c *OpGroupMemberDecorate) Verify() error  { return c.verify() }
 
 func init() {
-	bind(func() Instruction { return &OpDecorationGroup{} })
 	bind(func() Instruction { return &OpDecorate{} })
 	bind(func() Instruction { return &OpMemberDecorate{} })
+	bind(func() Instruction { return &OpDecorationGroup{} })
 	bind(func() Instruction { return &OpGroupDecorate{} })
 	bind(func() Instruction { return &OpGroupMemberDecorate{} })
 }
@@ -4,66 +4,105 @@
 package spirv
 
 import (
-	"errors"
+	"fmt"
 	"testing"
 )
 
 func TestAnnotations(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x00020031, 0xff},
-			want: &OpDecorationGroup{
-				ResultId: 0xff,
+			in: []uint32{0x00030047, 1, DecorationBlock},
+			want: &OpDecorate{
+				Target:     1,
+				Decoration: DecorationBlock,
+			},
+		},
+		{
+			in: []uint32{0x00040047, 1, DecorationLocation, 2},
+			want: &OpDecorate{
+				Target:     1,
+				Decoration: DecorationLocation,
+				Argv:       []uint32{2},
 			},
 		},
 		{
-			in:  []uint32{0x00060032, 1, DecorationNoStaticUse, 2, 3, 4},
-			err: errors.New("OpDecorate: extraneous arguments for Decoration(26)"),
+			in:  []uint32{0x00050047, 1, DecorationFlat, 2, 3},
+			err: fmt.Errorf("OpDecorate: extraneous arguments for Decoration(%d)", DecorationFlat),
 		},
 		{
-			in:  []uint32{0x00040032, 1, DecorationLinkageType, 2},
-			err: errors.New("OpDecorate: invalid LinkageType value"),
+			in:  []uint32{0x00030047, 1, DecorationLinkageAttributes},
+			err: fmt.Errorf("OpDecorate: Decoration(41) must have 2 arguments"),
 		},
 		{
-			in:  []uint32{0x00040032, 1, DecorationLinkageType, LinkageTypeExport},
-			err: errors.New("OpDecorate: invalid LinkageType value"),
+			in:  []uint32{0x00060047, 1, DecorationLinkageAttributes, 0x74736574, 0, 7},
+			err: fmt.Errorf("OpDecorate: invalid LinkageType value"),
+		},
+		{
+			in: []uint32{0x00060047, 1, DecorationLinkageAttributes,
+				0x74736574, 0, LinkageTypeExport},
 			want: &OpDecorate{
 				Target:     1,
-				Decoration: DecorationLinkageType,
-				Argv:       []uint32{LinkageTypeExport},
+				Decoration: DecorationLinkageAttributes,
+				Argv:       []uint32{0x74736574, 0, LinkageTypeExport},
 			},
 		},
 		{
-			in:  []uint32{0x00060033, 1, 2, DecorationNoStaticUse, 3, 4},
-			err: errors.New("OpMemberDecorate: extraneous arguments for Decoration(26)"),
-		},
-		{
-			in:  []uint32{0x00050033, 1, 2, DecorationLinkageType, 3},
-			err: errors.New("OpMemberDecorate: invalid LinkageType value"),
+			in: []uint32{0x00040048, 1, 2, DecorationBlock},
+			want: &OpMemberDecorate{
+				StructType: 1,
+				Member:     2,
+				Decoration: DecorationBlock,
+			},
 		},
 		{
-			in: []uint32{0x00050033, 1, 2, DecorationLinkageType, LinkageTypeExport},
+			in: []uint32{0x00050048, 1, 2, DecorationOffset, 3},
 			want: &OpMemberDecorate{
 				StructType: 1,
 				Member:     2,
-				Decoration: DecorationLinkageType,
-				Argv:       []uint32{LinkageTypeExport},
+				Decoration: DecorationOffset,
+				Argv:       []uint32{3},
 			},
 		},
 		{
-			in: []uint32{0x00060034, 1, 2, 3, 4, 5},
+			in:  []uint32{0x00060048, 1, 2, DecorationFlat, 3, 4},
+			err: fmt.Errorf("OpMemberDecorate: extraneous arguments for Decoration(%d)", DecorationFlat),
+		},
+		{
+			in: []uint32{0x00020049, 1},
+			want: &OpDecorationGroup{
+				ResultId: 1,
+			},
+		},
+		{
+			in: []uint32{0x0002004a, 1},
+			want: &OpGroupDecorate{
+				Group: 1,
+			},
+		},
+		{
+			in: []uint32{0x0004004a, 1, 2, 3},
 			want: &OpGroupDecorate{
 				Group:   1,
-				Targets: []Id{2, 3, 4, 5},
+				Targets: []Id{2, 3},
+			},
+		},
+		{
+			in: []uint32{0x0002004b, 1},
+			want: &OpGroupMemberDecorate{
+				Group: 1,
 			},
 		},
 		{
-			in: []uint32{0x00060035, 1, 2, 3, 4, 5},
+			in: []uint32{0x0006004b, 1, 2, 3, 4, 5},
 			want: &OpGroupMemberDecorate{
 				Group:   1,
-				Targets: []Id{2, 3, 4, 5},
+				Targets: []uint32{2, 3, 4, 5},
 			},
 		},
+		{
+			in:  []uint32{0x0005004b, 1, 2, 3, 4},
+			err: fmt.Errorf("OpGroupMemberDecorate: Targets expects array of (Target, Member) pairs"),
+		},
 	} {
 		testInstruction(t, st)
 	}
@@ -1,6 +1,10 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
 package spirv
 
 // OpSNegate performs signed-integer subtract of Operand from zero.
@@ -25,17 +29,6 @@ func (c *OpFNegate) Opcode() uint32 { return opcodeFNegate }
 func (c *OpFNegate) Optional() bool { return false }
 func (c *OpFNegate) Verify() error  { return nil }
 
-// OpNot complements the bits of Operand.
-type OpNot struct {
-	ResultType Id
-	ResultId   Id
-	Operand    Id
-}
-
-func (c *OpNot) Opcode() uint32 { return opcodeNot }
-func (c *OpNot) Optional() bool { return false }
-func (c *OpNot) Verify() error  { return nil }
-
 // OpIAdd performs Integer addition of Operand 1 and Operand 2.
 type OpIAdd struct {
 	ResultType Id
@@ -220,7 +213,7 @@ func (c *OpVectorTimesScalar) Verify() error  { return nil }
 type OpMatrixTimesScalar struct {
 	ResultType Id
 	ResultId   Id
-	Vector     Id
+	Matrix     Id
 	Scalar     Id
 }
 
@@ -240,7 +233,7 @@ func (c *OpVectorTimesMatrix) Opcode() uint32 { return opcodeVectorTimesMatrix }
 func (c *OpVectorTimesMatrix) Optional() bool { return false }
 func (c *OpVectorTimesMatrix) Verify() error  { return nil }
 
-// OpMatrixTimesVector performs Linear-algebraic Vector X Matrix.
+// OpMatrixTimesVector performs Linear-algebraic Matrix X Vector.
 type OpMatrixTimesVector struct {
 	ResultType Id
 	ResultId   Id
@@ -252,12 +245,13 @@ func (c *OpMatrixTimesVector) Opcode() uint32 { return opcodeMatrixTimesVector }
 func (c *OpMatrixTimesVector) Optional() bool { return false }
 func (c *OpMatrixTimesVector) Verify() error  { return nil }
 
-// OpMatrixTimesMatrix performs Linear-algebraic multiply of Left X Right.
+// OpMatrixTimesMatrix performs Linear-algebraic multiply of
+// LeftMatrix X RightMatrix.
 type OpMatrixTimesMatrix struct {
-	ResultType Id
-	ResultId   Id
-	Left       Id
-	Right      Id
+	ResultType  Id
+	ResultId    Id
+	LeftMatrix  Id
+	RightMatrix Id
 }
 
 func (c *OpMatrixTimesMatrix) Opcode() uint32 { return opcodeMatrixTimesMatrix }
@@ -288,39 +282,94 @@ func (c *OpDot) Opcode() uint32 { return opcodeDot }
 func (c *OpDot) Optional() bool { return false }
 func (c *OpDot) Verify() error  { return nil }
 
-// OpShiftRightLogical shifts the bits in Operand 1 right by the number
-// of bits specified in Operand 2
-type OpShiftRightLogical struct {
+// OpIAddCarry computes the result of an unsigned integer addition of
+// Operand 1 and Operand 2, along with its carry.
+type OpIAddCarry struct {
 	ResultType Id
 	ResultId   Id
 	Operand1   Id
 	Operand2   Id
 }
 
+func (c *OpIAddCarry) Opcode() uint32 { return opcodeIAddCarry }
+func (c *OpIAddCarry) Optional() bool { return false }
+func (c *OpIAddCarry) Verify() error  { return nil }
+
+// OpISubBorrow computes the result of an unsigned integer subtraction of
+// Operand 2 from Operand 1, along with the borrow, if needed.
+type OpISubBorrow struct {
+	ResultType Id
+	ResultId   Id
+	Operand1   Id
+	Operand2   Id
+}
+
+func (c *OpISubBorrow) Opcode() uint32 { return opcodeISubBorrow }
+func (c *OpISubBorrow) Optional() bool { return false }
+func (c *OpISubBorrow) Verify() error  { return nil }
+
+// OpUMulExtended computes the full unsigned integer multiplication of
+// Operand 1 and Operand 2. The result holds the low-order and high-order
+// bits of the product.
+type OpUMulExtended struct {
+	ResultType Id
+	ResultId   Id
+	Operand1   Id
+	Operand2   Id
+}
+
+func (c *OpUMulExtended) Opcode() uint32 { return opcodeUMulExtended }
+func (c *OpUMulExtended) Optional() bool { return false }
+func (c *OpUMulExtended) Verify() error  { return nil }
+
+// OpSMulExtended computes the full signed integer multiplication of
+// Operand 1 and Operand 2. The result holds the low-order and high-order
+// bits of the product.
+type OpSMulExtended struct {
+	ResultType Id
+	ResultId   Id
+	Operand1   Id
+	Operand2   Id
+}
+
+func (c *OpSMulExtended) Opcode() uint32 { return opcodeSMulExtended }
+func (c *OpSMulExtended) Optional() bool { return false }
+func (c *OpSMulExtended) Verify() error  { return nil }
+
+// OpShiftRightLogical shifts the bits in Base right by the number of
+// bits specified in Shift. The most-significant bits will be zero filled.
+type OpShiftRightLogical struct {
+	ResultType Id
+	ResultId   Id
+	Base       Id
+	Shift      Id
+}
+
 func (c *OpShiftRightLogical) Opcode() uint32 { return opcodeShiftRightLogical }
 func (c *OpShiftRightLogical) Optional() bool { return false }
 func (c *OpShiftRightLogical) Verify() error  { return nil }
 
-// OpShiftRightArithmetic shifts the bits in Operand 1 right by the number of
-// bits specified in Operand 2
+// OpShiftRightArithmetic shifts the bits in Base right by the number of
+// bits specified in Shift. The most-significant bits will be filled with the
+// sign bit from Base.
 type OpShiftRightArithmetic struct {
 	ResultType Id
 	ResultId   Id
-	Operand1   Id
-	Operand2   Id
+	Base       Id
+	Shift      Id
 }
 
 func (c *OpShiftRightArithmetic) Opcode() uint32 { return opcodeShiftRightArithmetic }
 func (c *OpShiftRightArithmetic) Optional() bool { return false }
 func (c *OpShiftRightArithmetic) Verify() error  { return nil }
 
-// OpShiftLeftLogical shifts the bits in Operand 1 left by the number
-// of bits specified in Operand 2
+// OpShiftLeftLogical shifts the bits in Base left by the number of bits
+// specified in Shift. The least-significant bits will be zero filled.
 type OpShiftLeftLogical struct {
 	ResultType Id
 	ResultId   Id
-	Operand1   Id
-	Operand2   Id
+	Base       Id
+	Shift      Id
 }
 
 func (c *OpShiftLeftLogical) Opcode() uint32 { return opcodeShiftLeftLogical }
@@ -366,10 +415,85 @@ func (c *OpBitwiseAnd) Opcode() uint32 { return opcodeBitwiseAnd }
 func (c *OpBitwiseAnd) Optional() bool { return false }
 func (c *OpBitwiseAnd) Verify() error  { return nil }
 
+// OpNot complements the bits of Operand.
+type OpNot struct {
+	ResultType Id
+	ResultId   Id
+	Operand    Id
+}
+
+func (c *OpNot) Opcode() uint32 { return opcodeNot }
+func (c *OpNot) Optional() bool { return false }
+func (c *OpNot) Verify() error  { return nil }
+
+// OpBitFieldInsert makes a copy of an object, with a modified bit field
+// that comes from another object.
+type OpBitFieldInsert struct {
+	ResultType Id
+	ResultId   Id
+	Base       Id
+	Insert     Id
+	Offset     Id
+	Count      Id
+}
+
+func (c *OpBitFieldInsert) Opcode() uint32 { return opcodeBitFieldInsert }
+func (c *OpBitFieldInsert) Optional() bool { return false }
+func (c *OpBitFieldInsert) Verify() error  { return nil }
+
+// OpBitFieldSExtract extracts a bit field from an object, with sign
+// extension.
+type OpBitFieldSExtract struct {
+	ResultType Id
+	ResultId   Id
+	Base       Id
+	Offset     Id
+	Count      Id
+}
+
+func (c *OpBitFieldSExtract) Opcode() uint32 { return opcodeBitFieldSExtract }
+func (c *OpBitFieldSExtract) Optional() bool { return false }
+func (c *OpBitFieldSExtract) Verify() error  { return nil }
+
+// OpBitFieldUExtract extracts a bit field from an object, without sign
+// extension.
+type OpBitFieldUExtract struct {
+	ResultType Id
+	ResultId   Id
+	Base       Id
+	Offset     Id
+	Count      Id
+}
+
+func (c *OpBitFieldUExtract) Opcode() uint32 { return opcodeBitFieldUExtract }
+func (c *OpBitFieldUExtract) Optional() bool { return false }
+func (c *OpBitFieldUExtract) Verify() error  { return nil }
+
+// OpBitReverse reverses the bits in an object.
+type OpBitReverse struct {
+	ResultType Id
+	ResultId   Id
+	Base       Id
+}
+
+func (c *OpBitReverse) Opcode() uint32 { return opcodeBitReverse }
+func (c *OpBitReverse) Optional() bool { return false }
+func (c *OpBitReverse) Verify() error  { return nil }
+
+// OpBitCount counts the number of set bits in an object.
+type OpBitCount struct {
+	ResultType Id
+	ResultId   Id
+	Base       Id
+}
+
+func (c *OpBitCount) Opcode() uint32 { return opcodeBitCount }
+func (c *OpBitCount) Optional() bool { return false }
+func (c *OpBitCount) Verify() error  { return nil }
+
 func init() {
 	bind(func() Instruction { return &OpSNegate{} })
 	bind(func() Instruction { return &OpFNegate{} })
-	bind(func() Instruction { return &OpNot{} })
 	bind(func() Instruction { return &OpIAdd{} })
 	bind(func() Instruction { return &OpFAdd{} })
 	bind(func() Instruction { return &OpISub{} })
@@ -391,10 +515,20 @@ func init() {
 	bind(func() Instruction { return &OpMatrixTimesMatrix{} })
 	bind(func() Instruction { return &OpOuterProduct{} })
 	bind(func() Instruction { return &OpDot{} })
+	bind(func() Instruction { return &OpIAddCarry{} })
+	bind(func() Instruction { return &OpISubBorrow{} })
+	bind(func() Instruction { return &OpUMulExtended{} })
+	bind(func() Instruction { return &OpSMulExtended{} })
 	bind(func() Instruction { return &OpShiftRightLogical{} })
 	bind(func() Instruction { return &OpShiftRightArithmetic{} })
 	bind(func() Instruction { return &OpShiftLeftLogical{} })
 	bind(func() Instruction { return &OpBitwiseOr{} })
 	bind(func() Instruction { return &OpBitwiseXor{} })
 	bind(func() Instruction { return &OpBitwiseAnd{} })
+	bind(func() Instruction { return &OpNot{} })
+	bind(func() Instruction { return &OpBitFieldInsert{} })
+	bind(func() Instruction { return &OpBitFieldSExtract{} })
+	bind(func() Instruction { return &OpBitFieldUExtract{} })
+	bind(func() Instruction { return &OpBitReverse{} })
+	bind(func() Instruction { return &OpBitCount{} })
 }
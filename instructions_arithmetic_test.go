@@ -8,7 +8,7 @@ import "testing"
 func TestArithmetic(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x0004005f, 1, 2, 3},
+			in: []uint32{0x0004007e, 1, 2, 3},
 			want: &OpSNegate{
 				ResultType: 1,
 				ResultId:   2,
@@ -16,7 +16,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00040060, 1, 2, 3},
+			in: []uint32{0x0004007f, 1, 2, 3},
 			want: &OpFNegate{
 				ResultType: 1,
 				ResultId:   2,
@@ -24,15 +24,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00040061, 1, 2, 3},
-			want: &OpNot{
-				ResultType: 1,
-				ResultId:   2,
-				Operand:    3,
-			},
-		},
-		{
-			in: []uint32{0x0005007a, 1, 2, 3, 4},
+			in: []uint32{0x00050080, 1, 2, 3, 4},
 			want: &OpIAdd{
 				ResultType: 1,
 				ResultId:   2,
@@ -41,7 +33,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0005007b, 1, 2, 3, 4},
+			in: []uint32{0x00050081, 1, 2, 3, 4},
 			want: &OpFAdd{
 				ResultType: 1,
 				ResultId:   2,
@@ -50,7 +42,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0005007c, 1, 2, 3, 4},
+			in: []uint32{0x00050082, 1, 2, 3, 4},
 			want: &OpISub{
 				ResultType: 1,
 				ResultId:   2,
@@ -59,7 +51,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0005007d, 1, 2, 3, 4},
+			in: []uint32{0x00050083, 1, 2, 3, 4},
 			want: &OpFSub{
 				ResultType: 1,
 				ResultId:   2,
@@ -68,7 +60,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0005007e, 1, 2, 3, 4},
+			in: []uint32{0x00050084, 1, 2, 3, 4},
 			want: &OpIMul{
 				ResultType: 1,
 				ResultId:   2,
@@ -77,7 +69,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0005007f, 1, 2, 3, 4},
+			in: []uint32{0x00050085, 1, 2, 3, 4},
 			want: &OpFMul{
 				ResultType: 1,
 				ResultId:   2,
@@ -86,7 +78,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050080, 1, 2, 3, 4},
+			in: []uint32{0x00050086, 1, 2, 3, 4},
 			want: &OpUDiv{
 				ResultType: 1,
 				ResultId:   2,
@@ -95,7 +87,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050081, 1, 2, 3, 4},
+			in: []uint32{0x00050087, 1, 2, 3, 4},
 			want: &OpSDiv{
 				ResultType: 1,
 				ResultId:   2,
@@ -104,7 +96,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050082, 1, 2, 3, 4},
+			in: []uint32{0x00050088, 1, 2, 3, 4},
 			want: &OpFDiv{
 				ResultType: 1,
 				ResultId:   2,
@@ -113,7 +105,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050083, 1, 2, 3, 4},
+			in: []uint32{0x00050089, 1, 2, 3, 4},
 			want: &OpUMod{
 				ResultType: 1,
 				ResultId:   2,
@@ -122,7 +114,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050084, 1, 2, 3, 4},
+			in: []uint32{0x0005008a, 1, 2, 3, 4},
 			want: &OpSRem{
 				ResultType: 1,
 				ResultId:   2,
@@ -131,7 +123,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050085, 1, 2, 3, 4},
+			in: []uint32{0x0005008b, 1, 2, 3, 4},
 			want: &OpSMod{
 				ResultType: 1,
 				ResultId:   2,
@@ -140,7 +132,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050086, 1, 2, 3, 4},
+			in: []uint32{0x0005008c, 1, 2, 3, 4},
 			want: &OpFRem{
 				ResultType: 1,
 				ResultId:   2,
@@ -149,7 +141,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050087, 1, 2, 3, 4},
+			in: []uint32{0x0005008d, 1, 2, 3, 4},
 			want: &OpFMod{
 				ResultType: 1,
 				ResultId:   2,
@@ -158,7 +150,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050088, 1, 2, 3, 4},
+			in: []uint32{0x0005008e, 1, 2, 3, 4},
 			want: &OpVectorTimesScalar{
 				ResultType: 1,
 				ResultId:   2,
@@ -167,16 +159,16 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050089, 1, 2, 3, 4},
+			in: []uint32{0x0005008f, 1, 2, 3, 4},
 			want: &OpMatrixTimesScalar{
 				ResultType: 1,
 				ResultId:   2,
-				Vector:     3,
+				Matrix:     3,
 				Scalar:     4,
 			},
 		},
 		{
-			in: []uint32{0x0005008a, 1, 2, 3, 4},
+			in: []uint32{0x00050090, 1, 2, 3, 4},
 			want: &OpVectorTimesMatrix{
 				ResultType: 1,
 				ResultId:   2,
@@ -185,7 +177,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0005008b, 1, 2, 3, 4},
+			in: []uint32{0x00050091, 1, 2, 3, 4},
 			want: &OpMatrixTimesVector{
 				ResultType: 1,
 				ResultId:   2,
@@ -194,16 +186,16 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0005008c, 1, 2, 3, 4},
+			in: []uint32{0x00050092, 1, 2, 3, 4},
 			want: &OpMatrixTimesMatrix{
-				ResultType: 1,
-				ResultId:   2,
-				Left:       3,
-				Right:      4,
+				ResultType:  1,
+				ResultId:    2,
+				LeftMatrix:  3,
+				RightMatrix: 4,
 			},
 		},
 		{
-			in: []uint32{0x0005008d, 1, 2, 3, 4},
+			in: []uint32{0x00050093, 1, 2, 3, 4},
 			want: &OpOuterProduct{
 				ResultType: 1,
 				ResultId:   2,
@@ -212,7 +204,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0005008e, 1, 2, 3, 4},
+			in: []uint32{0x00050094, 1, 2, 3, 4},
 			want: &OpDot{
 				ResultType: 1,
 				ResultId:   2,
@@ -221,8 +213,8 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0005008f, 1, 2, 3, 4},
-			want: &OpShiftRightLogical{
+			in: []uint32{0x00050095, 1, 2, 3, 4},
+			want: &OpIAddCarry{
 				ResultType: 1,
 				ResultId:   2,
 				Operand1:   3,
@@ -230,8 +222,8 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050090, 1, 2, 3, 4},
-			want: &OpShiftRightArithmetic{
+			in: []uint32{0x00050096, 1, 2, 3, 4},
+			want: &OpISubBorrow{
 				ResultType: 1,
 				ResultId:   2,
 				Operand1:   3,
@@ -239,8 +231,8 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050091, 1, 2, 3, 4},
-			want: &OpShiftLeftLogical{
+			in: []uint32{0x00050097, 1, 2, 3, 4},
+			want: &OpUMulExtended{
 				ResultType: 1,
 				ResultId:   2,
 				Operand1:   3,
@@ -248,7 +240,43 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050095, 1, 2, 3, 4},
+			in: []uint32{0x00050098, 1, 2, 3, 4},
+			want: &OpSMulExtended{
+				ResultType: 1,
+				ResultId:   2,
+				Operand1:   3,
+				Operand2:   4,
+			},
+		},
+		{
+			in: []uint32{0x000500c2, 1, 2, 3, 4},
+			want: &OpShiftRightLogical{
+				ResultType: 1,
+				ResultId:   2,
+				Base:       3,
+				Shift:      4,
+			},
+		},
+		{
+			in: []uint32{0x000500c3, 1, 2, 3, 4},
+			want: &OpShiftRightArithmetic{
+				ResultType: 1,
+				ResultId:   2,
+				Base:       3,
+				Shift:      4,
+			},
+		},
+		{
+			in: []uint32{0x000500c4, 1, 2, 3, 4},
+			want: &OpShiftLeftLogical{
+				ResultType: 1,
+				ResultId:   2,
+				Base:       3,
+				Shift:      4,
+			},
+		},
+		{
+			in: []uint32{0x000500c5, 1, 2, 3, 4},
 			want: &OpBitwiseOr{
 				ResultType: 1,
 				ResultId:   2,
@@ -257,7 +285,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050096, 1, 2, 3, 4},
+			in: []uint32{0x000500c6, 1, 2, 3, 4},
 			want: &OpBitwiseXor{
 				ResultType: 1,
 				ResultId:   2,
@@ -266,7 +294,7 @@ func TestArithmetic(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050097, 1, 2, 3, 4},
+			in: []uint32{0x000500c7, 1, 2, 3, 4},
 			want: &OpBitwiseAnd{
 				ResultType: 1,
 				ResultId:   2,
@@ -274,6 +302,61 @@ func TestArithmetic(t *testing.T) {
 				Operand2:   4,
 			},
 		},
+		{
+			in: []uint32{0x000400c8, 1, 2, 3},
+			want: &OpNot{
+				ResultType: 1,
+				ResultId:   2,
+				Operand:    3,
+			},
+		},
+		{
+			in: []uint32{0x000700c9, 1, 2, 3, 4, 5, 6},
+			want: &OpBitFieldInsert{
+				ResultType: 1,
+				ResultId:   2,
+				Base:       3,
+				Insert:     4,
+				Offset:     5,
+				Count:      6,
+			},
+		},
+		{
+			in: []uint32{0x000600ca, 1, 2, 3, 4, 5},
+			want: &OpBitFieldSExtract{
+				ResultType: 1,
+				ResultId:   2,
+				Base:       3,
+				Offset:     4,
+				Count:      5,
+			},
+		},
+		{
+			in: []uint32{0x000600cb, 1, 2, 3, 4, 5},
+			want: &OpBitFieldUExtract{
+				ResultType: 1,
+				ResultId:   2,
+				Base:       3,
+				Offset:     4,
+				Count:      5,
+			},
+		},
+		{
+			in: []uint32{0x000400cc, 1, 2, 3},
+			want: &OpBitReverse{
+				ResultType: 1,
+				ResultId:   2,
+				Base:       3,
+			},
+		},
+		{
+			in: []uint32{0x000400cd, 1, 2, 3},
+			want: &OpBitCount{
+				ResultType: 1,
+				ResultId:   2,
+				Base:       3,
+			},
+		},
 	} {
 		testInstruction(t, st)
 	}
@@ -1,29 +1,22 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
-package spirv
-
-// OpAtomicInit initializes atomic memory to Value.
-// This is not done atomically with respect to anything.
-type OpAtomicInit struct {
-	Pointer Id
-	Value   Id
-}
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
 
-func (c *OpAtomicInit) Opcode() uint32 { return opcodeAtomicInit }
-func (c *OpAtomicInit) Optional() bool { return false }
-func (c *OpAtomicInit) Verify() error  { return nil }
+package spirv
 
 // OpAtomicLoad atomically loads through Pointer using the given Semantics.
 //
 // All subparts of the value that is loaded will be read atomically with
 // respect to all other atomic accesses to it within Scope.
 type OpAtomicLoad struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
 }
 
 func (c *OpAtomicLoad) Opcode() uint32 { return opcodeAtomicLoad }
@@ -35,10 +28,10 @@ func (c *OpAtomicLoad) Verify() error  { return nil }
 // All subparts of Value will be written atomically with respect to all
 // other atomic accesses to it within Scope.
 type OpAtomicStore struct {
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	Pointer   Id
+	Scope     Id
+	Semantics Id
+	Value     Id
 }
 
 func (c *OpAtomicStore) Opcode() uint32 { return opcodeAtomicStore }
@@ -48,18 +41,18 @@ func (c *OpAtomicStore) Verify() error  { return nil }
 // OpAtomicExchange performs the following steps atomically with respect to any
 // other atomic accesses within Scope to the same location:
 //
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value from copying Value, and
-//   3) store the New Value back through Pointer.
+//  1. load through Pointer to get an Original Value,
+//  2. get a New Value from copying Value, and
+//  3. store the New Value back through Pointer.
 //
 // The instruction’s result is the Original Value.
 type OpAtomicExchange struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicExchange) Opcode() uint32 { return opcodeAtomicExchange }
@@ -69,20 +62,21 @@ func (c *OpAtomicExchange) Verify() error  { return nil }
 // OpAtomicCompareExchange performs the following steps atomically with respect
 // to any other atomic accesses within Scope to the same location:
 //
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by selecting Value if Original Value equals Comparator
-//      or selecting Original Value otherwise, and
-//   3) store the New Value back through Pointer.
+//  1. load through Pointer to get an Original Value,
+//  2. get a New Value by selecting Value if Original Value equals Comparator
+//     or selecting Original Value otherwise, and
+//  3. store the New Value back through Pointer.
 //
 // The instruction’s result is the Original Value.
 type OpAtomicCompareExchange struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
-	Comparator     Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Equal      Id
+	Unequal    Id
+	Value      Id
+	Comparator Id
 }
 
 func (c *OpAtomicCompareExchange) Opcode() uint32 { return opcodeAtomicCompareExchange }
@@ -92,19 +86,19 @@ func (c *OpAtomicCompareExchange) Verify() error  { return nil }
 // OpAtomicCompareExchangeWeak performs the following steps atomically
 // with respect to any other atomic accesses within Scope to the same location:
 //
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by selecting Value if Original Value equals Comparator
-//      or selecting Original Value otherwise, and
-//   3) store the New Value back through Pointer.
-//
+//  1. load through Pointer to get an Original Value,
+//  2. get a New Value by selecting Value if Original Value equals Comparator
+//     or selecting Original Value otherwise, and
+//  3. store the New Value back through Pointer.
 type OpAtomicCompareExchangeWeak struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
-	Comparator     Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Equal      Id
+	Unequal    Id
+	Value      Id
+	Comparator Id
 }
 
 func (c *OpAtomicCompareExchangeWeak) Opcode() uint32 { return opcodeAtomicCompareExchangeWeak }
@@ -114,16 +108,15 @@ func (c *OpAtomicCompareExchangeWeak) Verify() error  { return nil }
 // OpAtomicIIncrement performs the following steps atomically with respect
 // to any other atomic accesses within Scope to the same location
 //
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value through integer addition of 1 to Original Value, and
-//   3) store the New Value back through Pointer.
-//
+//  1. load through Pointer to get an Original Value,
+//  2. get a New Value through integer addition of 1 to Original Value, and
+//  3. store the New Value back through Pointer.
 type OpAtomicIIncrement struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
 }
 
 func (c *OpAtomicIIncrement) Opcode() uint32 { return opcodeAtomicIIncrement }
@@ -133,16 +126,15 @@ func (c *OpAtomicIIncrement) Verify() error  { return nil }
 // OpAtomicIDecrement performs the following steps atomically with respect
 // to any other atomic accesses within Scope to the same location
 //
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value through integer subtraction of 1 from Original Value, and
-//   3) store the New Value back through Pointer.
-//
+//  1. load through Pointer to get an Original Value,
+//  2. get a New Value through integer subtraction of 1 from Original Value, and
+//  3. store the New Value back through Pointer.
 type OpAtomicIDecrement struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
 }
 
 func (c *OpAtomicIDecrement) Opcode() uint32 { return opcodeAtomicIDecrement }
@@ -152,17 +144,16 @@ func (c *OpAtomicIDecrement) Verify() error  { return nil }
 // OpAtomicIAdd performs the following steps atomically with respect to
 // any other atomic accesses within Scope to the same location:
 //
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by integer addition of Original Value and Value, and
-//   3) store the New Value back through Pointer.
-//
+//  1. load through Pointer to get an Original Value,
+//  2. get a New Value by integer addition of Original Value and Value, and
+//  3. store the New Value back through Pointer.
 type OpAtomicIAdd struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicIAdd) Opcode() uint32 { return opcodeAtomicIAdd }
@@ -172,59 +163,86 @@ func (c *OpAtomicIAdd) Verify() error  { return nil }
 // OpAtomicISub performs the following steps atomically with respect to
 // any other atomic accesses within Scope to the same location:
 //
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by integer subtraction of Value from Original Value, and
-//   3) store the New Value back through Pointer.
-//
+//  1. load through Pointer to get an Original Value,
+//  2. get a New Value by integer subtraction of Value from Original Value, and
+//  3. store the New Value back through Pointer.
 type OpAtomicISub struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicISub) Opcode() uint32 { return opcodeAtomicISub }
 func (c *OpAtomicISub) Optional() bool { return false }
 func (c *OpAtomicISub) Verify() error  { return nil }
 
+// OpAtomicSMin atomically finds the smallest signed integer of Value and
+// the value pointed to by Pointer, and stores the result through Pointer.
+type OpAtomicSMin struct {
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
+	Value      Id
+}
+
+func (c *OpAtomicSMin) Opcode() uint32 { return opcodeAtomicSMin }
+func (c *OpAtomicSMin) Optional() bool { return false }
+func (c *OpAtomicSMin) Verify() error  { return nil }
+
 // OpAtomicUMin performs the following steps atomically with respect to
 // any other atomic accesses within Scope to the same location:
 //
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by finding the smallest unsigned integer of
-//      Original Value and Value, and
-//   3) store the New Value back through Pointer.
-//
+//  1. load through Pointer to get an Original Value,
+//  2. get a New Value by finding the smallest unsigned integer of
+//     Original Value and Value, and
+//  3. store the New Value back through Pointer.
 type OpAtomicUMin struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicUMin) Opcode() uint32 { return opcodeAtomicUMin }
 func (c *OpAtomicUMin) Optional() bool { return false }
 func (c *OpAtomicUMin) Verify() error  { return nil }
 
+// OpAtomicSMax atomically finds the largest signed integer of Value and
+// the value pointed to by Pointer, and stores the result through Pointer.
+type OpAtomicSMax struct {
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
+	Value      Id
+}
+
+func (c *OpAtomicSMax) Opcode() uint32 { return opcodeAtomicSMax }
+func (c *OpAtomicSMax) Optional() bool { return false }
+func (c *OpAtomicSMax) Verify() error  { return nil }
+
 // OpAtomicUMax performs the following steps atomically with respect to
 // any other atomic accesses within Scope to the same location:
 //
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by finding the largest unsigned integer of Original
-//      Value and Value, and
-//   3) store the New Value back through Pointer.
-//
+//  1. load through Pointer to get an Original Value,
+//  2. get a New Value by finding the largest unsigned integer of Original
+//     Value and Value, and
+//  3. store the New Value back through Pointer.
 type OpAtomicUMax struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicUMax) Opcode() uint32 { return opcodeAtomicUMax }
@@ -234,17 +252,16 @@ func (c *OpAtomicUMax) Verify() error  { return nil }
 // OpAtomicAnd performs the following steps atomically with respect to
 // any other atomic accesses within Scope to the same location:
 //
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by the bitwise AND of Original Value and Value, and
-//   3) store the New Value back through Pointer.
-//
+//  1. load through Pointer to get an Original Value,
+//  2. get a New Value by the bitwise AND of Original Value and Value, and
+//  3. store the New Value back through Pointer.
 type OpAtomicAnd struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicAnd) Opcode() uint32 { return opcodeAtomicAnd }
@@ -254,17 +271,16 @@ func (c *OpAtomicAnd) Verify() error  { return nil }
 // OpAtomicOr performs the following steps atomically with respect to
 // any other atomic accesses within Scope to the same location:
 //
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by the bitwise OR of Original Value and Value, and
-//   3) store the New Value back through Pointer.
-//
+//  1. load through Pointer to get an Original Value,
+//  2. get a New Value by the bitwise OR of Original Value and Value, and
+//  3. store the New Value back through Pointer.
 type OpAtomicOr struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicOr) Opcode() uint32 { return opcodeAtomicOr }
@@ -274,26 +290,51 @@ func (c *OpAtomicOr) Verify() error  { return nil }
 // OpAtomicXor performs the following steps atomically with respect to
 // any other atomic accesses within Scope to the same location:
 //
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by the bitwise exclusive OR of Original
-//      Value and Value, and
-//   3) store the New Value back through Pointer.
-//
+//  1. load through Pointer to get an Original Value,
+//  2. get a New Value by the bitwise exclusive OR of Original
+//     Value and Value, and
+//  3. store the New Value back through Pointer.
 type OpAtomicXor struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicXor) Opcode() uint32 { return opcodeAtomicXor }
 func (c *OpAtomicXor) Optional() bool { return false }
 func (c *OpAtomicXor) Verify() error  { return nil }
 
+// OpAtomicFlagTestAndSet atomically sets the flag value pointed to by
+// Pointer to the set state. The result is true if the flag was in the set
+// state, false otherwise.
+type OpAtomicFlagTestAndSet struct {
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Scope      Id
+	Semantics  Id
+}
+
+func (c *OpAtomicFlagTestAndSet) Opcode() uint32 { return opcodeAtomicFlagTestAndSet }
+func (c *OpAtomicFlagTestAndSet) Optional() bool { return false }
+func (c *OpAtomicFlagTestAndSet) Verify() error  { return nil }
+
+// OpAtomicFlagClear atomically sets the flag value pointed to by
+// Pointer to the clear state.
+type OpAtomicFlagClear struct {
+	Pointer   Id
+	Scope     Id
+	Semantics Id
+}
+
+func (c *OpAtomicFlagClear) Opcode() uint32 { return opcodeAtomicFlagClear }
+func (c *OpAtomicFlagClear) Optional() bool { return false }
+func (c *OpAtomicFlagClear) Verify() error  { return nil }
+
 func init() {
-	bind(func() Instruction { return &OpAtomicInit{} })
 	bind(func() Instruction { return &OpAtomicLoad{} })
 	bind(func() Instruction { return &OpAtomicStore{} })
 	bind(func() Instruction { return &OpAtomicExchange{} })
@@ -303,9 +344,13 @@ func init() {
 	bind(func() Instruction { return &OpAtomicIDecrement{} })
 	bind(func() Instruction { return &OpAtomicIAdd{} })
 	bind(func() Instruction { return &OpAtomicISub{} })
+	bind(func() Instruction { return &OpAtomicSMin{} })
 	bind(func() Instruction { return &OpAtomicUMin{} })
+	bind(func() Instruction { return &OpAtomicSMax{} })
 	bind(func() Instruction { return &OpAtomicUMax{} })
 	bind(func() Instruction { return &OpAtomicAnd{} })
 	bind(func() Instruction { return &OpAtomicOr{} })
 	bind(func() Instruction { return &OpAtomicXor{} })
+	bind(func() Instruction { return &OpAtomicFlagTestAndSet{} })
+	bind(func() Instruction { return &OpAtomicFlagClear{} })
 }
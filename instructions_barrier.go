@@ -1,18 +1,26 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
 package spirv
 
-// OpControlBarrier waits for other invocations of this module to reach this
-// same point of execution. All invocations of this module within Scope must
-// reach this point of execution before any will proceed beyond it.
+// OpControlBarrier waits for other invocations of this module to reach
+// the current point of execution.
 //
-// This instruction is only guaranteed to work correctly if placed strictly
-// within dynamically uniform control flow within Scope. This ensures that if
-// any invocation executes it, all invocations will execute it. If placed
-// elsewhere, an invocation may stall indefinitely.
+// All invocations of this module within Execution scope must reach this point
+// of execution before any invocation will proceed beyond it.
 type OpControlBarrier struct {
-	ExecutionScope ExecutionScope
+	// Execution is the scope of the execution barrier.
+	Execution Id
+
+	// Memory is the scope of the memory barrier.
+	Memory Id
+
+	// Semantics declares what kind of memory is being controlled.
+	Semantics Id
 }
 
 func (c *OpControlBarrier) Opcode() uint32 { return opcodeControlBarrier }
@@ -21,13 +29,14 @@ func (c *OpControlBarrier) Verify() error  { return nil }
 
 // OpMemoryBarrier controls the order that memory accesses are observed.
 //
-// Ensures that memory accesses issued before this instruction will be observed
-// before memory accesses issued after this instruction. This control is
-// ensured only for memory accesses issued by this invocation and observed by
-// another invocation executing within Scope.
+// Ensures that memory accesses issued before this instruction will be
+// observed before memory accesses issued after this instruction.
 type OpMemoryBarrier struct {
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
+	// Memory is the scope of the memory barrier.
+	Memory Id
+
+	// Semantics declares what kind of memory is being controlled.
+	Semantics Id
 }
 
 func (c *OpMemoryBarrier) Opcode() uint32 { return opcodeMemoryBarrier }
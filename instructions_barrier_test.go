@@ -8,20 +8,18 @@ import "testing"
 func TestBarrier(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x00200bc, ExecutionScopeDevice},
+			in: []uint32{0x000400e0, 1, 2, 3},
 			want: &OpControlBarrier{
-				ExecutionScope: ExecutionScopeDevice,
+				Execution: 1,
+				Memory:    2,
+				Semantics: 3,
 			},
 		},
 		{
-			in: []uint32{
-				0x00300bd,
-				ExecutionScopeDevice,
-				MemorySemanticSequentiallyConsistent,
-			},
+			in: []uint32{0x000300e1, 1, 2},
 			want: &OpMemoryBarrier{
-				ExecutionScope: ExecutionScopeDevice,
-				MemorySemantic: MemorySemanticSequentiallyConsistent,
+				Memory:    1,
+				Semantics: 2,
 			},
 		},
 	} {
@@ -8,7 +8,7 @@ import "testing"
 func TestComposite(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x0005003a, 1, 2, 3, 4},
+			in: []uint32{0x0005004d, 1, 2, 3, 4},
 			want: &OpVectorExtractDynamic{
 				ResultType: 1,
 				ResultId:   2,
@@ -17,7 +17,7 @@ func TestComposite(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0006003b, 1, 2, 3, 4, 5},
+			in: []uint32{0x0006004e, 1, 2, 3, 4, 5},
 			want: &OpVectorInsertDynamic{
 				ResultType: 1,
 				ResultId:   2,
@@ -27,25 +27,41 @@ func TestComposite(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0008003c, 1, 2, 3, 4, 5, 6, 7},
+			in: []uint32{0x0005004f, 1, 2, 3, 4},
 			want: &OpVectorShuffle{
 				ResultType: 1,
 				ResultId:   2,
 				Vector1:    3,
 				Vector2:    4,
-				Components: []uint32{5, 6, 7},
 			},
 		},
 		{
-			in: []uint32{0x0006003d, 1, 2, 3, 4, 5},
+			in: []uint32{0x0007004f, 1, 2, 3, 4, 5, 6},
+			want: &OpVectorShuffle{
+				ResultType: 1,
+				ResultId:   2,
+				Vector1:    3,
+				Vector2:    4,
+				Components: []uint32{5, 6},
+			},
+		},
+		{
+			in: []uint32{0x00030050, 1, 2},
+			want: &OpCompositeConstruct{
+				ResultType: 1,
+				ResultId:   2,
+			},
+		},
+		{
+			in: []uint32{0x00050050, 1, 2, 3, 4},
 			want: &OpCompositeConstruct{
 				ResultType:   1,
 				ResultId:     2,
-				Constituents: []Id{3, 4, 5},
+				Constituents: []Id{3, 4},
 			},
 		},
 		{
-			in: []uint32{0x0004003e, 1, 2, 3},
+			in: []uint32{0x00040051, 1, 2, 3},
 			want: &OpCompositeExtract{
 				ResultType: 1,
 				ResultId:   2,
@@ -53,7 +69,7 @@ func TestComposite(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0006003e, 1, 2, 3, 4, 5},
+			in: []uint32{0x00060051, 1, 2, 3, 4, 5},
 			want: &OpCompositeExtract{
 				ResultType: 1,
 				ResultId:   2,
@@ -62,7 +78,7 @@ func TestComposite(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0005003f, 1, 2, 3, 4},
+			in: []uint32{0x00050052, 1, 2, 3, 4},
 			want: &OpCompositeInsert{
 				ResultType: 1,
 				ResultId:   2,
@@ -71,7 +87,7 @@ func TestComposite(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0007003f, 1, 2, 3, 4, 5, 6},
+			in: []uint32{0x00070052, 1, 2, 3, 4, 5, 6},
 			want: &OpCompositeInsert{
 				ResultType: 1,
 				ResultId:   2,
@@ -81,7 +97,7 @@ func TestComposite(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00040040, 1, 2, 3},
+			in: []uint32{0x00040053, 1, 2, 3},
 			want: &OpCopyObject{
 				ResultType: 1,
 				ResultId:   2,
@@ -89,7 +105,7 @@ func TestComposite(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00040070, 1, 2, 3},
+			in: []uint32{0x00040054, 1, 2, 3},
 			want: &OpTranspose{
 				ResultType: 1,
 				ResultId:   2,
@@ -1,9 +1,11 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
-package spirv
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
 
-import "fmt"
+package spirv
 
 // OpConstantTrue declares a true Boolean-type scalar constant.
 type OpConstantTrue struct {
@@ -68,17 +70,13 @@ func (c *OpConstantComposite) Opcode() uint32 { return opcodeConstantComposite }
 func (c *OpConstantComposite) Optional() bool { return false }
 func (c *OpConstantComposite) Verify() error  { return nil }
 
-// FIXME: Specification uses a Literal Number for Mode and Filter, not
-// SamplerAddressingMode and SamplerFilterMode, respectively. Probably
-// in error.
-
-// OpConstantSampler declares a new null sampler constant.
+// OpConstantSampler declares a new sampler constant.
 type OpConstantSampler struct {
 	ResultType Id
 	ResultId   Id
 
-	// Mode is the addressing Mode.
-	Mode SamplerAddressingMode
+	// AddressingMode is the addressing mode.
+	AddressingMode SamplerAddressingMode
 
 	// Param is one of:
 	//
@@ -87,42 +85,32 @@ type OpConstantSampler struct {
 	//
 	Param uint32
 
-	// Filter is the filter mode.
-	Filter SamplerFilterMode
+	// FilterMode is the filter mode.
+	FilterMode SamplerFilterMode
 }
 
 func (c *OpConstantSampler) Opcode() uint32 { return opcodeConstantSampler }
 func (c *OpConstantSampler) Optional() bool { return false }
-func (c *OpConstantSampler) Verify() error {
-	switch c.Param {
-	case 0, 1:
-	default:
-		return fmt.Errorf("OpConstantSampler: Param must be 0 or 1")
-	}
-
-	return nil
-}
-
-// OpConstantNullPointer declares a new null pointer constant.
-type OpConstantNullPointer struct {
-	ResultType Id
-	ResultId   Id
-}
+func (c *OpConstantSampler) Verify() error  { return c.verify() }
 
-func (c *OpConstantNullPointer) Opcode() uint32 { return opcodeConstantNullPointer }
-func (c *OpConstantNullPointer) Optional() bool { return false }
-func (c *OpConstantNullPointer) Verify() error  { return nil }
-
-// OpConstantNullObject declares a new null object constant.
-// The objerct can be a queue, event or reservation id.
-type OpConstantNullObject struct {
+// OpConstantNull declares a new null constant value.
+//
+// The null value is type dependent, defined as follows:
+//
+//   - Scalar Boolean: false
+//   - Scalar integer: 0
+//   - Scalar floating point: +0.0 (all bits 0)
+//   - All other scalars: Abstract
+//   - Composites: Members are set recursively to the null constant
+//     according to the null value of their constituent types.
+type OpConstantNull struct {
 	ResultType Id
 	ResultId   Id
 }
 
-func (c *OpConstantNullObject) Opcode() uint32 { return opcodeConstantNullObject }
-func (c *OpConstantNullObject) Optional() bool { return false }
-func (c *OpConstantNullObject) Verify() error  { return nil }
+func (c *OpConstantNull) Opcode() uint32 { return opcodeConstantNull }
+func (c *OpConstantNull) Optional() bool { return false }
+func (c *OpConstantNull) Verify() error  { return nil }
 
 // OpSpecConstantTrue declares a Boolean-type scalar specialization
 // constant with a default value of true.
@@ -196,16 +184,33 @@ func (c *OpSpecConstantComposite) Opcode() uint32 { return opcodeSpecConstantCom
 func (c *OpSpecConstantComposite) Optional() bool { return false }
 func (c *OpSpecConstantComposite) Verify() error  { return nil }
 
+// OpSpecConstantOp declares a new specialization constant that results
+// from doing an operation.
+type OpSpecConstantOp struct {
+	ResultType Id
+	ResultId   Id
+
+	// Operation is the opcode of the operation to perform.
+	Operation uint32
+
+	// The operands to the operation.
+	Operands []Id
+}
+
+func (c *OpSpecConstantOp) Opcode() uint32 { return opcodeSpecConstantOp }
+func (c *OpSpecConstantOp) Optional() bool { return false }
+func (c *OpSpecConstantOp) Verify() error  { return nil }
+
 func init() {
-	bind(func() Instruction { return &OpSpecConstantTrue{} })
 	bind(func() Instruction { return &OpConstantTrue{} })
 	bind(func() Instruction { return &OpConstantFalse{} })
 	bind(func() Instruction { return &OpConstant{} })
 	bind(func() Instruction { return &OpConstantComposite{} })
 	bind(func() Instruction { return &OpConstantSampler{} })
-	bind(func() Instruction { return &OpConstantNullPointer{} })
-	bind(func() Instruction { return &OpConstantNullObject{} })
+	bind(func() Instruction { return &OpConstantNull{} })
+	bind(func() Instruction { return &OpSpecConstantTrue{} })
 	bind(func() Instruction { return &OpSpecConstantFalse{} })
 	bind(func() Instruction { return &OpSpecConstant{} })
 	bind(func() Instruction { return &OpSpecConstantComposite{} })
+	bind(func() Instruction { return &OpSpecConstantOp{} })
 }
@@ -1,26 +1,30 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
 package spirv
 
-// OpConvertFToU converts (value preserving) Float Value from floating
-// point to unsigned integer, with rounding toward 0
+// OpConvertFToU converts (value preserving) Float Value from
+// floating point to unsigned integer, with round toward 0.0.
 type OpConvertFToU struct {
 	ResultType Id
 	ResultId   Id
-	Value      Id
+	FloatValue Id
 }
 
 func (c *OpConvertFToU) Opcode() uint32 { return opcodeConvertFToU }
 func (c *OpConvertFToU) Optional() bool { return false }
 func (c *OpConvertFToU) Verify() error  { return nil }
 
-// OpConvertFToS converts (value preserving) Float Value from floating
-// point to signed integer, with round toward 0
+// OpConvertFToS converts (value preserving) Float Value from
+// floating point to signed integer, with round toward 0.0.
 type OpConvertFToS struct {
 	ResultType Id
 	ResultId   Id
-	Value      Id
+	FloatValue Id
 }
 
 func (c *OpConvertFToS) Opcode() uint32 { return opcodeConvertFToS }
@@ -30,9 +34,9 @@ func (c *OpConvertFToS) Verify() error  { return nil }
 // OpConvertSToF converts (value preserving) Signed Value from signed integer
 // to floating point.
 type OpConvertSToF struct {
-	ResultType Id
-	ResultId   Id
-	Value      Id
+	ResultType  Id
+	ResultId    Id
+	SignedValue Id
 }
 
 func (c *OpConvertSToF) Opcode() uint32 { return opcodeConvertSToF }
@@ -42,9 +46,9 @@ func (c *OpConvertSToF) Verify() error  { return nil }
 // OpConvertUToF converts (value preserving) Unsigned value from unsigned
 // integer to floating point
 type OpConvertUToF struct {
-	ResultType Id
-	ResultId   Id
-	Value      Id
+	ResultType    Id
+	ResultId      Id
+	UnsignedValue Id
 }
 
 func (c *OpConvertUToF) Opcode() uint32 { return opcodeConvertUToF }
@@ -54,9 +58,9 @@ func (c *OpConvertUToF) Verify() error  { return nil }
 // OpUConvert converts (value preserving) the width of Unsigned value.
 // This is either a truncate or a zero extend.
 type OpUConvert struct {
-	ResultType Id
-	ResultId   Id
-	Value      Id
+	ResultType    Id
+	ResultId      Id
+	UnsignedValue Id
 }
 
 func (c *OpUConvert) Opcode() uint32 { return opcodeUConvert }
@@ -66,9 +70,9 @@ func (c *OpUConvert) Verify() error  { return nil }
 // OpSConvert converts (value preserving) the width of Signed Value.
 // This is either a truncate or a sign extend.
 type OpSConvert struct {
-	ResultType Id
-	ResultId   Id
-	Value      Id
+	ResultType  Id
+	ResultId    Id
+	SignedValue Id
 }
 
 func (c *OpSConvert) Opcode() uint32 { return opcodeSConvert }
@@ -83,62 +87,111 @@ func (c *OpSConvert) Verify() error  { return nil }
 type OpFConvert struct {
 	ResultType Id
 	ResultId   Id
-	Value      Id
+	FloatValue Id
 }
 
 func (c *OpFConvert) Opcode() uint32 { return opcodeFConvert }
 func (c *OpFConvert) Optional() bool { return false }
 func (c *OpFConvert) Verify() error  { return nil }
 
-// OpConvertPtrToU converts Pointer to an unsigned integer type. A Result Type
-// width larger than the width of Pointer will zero extend.
-type OpConvertPtrToU struct {
+// OpQuantizeToF16 quantizes a floating-point value to what is
+// expressible by a 16-bit floating-point value.
+type OpQuantizeToF16 struct {
 	ResultType Id
 	ResultId   Id
 	Value      Id
 }
 
+func (c *OpQuantizeToF16) Opcode() uint32 { return opcodeQuantizeToF16 }
+func (c *OpQuantizeToF16) Optional() bool { return false }
+func (c *OpQuantizeToF16) Verify() error  { return nil }
+
+// OpConvertPtrToU converts Pointer to an unsigned integer type.
+type OpConvertPtrToU struct {
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+}
+
 func (c *OpConvertPtrToU) Opcode() uint32 { return opcodeConvertPtrToU }
 func (c *OpConvertPtrToU) Optional() bool { return false }
 func (c *OpConvertPtrToU) Verify() error  { return nil }
 
-// OpConvertUToPtr converts Integer value to a pointer. A Result Type width
-// smaller than the width of Integer value pointer will truncate.
+// OpSatConvertSToU converts a signed integer to unsigned integer.
+// Converted values outside the representable range of Result Type are clamped
+// to the nearest representable value of Result Type.
+type OpSatConvertSToU struct {
+	ResultType  Id
+	ResultId    Id
+	SignedValue Id
+}
+
+func (c *OpSatConvertSToU) Opcode() uint32 { return opcodeSatConvertSToU }
+func (c *OpSatConvertSToU) Optional() bool { return false }
+func (c *OpSatConvertSToU) Verify() error  { return nil }
+
+// OpSatConvertUToS converts an unsigned integer to signed integer.
+// Converted values outside the representable range of Result Type are clamped
+// to the nearest representable value of Result Type.
+type OpSatConvertUToS struct {
+	ResultType    Id
+	ResultId      Id
+	UnsignedValue Id
+}
+
+func (c *OpSatConvertUToS) Opcode() uint32 { return opcodeSatConvertUToS }
+func (c *OpSatConvertUToS) Optional() bool { return false }
+func (c *OpSatConvertUToS) Verify() error  { return nil }
+
+// OpConvertUToPtr converts Integer Value to a pointer.
 type OpConvertUToPtr struct {
-	ResultType Id
-	ResultId   Id
-	Value      Id
+	ResultType   Id
+	ResultId     Id
+	IntegerValue Id
 }
 
 func (c *OpConvertUToPtr) Opcode() uint32 { return opcodeConvertUToPtr }
 func (c *OpConvertUToPtr) Optional() bool { return false }
 func (c *OpConvertUToPtr) Verify() error  { return nil }
 
-// OpPtrCastToGeneric converts Source pointer to a pointer value pointing to
-// storage class Generic. Source pointer must point to storage class
-// WorkgroupLocal, WorkgroupGlobal or Private.
+// OpPtrCastToGeneric converts Pointer to a pointer with the
+// Generic storage class.
 type OpPtrCastToGeneric struct {
 	ResultType Id
 	ResultId   Id
-	Source     Id
+	Pointer    Id
 }
 
 func (c *OpPtrCastToGeneric) Opcode() uint32 { return opcodePtrCastToGeneric }
 func (c *OpPtrCastToGeneric) Optional() bool { return false }
 func (c *OpPtrCastToGeneric) Verify() error  { return nil }
 
-// OpGenericCastToPtr converts Source pointer to a non-Generic storage-class
-// pointer value. Source pointer must point to Generic.
+// OpGenericCastToPtr converts the Generic Pointer to a pointer with
+// the Workgroup, CrossWorkgroup or Function storage class.
 type OpGenericCastToPtr struct {
 	ResultType Id
 	ResultId   Id
-	Source     Id
+	Pointer    Id
 }
 
 func (c *OpGenericCastToPtr) Opcode() uint32 { return opcodeGenericCastToPtr }
 func (c *OpGenericCastToPtr) Optional() bool { return false }
 func (c *OpGenericCastToPtr) Verify() error  { return nil }
 
+// OpGenericCastToPtrExplicit attempts to explicitly convert Pointer
+// to the Storage storage class. It returns a null pointer if the conversion
+// fails.
+type OpGenericCastToPtrExplicit struct {
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Storage    StorageClass
+}
+
+func (c *OpGenericCastToPtrExplicit) Opcode() uint32 { return opcodeGenericCastToPtrExplicit }
+func (c *OpGenericCastToPtrExplicit) Optional() bool { return false }
+func (c *OpGenericCastToPtrExplicit) Verify() error  { return nil }
+
 // OpBitcast defines a Bit-pattern preserving type conversion for
 // Numerical-type or pointer-type vectors and scalars.
 type OpBitcast struct {
@@ -151,19 +204,6 @@ func (c *OpBitcast) Opcode() uint32 { return opcodeBitcast }
 func (c *OpBitcast) Optional() bool { return false }
 func (c *OpBitcast) Verify() error  { return nil }
 
-// OpGenericCastToPtrExplicit attempts to explicitly convert Source pointer
-// to storage storage-class pointer value.
-type OpGenericCastToPtrExplicit struct {
-	ResultType Id
-	ResultId   Id
-	SourcePtr  Id
-	Storage    StorageClass
-}
-
-func (c *OpGenericCastToPtrExplicit) Opcode() uint32 { return opcodeGenericCastToPtrExplicit }
-func (c *OpGenericCastToPtrExplicit) Optional() bool { return false }
-func (c *OpGenericCastToPtrExplicit) Verify() error  { return nil }
-
 func init() {
 	bind(func() Instruction { return &OpConvertFToU{} })
 	bind(func() Instruction { return &OpConvertFToS{} })
@@ -172,10 +212,13 @@ func init() {
 	bind(func() Instruction { return &OpUConvert{} })
 	bind(func() Instruction { return &OpSConvert{} })
 	bind(func() Instruction { return &OpFConvert{} })
+	bind(func() Instruction { return &OpQuantizeToF16{} })
 	bind(func() Instruction { return &OpConvertPtrToU{} })
+	bind(func() Instruction { return &OpSatConvertSToU{} })
+	bind(func() Instruction { return &OpSatConvertUToS{} })
 	bind(func() Instruction { return &OpConvertUToPtr{} })
 	bind(func() Instruction { return &OpPtrCastToGeneric{} })
 	bind(func() Instruction { return &OpGenericCastToPtr{} })
-	bind(func() Instruction { return &OpBitcast{} })
 	bind(func() Instruction { return &OpGenericCastToPtrExplicit{} })
+	bind(func() Instruction { return &OpBitcast{} })
 }
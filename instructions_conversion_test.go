@@ -3,115 +3,137 @@
 
 package spirv
 
-import (
-	"testing"
-)
+import "testing"
 
 func TestConversions(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x00040064, 1, 2, 3},
+			in: []uint32{0x0004006d, 1, 2, 3},
 			want: &OpConvertFToU{
 				ResultType: 1,
 				ResultId:   2,
-				Value:      3,
+				FloatValue: 3,
 			},
 		},
 		{
-			in: []uint32{0x00040065, 1, 2, 3},
+			in: []uint32{0x0004006e, 1, 2, 3},
 			want: &OpConvertFToS{
 				ResultType: 1,
 				ResultId:   2,
-				Value:      3,
+				FloatValue: 3,
 			},
 		},
 		{
-			in: []uint32{0x00040066, 1, 2, 3},
+			in: []uint32{0x0004006f, 1, 2, 3},
 			want: &OpConvertSToF{
-				ResultType: 1,
-				ResultId:   2,
-				Value:      3,
+				ResultType:  1,
+				ResultId:    2,
+				SignedValue: 3,
 			},
 		},
 		{
-			in: []uint32{0x00040067, 1, 2, 3},
+			in: []uint32{0x00040070, 1, 2, 3},
 			want: &OpConvertUToF{
-				ResultType: 1,
-				ResultId:   2,
-				Value:      3,
+				ResultType:    1,
+				ResultId:      2,
+				UnsignedValue: 3,
 			},
 		},
 		{
-			in: []uint32{0x00040068, 1, 2, 3},
+			in: []uint32{0x00040071, 1, 2, 3},
 			want: &OpUConvert{
-				ResultType: 1,
-				ResultId:   2,
-				Value:      3,
+				ResultType:    1,
+				ResultId:      2,
+				UnsignedValue: 3,
 			},
 		},
 		{
-			in: []uint32{0x00040069, 1, 2, 3},
+			in: []uint32{0x00040072, 1, 2, 3},
 			want: &OpSConvert{
+				ResultType:  1,
+				ResultId:    2,
+				SignedValue: 3,
+			},
+		},
+		{
+			in: []uint32{0x00040073, 1, 2, 3},
+			want: &OpFConvert{
 				ResultType: 1,
 				ResultId:   2,
-				Value:      3,
+				FloatValue: 3,
 			},
 		},
 		{
-			in: []uint32{0x0004006a, 1, 2, 3},
-			want: &OpFConvert{
+			in: []uint32{0x00040074, 1, 2, 3},
+			want: &OpQuantizeToF16{
 				ResultType: 1,
 				ResultId:   2,
 				Value:      3,
 			},
 		},
 		{
-			in: []uint32{0x0004006b, 1, 2, 3},
+			in: []uint32{0x00040075, 1, 2, 3},
 			want: &OpConvertPtrToU{
 				ResultType: 1,
 				ResultId:   2,
-				Value:      3,
+				Pointer:    3,
+			},
+		},
+		{
+			in: []uint32{0x00040076, 1, 2, 3},
+			want: &OpSatConvertSToU{
+				ResultType:  1,
+				ResultId:    2,
+				SignedValue: 3,
+			},
+		},
+		{
+			in: []uint32{0x00040077, 1, 2, 3},
+			want: &OpSatConvertUToS{
+				ResultType:    1,
+				ResultId:      2,
+				UnsignedValue: 3,
 			},
 		},
 		{
-			in: []uint32{0x0004006c, 1, 2, 3},
+			in: []uint32{0x00040078, 1, 2, 3},
 			want: &OpConvertUToPtr{
-				ResultType: 1,
-				ResultId:   2,
-				Value:      3,
+				ResultType:   1,
+				ResultId:     2,
+				IntegerValue: 3,
 			},
 		},
 		{
-			in: []uint32{0x0004006d, 1, 2, 3},
+			in: []uint32{0x00040079, 1, 2, 3},
 			want: &OpPtrCastToGeneric{
 				ResultType: 1,
 				ResultId:   2,
-				Source:     3,
+				Pointer:    3,
 			},
 		},
 		{
-			in: []uint32{0x0004006e, 1, 2, 3},
+			in: []uint32{0x0004007a, 1, 2, 3},
 			want: &OpGenericCastToPtr{
 				ResultType: 1,
 				ResultId:   2,
-				Source:     3,
+				Pointer:    3,
 			},
 		},
 		{
-			in: []uint32{0x0004006f, 1, 2, 3},
-			want: &OpBitcast{
+			in: []uint32{0x0005007b, 1, 2, 3, StorageClassPrivate},
+			want: &OpGenericCastToPtrExplicit{
 				ResultType: 1,
 				ResultId:   2,
-				Operand:    3,
+				Pointer:    3,
+				Storage:    StorageClassPrivate,
 			},
 		},
 		{
-			in: []uint32{0x000500e8, 1, 2, 3, StorageClassFunction},
-			want: &OpGenericCastToPtrExplicit{
+			in: []uint32{0x0004007c, 1, 2, 3},
+			want: &OpBitcast{
 				ResultType: 1,
 				ResultId:   2,
-				SourcePtr:  3,
-				Storage:    StorageClassFunction,
+				Operand:    3,
 			},
 		},
 	} {
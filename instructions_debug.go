@@ -1,15 +1,36 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
 package spirv
 
-// OpSource defines the OpSource instruction.
-//
-// It documents what source language a module was translated from.
+// OpSourceContinued continues specifying the Source text from the
+// previous instruction. This has no semantic impact and can safely be removed.
+type OpSourceContinued struct {
+	ContinuedSource String
+}
+
+func (c *OpSourceContinued) Opcode() uint32 { return opcodeSourceContinued }
+func (c *OpSourceContinued) Optional() bool { return true }
+func (c *OpSourceContinued) Verify() error  { return nil }
+
+// OpSource documents what source language a module was translated from.
 // This has no semantic impact and can safely be removed from a module.
 type OpSource struct {
 	SourceLanguage SourceLanguage
-	Version        uint32
+
+	// The version of the source language.
+	Version uint32
+
+	// File is an OpString instruction and is the source-level file name.
+	File Id `spirv:"optional"`
+
+	// Source is the text of the source-level file.
+	// Each client API describes what form this operand takes.
+	Source String `spirv:"optional"`
 }
 
 func (c *OpSource) Opcode() uint32 { return opcodeSource }
@@ -68,14 +89,17 @@ func (c *OpString) Opcode() uint32 { return opcodeString }
 func (c *OpString) Optional() bool { return true }
 func (c *OpString) Verify() error  { return nil }
 
-// OpLine defines the OpLine instruction.
-//
-// It adds source-level location information.
-// This has no semantic impact and can safely be removed from a module.
+// OpLine adds source-level location information to the instructions
+// which follow it. This has no semantic impact and can safely be removed
+// from a module.
 type OpLine struct {
-	Target Id
-	File   Id
-	Line   uint32
+	// File must be an OpString instruction holding the source file name.
+	File Id
+
+	// Line is the source line number.
+	Line uint32
+
+	// Column is the source column number.
 	Column uint32
 }
 
@@ -83,11 +107,21 @@ func (c *OpLine) Opcode() uint32 { return opcodeLine }
 func (c *OpLine) Optional() bool { return true }
 func (c *OpLine) Verify() error  { return nil }
 
+// OpNoLine discontinues any source-level location information that
+// might be active from a previous OpLine instruction.
+type OpNoLine struct{}
+
+func (c *OpNoLine) Opcode() uint32 { return opcodeNoLine }
+func (c *OpNoLine) Optional() bool { return true }
+func (c *OpNoLine) Verify() error  { return nil }
+
 func init() {
+	bind(func() Instruction { return &OpSourceContinued{} })
 	bind(func() Instruction { return &OpSource{} })
 	bind(func() Instruction { return &OpSourceExtension{} })
 	bind(func() Instruction { return &OpName{} })
 	bind(func() Instruction { return &OpMemberName{} })
 	bind(func() Instruction { return &OpString{} })
 	bind(func() Instruction { return &OpLine{} })
+	bind(func() Instruction { return &OpNoLine{} })
 }
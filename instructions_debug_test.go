@@ -8,35 +8,44 @@ import "testing"
 func TestDebug(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x0030001, SourceLanguageGLSL, 450},
+			in: []uint32{0x00030002, 0x74736574, 0x00000000},
+			want: &OpSourceContinued{
+				ContinuedSource: "test",
+			},
+		},
+		{
+			in: []uint32{0x00030003, SourceLanguageGLSL, 450},
 			want: &OpSource{
 				SourceLanguage: SourceLanguageGLSL,
 				Version:        450,
 			},
 		},
 		{
-			in: []uint32{
-				0x00070002,
-				0x74736574,
-				0x756f7320,
-				0x20656372,
-				0x65747865,
-				0x6f69736e,
-				0x0000006e,
+			in: []uint32{0x00070003,
+				SourceLanguageGLSL, 450, 1, 0x74736574, 0x756f7320, 0x00656372},
+			want: &OpSource{
+				SourceLanguage: SourceLanguageGLSL,
+				Version:        450,
+				File:           1,
+				Source:         "test source",
 			},
+		},
+		{
+			in: []uint32{0x00050004,
+				0x74736574, 0x74786520, 0x69736e65, 0x00006e6f},
 			want: &OpSourceExtension{
-				Extension: "test source extension",
+				Extension: "test extension",
 			},
 		},
 		{
-			in: []uint32{0x00050036, 1, 0x74736574, 0x6d616e5f, 0x00000065},
+			in: []uint32{0x00050005, 1, 0x74736574, 0x6d616e5f, 0x00000065},
 			want: &OpName{
 				Target: 1,
 				Name:   "test_name",
 			},
 		},
 		{
-			in: []uint32{0x00060037, 1, 2, 0x74736574, 0x6d616e5f, 0x00000065},
+			in: []uint32{0x00060006, 1, 2, 0x74736574, 0x6d616e5f, 0x00000065},
 			want: &OpMemberName{
 				Type:   1,
 				Member: 2,
@@ -44,21 +53,24 @@ func TestDebug(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00050038, 1, 0x74736574, 0x72747320, 0x676e69},
+			in: []uint32{0x00050007, 1, 0x74736574, 0x72747320, 0x00676e69},
 			want: &OpString{
 				ResultId: 1,
 				String:   "test string",
 			},
 		},
 		{
-			in: []uint32{0x00050039, 1, 2, 3, 4},
+			in: []uint32{0x00040008, 1, 2, 3},
 			want: &OpLine{
-				Target: 1,
-				File:   2,
-				Line:   3,
-				Column: 4,
+				File:   1,
+				Line:   2,
+				Column: 3,
 			},
 		},
+		{
+			in:   []uint32{0x0001013d},
+			want: &OpNoLine{},
+		},
 	} {
 		testInstruction(t, st)
 	}
@@ -8,7 +8,7 @@ import "testing"
 func TestDerivative(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x000400af, 1, 2, 3},
+			in: []uint32{0x000400cf, 1, 2, 3},
 			want: &OpDPdx{
 				ResultType: 1,
 				ResultId:   2,
@@ -16,7 +16,7 @@ func TestDerivative(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x000400b0, 1, 2, 3},
+			in: []uint32{0x000400d0, 1, 2, 3},
 			want: &OpDPdy{
 				ResultType: 1,
 				ResultId:   2,
@@ -24,7 +24,7 @@ func TestDerivative(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x000400b1, 1, 2, 3},
+			in: []uint32{0x000400d1, 1, 2, 3},
 			want: &OpFwidth{
 				ResultType: 1,
 				ResultId:   2,
@@ -32,7 +32,7 @@ func TestDerivative(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x000400b2, 1, 2, 3},
+			in: []uint32{0x000400d2, 1, 2, 3},
 			want: &OpDPdxFine{
 				ResultType: 1,
 				ResultId:   2,
@@ -40,7 +40,7 @@ func TestDerivative(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x000400b3, 1, 2, 3},
+			in: []uint32{0x000400d3, 1, 2, 3},
 			want: &OpDPdyFine{
 				ResultType: 1,
 				ResultId:   2,
@@ -48,7 +48,7 @@ func TestDerivative(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x000400b4, 1, 2, 3},
+			in: []uint32{0x000400d4, 1, 2, 3},
 			want: &OpFwidthFine{
 				ResultType: 1,
 				ResultId:   2,
@@ -56,7 +56,7 @@ func TestDerivative(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x000400b5, 1, 2, 3},
+			in: []uint32{0x000400d5, 1, 2, 3},
 			want: &OpDPdxCoarse{
 				ResultType: 1,
 				ResultId:   2,
@@ -64,7 +64,7 @@ func TestDerivative(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x000400b6, 1, 2, 3},
+			in: []uint32{0x000400d6, 1, 2, 3},
 			want: &OpDPdyCoarse{
 				ResultType: 1,
 				ResultId:   2,
@@ -72,7 +72,7 @@ func TestDerivative(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x000400b7, 1, 2, 3},
+			in: []uint32{0x000400d7, 1, 2, 3},
 			want: &OpFwidthCoarse{
 				ResultType: 1,
 				ResultId:   2,
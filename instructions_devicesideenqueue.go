@@ -1,13 +1,17 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
 package spirv
 
 // OpEnqueueMarker enqueues a marker command to to the queue object specified by Q.
 type OpEnqueueMarker struct {
 	ResultType Id
 	ResultId   Id
-	Q          Id
+	Queue      Id
 	NumEvents  Id
 	WaitEvents Id
 	RetEvent   Id
@@ -22,8 +26,8 @@ func (c *OpEnqueueMarker) Verify() error  { return nil }
 type OpEnqueueKernel struct {
 	ResultType Id
 	ResultId   Id
-	Q          Id
-	Flags      KernelEnqueueFlag
+	Queue      Id
+	Flags      Id
 	NDRange    Id
 	NumEvents  Id
 	WaitEvents Id
@@ -32,34 +36,42 @@ type OpEnqueueKernel struct {
 	Param      Id
 	ParamSize  Id
 	ParamAlign Id
-	LocalSize  []Id `spirv:"optional"`
+	LocalSize  []Id
 }
 
 func (c *OpEnqueueKernel) Opcode() uint32 { return opcodeEnqueueKernel }
 func (c *OpEnqueueKernel) Optional() bool { return false }
 func (c *OpEnqueueKernel) Verify() error  { return nil }
 
-// OpGetKernelNDrangeSubGroupCount returns the number of subgroups in each
-// work-group of the dispatch given the combination of NDRange and
-// Invoke.
+// OpGetKernelNDrangeSubGroupCount returns the number of subgroups in
+// each workgroup of the dispatch (except for the last in cases where the
+// global size does not divide cleanly into work-groups) given the combination
+// of the passed NDRange descriptor specified by ND Range and the function
+// specified by Invoke.
 type OpGetKernelNDrangeSubGroupCount struct {
 	ResultType Id
 	ResultId   Id
 	NDRange    Id
 	Invoke     Id
+	Param      Id
+	ParamSize  Id
+	ParamAlign Id
 }
 
 func (c *OpGetKernelNDrangeSubGroupCount) Opcode() uint32 { return opcodeGetKernelNDrangeSubGroupCount }
 func (c *OpGetKernelNDrangeSubGroupCount) Optional() bool { return false }
 func (c *OpGetKernelNDrangeSubGroupCount) Verify() error  { return nil }
 
-// OpGetKernelNDrangeMaxSubGroupSize returns the maximum sub-group size for
-// Invoke and NDRange.
+// OpGetKernelNDrangeMaxSubGroupSize returns the maximum sub-group size
+// for the function specified by Invoke and the NDRange specified by ND Range.
 type OpGetKernelNDrangeMaxSubGroupSize struct {
 	ResultType Id
 	ResultId   Id
 	NDRange    Id
 	Invoke     Id
+	Param      Id
+	ParamSize  Id
+	ParamAlign Id
 }
 
 func (c *OpGetKernelNDrangeMaxSubGroupSize) Opcode() uint32 {
@@ -74,6 +86,9 @@ type OpGetKernelWorkGroupSize struct {
 	ResultType Id
 	ResultId   Id
 	Invoke     Id
+	Param      Id
+	ParamSize  Id
+	ParamAlign Id
 }
 
 func (c *OpGetKernelWorkGroupSize) Opcode() uint32 { return opcodeGetKernelWorkGroupSize }
@@ -86,6 +101,9 @@ type OpGetKernelPreferredWorkGroupSizeMultiple struct {
 	ResultType Id
 	ResultId   Id
 	Invoke     Id
+	Param      Id
+	ParamSize  Id
+	ParamAlign Id
 }
 
 func (c *OpGetKernelPreferredWorkGroupSizeMultiple) Opcode() uint32 {
@@ -108,7 +126,7 @@ type OpReleaseEvent struct {
 	Event Id
 }
 
-func (c *OpReleaseEvent) Opcode() uint32 { return 256 }
+func (c *OpReleaseEvent) Opcode() uint32 { return opcodeReleaseEvent }
 func (c *OpReleaseEvent) Optional() bool { return false }
 func (c *OpReleaseEvent) Verify() error  { return nil }
 
@@ -144,13 +162,13 @@ func (c *OpSetUserEventStatus) Opcode() uint32 { return opcodeSetUserEventStatus
 func (c *OpSetUserEventStatus) Optional() bool { return false }
 func (c *OpSetUserEventStatus) Verify() error  { return nil }
 
-// OpCaptureEventProfilingInfo captures the profiling information specified
-// by info for the command associated with the event specified by event in
-// the memory pointed by value.
+// OpCaptureEventProfilingInfo captures the profiling information
+// specified by ProfilingInfo for the command associated with the event
+// specified by Event in the memory pointed to by Value.
 type OpCaptureEventProfilingInfo struct {
-	Event Id
-	Info  KernelProfilingInfo
-	Value Id
+	Event         Id
+	ProfilingInfo Id
+	Value         Id
 }
 
 func (c *OpCaptureEventProfilingInfo) Opcode() uint32 { return opcodeCaptureEventProfilingInfo }
@@ -181,9 +199,8 @@ func (c *OpBuildNDRange) Optional() bool { return false }
 func (c *OpBuildNDRange) Verify() error  { return nil }
 
 func init() {
-	bind(func() Instruction { return &OpIsValidEvent{} })
 	bind(func() Instruction { return &OpEnqueueMarker{} })
-	bind(func() Instruction { return &OpEnqueueKernel{LocalSize: []Id{}} })
+	bind(func() Instruction { return &OpEnqueueKernel{} })
 	bind(func() Instruction { return &OpGetKernelNDrangeSubGroupCount{} })
 	bind(func() Instruction { return &OpGetKernelNDrangeMaxSubGroupSize{} })
 	bind(func() Instruction { return &OpGetKernelWorkGroupSize{} })
@@ -191,6 +208,7 @@ func init() {
 	bind(func() Instruction { return &OpRetainEvent{} })
 	bind(func() Instruction { return &OpReleaseEvent{} })
 	bind(func() Instruction { return &OpCreateUserEvent{} })
+	bind(func() Instruction { return &OpIsValidEvent{} })
 	bind(func() Instruction { return &OpSetUserEventStatus{} })
 	bind(func() Instruction { return &OpCaptureEventProfilingInfo{} })
 	bind(func() Instruction { return &OpGetDefaultQueue{} })
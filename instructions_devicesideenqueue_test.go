@@ -8,23 +8,23 @@ import "testing"
 func TestDeviceSideEnqueue(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x000700f9, 1, 2, 3, 4, 5, 6},
+			in: []uint32{0x00070123, 1, 2, 3, 4, 5, 6},
 			want: &OpEnqueueMarker{
 				ResultType: 1,
 				ResultId:   2,
-				Q:          3,
+				Queue:      3,
 				NumEvents:  4,
 				WaitEvents: 5,
 				RetEvent:   6,
 			},
 		},
 		{
-			in: []uint32{0x000d00fa, 1, 2, 3, uint32(KernelEnqueueFlagNoWait), 5, 6, 7, 8, 9, 10, 11, 12},
+			in: []uint32{0x000d0124, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
 			want: &OpEnqueueKernel{
 				ResultType: 1,
 				ResultId:   2,
-				Q:          3,
-				Flags:      KernelEnqueueFlagNoWait,
+				Queue:      3,
+				Flags:      4,
 				NDRange:    5,
 				NumEvents:  6,
 				WaitEvents: 7,
@@ -33,16 +33,16 @@ func TestDeviceSideEnqueue(t *testing.T) {
 				Param:      10,
 				ParamSize:  11,
 				ParamAlign: 12,
-				LocalSize:  []Id{}, // optional
 			},
 		},
 		{
-			in: []uint32{0x000e00fa, 1, 2, 3, uint32(KernelEnqueueFlagWaitWorkGroup), 5, 6, 7, 8, 9, 10, 11, 12, 13},
+			in: []uint32{0x000f0124,
+				1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
 			want: &OpEnqueueKernel{
 				ResultType: 1,
 				ResultId:   2,
-				Q:          3,
-				Flags:      KernelEnqueueFlagWaitWorkGroup,
+				Queue:      3,
+				Flags:      4,
 				NDRange:    5,
 				NumEvents:  6,
 				WaitEvents: 7,
@@ -51,64 +51,76 @@ func TestDeviceSideEnqueue(t *testing.T) {
 				Param:      10,
 				ParamSize:  11,
 				ParamAlign: 12,
-				LocalSize:  []Id{13}, // optional
+				LocalSize:  []Id{13, 14},
 			},
 		},
 		{
-			in: []uint32{0x000500fb, 1, 2, 3, 4},
+			in: []uint32{0x00080125, 1, 2, 3, 4, 5, 6, 7},
 			want: &OpGetKernelNDrangeSubGroupCount{
 				ResultType: 1,
 				ResultId:   2,
 				NDRange:    3,
 				Invoke:     4,
+				Param:      5,
+				ParamSize:  6,
+				ParamAlign: 7,
 			},
 		},
 		{
-			in: []uint32{0x000500fc, 1, 2, 3, 4},
+			in: []uint32{0x00080126, 1, 2, 3, 4, 5, 6, 7},
 			want: &OpGetKernelNDrangeMaxSubGroupSize{
 				ResultType: 1,
 				ResultId:   2,
 				NDRange:    3,
 				Invoke:     4,
+				Param:      5,
+				ParamSize:  6,
+				ParamAlign: 7,
 			},
 		},
 		{
-			in: []uint32{0x000400fd, 1, 2, 3},
+			in: []uint32{0x00070127, 1, 2, 3, 4, 5, 6},
 			want: &OpGetKernelWorkGroupSize{
 				ResultType: 1,
 				ResultId:   2,
 				Invoke:     3,
+				Param:      4,
+				ParamSize:  5,
+				ParamAlign: 6,
 			},
 		},
 		{
-			in: []uint32{0x000400fe, 1, 2, 3},
+			in: []uint32{0x00070128, 1, 2, 3, 4, 5, 6},
 			want: &OpGetKernelPreferredWorkGroupSizeMultiple{
 				ResultType: 1,
 				ResultId:   2,
 				Invoke:     3,
+				Param:      4,
+				ParamSize:  5,
+				ParamAlign: 6,
 			},
 		},
 		{
-			in: []uint32{0x000200ff, 1},
+			in: []uint32{0x00020129, 1},
 			want: &OpRetainEvent{
 				Event: 1,
 			},
 		},
 		{
-			in: []uint32{0x00020100, 1},
+			in: []uint32{0x0002012a, 1},
 			want: &OpReleaseEvent{
 				Event: 1,
 			},
 		},
 		{
-			in: []uint32{0x00030101, 1, 2},
+			in: []uint32{0x0003012b, 1, 2},
 			want: &OpCreateUserEvent{
 				ResultType: 1,
 				ResultId:   2,
 			},
 		},
 		{
-			in: []uint32{0x00040102, 1, 2, 3},
+			in: []uint32{0x0004012c, 1, 2, 3},
 			want: &OpIsValidEvent{
 				ResultType: 1,
 				ResultId:   2,
@@ -116,29 +128,29 @@ func TestDeviceSideEnqueue(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x00030103, 1, 2},
+			in: []uint32{0x0003012d, 1, 2},
 			want: &OpSetUserEventStatus{
 				Event:  1,
 				Status: 2,
 			},
 		},
 		{
-			in: []uint32{0x00040104, 1, 2, 3},
+			in: []uint32{0x0004012e, 1, 2, 3},
 			want: &OpCaptureEventProfilingInfo{
-				Event: 1,
-				Info:  2,
-				Value: 3,
+				Event:         1,
+				ProfilingInfo: 2,
+				Value:         3,
 			},
 		},
 		{
-			in: []uint32{0x00030105, 1, 2},
+			in: []uint32{0x0003012f, 1, 2},
 			want: &OpGetDefaultQueue{
 				ResultType: 1,
 				ResultId:   2,
 			},
 		},
 		{
-			in: []uint32{0x00060106, 1, 2, 3, 4, 5},
+			in: []uint32{0x00060130, 1, 2, 3, 4, 5},
 			want: &OpBuildNDRange{
 				ResultType:       1,
 				ResultId:         2,
@@ -1,48 +1,46 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
-package spirv
-
-import "fmt"
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
 
-// TODO: Context-aware validation. Most instructions here require context
-// (i.e., module) to properly validate.
+package spirv
 
 // OpPhi is the SSA phi function.
 type OpPhi struct {
 	ResultType Id
 	ResultId   Id
-	Operands   []Id
+
+	// Operands is a list of (Variable, Parent) pairs. Parent is the
+	// <id> of the parent block for Variable.
+	Operands []Id
 }
 
 func (c *OpPhi) Opcode() uint32 { return opcodePhi }
 func (c *OpPhi) Optional() bool { return false }
-func (c *OpPhi) Verify() error {
-	if len(c.Operands) == 0 {
-		return fmt.Errorf("OpPhi: expected operands")
-	}
+func (c *OpPhi) Verify() error  { return c.verify() }
 
-	if len(c.Operands)%2 != 0 {
-		return fmt.Errorf("OpPhi: expected array of (Variable, ParentBlock) operand pairs")
-	}
-
-	return nil
-}
-
-// OpLoopMerge declares and controls a structured control-flow loop construct.
+// OpLoopMerge declares a structured loop. It must immediately precede
+// either an OpBranch or OpBranchConditional instruction.
 type OpLoopMerge struct {
-	Label       Id
-	LoopControl LoopControl
+	// MergeBlock is the label of the merge block for this structured loop.
+	MergeBlock Id
+
+	// ContinueTarget is the label of a block targeted for processing a loop continue.
+	ContinueTarget Id
+	LoopControl    LoopControl
 }
 
 func (c *OpLoopMerge) Opcode() uint32 { return opcodeLoopMerge }
 func (c *OpLoopMerge) Optional() bool { return false }
 func (c *OpLoopMerge) Verify() error  { return nil }
 
-// OpSelectionMerge declares and controls a structured control-flow selection
-// construct, used with OpBranchConditional or OpSwitch.
+// OpSelectionMerge declares a structured selection, used with
+// OpBranchConditional or OpSwitch.
 type OpSelectionMerge struct {
-	Label            Id
+	// MergeBlock is the label of the merge block for this structured selection.
+	MergeBlock       Id
 	SelectionControl SelectionControl
 }
 
@@ -74,43 +72,27 @@ type OpBranchConditional struct {
 	Condition     Id
 	TrueLabel     Id
 	FalseLabel    Id
-	BranchWeights []uint32 `spirv:"optional"`
+	BranchWeights []uint32
 }
 
 func (c *OpBranchConditional) Opcode() uint32 { return opcodeBranchConditional }
 func (c *OpBranchConditional) Optional() bool { return false }
-func (c *OpBranchConditional) Verify() error {
-	if len(c.BranchWeights) != 0 && len(c.BranchWeights) != 2 {
-		return fmt.Errorf("OpBranchConditional: BranchWeights expects 0 or 2 elements")
-	}
-	return nil
-}
+func (c *OpBranchConditional) Verify() error  { return c.verify() }
 
 // OpSwitch branches to a matching operand label.
 type OpSwitch struct {
 	Selector Id
 	Default  Id
-	// TODO: struct for pair (Value, LabelId)
-	Target []uint32 `spirv:"optional"`
+
+	// Target is a list of (Literal, Label) pairs. Literals are as wide
+	// as the selector; only selectors of up to 32 bits are supported,
+	// so each literal is a single word.
+	Target []uint32
 }
 
 func (c *OpSwitch) Opcode() uint32 { return opcodeSwitch }
 func (c *OpSwitch) Optional() bool { return false }
-func (c *OpSwitch) Verify() error {
-	if len(c.Target)%2 != 0 {
-		return fmt.Errorf("OpSwitch: Target expects array of (LiteralNumber, Label) pairs")
-	}
-
-	for j := 0; j < len(c.Target); j += 2 {
-		for k := j + 2; k < len(c.Target); k += 2 {
-			if c.Target[j] == c.Target[k] {
-				return fmt.Errorf("OpSwitch: Target literals must be unique")
-			}
-		}
-	}
-
-	return nil
-}
+func (c *OpSwitch) Verify() error  { return c.verify() }
 
 // OpKill discards the fragment shader.
 type OpKill struct{}
@@ -119,12 +101,6 @@ func (c *OpKill) Opcode() uint32 { return opcodeKill }
 func (c *OpKill) Optional() bool { return false }
 func (c *OpKill) Verify() error  { return nil }
 
-func init() {
-	bind(func() Instruction {
-		return &OpKill{}
-	})
-}
-
 // OpReturn returns with no value from a function with void return type.
 type OpReturn struct{}
 
@@ -149,22 +125,29 @@ func (c *OpUnreachable) Opcode() uint32 { return opcodeUnreachable }
 func (c *OpUnreachable) Optional() bool { return false }
 func (c *OpUnreachable) Verify() error  { return nil }
 
-// OpLifetimeStart declares that the content of the object pointed to was
-// not defined before this instruction.
+// OpLifetimeStart declares that an object was not defined before this
+// instruction.
 type OpLifetimeStart struct {
-	Object       Id
-	MemoryAmount uint32
+	// Pointer is a pointer to the object whose lifetime is starting.
+	Pointer Id
+
+	// Size must be 0 for pointers to non-void types. Otherwise it is the
+	// number of bytes in the object.
+	Size uint32
 }
 
 func (c *OpLifetimeStart) Opcode() uint32 { return opcodeLifetimeStart }
 func (c *OpLifetimeStart) Optional() bool { return false }
 func (c *OpLifetimeStart) Verify() error  { return nil }
 
-// OpLifetimeStop declares that the content of the object pointed to is
-// dead after this instruction.
+// OpLifetimeStop declares that an object is dead after this instruction.
 type OpLifetimeStop struct {
-	Object       Id
-	MemoryAmount uint32
+	// Pointer is a pointer to the object whose lifetime is ending.
+	Pointer Id
+
+	// Size must be 0 for pointers to non-void types. Otherwise it is the
+	// number of bytes in the object.
+	Size uint32
 }
 
 func (c *OpLifetimeStop) Opcode() uint32 { return opcodeLifetimeStop }
@@ -179,6 +162,7 @@ func init() {
 	bind(func() Instruction { return &OpBranch{} })
 	bind(func() Instruction { return &OpBranchConditional{} })
 	bind(func() Instruction { return &OpSwitch{} })
+	bind(func() Instruction { return &OpKill{} })
 	bind(func() Instruction { return &OpReturn{} })
 	bind(func() Instruction { return &OpReturnValue{} })
 	bind(func() Instruction { return &OpUnreachable{} })
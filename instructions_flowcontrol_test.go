@@ -3,46 +3,54 @@
 
 package spirv
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 func TestFlowControl(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x00050030, 1, 2, 3, 4},
+			in: []uint32{0x000700f5, 1, 2, 3, 4, 5, 6},
 			want: &OpPhi{
 				ResultType: 1,
 				ResultId:   2,
-				Operands:   []Id{3, 4},
+				Operands:   []Id{3, 4, 5, 6},
 			},
 		},
 		{
-			in: []uint32{0x000300ce, 1, 2},
+			in:  []uint32{0x000600f5, 1, 2, 3, 4, 5},
+			err: fmt.Errorf("OpPhi: expected array of (Variable, ParentBlock) operand pairs"),
+		},
+		{
+			in: []uint32{0x000400f6, 1, 2, LoopControlUnroll},
 			want: &OpLoopMerge{
-				Label:       1,
-				LoopControl: 2,
+				MergeBlock:     1,
+				ContinueTarget: 2,
+				LoopControl:    LoopControlUnroll,
 			},
 		},
 		{
-			in: []uint32{0x000300cf, 1, 2},
+			in: []uint32{0x000300f7, 1, SelectionControlFlatten},
 			want: &OpSelectionMerge{
-				Label:            1,
-				SelectionControl: 2,
+				MergeBlock:       1,
+				SelectionControl: SelectionControlFlatten,
 			},
 		},
 		{
-			in: []uint32{0x000200d0, 1},
+			in: []uint32{0x000200f8, 1},
 			want: &OpLabel{
 				ResultId: 1,
 			},
 		},
 		{
-			in: []uint32{0x000200d1, 1},
+			in: []uint32{0x000200f9, 1},
 			want: &OpBranch{
 				TargetLabel: 1,
 			},
 		},
 		{
-			in: []uint32{0x000400d2, 1, 2, 3},
+			in: []uint32{0x000400fa, 1, 2, 3},
 			want: &OpBranchConditional{
 				Condition:  1,
 				TrueLabel:  2,
@@ -50,7 +58,7 @@ func TestFlowControl(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x000600d2, 1, 2, 3, 4, 5},
+			in: []uint32{0x000600fa, 1, 2, 3, 4, 5},
 			want: &OpBranchConditional{
 				Condition:     1,
 				TrueLabel:     2,
@@ -59,50 +67,58 @@ func TestFlowControl(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x000300d3, 1, 2},
+			in:  []uint32{0x000500fa, 1, 2, 3, 4},
+			err: fmt.Errorf("OpBranchConditional: BranchWeights expects 0 or 2 elements"),
+		},
+		{
+			in: []uint32{0x000300fb, 1, 2},
 			want: &OpSwitch{
 				Selector: 1,
 				Default:  2,
 			},
 		},
 		{
-			in: []uint32{0x000500d3, 1, 2, 3, 4},
+			in: []uint32{0x000700fb, 1, 2, 3, 4, 5, 6},
 			want: &OpSwitch{
 				Selector: 1,
 				Default:  2,
-				Target:   []uint32{3, 4},
+				Target:   []uint32{3, 4, 5, 6},
 			},
 		},
 		{
-			in:   []uint32{0x000100d4},
+			in:  []uint32{0x000700fb, 1, 2, 3, 4, 3, 5},
+			err: fmt.Errorf("OpSwitch: Target literals must be unique"),
+		},
+		{
+			in:   []uint32{0x000100fc},
 			want: &OpKill{},
 		},
 		{
-			in:   []uint32{0x000100d5},
+			in:   []uint32{0x000100fd},
 			want: &OpReturn{},
 		},
 		{
-			in: []uint32{0x000200d6, 1},
+			in: []uint32{0x000200fe, 1},
 			want: &OpReturnValue{
 				Value: 1,
 			},
 		},
 		{
-			in:   []uint32{0x000100d7},
+			in:   []uint32{0x000100ff},
 			want: &OpUnreachable{},
 		},
 		{
-			in: []uint32{0x000300d8, 1, 2},
+			in: []uint32{0x00030100, 1, 2},
 			want: &OpLifetimeStart{
-				Object:       1,
-				MemoryAmount: 2,
+				Pointer: 1,
+				Size:    2,
 			},
 		},
 		{
-			in: []uint32{0x000300d9, 1, 2},
+			in: []uint32{0x00030101, 1, 2},
 			want: &OpLifetimeStop{
-				Object:       1,
-				MemoryAmount: 2,
+				Pointer: 1,
+				Size:    2,
 			},
 		},
 	} {
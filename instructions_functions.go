@@ -1,6 +1,10 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
 package spirv
 
 // OpFunction defines a function body. This instruction must be
@@ -10,10 +14,12 @@ package spirv
 // This function’s body will terminate with the next OpFunctionEnd
 // instruction.
 type OpFunction struct {
-	ResultType   Id
-	ResultId     Id
-	ControlMask  FunctionControlMask
-	FunctionType Id
+	ResultType Id
+	ResultId   Id
+
+	// FunctionControl specifies hints for the function.
+	FunctionControl FunctionControl
+	FunctionType    Id
 }
 
 func (c *OpFunction) Opcode() uint32 { return opcodeFunction }
@@ -8,27 +8,27 @@ import "testing"
 func TestFunctions(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x00050028, 1, 2, 3, 4},
+			in: []uint32{0x00050036, 1, 2, FunctionControlInline, 3},
 			want: &OpFunction{
-				ResultType:   1,
-				ResultId:     2,
-				ControlMask:  3,
-				FunctionType: 4,
+				ResultType:      1,
+				ResultId:        2,
+				FunctionControl: FunctionControlInline,
+				FunctionType:    3,
 			},
 		},
 		{
-			in: []uint32{0x00030029, 1, 2},
+			in: []uint32{0x00030037, 1, 2},
 			want: &OpFunctionParameter{
 				ResultType: 1,
 				ResultId:   2,
 			},
 		},
 		{
-			in:   []uint32{0x0001002a},
+			in:   []uint32{0x00010038},
 			want: &OpFunctionEnd{},
 		},
 		{
-			in: []uint32{0x0004002b, 1, 2, 3},
+			in: []uint32{0x00040039, 1, 2, 3},
 			want: &OpFunctionCall{
 				ResultType: 1,
 				ResultId:   2,
@@ -36,12 +36,12 @@ func TestFunctions(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0007002b, 1, 2, 3, 4, 5, 6},
+			in: []uint32{0x00060039, 1, 2, 3, 4, 5},
 			want: &OpFunctionCall{
 				ResultType: 1,
 				ResultId:   2,
 				Function:   3,
-				Argv:       []Id{4, 5, 6},
+				Argv:       []Id{4, 5},
 			},
 		},
 	} {
@@ -3,192 +3,135 @@
 
 package spirv
 
-import (
-	"errors"
-	"testing"
-)
+import "testing"
 
 func TestGroup(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in:  []uint32{0x000900db, 1, 2, ExecutionScopeDevice, 3, 4, 5, 6, 7},
-			err: errors.New("OpAsyncGroupCopy: Scope must be Subgroup or Workgroup"),
-		},
-		{
-			in: []uint32{0x000900db, 1, 2, ExecutionScopeSubgroup, 3, 4, 5, 6, 7},
-			want: &OpAsyncGroupCopy{
+			in: []uint32{0x00090103, 1, 2, 3, 4, 5, 6, 7, 8},
+			want: &OpGroupAsyncCopy{
 				ResultType:  1,
 				ResultId:    2,
-				Scope:       ExecutionScopeSubgroup,
-				Destination: 3,
-				Source:      4,
-				NumElements: 5,
-				Stride:      6,
-				Event:       7,
+				Execution:   3,
+				Destination: 4,
+				Source:      5,
+				NumElements: 6,
+				Stride:      7,
+				Event:       8,
 			},
 		},
 		{
-			in:  []uint32{0x000600dc, 1, 2, ExecutionScopeDevice, 3, 4},
-			err: errors.New("OpWaitGroupEvents: Scope must be Subgroup or Workgroup"),
-		},
-		{
-			in: []uint32{0x000600dc, 1, 2, ExecutionScopeSubgroup, 3, 4},
-			want: &OpWaitGroupEvents{
-				ResultType: 1,
-				ResultId:   2,
-				Scope:      ExecutionScopeSubgroup,
-				NumEvents:  3,
-				EventsList: 4,
+			in: []uint32{0x00040104, 1, 2, 3},
+			want: &OpGroupWaitEvents{
+				Execution:  1,
+				NumEvents:  2,
+				EventsList: 3,
 			},
 		},
 		{
-			in:  []uint32{0x000500dd, 1, 2, ExecutionScopeDevice, 3},
-			err: errors.New("OpGroupAll: Scope must be Subgroup or Workgroup"),
-		},
-		{
-			in: []uint32{0x000500dd, 1, 2, ExecutionScopeSubgroup, 3},
+			in: []uint32{0x00050105, 1, 2, 3, 4},
 			want: &OpGroupAll{
 				ResultType: 1,
 				ResultId:   2,
-				Scope:      ExecutionScopeSubgroup,
-				Predicate:  3,
+				Execution:  3,
+				Predicate:  4,
 			},
 		},
 		{
-			in:  []uint32{0x000500de, 1, 2, ExecutionScopeDevice, 3},
-			err: errors.New("OpGroupAny: Scope must be Subgroup or Workgroup"),
-		},
-		{
-			in: []uint32{0x000500de, 1, 2, ExecutionScopeSubgroup, 3},
+			in: []uint32{0x00050106, 1, 2, 3, 4},
 			want: &OpGroupAny{
 				ResultType: 1,
 				ResultId:   2,
-				Scope:      ExecutionScopeSubgroup,
-				Predicate:  3,
+				Execution:  3,
+				Predicate:  4,
 			},
 		},
 		{
-			in:  []uint32{0x000600df, 1, 2, ExecutionScopeDevice, 3, 4},
-			err: errors.New("OpGroupBroadcast: Scope must be Subgroup or Workgroup"),
-		},
-		{
-			in: []uint32{0x000600df, 1, 2, ExecutionScopeSubgroup, 3, 4},
+			in: []uint32{0x00060107, 1, 2, 3, 4, 5},
 			want: &OpGroupBroadcast{
 				ResultType: 1,
 				ResultId:   2,
-				Scope:      ExecutionScopeSubgroup,
-				Value:      3,
-				LocalId:    4,
+				Execution:  3,
+				Value:      4,
+				LocalId:    5,
 			},
 		},
 		{
-			in:  []uint32{0x000600e0, 1, 2, ExecutionScopeDevice, GroupOperationReduce, 4},
-			err: errors.New("OpGroupIAdd: Scope must be Subgroup or Workgroup"),
-		},
-		{
-			in: []uint32{0x000600e0, 1, 2, ExecutionScopeSubgroup, GroupOperationReduce, 4},
+			in: []uint32{0x00060108, 1, 2, 3, GroupOperationReduce, 4},
 			want: &OpGroupIAdd{
 				ResultType: 1,
 				ResultId:   2,
-				Scope:      ExecutionScopeSubgroup,
+				Execution:  3,
 				Operation:  GroupOperationReduce,
 				X:          4,
 			},
 		},
 		{
-			in:  []uint32{0x000600e1, 1, 2, ExecutionScopeDevice, GroupOperationReduce, 4},
-			err: errors.New("OpGroupFAdd: Scope must be Subgroup or Workgroup"),
-		},
-		{
-			in: []uint32{0x000600e1, 1, 2, ExecutionScopeSubgroup, GroupOperationReduce, 4},
+			in: []uint32{0x00060109, 1, 2, 3, GroupOperationReduce, 4},
 			want: &OpGroupFAdd{
 				ResultType: 1,
 				ResultId:   2,
-				Scope:      ExecutionScopeSubgroup,
+				Execution:  3,
 				Operation:  GroupOperationReduce,
 				X:          4,
 			},
 		},
 		{
-			in:  []uint32{0x000600e2, 1, 2, ExecutionScopeDevice, GroupOperationReduce, 4},
-			err: errors.New("OpGroupFMin: Scope must be Subgroup or Workgroup"),
-		},
-		{
-			in: []uint32{0x000600e2, 1, 2, ExecutionScopeSubgroup, GroupOperationReduce, 4},
+			in: []uint32{0x0006010a, 1, 2, 3, GroupOperationReduce, 4},
 			want: &OpGroupFMin{
 				ResultType: 1,
 				ResultId:   2,
-				Scope:      ExecutionScopeSubgroup,
+				Execution:  3,
 				Operation:  GroupOperationReduce,
 				X:          4,
 			},
 		},
 		{
-			in:  []uint32{0x000600e3, 1, 2, ExecutionScopeDevice, GroupOperationReduce, 4},
-			err: errors.New("OpGroupUMin: Scope must be Subgroup or Workgroup"),
-		},
-		{
-			in: []uint32{0x000600e3, 1, 2, ExecutionScopeSubgroup, GroupOperationReduce, 4},
+			in: []uint32{0x0006010b, 1, 2, 3, GroupOperationReduce, 4},
 			want: &OpGroupUMin{
 				ResultType: 1,
 				ResultId:   2,
-				Scope:      ExecutionScopeSubgroup,
+				Execution:  3,
 				Operation:  GroupOperationReduce,
 				X:          4,
 			},
 		},
 		{
-			in:  []uint32{0x000600e4, 1, 2, ExecutionScopeDevice, GroupOperationReduce, 4},
-			err: errors.New("OpGroupSMin: Scope must be Subgroup or Workgroup"),
-		},
-		{
-			in: []uint32{0x000600e4, 1, 2, ExecutionScopeSubgroup, GroupOperationReduce, 4},
+			in: []uint32{0x0006010c, 1, 2, 3, GroupOperationReduce, 4},
 			want: &OpGroupSMin{
 				ResultType: 1,
 				ResultId:   2,
-				Scope:      ExecutionScopeSubgroup,
+				Execution:  3,
 				Operation:  GroupOperationReduce,
 				X:          4,
 			},
 		},
 		{
-			in:  []uint32{0x000600e5, 1, 2, ExecutionScopeDevice, GroupOperationReduce, 4},
-			err: errors.New("OpGroupFMax: Scope must be Subgroup or Workgroup"),
-		},
-		{
-			in: []uint32{0x000600e5, 1, 2, ExecutionScopeSubgroup, GroupOperationReduce, 4},
+			in: []uint32{0x0006010d, 1, 2, 3, GroupOperationReduce, 4},
 			want: &OpGroupFMax{
 				ResultType: 1,
 				ResultId:   2,
-				Scope:      ExecutionScopeSubgroup,
+				Execution:  3,
 				Operation:  GroupOperationReduce,
 				X:          4,
 			},
 		},
 		{
-			in:  []uint32{0x000600e6, 1, 2, ExecutionScopeDevice, GroupOperationReduce, 4},
-			err: errors.New("OpGroupUMax: Scope must be Subgroup or Workgroup"),
-		},
-		{
-			in: []uint32{0x000600e6, 1, 2, ExecutionScopeSubgroup, GroupOperationReduce, 4},
+			in: []uint32{0x0006010e, 1, 2, 3, GroupOperationReduce, 4},
 			want: &OpGroupUMax{
 				ResultType: 1,
 				ResultId:   2,
-				Scope:      ExecutionScopeSubgroup,
+				Execution:  3,
 				Operation:  GroupOperationReduce,
 				X:          4,
 			},
 		},
 		{
-			in:  []uint32{0x000600e7, 1, 2, ExecutionScopeDevice, GroupOperationReduce, 4},
-			err: errors.New("OpGroupSMax: Scope must be Subgroup or Workgroup"),
-		},
-		{
-			in: []uint32{0x000600e7, 1, 2, ExecutionScopeSubgroup, GroupOperationReduce, 4},
+			in: []uint32{0x0006010f, 1, 2, 3, GroupOperationReduce, 4},
 			want: &OpGroupSMax{
 				ResultType: 1,
 				ResultId:   2,
-				Scope:      ExecutionScopeSubgroup,
+				Execution:  3,
 				Operation:  GroupOperationReduce,
 				X:          4,
 			},
@@ -0,0 +1,564 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
+package spirv
+
+// OpSampledImage creates a sampled image, containing both a sampler
+// and an image.
+type OpSampledImage struct {
+	ResultType Id
+	ResultId   Id
+	Image      Id
+	Sampler    Id
+}
+
+func (c *OpSampledImage) Opcode() uint32 { return opcodeSampledImage }
+func (c *OpSampledImage) Optional() bool { return false }
+func (c *OpSampledImage) Verify() error  { return nil }
+
+// OpImageSampleImplicitLod samples an image with an implicit level
+// of detail.
+type OpImageSampleImplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageSampleImplicitLod) Opcode() uint32 { return opcodeImageSampleImplicitLod }
+func (c *OpImageSampleImplicitLod) Optional() bool { return false }
+func (c *OpImageSampleImplicitLod) Verify() error  { return nil }
+
+// OpImageSampleExplicitLod samples an image using an explicit level
+// of detail.
+type OpImageSampleExplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	ImageOperands ImageOperands
+	Argv          []Id
+}
+
+func (c *OpImageSampleExplicitLod) Opcode() uint32 { return opcodeImageSampleExplicitLod }
+func (c *OpImageSampleExplicitLod) Optional() bool { return false }
+func (c *OpImageSampleExplicitLod) Verify() error  { return nil }
+
+// OpImageSampleDrefImplicitLod samples an image doing depth-comparison
+// with an implicit level of detail.
+type OpImageSampleDrefImplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	Dref          Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageSampleDrefImplicitLod) Opcode() uint32 { return opcodeImageSampleDrefImplicitLod }
+func (c *OpImageSampleDrefImplicitLod) Optional() bool { return false }
+func (c *OpImageSampleDrefImplicitLod) Verify() error  { return nil }
+
+// OpImageSampleDrefExplicitLod samples an image doing depth-comparison
+// using an explicit level of detail.
+type OpImageSampleDrefExplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	Dref          Id
+	ImageOperands ImageOperands
+	Argv          []Id
+}
+
+func (c *OpImageSampleDrefExplicitLod) Opcode() uint32 { return opcodeImageSampleDrefExplicitLod }
+func (c *OpImageSampleDrefExplicitLod) Optional() bool { return false }
+func (c *OpImageSampleDrefExplicitLod) Verify() error  { return nil }
+
+// OpImageSampleProjImplicitLod samples an image with a projective
+// coordinate and an implicit level of detail.
+type OpImageSampleProjImplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageSampleProjImplicitLod) Opcode() uint32 { return opcodeImageSampleProjImplicitLod }
+func (c *OpImageSampleProjImplicitLod) Optional() bool { return false }
+func (c *OpImageSampleProjImplicitLod) Verify() error  { return nil }
+
+// OpImageSampleProjExplicitLod samples an image with a projective
+// coordinate using an explicit level of detail.
+type OpImageSampleProjExplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	ImageOperands ImageOperands
+	Argv          []Id
+}
+
+func (c *OpImageSampleProjExplicitLod) Opcode() uint32 { return opcodeImageSampleProjExplicitLod }
+func (c *OpImageSampleProjExplicitLod) Optional() bool { return false }
+func (c *OpImageSampleProjExplicitLod) Verify() error  { return nil }
+
+// OpImageSampleProjDrefImplicitLod samples an image with a projective
+// coordinate, doing depth-comparison, with an implicit level of detail.
+type OpImageSampleProjDrefImplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	Dref          Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageSampleProjDrefImplicitLod) Opcode() uint32 {
+	return opcodeImageSampleProjDrefImplicitLod
+}
+func (c *OpImageSampleProjDrefImplicitLod) Optional() bool { return false }
+func (c *OpImageSampleProjDrefImplicitLod) Verify() error  { return nil }
+
+// OpImageSampleProjDrefExplicitLod samples an image with a projective
+// coordinate, doing depth-comparison, using an explicit level of detail.
+type OpImageSampleProjDrefExplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	Dref          Id
+	ImageOperands ImageOperands
+	Argv          []Id
+}
+
+func (c *OpImageSampleProjDrefExplicitLod) Opcode() uint32 {
+	return opcodeImageSampleProjDrefExplicitLod
+}
+func (c *OpImageSampleProjDrefExplicitLod) Optional() bool { return false }
+func (c *OpImageSampleProjDrefExplicitLod) Verify() error  { return nil }
+
+// OpImageFetch fetches a single texel from a sampled image.
+type OpImageFetch struct {
+	ResultType    Id
+	ResultId      Id
+	Image         Id
+	Coordinate    Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageFetch) Opcode() uint32 { return opcodeImageFetch }
+func (c *OpImageFetch) Optional() bool { return false }
+func (c *OpImageFetch) Verify() error  { return nil }
+
+// OpImageGather gathers the requested component from four texels.
+type OpImageGather struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	Component     Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageGather) Opcode() uint32 { return opcodeImageGather }
+func (c *OpImageGather) Optional() bool { return false }
+func (c *OpImageGather) Verify() error  { return nil }
+
+// OpImageDrefGather gathers the requested depth-comparison from
+// four texels.
+type OpImageDrefGather struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	Dref          Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageDrefGather) Opcode() uint32 { return opcodeImageDrefGather }
+func (c *OpImageDrefGather) Optional() bool { return false }
+func (c *OpImageDrefGather) Verify() error  { return nil }
+
+// OpImageRead reads a texel from an image without a sampler.
+type OpImageRead struct {
+	ResultType    Id
+	ResultId      Id
+	Image         Id
+	Coordinate    Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageRead) Opcode() uint32 { return opcodeImageRead }
+func (c *OpImageRead) Optional() bool { return false }
+func (c *OpImageRead) Verify() error  { return nil }
+
+// OpImageWrite writes a texel to an image without a sampler.
+type OpImageWrite struct {
+	Image      Id
+	Coordinate Id
+
+	// Texel is the data to write.
+	Texel         Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageWrite) Opcode() uint32 { return opcodeImageWrite }
+func (c *OpImageWrite) Optional() bool { return false }
+func (c *OpImageWrite) Verify() error  { return nil }
+
+// OpImage extracts the image from a sampled image.
+type OpImage struct {
+	ResultType   Id
+	ResultId     Id
+	SampledImage Id
+}
+
+func (c *OpImage) Opcode() uint32 { return opcodeImage }
+func (c *OpImage) Optional() bool { return false }
+func (c *OpImage) Verify() error  { return nil }
+
+// OpImageQueryFormat queries the image format of an image created with
+// an Unknown Image Format.
+type OpImageQueryFormat struct {
+	ResultType Id
+	ResultId   Id
+	Image      Id
+}
+
+func (c *OpImageQueryFormat) Opcode() uint32 { return opcodeImageQueryFormat }
+func (c *OpImageQueryFormat) Optional() bool { return false }
+func (c *OpImageQueryFormat) Verify() error  { return nil }
+
+// OpImageQueryOrder queries the channel order of an image created with
+// an Unknown Image Format.
+type OpImageQueryOrder struct {
+	ResultType Id
+	ResultId   Id
+	Image      Id
+}
+
+func (c *OpImageQueryOrder) Opcode() uint32 { return opcodeImageQueryOrder }
+func (c *OpImageQueryOrder) Optional() bool { return false }
+func (c *OpImageQueryOrder) Verify() error  { return nil }
+
+// OpImageQuerySizeLod queries the dimensions of Image for mipmap level
+// for Level of Detail.
+type OpImageQuerySizeLod struct {
+	ResultType    Id
+	ResultId      Id
+	Image         Id
+	LevelOfDetail Id
+}
+
+func (c *OpImageQuerySizeLod) Opcode() uint32 { return opcodeImageQuerySizeLod }
+func (c *OpImageQuerySizeLod) Optional() bool { return false }
+func (c *OpImageQuerySizeLod) Verify() error  { return nil }
+
+// OpImageQuerySize queries the dimensions of Image, with no level
+// of detail.
+type OpImageQuerySize struct {
+	ResultType Id
+	ResultId   Id
+	Image      Id
+}
+
+func (c *OpImageQuerySize) Opcode() uint32 { return opcodeImageQuerySize }
+func (c *OpImageQuerySize) Optional() bool { return false }
+func (c *OpImageQuerySize) Verify() error  { return nil }
+
+// OpImageQueryLod queries the mipmap level and the level of detail for
+// a hypothetical sampling of Image at Coordinate using an implicit level
+// of detail.
+type OpImageQueryLod struct {
+	ResultType   Id
+	ResultId     Id
+	SampledImage Id
+	Coordinate   Id
+}
+
+func (c *OpImageQueryLod) Opcode() uint32 { return opcodeImageQueryLod }
+func (c *OpImageQueryLod) Optional() bool { return false }
+func (c *OpImageQueryLod) Verify() error  { return nil }
+
+// OpImageQueryLevels queries the number of mipmap levels accessible
+// through Image.
+type OpImageQueryLevels struct {
+	ResultType Id
+	ResultId   Id
+	Image      Id
+}
+
+func (c *OpImageQueryLevels) Opcode() uint32 { return opcodeImageQueryLevels }
+func (c *OpImageQueryLevels) Optional() bool { return false }
+func (c *OpImageQueryLevels) Verify() error  { return nil }
+
+// OpImageQuerySamples queries the number of samples available per
+// texel fetch in a multisample image.
+type OpImageQuerySamples struct {
+	ResultType Id
+	ResultId   Id
+	Image      Id
+}
+
+func (c *OpImageQuerySamples) Opcode() uint32 { return opcodeImageQuerySamples }
+func (c *OpImageQuerySamples) Optional() bool { return false }
+func (c *OpImageQuerySamples) Verify() error  { return nil }
+
+// OpImageSparseSampleImplicitLod samples a sparse image with an
+// implicit level of detail.
+type OpImageSparseSampleImplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageSparseSampleImplicitLod) Opcode() uint32 { return opcodeImageSparseSampleImplicitLod }
+func (c *OpImageSparseSampleImplicitLod) Optional() bool { return false }
+func (c *OpImageSparseSampleImplicitLod) Verify() error  { return nil }
+
+// OpImageSparseSampleExplicitLod samples a sparse image using an
+// explicit level of detail.
+type OpImageSparseSampleExplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	ImageOperands ImageOperands
+	Argv          []Id
+}
+
+func (c *OpImageSparseSampleExplicitLod) Opcode() uint32 { return opcodeImageSparseSampleExplicitLod }
+func (c *OpImageSparseSampleExplicitLod) Optional() bool { return false }
+func (c *OpImageSparseSampleExplicitLod) Verify() error  { return nil }
+
+// OpImageSparseSampleDrefImplicitLod samples a sparse image doing
+// depth-comparison with an implicit level of detail.
+type OpImageSparseSampleDrefImplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	Dref          Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageSparseSampleDrefImplicitLod) Opcode() uint32 {
+	return opcodeImageSparseSampleDrefImplicitLod
+}
+func (c *OpImageSparseSampleDrefImplicitLod) Optional() bool { return false }
+func (c *OpImageSparseSampleDrefImplicitLod) Verify() error  { return nil }
+
+// OpImageSparseSampleDrefExplicitLod samples a sparse image doing
+// depth-comparison using an explicit level of detail.
+type OpImageSparseSampleDrefExplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	Dref          Id
+	ImageOperands ImageOperands
+	Argv          []Id
+}
+
+func (c *OpImageSparseSampleDrefExplicitLod) Opcode() uint32 {
+	return opcodeImageSparseSampleDrefExplicitLod
+}
+func (c *OpImageSparseSampleDrefExplicitLod) Optional() bool { return false }
+func (c *OpImageSparseSampleDrefExplicitLod) Verify() error  { return nil }
+
+// OpImageSparseSampleProjImplicitLod is reserved for future use.
+type OpImageSparseSampleProjImplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageSparseSampleProjImplicitLod) Opcode() uint32 {
+	return opcodeImageSparseSampleProjImplicitLod
+}
+func (c *OpImageSparseSampleProjImplicitLod) Optional() bool { return false }
+func (c *OpImageSparseSampleProjImplicitLod) Verify() error  { return nil }
+
+// OpImageSparseSampleProjExplicitLod is reserved for future use.
+type OpImageSparseSampleProjExplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	ImageOperands ImageOperands
+	Argv          []Id
+}
+
+func (c *OpImageSparseSampleProjExplicitLod) Opcode() uint32 {
+	return opcodeImageSparseSampleProjExplicitLod
+}
+func (c *OpImageSparseSampleProjExplicitLod) Optional() bool { return false }
+func (c *OpImageSparseSampleProjExplicitLod) Verify() error  { return nil }
+
+// OpImageSparseSampleProjDrefImplicitLod is reserved for future use.
+type OpImageSparseSampleProjDrefImplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	Dref          Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageSparseSampleProjDrefImplicitLod) Opcode() uint32 {
+	return opcodeImageSparseSampleProjDrefImplicitLod
+}
+func (c *OpImageSparseSampleProjDrefImplicitLod) Optional() bool { return false }
+func (c *OpImageSparseSampleProjDrefImplicitLod) Verify() error  { return nil }
+
+// OpImageSparseSampleProjDrefExplicitLod is reserved for future use.
+type OpImageSparseSampleProjDrefExplicitLod struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	Dref          Id
+	ImageOperands ImageOperands
+	Argv          []Id
+}
+
+func (c *OpImageSparseSampleProjDrefExplicitLod) Opcode() uint32 {
+	return opcodeImageSparseSampleProjDrefExplicitLod
+}
+func (c *OpImageSparseSampleProjDrefExplicitLod) Optional() bool { return false }
+func (c *OpImageSparseSampleProjDrefExplicitLod) Verify() error  { return nil }
+
+// OpImageSparseFetch fetches a single texel from a sampled sparse
+// image.
+type OpImageSparseFetch struct {
+	ResultType    Id
+	ResultId      Id
+	Image         Id
+	Coordinate    Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageSparseFetch) Opcode() uint32 { return opcodeImageSparseFetch }
+func (c *OpImageSparseFetch) Optional() bool { return false }
+func (c *OpImageSparseFetch) Verify() error  { return nil }
+
+// OpImageSparseGather gathers the requested component from four
+// texels of a sparse image.
+type OpImageSparseGather struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	Component     Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageSparseGather) Opcode() uint32 { return opcodeImageSparseGather }
+func (c *OpImageSparseGather) Optional() bool { return false }
+func (c *OpImageSparseGather) Verify() error  { return nil }
+
+// OpImageSparseDrefGather gathers the requested depth-comparison from
+// four texels of a sparse image.
+type OpImageSparseDrefGather struct {
+	ResultType    Id
+	ResultId      Id
+	SampledImage  Id
+	Coordinate    Id
+	Dref          Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageSparseDrefGather) Opcode() uint32 { return opcodeImageSparseDrefGather }
+func (c *OpImageSparseDrefGather) Optional() bool { return false }
+func (c *OpImageSparseDrefGather) Verify() error  { return nil }
+
+// OpImageSparseTexelsResident translates a Resident Code into a Boolean.
+// The result is false if any of the sparse texels accessed were not resident.
+type OpImageSparseTexelsResident struct {
+	ResultType   Id
+	ResultId     Id
+	ResidentCode Id
+}
+
+func (c *OpImageSparseTexelsResident) Opcode() uint32 { return opcodeImageSparseTexelsResident }
+func (c *OpImageSparseTexelsResident) Optional() bool { return false }
+func (c *OpImageSparseTexelsResident) Verify() error  { return nil }
+
+// OpImageSparseRead reads a texel from a sparse image without a sampler.
+type OpImageSparseRead struct {
+	ResultType    Id
+	ResultId      Id
+	Image         Id
+	Coordinate    Id
+	ImageOperands ImageOperands `spirv:"optional"`
+	Argv          []Id
+}
+
+func (c *OpImageSparseRead) Opcode() uint32 { return opcodeImageSparseRead }
+func (c *OpImageSparseRead) Optional() bool { return false }
+func (c *OpImageSparseRead) Verify() error  { return nil }
+
+func init() {
+	bind(func() Instruction { return &OpSampledImage{} })
+	bind(func() Instruction { return &OpImageSampleImplicitLod{} })
+	bind(func() Instruction { return &OpImageSampleExplicitLod{} })
+	bind(func() Instruction { return &OpImageSampleDrefImplicitLod{} })
+	bind(func() Instruction { return &OpImageSampleDrefExplicitLod{} })
+	bind(func() Instruction { return &OpImageSampleProjImplicitLod{} })
+	bind(func() Instruction { return &OpImageSampleProjExplicitLod{} })
+	bind(func() Instruction { return &OpImageSampleProjDrefImplicitLod{} })
+	bind(func() Instruction { return &OpImageSampleProjDrefExplicitLod{} })
+	bind(func() Instruction { return &OpImageFetch{} })
+	bind(func() Instruction { return &OpImageGather{} })
+	bind(func() Instruction { return &OpImageDrefGather{} })
+	bind(func() Instruction { return &OpImageRead{} })
+	bind(func() Instruction { return &OpImageWrite{} })
+	bind(func() Instruction { return &OpImage{} })
+	bind(func() Instruction { return &OpImageQueryFormat{} })
+	bind(func() Instruction { return &OpImageQueryOrder{} })
+	bind(func() Instruction { return &OpImageQuerySizeLod{} })
+	bind(func() Instruction { return &OpImageQuerySize{} })
+	bind(func() Instruction { return &OpImageQueryLod{} })
+	bind(func() Instruction { return &OpImageQueryLevels{} })
+	bind(func() Instruction { return &OpImageQuerySamples{} })
+	bind(func() Instruction { return &OpImageSparseSampleImplicitLod{} })
+	bind(func() Instruction { return &OpImageSparseSampleExplicitLod{} })
+	bind(func() Instruction { return &OpImageSparseSampleDrefImplicitLod{} })
+	bind(func() Instruction { return &OpImageSparseSampleDrefExplicitLod{} })
+	bind(func() Instruction { return &OpImageSparseSampleProjImplicitLod{} })
+	bind(func() Instruction { return &OpImageSparseSampleProjExplicitLod{} })
+	bind(func() Instruction { return &OpImageSparseSampleProjDrefImplicitLod{} })
+	bind(func() Instruction { return &OpImageSparseSampleProjDrefExplicitLod{} })
+	bind(func() Instruction { return &OpImageSparseFetch{} })
+	bind(func() Instruction { return &OpImageSparseGather{} })
+	bind(func() Instruction { return &OpImageSparseDrefGather{} })
+	bind(func() Instruction { return &OpImageSparseTexelsResident{} })
+	bind(func() Instruction { return &OpImageSparseRead{} })
+}
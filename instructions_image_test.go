@@ -0,0 +1,626 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "testing"
+
+func TestImage(t *testing.T) {
+	for _, st := range []InstructionTest{
+		{
+			in: []uint32{0x00050056, 1, 2, 3, 4},
+			want: &OpSampledImage{
+				ResultType: 1,
+				ResultId:   2,
+				Image:      3,
+				Sampler:    4,
+			},
+		},
+		{
+			in: []uint32{0x00050057, 1, 2, 3, 4},
+			want: &OpImageSampleImplicitLod{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+			},
+		},
+		{
+			in: []uint32{0x00080057, 1, 2, 3, 4, ImageOperandsBias, 5, 6},
+			want: &OpImageSampleImplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{5, 6},
+			},
+		},
+		{
+			in: []uint32{0x00060058, 1, 2, 3, 4, ImageOperandsBias},
+			want: &OpImageSampleExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+			},
+		},
+		{
+			in: []uint32{0x00080058, 1, 2, 3, 4, ImageOperandsBias, 5, 6},
+			want: &OpImageSampleExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{5, 6},
+			},
+		},
+		{
+			in: []uint32{0x00060059, 1, 2, 3, 4, 5},
+			want: &OpImageSampleDrefImplicitLod{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+				Dref:         5,
+			},
+		},
+		{
+			in: []uint32{0x00090059, 1, 2, 3, 4, 5, ImageOperandsBias, 6, 7},
+			want: &OpImageSampleDrefImplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{6, 7},
+			},
+		},
+		{
+			in: []uint32{0x0007005a, 1, 2, 3, 4, 5, ImageOperandsBias},
+			want: &OpImageSampleDrefExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+			},
+		},
+		{
+			in: []uint32{0x0009005a, 1, 2, 3, 4, 5, ImageOperandsBias, 6, 7},
+			want: &OpImageSampleDrefExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{6, 7},
+			},
+		},
+		{
+			in: []uint32{0x0005005b, 1, 2, 3, 4},
+			want: &OpImageSampleProjImplicitLod{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+			},
+		},
+		{
+			in: []uint32{0x0008005b, 1, 2, 3, 4, ImageOperandsBias, 5, 6},
+			want: &OpImageSampleProjImplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{5, 6},
+			},
+		},
+		{
+			in: []uint32{0x0006005c, 1, 2, 3, 4, ImageOperandsBias},
+			want: &OpImageSampleProjExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+			},
+		},
+		{
+			in: []uint32{0x0008005c, 1, 2, 3, 4, ImageOperandsBias, 5, 6},
+			want: &OpImageSampleProjExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{5, 6},
+			},
+		},
+		{
+			in: []uint32{0x0006005d, 1, 2, 3, 4, 5},
+			want: &OpImageSampleProjDrefImplicitLod{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+				Dref:         5,
+			},
+		},
+		{
+			in: []uint32{0x0009005d, 1, 2, 3, 4, 5, ImageOperandsBias, 6, 7},
+			want: &OpImageSampleProjDrefImplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{6, 7},
+			},
+		},
+		{
+			in: []uint32{0x0007005e, 1, 2, 3, 4, 5, ImageOperandsBias},
+			want: &OpImageSampleProjDrefExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+			},
+		},
+		{
+			in: []uint32{0x0009005e, 1, 2, 3, 4, 5, ImageOperandsBias, 6, 7},
+			want: &OpImageSampleProjDrefExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{6, 7},
+			},
+		},
+		{
+			in: []uint32{0x0005005f, 1, 2, 3, 4},
+			want: &OpImageFetch{
+				ResultType: 1,
+				ResultId:   2,
+				Image:      3,
+				Coordinate: 4,
+			},
+		},
+		{
+			in: []uint32{0x0008005f, 1, 2, 3, 4, ImageOperandsBias, 5, 6},
+			want: &OpImageFetch{
+				ResultType:    1,
+				ResultId:      2,
+				Image:         3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{5, 6},
+			},
+		},
+		{
+			in: []uint32{0x00060060, 1, 2, 3, 4, 5},
+			want: &OpImageGather{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+				Component:    5,
+			},
+		},
+		{
+			in: []uint32{0x00090060, 1, 2, 3, 4, 5, ImageOperandsBias, 6, 7},
+			want: &OpImageGather{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Component:     5,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{6, 7},
+			},
+		},
+		{
+			in: []uint32{0x00060061, 1, 2, 3, 4, 5},
+			want: &OpImageDrefGather{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+				Dref:         5,
+			},
+		},
+		{
+			in: []uint32{0x00090061, 1, 2, 3, 4, 5, ImageOperandsBias, 6, 7},
+			want: &OpImageDrefGather{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{6, 7},
+			},
+		},
+		{
+			in: []uint32{0x00050062, 1, 2, 3, 4},
+			want: &OpImageRead{
+				ResultType: 1,
+				ResultId:   2,
+				Image:      3,
+				Coordinate: 4,
+			},
+		},
+		{
+			in: []uint32{0x00080062, 1, 2, 3, 4, ImageOperandsBias, 5, 6},
+			want: &OpImageRead{
+				ResultType:    1,
+				ResultId:      2,
+				Image:         3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{5, 6},
+			},
+		},
+		{
+			in: []uint32{0x00040063, 1, 2, 3},
+			want: &OpImageWrite{
+				Image:      1,
+				Coordinate: 2,
+				Texel:      3,
+			},
+		},
+		{
+			in: []uint32{0x00070063, 1, 2, 3, ImageOperandsBias, 4, 5},
+			want: &OpImageWrite{
+				Image:         1,
+				Coordinate:    2,
+				Texel:         3,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{4, 5},
+			},
+		},
+		{
+			in: []uint32{0x00040064, 1, 2, 3},
+			want: &OpImage{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+			},
+		},
+		{
+			in: []uint32{0x00040065, 1, 2, 3},
+			want: &OpImageQueryFormat{
+				ResultType: 1,
+				ResultId:   2,
+				Image:      3,
+			},
+		},
+		{
+			in: []uint32{0x00040066, 1, 2, 3},
+			want: &OpImageQueryOrder{
+				ResultType: 1,
+				ResultId:   2,
+				Image:      3,
+			},
+		},
+		{
+			in: []uint32{0x00050067, 1, 2, 3, 4},
+			want: &OpImageQuerySizeLod{
+				ResultType:    1,
+				ResultId:      2,
+				Image:         3,
+				LevelOfDetail: 4,
+			},
+		},
+		{
+			in: []uint32{0x00040068, 1, 2, 3},
+			want: &OpImageQuerySize{
+				ResultType: 1,
+				ResultId:   2,
+				Image:      3,
+			},
+		},
+		{
+			in: []uint32{0x00050069, 1, 2, 3, 4},
+			want: &OpImageQueryLod{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+			},
+		},
+		{
+			in: []uint32{0x0004006a, 1, 2, 3},
+			want: &OpImageQueryLevels{
+				ResultType: 1,
+				ResultId:   2,
+				Image:      3,
+			},
+		},
+		{
+			in: []uint32{0x0004006b, 1, 2, 3},
+			want: &OpImageQuerySamples{
+				ResultType: 1,
+				ResultId:   2,
+				Image:      3,
+			},
+		},
+		{
+			in: []uint32{0x00050131, 1, 2, 3, 4},
+			want: &OpImageSparseSampleImplicitLod{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+			},
+		},
+		{
+			in: []uint32{0x00080131, 1, 2, 3, 4, ImageOperandsBias, 5, 6},
+			want: &OpImageSparseSampleImplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{5, 6},
+			},
+		},
+		{
+			in: []uint32{0x00060132, 1, 2, 3, 4, ImageOperandsBias},
+			want: &OpImageSparseSampleExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+			},
+		},
+		{
+			in: []uint32{0x00080132, 1, 2, 3, 4, ImageOperandsBias, 5, 6},
+			want: &OpImageSparseSampleExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{5, 6},
+			},
+		},
+		{
+			in: []uint32{0x00060133, 1, 2, 3, 4, 5},
+			want: &OpImageSparseSampleDrefImplicitLod{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+				Dref:         5,
+			},
+		},
+		{
+			in: []uint32{0x00090133, 1, 2, 3, 4, 5, ImageOperandsBias, 6, 7},
+			want: &OpImageSparseSampleDrefImplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{6, 7},
+			},
+		},
+		{
+			in: []uint32{0x00070134, 1, 2, 3, 4, 5, ImageOperandsBias},
+			want: &OpImageSparseSampleDrefExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+			},
+		},
+		{
+			in: []uint32{0x00090134, 1, 2, 3, 4, 5, ImageOperandsBias, 6, 7},
+			want: &OpImageSparseSampleDrefExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{6, 7},
+			},
+		},
+		{
+			in: []uint32{0x00050135, 1, 2, 3, 4},
+			want: &OpImageSparseSampleProjImplicitLod{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+			},
+		},
+		{
+			in: []uint32{0x00080135, 1, 2, 3, 4, ImageOperandsBias, 5, 6},
+			want: &OpImageSparseSampleProjImplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{5, 6},
+			},
+		},
+		{
+			in: []uint32{0x00060136, 1, 2, 3, 4, ImageOperandsBias},
+			want: &OpImageSparseSampleProjExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+			},
+		},
+		{
+			in: []uint32{0x00080136, 1, 2, 3, 4, ImageOperandsBias, 5, 6},
+			want: &OpImageSparseSampleProjExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{5, 6},
+			},
+		},
+		{
+			in: []uint32{0x00060137, 1, 2, 3, 4, 5},
+			want: &OpImageSparseSampleProjDrefImplicitLod{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+				Dref:         5,
+			},
+		},
+		{
+			in: []uint32{0x00090137, 1, 2, 3, 4, 5, ImageOperandsBias, 6, 7},
+			want: &OpImageSparseSampleProjDrefImplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{6, 7},
+			},
+		},
+		{
+			in: []uint32{0x00070138, 1, 2, 3, 4, 5, ImageOperandsBias},
+			want: &OpImageSparseSampleProjDrefExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+			},
+		},
+		{
+			in: []uint32{0x00090138, 1, 2, 3, 4, 5, ImageOperandsBias, 6, 7},
+			want: &OpImageSparseSampleProjDrefExplicitLod{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{6, 7},
+			},
+		},
+		{
+			in: []uint32{0x00050139, 1, 2, 3, 4},
+			want: &OpImageSparseFetch{
+				ResultType: 1,
+				ResultId:   2,
+				Image:      3,
+				Coordinate: 4,
+			},
+		},
+		{
+			in: []uint32{0x00080139, 1, 2, 3, 4, ImageOperandsBias, 5, 6},
+			want: &OpImageSparseFetch{
+				ResultType:    1,
+				ResultId:      2,
+				Image:         3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{5, 6},
+			},
+		},
+		{
+			in: []uint32{0x0006013a, 1, 2, 3, 4, 5},
+			want: &OpImageSparseGather{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+				Component:    5,
+			},
+		},
+		{
+			in: []uint32{0x0009013a, 1, 2, 3, 4, 5, ImageOperandsBias, 6, 7},
+			want: &OpImageSparseGather{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Component:     5,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{6, 7},
+			},
+		},
+		{
+			in: []uint32{0x0006013b, 1, 2, 3, 4, 5},
+			want: &OpImageSparseDrefGather{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+				Dref:         5,
+			},
+		},
+		{
+			in: []uint32{0x0009013b, 1, 2, 3, 4, 5, ImageOperandsBias, 6, 7},
+			want: &OpImageSparseDrefGather{
+				ResultType:    1,
+				ResultId:      2,
+				SampledImage:  3,
+				Coordinate:    4,
+				Dref:          5,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{6, 7},
+			},
+		},
+		{
+			in: []uint32{0x0004013c, 1, 2, 3},
+			want: &OpImageSparseTexelsResident{
+				ResultType:   1,
+				ResultId:     2,
+				ResidentCode: 3,
+			},
+		},
+		{
+			in: []uint32{0x00050140, 1, 2, 3, 4},
+			want: &OpImageSparseRead{
+				ResultType: 1,
+				ResultId:   2,
+				Image:      3,
+				Coordinate: 4,
+			},
+		},
+		{
+			in: []uint32{0x00080140, 1, 2, 3, 4, ImageOperandsBias, 5, 6},
+			want: &OpImageSparseRead{
+				ResultType:    1,
+				ResultId:      2,
+				Image:         3,
+				Coordinate:    4,
+				ImageOperands: ImageOperandsBias,
+				Argv:          []Id{5, 6},
+			},
+		},
+	} {
+		testInstruction(t, st)
+	}
+}
@@ -1,6 +1,10 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
 package spirv
 
 // OpVariable allocates an object in memory, resulting in a pointer
@@ -25,48 +29,47 @@ func (c *OpVariable) Opcode() uint32 { return opcodeVariable }
 func (c *OpVariable) Optional() bool { return false }
 func (c *OpVariable) Verify() error  { return nil }
 
-// OpVariableArray allocates N objects sequentially in memory,
-// resulting in a pointer to the first such object.
-//
-// This is not the same thing as allocating a single object that is an array.
-type OpVariableArray struct {
-	// Result Type is a type from OpTypePointer whose type pointed to is
-	// the type of one of the N objects allocated in memory
+// OpImageTexelPointer forms a pointer to a texel of an image.
+// Use of such a pointer is limited to atomic operations.
+type OpImageTexelPointer struct {
 	ResultType Id
+	ResultId   Id
 
-	ResultId Id
-
-	// Storage Class is the kind of memory holding the object.
-	StorageClass StorageClass
+	// Image is a pointer to a variable of type OpTypeImage.
+	Image Id
 
-	// N is the number of objects to allocate.
-	N Id
+	// Coordinate and Sample specify which texel and sample within
+	// the image to form an address of.
+	Coordinate Id
+	Sample     Id
 }
 
-func (c *OpVariableArray) Opcode() uint32 { return opcodeVariableArray }
-func (c *OpVariableArray) Optional() bool { return false }
-func (c *OpVariableArray) Verify() error  { return nil }
+func (c *OpImageTexelPointer) Opcode() uint32 { return opcodeImageTexelPointer }
+func (c *OpImageTexelPointer) Optional() bool { return false }
+func (c *OpImageTexelPointer) Verify() error  { return nil }
 
 // OpLoad loads data through a pointer.
 type OpLoad struct {
 	// Result Type is a type from OpTypePointer whose type pointed to is
 	// the type of one of the N objects allocated in memory
 	ResultType Id
-
-	ResultId Id
+	ResultId   Id
 
 	// Pointer is the pointer to load through. It must have a type of
 	// OpTypePointer whose operand is the same as Result Type.
 	Pointer Id
 
-	// MemoryAccess must be one or more Memory Access literals.
-	// FIXME: Incorrect type in specification.
-	MemoryAccess []MemoryAccess
+	// MemoryAccess is an optional set of Memory Access flags.
+	MemoryAccess MemoryAccess `spirv:"optional"`
+
+	// Argv holds the literal arguments for MemoryAccess, if any.
+	// For example, the alignment for MemoryAccessAligned.
+	Argv []uint32
 }
 
 func (c *OpLoad) Opcode() uint32 { return opcodeLoad }
 func (c *OpLoad) Optional() bool { return false }
-func (c *OpLoad) Verify() error  { return nil }
+func (c *OpLoad) Verify() error  { return c.verify() }
 
 // OpStore stores data through a pointer.
 type OpStore struct {
@@ -77,14 +80,17 @@ type OpStore struct {
 	// Object is the object to store.
 	Object Id
 
-	// MemoryAccess must be one or more Memory Access literals.
-	// FIXME: Incorrect type in specification.
-	MemoryAccess []MemoryAccess
+	// MemoryAccess is an optional set of Memory Access flags.
+	MemoryAccess MemoryAccess `spirv:"optional"`
+
+	// Argv holds the literal arguments for MemoryAccess, if any.
+	// For example, the alignment for MemoryAccessAligned.
+	Argv []uint32
 }
 
 func (c *OpStore) Opcode() uint32 { return opcodeStore }
 func (c *OpStore) Optional() bool { return false }
-func (c *OpStore) Verify() error  { return nil }
+func (c *OpStore) Verify() error  { return c.verify() }
 
 // OpCopyMemory copies from the memory pointed to by Source to the
 // memory pointed to by Target.
@@ -99,14 +105,17 @@ type OpCopyMemory struct {
 	// The source address.
 	Source Id
 
-	// MemoryAccess must be one or more Memory Access literals.
-	// FIXME: Incorrect type in specification.
-	MemoryAccess []MemoryAccess
+	// MemoryAccess is an optional set of Memory Access flags.
+	MemoryAccess MemoryAccess `spirv:"optional"`
+
+	// Argv holds the literal arguments for MemoryAccess, if any.
+	// For example, the alignment for MemoryAccessAligned.
+	Argv []uint32
 }
 
 func (c *OpCopyMemory) Opcode() uint32 { return opcodeCopyMemory }
 func (c *OpCopyMemory) Optional() bool { return false }
-func (c *OpCopyMemory) Verify() error  { return nil }
+func (c *OpCopyMemory) Verify() error  { return c.verify() }
 
 // OpCopyMemorySized copies from the memory pointed to by Source to the
 // memory pointed to by Target.
@@ -123,14 +132,17 @@ type OpCopyMemorySized struct {
 	// Size is the number of bytes to copy.
 	Size Id
 
-	// MemoryAccess must be one or more Memory Access literals.
-	// FIXME: Incorrect type in specification.
-	MemoryAccess []MemoryAccess
+	// MemoryAccess is an optional set of Memory Access flags.
+	MemoryAccess MemoryAccess `spirv:"optional"`
+
+	// Argv holds the literal arguments for MemoryAccess, if any.
+	// For example, the alignment for MemoryAccessAligned.
+	Argv []uint32
 }
 
 func (c *OpCopyMemorySized) Opcode() uint32 { return opcodeCopyMemorySized }
 func (c *OpCopyMemorySized) Optional() bool { return false }
-func (c *OpCopyMemorySized) Verify() error  { return nil }
+func (c *OpCopyMemorySized) Verify() error  { return c.verify() }
 
 // OpAccessChain creates a pointer into a composite object that can be
 // used with OpLoad and OpStore.
@@ -157,7 +169,7 @@ func (c *OpAccessChain) Verify() error  { return nil }
 
 // OpInboundsAccessChain has the same semantics as OpAccessChain, with the
 // addition that the resulting pointer is known to point within the base object.
-type OpInboundsAccessChain struct {
+type OpInBoundsAccessChain struct {
 	ResultType Id
 	ResultId   Id
 
@@ -171,71 +183,93 @@ type OpInboundsAccessChain struct {
 	Indices []Id
 }
 
-func (c *OpInboundsAccessChain) Opcode() uint32 { return opcodeInboundsAccessChain }
-func (c *OpInboundsAccessChain) Optional() bool { return false }
-func (c *OpInboundsAccessChain) Verify() error  { return nil }
+func (c *OpInBoundsAccessChain) Opcode() uint32 { return opcodeInBoundsAccessChain }
+func (c *OpInBoundsAccessChain) Optional() bool { return false }
+func (c *OpInBoundsAccessChain) Verify() error  { return nil }
 
-// OpArraylength results in the length of a run-time array.
-type OpArraylength struct {
+// OpPtrAccessChain has the same semantics as OpAccessChain, with the
+// addition of the Element operand. Element is used to do the initial
+// dereference of Base.
+type OpPtrAccessChain struct {
 	ResultType Id
 	ResultId   Id
 
-	// Structure must be an object of type OpTypeStruct that contains
-	// a member that is a run-time array.
-	Structure Id
+	// Base must be a pointer, pointing to the base of the object.
+	Base Id
 
-	// Array member is a member number of Structure that must have a
-	// type from OpTypeRuntimeArray.
-	Member uint32
+	// Element is used to do the initial dereference of Base.
+	Element Id
+
+	// Indices walk the type hierarchy to the desired depth.
+	Indices []Id
 }
 
-func (c *OpArraylength) Opcode() uint32 { return opcodeArraylength }
-func (c *OpArraylength) Optional() bool { return false }
-func (c *OpArraylength) Verify() error  { return nil }
+func (c *OpPtrAccessChain) Opcode() uint32 { return opcodePtrAccessChain }
+func (c *OpPtrAccessChain) Optional() bool { return false }
+func (c *OpPtrAccessChain) Verify() error  { return nil }
 
-// OpImagePointer forms a pointer to a texel of an image.
-// Use of such a pointer is limited to atomic operations.
-//
-// TODO: This requires an Image storage class to be added.
-type OpImagePointer struct {
+// OpArraylength results in the length of a run-time array.
+type OpArrayLength struct {
 	ResultType Id
 	ResultId   Id
 
-	// Image is a pointer to a variable of type of OpTypeSampler.
-	Image Id
+	// Structure must be an object of type OpTypeStruct that contains
+	// a member that is a run-time array.
+	Structure Id
 
-	// Coordinate and Sample specify which texel and sample within
-	// the image to form an address of.
-	Coordinate Id
-	Sample     Id
+	// Array member is a member number of Structure that must have a
+	// type from OpTypeRuntimeArray.
+	Member uint32
 }
 
-func (c *OpImagePointer) Opcode() uint32 { return opcodeImagePointer }
-func (c *OpImagePointer) Optional() bool { return false }
-func (c *OpImagePointer) Verify() error  { return nil }
+func (c *OpArrayLength) Opcode() uint32 { return opcodeArrayLength }
+func (c *OpArrayLength) Optional() bool { return false }
+func (c *OpArrayLength) Verify() error  { return nil }
 
 // OpGenericPtrMemSemantics returns a valid Memory Semantics
-// value for ptr.
+// value for Pointer.
 type OpGenericPtrMemSemantics struct {
 	ResultType Id // Result Type must be a 32-bits wide OpTypeInt value
 	ResultId   Id
-	Ptr        Id
+	Pointer    Id
 }
 
 func (c *OpGenericPtrMemSemantics) Opcode() uint32 { return opcodeGenericPtrMemSemantics }
 func (c *OpGenericPtrMemSemantics) Optional() bool { return false }
 func (c *OpGenericPtrMemSemantics) Verify() error  { return nil }
 
+// OpInBoundsPtrAccessChain has the same semantics as OpPtrAccessChain,
+// with the addition that the resulting pointer is known to point within the
+// base object.
+type OpInBoundsPtrAccessChain struct {
+	ResultType Id
+	ResultId   Id
+
+	// Base must be a pointer, pointing to the base of the object.
+	Base Id
+
+	// Element is used to do the initial dereference of Base.
+	Element Id
+
+	// Indices walk the type hierarchy to the desired depth.
+	Indices []Id
+}
+
+func (c *OpInBoundsPtrAccessChain) Opcode() uint32 { return opcodeInBoundsPtrAccessChain }
+func (c *OpInBoundsPtrAccessChain) Optional() bool { return false }
+func (c *OpInBoundsPtrAccessChain) Verify() error  { return nil }
+
 func init() {
-	bind(func() Instruction { return &OpVariable{Initializer: 0} })
-	bind(func() Instruction { return &OpVariableArray{} })
+	bind(func() Instruction { return &OpVariable{} })
+	bind(func() Instruction { return &OpImageTexelPointer{} })
 	bind(func() Instruction { return &OpLoad{} })
 	bind(func() Instruction { return &OpStore{} })
 	bind(func() Instruction { return &OpCopyMemory{} })
 	bind(func() Instruction { return &OpCopyMemorySized{} })
 	bind(func() Instruction { return &OpAccessChain{} })
-	bind(func() Instruction { return &OpInboundsAccessChain{} })
-	bind(func() Instruction { return &OpArraylength{} })
-	bind(func() Instruction { return &OpImagePointer{} })
+	bind(func() Instruction { return &OpInBoundsAccessChain{} })
+	bind(func() Instruction { return &OpPtrAccessChain{} })
+	bind(func() Instruction { return &OpArrayLength{} })
 	bind(func() Instruction { return &OpGenericPtrMemSemantics{} })
+	bind(func() Instruction { return &OpInBoundsPtrAccessChain{} })
 }
@@ -1,6 +1,10 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
 package spirv
 
 // OpNop represents the OpNop instruction.
@@ -12,7 +16,7 @@ type OpNop struct{}
 
 func (c *OpNop) Opcode() uint32 { return opcodeNop }
 func (c *OpNop) Optional() bool { return false }
-func (c *OpNop) Verify() error  { return ErrUnacceptable }
+func (c *OpNop) Verify() error  { return c.verify() }
 
 // OpUndef makes an intermediate object with no initialization.
 type OpUndef struct {
@@ -12,7 +12,7 @@ func TestMiscellaneous(t *testing.T) {
 			err: ErrUnacceptable,
 		},
 		{
-			in: []uint32{0x0003002d, 1, 2},
+			in: []uint32{0x00030001, 1, 2},
 			want: &OpUndef{
 				ResultType: 1,
 				ResultId:   2,
@@ -1,9 +1,11 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
-package spirv
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
 
-import "fmt"
+package spirv
 
 // OpMemoryModel represents the OpMemoryModel instruction.
 //
@@ -20,8 +22,19 @@ func (c *OpMemoryModel) Verify() error  { return nil }
 // OpEntryPoint represents the OpEntryPoint instruction.
 // It declares an entry point and its execution model.
 type OpEntryPoint struct {
+	// The execution model for the entry point and its static call tree.
 	ExecutionModel ExecutionModel
-	ResultId       Id
+
+	// EntryPoint must be the Result <id> of an OpFunction instruction.
+	EntryPoint Id
+
+	// Name is a name string for the entry point.
+	Name String
+
+	// Interface is a list of <id> of global OpVariable instructions
+	// with either Input or Output for its Storage Class operand. These declare
+	// the input/output interface of the entry point.
+	Interface []Id
 }
 
 func (c *OpEntryPoint) Opcode() uint32 { return opcodeEntryPoint }
@@ -38,48 +51,21 @@ type OpExecutionMode struct {
 
 func (c *OpExecutionMode) Opcode() uint32 { return opcodeExecutionMode }
 func (c *OpExecutionMode) Optional() bool { return false }
-func (c *OpExecutionMode) Verify() error {
-	argc := len(c.Argv)
-
-	switch c.Mode {
-	case ExecutionModeInvocations,
-		ExecutionModeOutputVertices,
-		ExecutionModeVecTypeHint:
-		if argc != 1 {
-			return fmt.Errorf("OpExecutionMode: ExecutionMode(%d) must have 1 argument", c.Mode)
-		}
-
-		return nil
-
-	case ExecutionModeLocalSize,
-		ExecutionModeLocalSizeHint:
-		if argc != 3 {
-			return fmt.Errorf("OpExecutionMode: ExecutionMode(%d) must have 3 arguments", c.Mode)
-		}
-
-		return nil
-	}
-
-	if argc > 0 {
-		return fmt.Errorf("OpExecutionMode: extraneous arguments for ExecutionMode(%d)",
-			c.Mode)
-	}
-
-	return nil
-}
+func (c *OpExecutionMode) Verify() error  { return c.verify() }
 
-// OpCompileFlag represents the OpCompileFlag instruction.
-type OpCompileFlag struct {
-	Flag String
+// OpCapability declares a capability used by this module.
+type OpCapability struct {
+	// The capability being declared.
+	Capability Capability
 }
 
-func (c *OpCompileFlag) Opcode() uint32 { return opcodeCompileFlag }
-func (c *OpCompileFlag) Optional() bool { return false }
-func (c *OpCompileFlag) Verify() error  { return nil }
+func (c *OpCapability) Opcode() uint32 { return opcodeCapability }
+func (c *OpCapability) Optional() bool { return false }
+func (c *OpCapability) Verify() error  { return nil }
 
 func init() {
 	bind(func() Instruction { return &OpMemoryModel{} })
 	bind(func() Instruction { return &OpEntryPoint{} })
 	bind(func() Instruction { return &OpExecutionMode{} })
-	bind(func() Instruction { return &OpCompileFlag{} })
+	bind(func() Instruction { return &OpCapability{} })
 }
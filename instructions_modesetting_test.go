@@ -3,48 +3,75 @@
 
 package spirv
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 func TestModeSetting(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{
-				0x00030005,
-				AddressingModePhysical32,
-				MemoryModelGLSL450,
-			},
+			in: []uint32{0x0003000e,
+				AddressingModelLogical, MemoryModelGLSL450},
 			want: &OpMemoryModel{
-				AddressingModel: AddressingModePhysical32,
+				AddressingModel: AddressingModelLogical,
 				MemoryModel:     MemoryModelGLSL450,
 			},
 		},
 		{
-			in: []uint32{0x00030006, ExecutionModelFragment, 0x7f},
+			in: []uint32{0x0006000f,
+				ExecutionModelFragment, 1, 0x74736574, 0x6d616e5f, 0x00000065},
 			want: &OpEntryPoint{
 				ExecutionModel: ExecutionModelFragment,
-				ResultId:       0x7f,
+				EntryPoint:     1,
+				Name:           "test_name",
 			},
 		},
 		{
-			in: []uint32{0x00060007, 0x7f, ExecutionModeLocalSize, 1, 2, 3},
+			in: []uint32{
+				0x0008000f,
+				ExecutionModelFragment,
+				1,
+				0x74736574,
+				0x6d616e5f,
+				0x00000065,
+				2,
+				3,
+			},
+			want: &OpEntryPoint{
+				ExecutionModel: ExecutionModelFragment,
+				EntryPoint:     1,
+				Name:           "test_name",
+				Interface:      []Id{2, 3},
+			},
+		},
+		{
+			in: []uint32{0x00030010, 1, ExecutionModeOriginUpperLeft},
 			want: &OpExecutionMode{
-				EntryPoint: 0x7f,
-				Mode:       ExecutionModeLocalSize,
-				Argv:       []uint32{1, 2, 3},
+				EntryPoint: 1,
+				Mode:       ExecutionModeOriginUpperLeft,
 			},
 		},
 		{
-			in: []uint32{
-				0x000700da,
-				0x74736574,
-				0x756f7320,
-				0x20656372,
-				0x65747865,
-				0x6f69736e,
-				0x0000006e,
+			in: []uint32{0x00060010, 1, ExecutionModeLocalSize, 2, 3, 4},
+			want: &OpExecutionMode{
+				EntryPoint: 1,
+				Mode:       ExecutionModeLocalSize,
+				Argv:       []uint32{2, 3, 4},
 			},
-			want: &OpCompileFlag{
-				Flag: "test source extension",
+		},
+		{
+			in:  []uint32{0x00040010, 1, ExecutionModeOriginUpperLeft, 2},
+			err: fmt.Errorf("OpExecutionMode: extraneous arguments for ExecutionMode(7)"),
+		},
+		{
+			in:  []uint32{0x00040010, 1, ExecutionModeLocalSize, 2},
+			err: fmt.Errorf("OpExecutionMode: ExecutionMode(17) must have 3 arguments"),
+		},
+		{
+			in: []uint32{0x00020011, CapabilityShader},
+			want: &OpCapability{
+				Capability: CapabilityShader,
 			},
 		},
 	} {
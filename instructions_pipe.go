@@ -1,26 +1,36 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
 package spirv
 
-// OpReadPipe reads a packet from P into Ptr.
+// OpReadPipe reads a packet from the pipe object specified by Pipe
+// into Pointer.
 type OpReadPipe struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
-	Ptr        Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	Pointer         Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpReadPipe) Opcode() uint32 { return opcodeReadPipe }
 func (c *OpReadPipe) Optional() bool { return false }
 func (c *OpReadPipe) Verify() error  { return nil }
 
-// OpWritePipe writes a packet from Ptr to P.
+// OpWritePipe writes a packet from Pointer to the pipe object
+// specified by Pipe.
 type OpWritePipe struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
-	Ptr        Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	Pointer         Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpWritePipe) Opcode() uint32 { return opcodeWritePipe }
@@ -30,12 +40,14 @@ func (c *OpWritePipe) Verify() error  { return nil }
 // OpReservedReadPipe reads a packet from the reserved area specified by
 // ReserveId and Index from P into Ptr.
 type OpReservedReadPipe struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
-	ReserveId  Id
-	Index      Id
-	Ptr        Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	ReserveId       Id
+	Index           Id
+	Pointer         Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpReservedReadPipe) Opcode() uint32 { return opcodeReservedReadPipe }
@@ -45,12 +57,14 @@ func (c *OpReservedReadPipe) Verify() error  { return nil }
 // OpReservedWritePipe writes a packet from Ptr into the reserved area
 // specified by ReserveId and Index into P.
 type OpReservedWritePipe struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
-	ReserveId  Id
-	Index      Id
-	Ptr        Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	ReserveId       Id
+	Index           Id
+	Pointer         Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpReservedWritePipe) Opcode() uint32 { return opcodeReservedWritePipe }
@@ -59,10 +73,12 @@ func (c *OpReservedWritePipe) Verify() error  { return nil }
 
 // OpReserveReadPipePackets reserves NumPackets entries for reading from P.
 type OpReserveReadPipePackets struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
-	NumPackets Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	NumPackets      Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpReserveReadPipePackets) Opcode() uint32 { return opcodeReserveReadPipePackets }
@@ -71,10 +87,12 @@ func (c *OpReserveReadPipePackets) Verify() error  { return nil }
 
 // OpReserveWritePipePackets reserves NumPackets entries for writing to P.
 type OpReserveWritePipePackets struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
-	NumPackets Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	NumPackets      Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpReserveWritePipePackets) Opcode() uint32 { return opcodeReserveWritePipePackets }
@@ -84,8 +102,10 @@ func (c *OpReserveWritePipePackets) Verify() error  { return nil }
 // OpCommitReadPipe indicates that all reads to NumPackets associated with
 // ReserveId in P are completed.
 type OpCommitReadPipe struct {
-	P         Id
-	ReserveId Id
+	Pipe            Id
+	ReserveId       Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpCommitReadPipe) Opcode() uint32 { return opcodeCommitReadPipe }
@@ -95,8 +115,10 @@ func (c *OpCommitReadPipe) Verify() error  { return nil }
 // OpCommitWritePipe indicates that all writes to NumPackets associated
 // with ReserveId in P are completed.
 type OpCommitWritePipe struct {
-	P         Id
-	ReserveId Id
+	Pipe            Id
+	ReserveId       Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpCommitWritePipe) Opcode() uint32 { return opcodeCommitWritePipe }
@@ -117,9 +139,11 @@ func (c *OpIsValidReserveId) Verify() error  { return nil }
 
 // OpGetNumPipePackets returns the number of available entries in P.
 type OpGetNumPipePackets struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpGetNumPipePackets) Opcode() uint32 { return opcodeGetNumPipePackets }
@@ -129,9 +153,11 @@ func (c *OpGetNumPipePackets) Verify() error  { return nil }
 // OpGetMaxPipePackets returns the maximum number of packets specified when
 // P was created.
 type OpGetMaxPipePackets struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpGetMaxPipePackets) Opcode() uint32 { return opcodeGetMaxPipePackets }
@@ -141,11 +167,13 @@ func (c *OpGetMaxPipePackets) Verify() error  { return nil }
 // OpGroupReserveReadPipePackets reserves NumPackets entries for reading
 // from P at group level.
 type OpGroupReserveReadPipePackets struct {
-	ResultType Id
-	ResultId   Id
-	Scope      ExecutionScope
-	P          Id
-	NumPackets Id
+	ResultType      Id
+	ResultId        Id
+	Execution       Id
+	Pipe            Id
+	NumPackets      Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpGroupReserveReadPipePackets) Opcode() uint32 { return opcodeGroupReserveReadPipePackets }
@@ -155,11 +183,13 @@ func (c *OpGroupReserveReadPipePackets) Verify() error  { return nil }
 // OpGroupReserveWritePipePackets reserves NumPackets entries for writing
 // to P at group level.
 type OpGroupReserveWritePipePackets struct {
-	ResultType Id
-	ResultId   Id
-	Scope      ExecutionScope
-	P          Id
-	NumPackets Id
+	ResultType      Id
+	ResultId        Id
+	Execution       Id
+	Pipe            Id
+	NumPackets      Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpGroupReserveWritePipePackets) Opcode() uint32 { return opcodeGroupReserveWritePipePackets }
@@ -169,9 +199,11 @@ func (c *OpGroupReserveWritePipePackets) Verify() error  { return nil }
 // OpGroupCommitReadPipe is a group level indication that all reads to
 // NumPackets associated with ReserveId to P are completed.
 type OpGroupCommitReadPipe struct {
-	Scope     ExecutionScope
-	P         Id
-	ReserveId Id
+	Execution       Id
+	Pipe            Id
+	ReserveId       Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpGroupCommitReadPipe) Opcode() uint32 { return opcodeGroupCommitReadPipe }
@@ -181,9 +213,11 @@ func (c *OpGroupCommitReadPipe) Verify() error  { return nil }
 // OpGroupCommitWritePipe is a group level indication that all writes to
 // NumPackets associated with ReserveId to P are completed.
 type OpGroupCommitWritePipe struct {
-	Scope     ExecutionScope
-	P         Id
-	ReserveId Id
+	Execution       Id
+	Pipe            Id
+	ReserveId       Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpGroupCommitWritePipe) Opcode() uint32 { return opcodeGroupCommitWritePipe }
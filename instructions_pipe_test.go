@@ -8,79 +8,95 @@ import "testing"
 func TestPipe(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x000500ea, 1, 2, 3, 4},
+			in: []uint32{0x00070112, 1, 2, 3, 4, 5, 6},
 			want: &OpReadPipe{
-				ResultType: 1,
-				ResultId:   2,
-				P:          3,
-				Ptr:        4,
+				ResultType:      1,
+				ResultId:        2,
+				Pipe:            3,
+				Pointer:         4,
+				PacketSize:      5,
+				PacketAlignment: 6,
 			},
 		},
 		{
-			in: []uint32{0x000500eb, 1, 2, 3, 4},
+			in: []uint32{0x00070113, 1, 2, 3, 4, 5, 6},
 			want: &OpWritePipe{
-				ResultType: 1,
-				ResultId:   2,
-				P:          3,
-				Ptr:        4,
+				ResultType:      1,
+				ResultId:        2,
+				Pipe:            3,
+				Pointer:         4,
+				PacketSize:      5,
+				PacketAlignment: 6,
 			},
 		},
 		{
-			in: []uint32{0x000700ec, 1, 2, 3, 4, 5, 6},
+			in: []uint32{0x00090114, 1, 2, 3, 4, 5, 6, 7, 8},
 			want: &OpReservedReadPipe{
-				ResultType: 1,
-				ResultId:   2,
-				P:          3,
-				ReserveId:  4,
-				Index:      5,
-				Ptr:        6,
+				ResultType:      1,
+				ResultId:        2,
+				Pipe:            3,
+				ReserveId:       4,
+				Index:           5,
+				Pointer:         6,
+				PacketSize:      7,
+				PacketAlignment: 8,
 			},
 		},
 		{
-			in: []uint32{0x000700ed, 1, 2, 3, 4, 5, 6},
+			in: []uint32{0x00090115, 1, 2, 3, 4, 5, 6, 7, 8},
 			want: &OpReservedWritePipe{
-				ResultType: 1,
-				ResultId:   2,
-				P:          3,
-				ReserveId:  4,
-				Index:      5,
-				Ptr:        6,
+				ResultType:      1,
+				ResultId:        2,
+				Pipe:            3,
+				ReserveId:       4,
+				Index:           5,
+				Pointer:         6,
+				PacketSize:      7,
+				PacketAlignment: 8,
 			},
 		},
 		{
-			in: []uint32{0x000500ee, 1, 2, 3, 4},
+			in: []uint32{0x00070116, 1, 2, 3, 4, 5, 6},
 			want: &OpReserveReadPipePackets{
-				ResultType: 1,
-				ResultId:   2,
-				P:          3,
-				NumPackets: 4,
+				ResultType:      1,
+				ResultId:        2,
+				Pipe:            3,
+				NumPackets:      4,
+				PacketSize:      5,
+				PacketAlignment: 6,
 			},
 		},
 		{
-			in: []uint32{0x000500ef, 1, 2, 3, 4},
+			in: []uint32{0x00070117, 1, 2, 3, 4, 5, 6},
 			want: &OpReserveWritePipePackets{
-				ResultType: 1,
-				ResultId:   2,
-				P:          3,
-				NumPackets: 4,
+				ResultType:      1,
+				ResultId:        2,
+				Pipe:            3,
+				NumPackets:      4,
+				PacketSize:      5,
+				PacketAlignment: 6,
 			},
 		},
 		{
-			in: []uint32{0x000300f0, 1, 2},
+			in: []uint32{0x00050118, 1, 2, 3, 4},
 			want: &OpCommitReadPipe{
-				P:         1,
-				ReserveId: 2,
+				Pipe:            1,
+				ReserveId:       2,
+				PacketSize:      3,
+				PacketAlignment: 4,
 			},
 		},
 		{
-			in: []uint32{0x000300f1, 1, 2},
+			in: []uint32{0x00050119, 1, 2, 3, 4},
 			want: &OpCommitWritePipe{
-				P:         1,
-				ReserveId: 2,
+				Pipe:            1,
+				ReserveId:       2,
+				PacketSize:      3,
+				PacketAlignment: 4,
 			},
 		},
 		{
-			in: []uint32{0x000400f2, 1, 2, 3},
+			in: []uint32{0x0004011a, 1, 2, 3},
 			want: &OpIsValidReserveId{
 				ResultType: 1,
 				ResultId:   2,
@@ -88,55 +104,67 @@ func TestPipe(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x000400f3, 1, 2, 3},
+			in: []uint32{0x0006011b, 1, 2, 3, 4, 5},
 			want: &OpGetNumPipePackets{
-				ResultType: 1,
-				ResultId:   2,
-				P:          3,
+				ResultType:      1,
+				ResultId:        2,
+				Pipe:            3,
+				PacketSize:      4,
+				PacketAlignment: 5,
 			},
 		},
 		{
-			in: []uint32{0x000400f4, 1, 2, 3},
+			in: []uint32{0x0006011c, 1, 2, 3, 4, 5},
 			want: &OpGetMaxPipePackets{
-				ResultType: 1,
-				ResultId:   2,
-				P:          3,
+				ResultType:      1,
+				ResultId:        2,
+				Pipe:            3,
+				PacketSize:      4,
+				PacketAlignment: 5,
 			},
 		},
 		{
-			in: []uint32{0x000600f5, 1, 2, 3, 4, 5},
+			in: []uint32{0x0008011d, 1, 2, 3, 4, 5, 6, 7},
 			want: &OpGroupReserveReadPipePackets{
-				ResultType: 1,
-				ResultId:   2,
-				Scope:      3,
-				P:          4,
-				NumPackets: 5,
+				ResultType:      1,
+				ResultId:        2,
+				Execution:       3,
+				Pipe:            4,
+				NumPackets:      5,
+				PacketSize:      6,
+				PacketAlignment: 7,
 			},
 		},
 		{
-			in: []uint32{0x000600f6, 1, 2, 3, 4, 5},
+			in: []uint32{0x0008011e, 1, 2, 3, 4, 5, 6, 7},
 			want: &OpGroupReserveWritePipePackets{
-				ResultType: 1,
-				ResultId:   2,
-				Scope:      3,
-				P:          4,
-				NumPackets: 5,
+				ResultType:      1,
+				ResultId:        2,
+				Execution:       3,
+				Pipe:            4,
+				NumPackets:      5,
+				PacketSize:      6,
+				PacketAlignment: 7,
 			},
 		},
 		{
-			in: []uint32{0x000400f7, 1, 2, 3},
+			in: []uint32{0x0006011f, 1, 2, 3, 4, 5},
 			want: &OpGroupCommitReadPipe{
-				Scope:     1,
-				P:         2,
-				ReserveId: 3,
+				Execution:       1,
+				Pipe:            2,
+				ReserveId:       3,
+				PacketSize:      4,
+				PacketAlignment: 5,
 			},
 		},
 		{
-			in: []uint32{0x000400f8, 1, 2, 3},
+			in: []uint32{0x00060120, 1, 2, 3, 4, 5},
 			want: &OpGroupCommitWritePipe{
-				Scope:     1,
-				P:         2,
-				ReserveId: 3,
+				Execution:       1,
+				Pipe:            2,
+				ReserveId:       3,
+				PacketSize:      4,
+				PacketAlignment: 5,
 			},
 		},
 	} {
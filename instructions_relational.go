@@ -1,6 +1,10 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
+
 package spirv
 
 // OpAny result is true if any component of Vector is true,
@@ -87,12 +91,13 @@ func (c *OpSignBitSet) Opcode() uint32 { return opcodeSignBitSet }
 func (c *OpSignBitSet) Optional() bool { return false }
 func (c *OpSignBitSet) Verify() error  { return nil }
 
-// OpLessOrGreater result is true if x < y or x > y,
-// where IEEE comparisons are used, otherwise result is false.
+// OpLessOrGreater tests if x < y or x > y, where x and y are
+// floating point.
 type OpLessOrGreater struct {
 	ResultType Id
 	ResultId   Id
 	X          Id
+	Y          Id
 }
 
 func (c *OpLessOrGreater) Opcode() uint32 { return opcodeLessOrGreater }
@@ -125,35 +130,45 @@ func (c *OpUnordered) Opcode() uint32 { return opcodeUnordered }
 func (c *OpUnordered) Optional() bool { return false }
 func (c *OpUnordered) Verify() error  { return nil }
 
-// OpLogicalOr result is true if either Operand 1 or Operand 2 is true.
-// Result is false if both Operand 1 and Operand 2 are false.
-type OpLogicalOr struct {
+// OpLogicalEqual results in true if Operand 1 and Operand 2 have the
+// same value.
+type OpLogicalEqual struct {
 	ResultType Id
 	ResultId   Id
 	Operand1   Id
 	Operand2   Id
 }
 
-func (c *OpLogicalOr) Opcode() uint32 { return opcodeLogicalOr }
-func (c *OpLogicalOr) Optional() bool { return false }
-func (c *OpLogicalOr) Verify() error  { return nil }
+func (c *OpLogicalEqual) Opcode() uint32 { return opcodeLogicalEqual }
+func (c *OpLogicalEqual) Optional() bool { return false }
+func (c *OpLogicalEqual) Verify() error  { return nil }
+
+// OpLogicalNotEqual results in true if Operand 1 and Operand 2 have
+// different values.
+type OpLogicalNotEqual struct {
+	ResultType Id
+	ResultId   Id
+	Operand1   Id
+	Operand2   Id
+}
+
+func (c *OpLogicalNotEqual) Opcode() uint32 { return opcodeLogicalNotEqual }
+func (c *OpLogicalNotEqual) Optional() bool { return false }
+func (c *OpLogicalNotEqual) Verify() error  { return nil }
 
-// OpLogicalXor result is true if exactly one of Operand 1 or
-// Operand 2 is true. Result is false if Operand 1 and Operand 2
-// have the same value.
-type OpLogicalXor struct {
+// OpLogicalOr results in true if either Operand 1 or Operand 2 is true.
+type OpLogicalOr struct {
 	ResultType Id
 	ResultId   Id
 	Operand1   Id
 	Operand2   Id
 }
 
-func (c *OpLogicalXor) Opcode() uint32 { return opcodeLogicalXor }
-func (c *OpLogicalXor) Optional() bool { return false }
-func (c *OpLogicalXor) Verify() error  { return nil }
+func (c *OpLogicalOr) Opcode() uint32 { return opcodeLogicalOr }
+func (c *OpLogicalOr) Optional() bool { return false }
+func (c *OpLogicalOr) Verify() error  { return nil }
 
-// OpLogicalAnd result is true if both Operand 1 and Operand 2 are true.
-// Result is false if either Operand 1 or Operand 2 are false
+// OpLogicalAnd results in true if both Operand 1 and Operand 2 are true.
 type OpLogicalAnd struct {
 	ResultType Id
 	ResultId   Id
@@ -165,6 +180,17 @@ func (c *OpLogicalAnd) Opcode() uint32 { return opcodeLogicalAnd }
 func (c *OpLogicalAnd) Optional() bool { return false }
 func (c *OpLogicalAnd) Verify() error  { return nil }
 
+// OpLogicalNot results in true if Operand is false.
+type OpLogicalNot struct {
+	ResultType Id
+	ResultId   Id
+	Operand    Id
+}
+
+func (c *OpLogicalNot) Opcode() uint32 { return opcodeLogicalNot }
+func (c *OpLogicalNot) Optional() bool { return false }
+func (c *OpLogicalNot) Verify() error  { return nil }
+
 // OpSelect select between two objects.
 // Results are computed per component
 type OpSelect struct {
@@ -183,85 +209,85 @@ func (c *OpSelect) Verify() error  { return nil }
 type OpIEqual struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
 func (c *OpIEqual) Opcode() uint32 { return opcodeIEqual }
 func (c *OpIEqual) Optional() bool { return false }
 func (c *OpIEqual) Verify() error  { return nil }
 
-// OpFOrdEqual performs Floating-point comparison for being
-// ordered and equal.
-type OpFOrdEqual struct {
+// OpINotEqual performs Integer comparison for inequality
+type OpINotEqual struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
-func (c *OpFOrdEqual) Opcode() uint32 { return opcodeFOrdEqual }
-func (c *OpFOrdEqual) Optional() bool { return false }
-func (c *OpFOrdEqual) Verify() error  { return nil }
+func (c *OpINotEqual) Opcode() uint32 { return opcodeINotEqual }
+func (c *OpINotEqual) Optional() bool { return false }
+func (c *OpINotEqual) Verify() error  { return nil }
 
-// OpFUnordEqual performs Floating-point comparison for being
-// unordered or equal.
-type OpFUnordEqual struct {
+// OpUGreaterThan performs Unsigned-integer comparison if Operand 1
+// is greater than Operand 2.
+type OpUGreaterThan struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
-func (c *OpFUnordEqual) Opcode() uint32 { return opcodeFUnordEqual }
-func (c *OpFUnordEqual) Optional() bool { return false }
-func (c *OpFUnordEqual) Verify() error  { return nil }
+func (c *OpUGreaterThan) Opcode() uint32 { return opcodeUGreaterThan }
+func (c *OpUGreaterThan) Optional() bool { return false }
+func (c *OpUGreaterThan) Verify() error  { return nil }
 
-// OpINotEqual performs Integer comparison for inequality
-type OpINotEqual struct {
+// OpSGreaterThan performs Signed-integer comparison if Operand 1
+// is greater than Operand 2.
+type OpSGreaterThan struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
-func (c *OpINotEqual) Opcode() uint32 { return opcodeINotEqual }
-func (c *OpINotEqual) Optional() bool { return false }
-func (c *OpINotEqual) Verify() error  { return nil }
+func (c *OpSGreaterThan) Opcode() uint32 { return opcodeSGreaterThan }
+func (c *OpSGreaterThan) Optional() bool { return false }
+func (c *OpSGreaterThan) Verify() error  { return nil }
 
-// OpFOrdNotEqual performs Floating-point comparison for being
-// ordered and not equal.
-type OpFOrdNotEqual struct {
+// OpUGreaterThanEqual performs Unsigned-integer comparison if Operand 1 is
+// greater than or equal to Operand 2.
+type OpUGreaterThanEqual struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
-func (c *OpFOrdNotEqual) Opcode() uint32 { return opcodeFOrdNotEqual }
-func (c *OpFOrdNotEqual) Optional() bool { return false }
-func (c *OpFOrdNotEqual) Verify() error  { return nil }
+func (c *OpUGreaterThanEqual) Opcode() uint32 { return opcodeUGreaterThanEqual }
+func (c *OpUGreaterThanEqual) Optional() bool { return false }
+func (c *OpUGreaterThanEqual) Verify() error  { return nil }
 
-// OpFUnordNotEqual performs Floating-point comparison for
-// being unordered or not equal.
-type OpFUnordNotEqual struct {
+// OpSGreaterThanEqual performs Signed-integer comparison if Operand 1 is
+// greater than or equal to Operand 2.
+type OpSGreaterThanEqual struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
-func (c *OpFUnordNotEqual) Opcode() uint32 { return opcodeFUnordNotEqual }
-func (c *OpFUnordNotEqual) Optional() bool { return false }
-func (c *OpFUnordNotEqual) Verify() error  { return nil }
+func (c *OpSGreaterThanEqual) Opcode() uint32 { return opcodeSGreaterThanEqual }
+func (c *OpSGreaterThanEqual) Optional() bool { return false }
+func (c *OpSGreaterThanEqual) Verify() error  { return nil }
 
 // OpULessThan performs Unsigned-integer comparison if Operand 1
 // is less than Operand 2.
 type OpULessThan struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
 func (c *OpULessThan) Opcode() uint32 { return opcodeULessThan }
@@ -273,21 +299,99 @@ func (c *OpULessThan) Verify() error  { return nil }
 type OpSLessThan struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
 func (c *OpSLessThan) Opcode() uint32 { return opcodeSLessThan }
 func (c *OpSLessThan) Optional() bool { return false }
 func (c *OpSLessThan) Verify() error  { return nil }
 
+// OpULessThanEqual performs Unsigned-integer comparison if Operand 1 is
+// less than or equal to Operand 2.
+type OpULessThanEqual struct {
+	ResultType Id
+	ResultId   Id
+	Operand1   Id
+	Operand2   Id
+}
+
+func (c *OpULessThanEqual) Opcode() uint32 { return opcodeULessThanEqual }
+func (c *OpULessThanEqual) Optional() bool { return false }
+func (c *OpULessThanEqual) Verify() error  { return nil }
+
+// OpSLessThanEqual performs Signed-integer comparison if Operand 1 is
+// less than or equal to Operand 2.
+type OpSLessThanEqual struct {
+	ResultType Id
+	ResultId   Id
+	Operand1   Id
+	Operand2   Id
+}
+
+func (c *OpSLessThanEqual) Opcode() uint32 { return opcodeSLessThanEqual }
+func (c *OpSLessThanEqual) Optional() bool { return false }
+func (c *OpSLessThanEqual) Verify() error  { return nil }
+
+// OpFOrdEqual performs Floating-point comparison for being
+// ordered and equal.
+type OpFOrdEqual struct {
+	ResultType Id
+	ResultId   Id
+	Operand1   Id
+	Operand2   Id
+}
+
+func (c *OpFOrdEqual) Opcode() uint32 { return opcodeFOrdEqual }
+func (c *OpFOrdEqual) Optional() bool { return false }
+func (c *OpFOrdEqual) Verify() error  { return nil }
+
+// OpFUnordEqual performs Floating-point comparison for being
+// unordered or equal.
+type OpFUnordEqual struct {
+	ResultType Id
+	ResultId   Id
+	Operand1   Id
+	Operand2   Id
+}
+
+func (c *OpFUnordEqual) Opcode() uint32 { return opcodeFUnordEqual }
+func (c *OpFUnordEqual) Optional() bool { return false }
+func (c *OpFUnordEqual) Verify() error  { return nil }
+
+// OpFOrdNotEqual performs Floating-point comparison for being
+// ordered and not equal.
+type OpFOrdNotEqual struct {
+	ResultType Id
+	ResultId   Id
+	Operand1   Id
+	Operand2   Id
+}
+
+func (c *OpFOrdNotEqual) Opcode() uint32 { return opcodeFOrdNotEqual }
+func (c *OpFOrdNotEqual) Optional() bool { return false }
+func (c *OpFOrdNotEqual) Verify() error  { return nil }
+
+// OpFUnordNotEqual performs Floating-point comparison for
+// being unordered or not equal.
+type OpFUnordNotEqual struct {
+	ResultType Id
+	ResultId   Id
+	Operand1   Id
+	Operand2   Id
+}
+
+func (c *OpFUnordNotEqual) Opcode() uint32 { return opcodeFUnordNotEqual }
+func (c *OpFUnordNotEqual) Optional() bool { return false }
+func (c *OpFUnordNotEqual) Verify() error  { return nil }
+
 // OpFOrdLessThan performs Floating-point comparison if operands are
 // ordered and Operand 1 is less than Operand 2.
 type OpFOrdLessThan struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
 func (c *OpFOrdLessThan) Opcode() uint32 { return opcodeFOrdLessThan }
@@ -299,47 +403,21 @@ func (c *OpFOrdLessThan) Verify() error  { return nil }
 type OpFUnordLessThan struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
 func (c *OpFUnordLessThan) Opcode() uint32 { return opcodeFUnordLessThan }
 func (c *OpFUnordLessThan) Optional() bool { return false }
 func (c *OpFUnordLessThan) Verify() error  { return nil }
 
-// OpUGreaterThan performs Unsigned-integer comparison if Operand 1
-// is greater than Operand 2.
-type OpUGreaterThan struct {
-	ResultType Id
-	ResultId   Id
-	Object1    Id
-	Object2    Id
-}
-
-func (c *OpUGreaterThan) Opcode() uint32 { return opcodeUGreaterThan }
-func (c *OpUGreaterThan) Optional() bool { return false }
-func (c *OpUGreaterThan) Verify() error  { return nil }
-
-// OpSGreaterThan performs Signed-integer comparison if Operand 1
-// is greater than Operand 2.
-type OpSGreaterThan struct {
-	ResultType Id
-	ResultId   Id
-	Object1    Id
-	Object2    Id
-}
-
-func (c *OpSGreaterThan) Opcode() uint32 { return opcodeSGreaterThan }
-func (c *OpSGreaterThan) Optional() bool { return false }
-func (c *OpSGreaterThan) Verify() error  { return nil }
-
 // OpFOrdGreaterThan performs Floating-point comparison if operands
 // are ordered and Operand 1 is greater than Operand 2.
 type OpFOrdGreaterThan struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
 func (c *OpFOrdGreaterThan) Opcode() uint32 { return opcodeFOrdGreaterThan }
@@ -351,47 +429,21 @@ func (c *OpFOrdGreaterThan) Verify() error  { return nil }
 type OpFUnordGreaterThan struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
 func (c *OpFUnordGreaterThan) Opcode() uint32 { return opcodeFUnordGreaterThan }
 func (c *OpFUnordGreaterThan) Optional() bool { return false }
 func (c *OpFUnordGreaterThan) Verify() error  { return nil }
 
-// OpULessThanEqual performs Unsigned-integer comparison if Operand 1 is
-// less than or equal to Operand 2.
-type OpULessThanEqual struct {
-	ResultType Id
-	ResultId   Id
-	Object1    Id
-	Object2    Id
-}
-
-func (c *OpULessThanEqual) Opcode() uint32 { return opcodeULessThanEqual }
-func (c *OpULessThanEqual) Optional() bool { return false }
-func (c *OpULessThanEqual) Verify() error  { return nil }
-
-// OpSLessThanEqual performs Signed-integer comparison if Operand 1 is
-// less than or equal to Operand 2.
-type OpSLessThanEqual struct {
-	ResultType Id
-	ResultId   Id
-	Object1    Id
-	Object2    Id
-}
-
-func (c *OpSLessThanEqual) Opcode() uint32 { return opcodeSLessThanEqual }
-func (c *OpSLessThanEqual) Optional() bool { return false }
-func (c *OpSLessThanEqual) Verify() error  { return nil }
-
 // OpFOrdLessThanEqual performs Floating-point comparison if operands
 // are ordered and Operand 1 is less than or equal to Operand 2.
 type OpFOrdLessThanEqual struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
 func (c *OpFOrdLessThanEqual) Opcode() uint32 { return opcodeFOrdLessThanEqual }
@@ -403,47 +455,21 @@ func (c *OpFOrdLessThanEqual) Verify() error  { return nil }
 type OpFUnordLessThanEqual struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
 func (c *OpFUnordLessThanEqual) Opcode() uint32 { return opcodeFUnordLessThanEqual }
 func (c *OpFUnordLessThanEqual) Optional() bool { return false }
 func (c *OpFUnordLessThanEqual) Verify() error  { return nil }
 
-// OpUGreaterThanEqual performs Unsigned-integer comparison if Operand 1 is
-// greater than or equal to Operand 2.
-type OpUGreaterThanEqual struct {
-	ResultType Id
-	ResultId   Id
-	Object1    Id
-	Object2    Id
-}
-
-func (c *OpUGreaterThanEqual) Opcode() uint32 { return opcodeUGreaterThanEqual }
-func (c *OpUGreaterThanEqual) Optional() bool { return false }
-func (c *OpUGreaterThanEqual) Verify() error  { return nil }
-
-// OpSGreaterThanEqual performs Signed-integer comparison if Operand 1 is
-// greater than or equal to Operand 2.
-type OpSGreaterThanEqual struct {
-	ResultType Id
-	ResultId   Id
-	Object1    Id
-	Object2    Id
-}
-
-func (c *OpSGreaterThanEqual) Opcode() uint32 { return opcodeSGreaterThanEqual }
-func (c *OpSGreaterThanEqual) Optional() bool { return false }
-func (c *OpSGreaterThanEqual) Verify() error  { return nil }
-
 // OpFOrdGreaterThanEqual performs Floating-point comparison if
 // operands are ordered and Operand 1 is greater than or equal to Operand 2.
 type OpFOrdGreaterThanEqual struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
 func (c *OpFOrdGreaterThanEqual) Opcode() uint32 { return opcodeFOrdGreaterThanEqual }
@@ -456,8 +482,8 @@ func (c *OpFOrdGreaterThanEqual) Verify() error  { return nil }
 type OpFUnordGreaterThanEqual struct {
 	ResultType Id
 	ResultId   Id
-	Object1    Id
-	Object2    Id
+	Operand1   Id
+	Operand2   Id
 }
 
 func (c *OpFUnordGreaterThanEqual) Opcode() uint32 { return opcodeFUnordGreaterThanEqual }
@@ -475,30 +501,32 @@ func init() {
 	bind(func() Instruction { return &OpLessOrGreater{} })
 	bind(func() Instruction { return &OpOrdered{} })
 	bind(func() Instruction { return &OpUnordered{} })
+	bind(func() Instruction { return &OpLogicalEqual{} })
+	bind(func() Instruction { return &OpLogicalNotEqual{} })
 	bind(func() Instruction { return &OpLogicalOr{} })
-	bind(func() Instruction { return &OpLogicalXor{} })
 	bind(func() Instruction { return &OpLogicalAnd{} })
+	bind(func() Instruction { return &OpLogicalNot{} })
 	bind(func() Instruction { return &OpSelect{} })
 	bind(func() Instruction { return &OpIEqual{} })
+	bind(func() Instruction { return &OpINotEqual{} })
+	bind(func() Instruction { return &OpUGreaterThan{} })
+	bind(func() Instruction { return &OpSGreaterThan{} })
+	bind(func() Instruction { return &OpUGreaterThanEqual{} })
+	bind(func() Instruction { return &OpSGreaterThanEqual{} })
+	bind(func() Instruction { return &OpULessThan{} })
+	bind(func() Instruction { return &OpSLessThan{} })
+	bind(func() Instruction { return &OpULessThanEqual{} })
+	bind(func() Instruction { return &OpSLessThanEqual{} })
 	bind(func() Instruction { return &OpFOrdEqual{} })
 	bind(func() Instruction { return &OpFUnordEqual{} })
-	bind(func() Instruction { return &OpINotEqual{} })
 	bind(func() Instruction { return &OpFOrdNotEqual{} })
 	bind(func() Instruction { return &OpFUnordNotEqual{} })
-	bind(func() Instruction { return &OpULessThan{} })
-	bind(func() Instruction { return &OpSLessThan{} })
 	bind(func() Instruction { return &OpFOrdLessThan{} })
 	bind(func() Instruction { return &OpFUnordLessThan{} })
-	bind(func() Instruction { return &OpUGreaterThan{} })
-	bind(func() Instruction { return &OpSGreaterThan{} })
 	bind(func() Instruction { return &OpFOrdGreaterThan{} })
 	bind(func() Instruction { return &OpFUnordGreaterThan{} })
-	bind(func() Instruction { return &OpULessThanEqual{} })
-	bind(func() Instruction { return &OpSLessThanEqual{} })
 	bind(func() Instruction { return &OpFOrdLessThanEqual{} })
 	bind(func() Instruction { return &OpFUnordLessThanEqual{} })
-	bind(func() Instruction { return &OpUGreaterThanEqual{} })
-	bind(func() Instruction { return &OpSGreaterThanEqual{} })
 	bind(func() Instruction { return &OpFOrdGreaterThanEqual{} })
 	bind(func() Instruction { return &OpFUnordGreaterThanEqual{} })
 }
@@ -8,7 +8,7 @@ import "testing"
 func TestRelational(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x0040062, 1, 2, 3},
+			in: []uint32{0x0004009a, 1, 2, 3},
 			want: &OpAny{
 				ResultType: 1,
 				ResultId:   2,
@@ -16,7 +16,7 @@ func TestRelational(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0040063, 1, 2, 3},
+			in: []uint32{0x0004009b, 1, 2, 3},
 			want: &OpAll{
 				ResultType: 1,
 				ResultId:   2,
@@ -24,7 +24,7 @@ func TestRelational(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0040071, 1, 2, 3},
+			in: []uint32{0x0004009c, 1, 2, 3},
 			want: &OpIsNan{
 				ResultType: 1,
 				ResultId:   2,
@@ -32,7 +32,7 @@ func TestRelational(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0040072, 1, 2, 3},
+			in: []uint32{0x0004009d, 1, 2, 3},
 			want: &OpIsInf{
 				ResultType: 1,
 				ResultId:   2,
@@ -40,7 +40,7 @@ func TestRelational(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0040073, 1, 2, 3},
+			in: []uint32{0x0004009e, 1, 2, 3},
 			want: &OpIsFinite{
 				ResultType: 1,
 				ResultId:   2,
@@ -48,7 +48,7 @@ func TestRelational(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0040074, 1, 2, 3},
+			in: []uint32{0x0004009f, 1, 2, 3},
 			want: &OpIsNormal{
 				ResultType: 1,
 				ResultId:   2,
@@ -56,7 +56,7 @@ func TestRelational(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0040075, 1, 2, 3},
+			in: []uint32{0x000400a0, 1, 2, 3},
 			want: &OpSignBitSet{
 				ResultType: 1,
 				ResultId:   2,
@@ -64,15 +64,16 @@ func TestRelational(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0040076, 1, 2, 3},
+			in: []uint32{0x000500a1, 1, 2, 3, 4},
 			want: &OpLessOrGreater{
 				ResultType: 1,
 				ResultId:   2,
 				X:          3,
+				Y:          4,
 			},
 		},
 		{
-			in: []uint32{0x0050077, 1, 2, 3, 4},
+			in: []uint32{0x000500a2, 1, 2, 3, 4},
 			want: &OpOrdered{
 				ResultType: 1,
 				ResultId:   2,
@@ -81,7 +82,7 @@ func TestRelational(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0050078, 1, 2, 3, 4},
+			in: []uint32{0x000500a3, 1, 2, 3, 4},
 			want: &OpUnordered{
 				ResultType: 1,
 				ResultId:   2,
@@ -90,8 +91,17 @@ func TestRelational(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0050092, 1, 2, 3, 4},
-			want: &OpLogicalOr{
+			in: []uint32{0x000500a4, 1, 2, 3, 4},
+			want: &OpLogicalEqual{
+				ResultType: 1,
+				ResultId:   2,
+				Operand1:   3,
+				Operand2:   4,
+			},
+		},
+		{
+			in: []uint32{0x000500a5, 1, 2, 3, 4},
+			want: &OpLogicalNotEqual{
 				ResultType: 1,
 				ResultId:   2,
 				Operand1:   3,
@@ -99,8 +109,8 @@ func TestRelational(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0050093, 1, 2, 3, 4},
-			want: &OpLogicalXor{
+			in: []uint32{0x000500a6, 1, 2, 3, 4},
+			want: &OpLogicalOr{
 				ResultType: 1,
 				ResultId:   2,
 				Operand1:   3,
@@ -108,7 +118,7 @@ func TestRelational(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0050094, 1, 2, 3, 4},
+			in: []uint32{0x000500a7, 1, 2, 3, 4},
 			want: &OpLogicalAnd{
 				ResultType: 1,
 				ResultId:   2,
@@ -117,7 +127,15 @@ func TestRelational(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0060098, 1, 2, 3, 4, 5},
+			in: []uint32{0x000400a8, 1, 2, 3},
+			want: &OpLogicalNot{
+				ResultType: 1,
+				ResultId:   2,
+				Operand:    3,
+			},
+		},
+		{
+			in: []uint32{0x000600a9, 1, 2, 3, 4, 5},
 			want: &OpSelect{
 				ResultType: 1,
 				ResultId:   2,
@@ -127,201 +145,201 @@ func TestRelational(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0050099, 1, 2, 3, 4},
+			in: []uint32{0x000500aa, 1, 2, 3, 4},
 			want: &OpIEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x005009a, 1, 2, 3, 4},
-			want: &OpFOrdEqual{
+			in: []uint32{0x000500ab, 1, 2, 3, 4},
+			want: &OpINotEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x005009b, 1, 2, 3, 4},
-			want: &OpFUnordEqual{
+			in: []uint32{0x000500ac, 1, 2, 3, 4},
+			want: &OpUGreaterThan{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x005009c, 1, 2, 3, 4},
-			want: &OpINotEqual{
+			in: []uint32{0x000500ad, 1, 2, 3, 4},
+			want: &OpSGreaterThan{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x005009d, 1, 2, 3, 4},
-			want: &OpFOrdNotEqual{
+			in: []uint32{0x000500ae, 1, 2, 3, 4},
+			want: &OpUGreaterThanEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x005009e, 1, 2, 3, 4},
-			want: &OpFUnordNotEqual{
+			in: []uint32{0x000500af, 1, 2, 3, 4},
+			want: &OpSGreaterThanEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x005009f, 1, 2, 3, 4},
+			in: []uint32{0x000500b0, 1, 2, 3, 4},
 			want: &OpULessThan{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500a0, 1, 2, 3, 4},
+			in: []uint32{0x000500b1, 1, 2, 3, 4},
 			want: &OpSLessThan{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500a1, 1, 2, 3, 4},
-			want: &OpFOrdLessThan{
+			in: []uint32{0x000500b2, 1, 2, 3, 4},
+			want: &OpULessThanEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500a2, 1, 2, 3, 4},
-			want: &OpFUnordLessThan{
+			in: []uint32{0x000500b3, 1, 2, 3, 4},
+			want: &OpSLessThanEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500a3, 1, 2, 3, 4},
-			want: &OpUGreaterThan{
+			in: []uint32{0x000500b4, 1, 2, 3, 4},
+			want: &OpFOrdEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500a4, 1, 2, 3, 4},
-			want: &OpSGreaterThan{
+			in: []uint32{0x000500b5, 1, 2, 3, 4},
+			want: &OpFUnordEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500a5, 1, 2, 3, 4},
-			want: &OpFOrdGreaterThan{
+			in: []uint32{0x000500b6, 1, 2, 3, 4},
+			want: &OpFOrdNotEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500a6, 1, 2, 3, 4},
-			want: &OpFUnordGreaterThan{
+			in: []uint32{0x000500b7, 1, 2, 3, 4},
+			want: &OpFUnordNotEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500a7, 1, 2, 3, 4},
-			want: &OpULessThanEqual{
+			in: []uint32{0x000500b8, 1, 2, 3, 4},
+			want: &OpFOrdLessThan{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500a8, 1, 2, 3, 4},
-			want: &OpSLessThanEqual{
+			in: []uint32{0x000500b9, 1, 2, 3, 4},
+			want: &OpFUnordLessThan{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500a9, 1, 2, 3, 4},
-			want: &OpFOrdLessThanEqual{
+			in: []uint32{0x000500ba, 1, 2, 3, 4},
+			want: &OpFOrdGreaterThan{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500aa, 1, 2, 3, 4},
-			want: &OpFUnordLessThanEqual{
+			in: []uint32{0x000500bb, 1, 2, 3, 4},
+			want: &OpFUnordGreaterThan{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500ab, 1, 2, 3, 4},
-			want: &OpUGreaterThanEqual{
+			in: []uint32{0x000500bc, 1, 2, 3, 4},
+			want: &OpFOrdLessThanEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500ac, 1, 2, 3, 4},
-			want: &OpSGreaterThanEqual{
+			in: []uint32{0x000500bd, 1, 2, 3, 4},
+			want: &OpFUnordLessThanEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500ad, 1, 2, 3, 4},
+			in: []uint32{0x000500be, 1, 2, 3, 4},
 			want: &OpFOrdGreaterThanEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 		{
-			in: []uint32{0x00500ae, 1, 2, 3, 4},
+			in: []uint32{0x000500bf, 1, 2, 3, 4},
 			want: &OpFUnordGreaterThanEqual{
 				ResultType: 1,
 				ResultId:   2,
-				Object1:    3,
-				Object2:    4,
+				Operand1:   3,
+				Operand2:   4,
 			},
 		},
 	} {
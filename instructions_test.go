@@ -49,6 +49,8 @@ func testInstruction(t *testing.T, st InstructionTest) {
 			st.in, have, have, st.want, st.want)
 	}
 
+	testReflectCodec(t, st.in, have)
+
 	// Encode the instruction again and compare the output to the
 	// original input. We can only encode to bytes, so we need to
 	// jump through some hoops to compare to the []uint32 input slice.
@@ -96,6 +98,38 @@ func TestFaultyInstructions(t *testing.T) {
 	}
 }
 
+// testReflectCodec ensures the reflection based encoder and decoder
+// yield the same results as the instruction's WordCodec implementation.
+func testReflectCodec(t *testing.T, in []uint32, want Instruction) {
+	have, _ := DefaultDialect.New(want.Opcode())
+
+	err := decodeReflect(have, in[1:])
+	if err != nil {
+		t.Fatalf("reflect decode error: %v\n%v", in, err)
+	}
+
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("reflect decode mismatch: %v\nHave: %T(%+v)\nWant: %T(%+v)",
+			in, have, have, want, want)
+	}
+
+	words, err := encodeReflect(nil, want)
+	if err != nil {
+		t.Fatalf("reflect encode error: %T(%v)\n%v", want, want, err)
+	}
+
+	if len(words) != len(in)-1 || (len(words) > 0 && !reflect.DeepEqual(words, in[1:])) {
+		t.Fatalf("reflect encode mismatch: %T(%v)\nHave: %v\nWant: %v",
+			want, want, words, in[1:])
+	}
+
+	size := EncodedLen(want)
+	if size != len(in) {
+		t.Fatalf("encoded length mismatch: %T(%v)\nHave: %d\nWant: %d",
+			want, want, size, len(in))
+	}
+}
+
 // testWordReader returns a type which reads the given set of words
 // as a sequence of bytes.
 func testWordReader(data []uint32) *bytes.Buffer {
@@ -1,12 +1,11 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
-package spirv
+// This file is generated by spirv-gen from the SPIR-V grammar. Apart from
+// documentation comments, which are kept when the file is regenerated,
+// it should not be edited by hand.
 
-import (
-	"errors"
-	"fmt"
-)
+package spirv
 
 // OpTypeVoid represents the OpTypeVoid instruction.
 type OpTypeVoid struct {
@@ -48,15 +47,7 @@ type OpTypeInt struct {
 
 func (c *OpTypeInt) Opcode() uint32 { return opcodeTypeInt }
 func (c *OpTypeInt) Optional() bool { return false }
-func (c *OpTypeInt) Verify() error {
-	switch c.Signedness {
-	case 0, 1:
-	default:
-		return fmt.Errorf("OpTypeInt: Signedness must 0 or 1")
-	}
-
-	return nil
-}
+func (c *OpTypeInt) Verify() error  { return c.verify() }
 
 // OpTypeFloat represents the OpTypeFloat instruction.
 // It declares a new floating point type.
@@ -107,30 +98,28 @@ func (c *OpTypeMatrix) Opcode() uint32 { return opcodeTypeMatrix }
 func (c *OpTypeMatrix) Optional() bool { return false }
 func (c *OpTypeMatrix) Verify() error  { return nil }
 
-// OpTypeSampler declares a new sampler type.
+// OpTypeImage declares a new image type.
 //
-// It is consumed, for example, by OpTextureSample.
-// This type is opaque: values of this type have no defined physical
-// size or bit pattern..
-type OpTypeSampler struct {
-	// The <id> of the new sampler type.
+// It is consumed, for example, by OpTypeSampledImage. This type is opaque:
+// values of this type have no defined physical size or bit pattern.
+type OpTypeImage struct {
+	// The <id> of the new image type.
 	ResultId Id
 
-	// A scalar type, of the type of the components resulting from
-	// sampling or loading through this sampler
+	// SampledType is the type of the components that result from sampling
+	// or reading from this image type.
 	SampledType Id
 
-	// The texture dimensionality.
-	Dimensionality uint32
+	// Dim is the image dimensionality.
+	Dim Dim
 
-	// Content must be one of the following indicated values:
+	// Depth is whether or not this image is a depth image:
 	//
-	//   0: indicates a texture, no filter (no sampling state)
-	//   1: indicates an image
-	//   2: indicates both a texture and filter (sampling state),
-	//      see OpTypeFilter
+	//   0: indicates not a depth image
+	//   1: indicates a depth image
+	//   2: means no indication as to whether this is a depth or non-depth image
 	//
-	Content uint32
+	Depth uint32
 
 	// Arrayed must be one of the following indicated values:
 	//
@@ -139,13 +128,6 @@ type OpTypeSampler struct {
 	//
 	Arrayed uint32
 
-	// Compare must be one of the following indicated values:
-	//
-	//   0: indicates depth comparisons are not done
-	//   1: indicates depth comparison are done
-	//
-	Compare uint32
-
 	// MS is multisampled and must be one of the following indicated values:
 	//
 	//   0: indicates single-sampled content
@@ -153,50 +135,50 @@ type OpTypeSampler struct {
 	//
 	MS uint32
 
+	// Sampled indicates whether or not this image will be accessed in
+	// combination with a sampler, and must be one of the following values:
+	//
+	//   0: indicates this is only known at run time, not at compile time
+	//   1: indicates will be used with sampler
+	//   2: indicates will be used without a sampler (a storage image)
+	//
+	Sampled uint32
+
+	// ImageFormat is the format of the image.
+	ImageFormat ImageFormat
+
 	// AccessQualifier is an image access qualifier and is optional.
-	AccessQualifier Id `spirv:"optional"`
+	AccessQualifier AccessQualifier `spirv:"optional"`
+}
+
+func (c *OpTypeImage) Opcode() uint32 { return opcodeTypeImage }
+func (c *OpTypeImage) Optional() bool { return false }
+func (c *OpTypeImage) Verify() error  { return c.verify() }
+
+// OpTypeSampler declares the sampler type. It is consumed by
+// OpSampledImage. This type is opaque: values of this type have no defined
+// physical size or bit pattern.
+type OpTypeSampler struct {
+	// The <id> of the new sampler type.
+	ResultId Id
 }
 
 func (c *OpTypeSampler) Opcode() uint32 { return opcodeTypeSampler }
 func (c *OpTypeSampler) Optional() bool { return false }
-func (c *OpTypeSampler) Verify() error {
-	switch c.Content {
-	case 0, 1, 2:
-	default:
-		return errors.New("OpTypeSampler: Content must be 0, 1 or 2")
-	}
-
-	switch c.Arrayed {
-	case 0, 1:
-	default:
-		return errors.New("OpTypeSampler: Arrayed must be 0 or 1")
-	}
-
-	switch c.Compare {
-	case 0, 1:
-	default:
-		return errors.New("OpTypeSampler: Compare must be 0 or 1")
-	}
-
-	switch c.MS {
-	case 0, 1:
-	default:
-		return errors.New("OpTypeSampler: MS must be 0 or 1")
-	}
-
-	return nil
-}
+func (c *OpTypeSampler) Verify() error  { return nil }
 
-// OpTypeFilter declares a filter type. It is consumed by OpSampler.
-// This type is opaque: values of this type have no defined
-// physical size or bit pattern.
-type OpTypeFilter struct {
+// OpTypeSampledImage declares a sampled image type: the result of
+// OpSampledImage, or an externally combined sampler and image.
+type OpTypeSampledImage struct {
 	ResultId Id
+
+	// ImageType must be an OpTypeImage.
+	ImageType Id
 }
 
-func (c *OpTypeFilter) Opcode() uint32 { return opcodeTypeFilter }
-func (c *OpTypeFilter) Optional() bool { return false }
-func (c *OpTypeFilter) Verify() error  { return nil }
+func (c *OpTypeSampledImage) Opcode() uint32 { return opcodeTypeSampledImage }
+func (c *OpTypeSampledImage) Optional() bool { return false }
+func (c *OpTypeSampledImage) Verify() error  { return nil }
 
 // OpTypeArray declares a new array type: a dynamically-indexable ordered
 // aggregate of elements all having the same type.
@@ -348,17 +330,28 @@ type OpTypePipe struct {
 	// The <id> of the new pipe type.
 	ResultId Id
 
-	// Type is the data type of the pipe.
-	Type Id
-
 	// Qualifier is the pipe access qualifier.
-	AccessQualifier AccessQualifier
+	Qualifier AccessQualifier
 }
 
 func (c *OpTypePipe) Opcode() uint32 { return opcodeTypePipe }
 func (c *OpTypePipe) Optional() bool { return false }
 func (c *OpTypePipe) Verify() error  { return nil }
 
+// OpTypeForwardPointer declares the storage class for a forward
+// reference to a pointer type.
+type OpTypeForwardPointer struct {
+	// PointerType is a forward reference to the result of an OpTypePointer.
+	PointerType Id
+
+	// The storage class of the type pointed to.
+	StorageClass StorageClass
+}
+
+func (c *OpTypeForwardPointer) Opcode() uint32 { return opcodeTypeForwardPointer }
+func (c *OpTypeForwardPointer) Optional() bool { return false }
+func (c *OpTypeForwardPointer) Verify() error  { return nil }
+
 func init() {
 	bind(func() Instruction { return &OpTypeVoid{} })
 	bind(func() Instruction { return &OpTypeBool{} })
@@ -366,8 +359,9 @@ func init() {
 	bind(func() Instruction { return &OpTypeFloat{} })
 	bind(func() Instruction { return &OpTypeVector{} })
 	bind(func() Instruction { return &OpTypeMatrix{} })
+	bind(func() Instruction { return &OpTypeImage{} })
 	bind(func() Instruction { return &OpTypeSampler{} })
-	bind(func() Instruction { return &OpTypeFilter{} })
+	bind(func() Instruction { return &OpTypeSampledImage{} })
 	bind(func() Instruction { return &OpTypeArray{} })
 	bind(func() Instruction { return &OpTypeRuntimeArray{} })
 	bind(func() Instruction { return &OpTypeStruct{} })
@@ -379,4 +373,5 @@ func init() {
 	bind(func() Instruction { return &OpTypeReserveId{} })
 	bind(func() Instruction { return &OpTypeQueue{} })
 	bind(func() Instruction { return &OpTypePipe{} })
+	bind(func() Instruction { return &OpTypeForwardPointer{} })
 }
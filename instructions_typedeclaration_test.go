@@ -5,111 +5,121 @@ package spirv
 
 import (
 	"errors"
-	"math"
+	"fmt"
 	"testing"
 )
 
 func TestTypeDeclaration(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x00020008, 1},
+			in: []uint32{0x00020013, 1},
 			want: &OpTypeVoid{
 				ResultId: 1,
 			},
 		},
 		{
-			in: []uint32{0x00020009, 1},
+			in: []uint32{0x00020014, 1},
 			want: &OpTypeBool{
 				ResultId: 1,
 			},
 		},
 		{
-			in:  []uint32{0x0004000a, 0x32, 64, 0xff},
-			err: errors.New("OpTypeInt: Signedness must 0 or 1"),
-		},
-		{
-			in: []uint32{0x0004000a, 0x32, 64, 1},
+			in: []uint32{0x00040015, 1, 32, 1},
 			want: &OpTypeInt{
-				ResultId:   0x32,
-				Width:      64,
+				ResultId:   1,
+				Width:      32,
 				Signedness: 1,
 			},
 		},
 		{
-			in: []uint32{
-				0x0003000b, 0x32,
-				math.Float32bits(123),
-			},
+			in:  []uint32{0x00040015, 1, 32, 2},
+			err: fmt.Errorf("OpTypeInt: Signedness must 0 or 1"),
+		},
+		{
+			in: []uint32{0x00030016, 1, 32},
 			want: &OpTypeFloat{
-				ResultId: 0x32,
-				Width:    math.Float32bits(123),
+				ResultId: 1,
+				Width:    32,
 			},
 		},
 		{
-			in: []uint32{0x0004000c, 0x32, 0x12, 0x03},
+			in: []uint32{0x00040017, 1, 2, 3},
 			want: &OpTypeVector{
-				ResultId:       0x32,
-				ComponentType:  0x12,
-				ComponentCount: 0x03,
+				ResultId:       1,
+				ComponentType:  2,
+				ComponentCount: 3,
 			},
 		},
 		{
-			in: []uint32{0x0004000d, 0x32, 0x12, 0x04},
+			in: []uint32{0x00040018, 1, 2, 3},
 			want: &OpTypeMatrix{
-				ResultId:    0x32,
-				ColumnType:  0x12,
-				ColumnCount: 0x04,
+				ResultId:    1,
+				ColumnType:  2,
+				ColumnCount: 3,
 			},
 		},
 		{
-			in:  []uint32{0x008000e, 1, 2, Dim3D, 4, 1, 0, 1},
-			err: errors.New("OpTypeSampler: Content must be 0, 1 or 2"),
-		},
-		{
-			in:  []uint32{0x008000e, 1, 2, Dim3D, 2, 4, 0, 1},
-			err: errors.New("OpTypeSampler: Arrayed must be 0 or 1"),
+			in: []uint32{0x00090019,
+				1, 2, Dim2D, 0, 0, 0, 1, ImageFormatRgba32f},
+			want: &OpTypeImage{
+				ResultId:    1,
+				SampledType: 2,
+				Dim:         Dim2D,
+				Depth:       0,
+				Arrayed:     0,
+				MS:          0,
+				Sampled:     1,
+				ImageFormat: ImageFormatRgba32f,
+			},
 		},
 		{
-			in:  []uint32{0x008000e, 1, 2, Dim3D, 2, 1, 4, 1},
-			err: errors.New("OpTypeSampler: Compare must be 0 or 1"),
+			in: []uint32{
+				0x000a0019,
+				1,
+				2,
+				Dim2D,
+				0,
+				0,
+				0,
+				1,
+				ImageFormatRgba32f,
+				AccessQualifierReadWrite,
+			},
+			want: &OpTypeImage{
+				ResultId:        1,
+				SampledType:     2,
+				Dim:             Dim2D,
+				Depth:           0,
+				Arrayed:         0,
+				MS:              0,
+				Sampled:         1,
+				ImageFormat:     ImageFormatRgba32f,
+				AccessQualifier: AccessQualifierReadWrite,
+			},
 		},
 		{
-			in:  []uint32{0x008000e, 1, 2, Dim3D, 2, 1, 1, 4},
-			err: errors.New("OpTypeSampler: MS must be 0 or 1"),
+			in:  []uint32{0x00090019, 1, 2, Dim2D, 3, 0, 0, 1, ImageFormatUnknown},
+			err: errors.New("OpTypeImage: Depth must be 0, 1 or 2"),
 		},
 		{
-			in: []uint32{0x008000e, 1, 2, Dim3D, 2, 1, 0, 1},
-			want: &OpTypeSampler{
-				ResultId:       1,
-				SampledType:    2,
-				Dimensionality: Dim3D,
-				Content:        2,
-				Arrayed:        1,
-				Compare:        0,
-				MS:             1,
-			},
+			in:  []uint32{0x00090019, 1, 2, Dim2D, 0, 0, 0, 3, ImageFormatUnknown},
+			err: errors.New("OpTypeImage: Sampled must be 0, 1 or 2"),
 		},
 		{
-			in: []uint32{0x009000e, 1, 2, Dim3D, 2, 1, 0, 1, AccessQualifierWriteOnly},
+			in: []uint32{0x0002001a, 1},
 			want: &OpTypeSampler{
-				ResultId:        1,
-				SampledType:     2,
-				Dimensionality:  Dim3D,
-				Content:         2,
-				Arrayed:         1,
-				Compare:         0,
-				MS:              1,
-				AccessQualifier: AccessQualifierWriteOnly,
+				ResultId: 1,
 			},
 		},
 		{
-			in: []uint32{0x002000f, 1},
-			want: &OpTypeFilter{
-				ResultId: 1,
+			in: []uint32{0x0003001b, 1, 2},
+			want: &OpTypeSampledImage{
+				ResultId:  1,
+				ImageType: 2,
 			},
 		},
 		{
-			in: []uint32{0x0040010, 1, 2, 3},
+			in: []uint32{0x0004001c, 1, 2, 3},
 			want: &OpTypeArray{
 				ResultId:    1,
 				ElementType: 2,
@@ -117,79 +127,91 @@ func TestTypeDeclaration(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x0030011, 1, 2},
+			in: []uint32{0x0003001d, 1, 2},
 			want: &OpTypeRuntimeArray{
 				ResultId:    1,
 				ElementType: 2,
 			},
 		},
 		{
-			in: []uint32{0x0060012, 1, 2, 3, 4, 5},
+			in: []uint32{0x0002001e, 1},
+			want: &OpTypeStruct{
+				ResultId: 1,
+			},
+		},
+		{
+			in: []uint32{0x0004001e, 1, 2, 3},
 			want: &OpTypeStruct{
 				ResultId: 1,
-				Members:  []Id{2, 3, 4, 5},
+				Members:  []Id{2, 3},
 			},
 		},
 		{
-			in: []uint32{0x0050013, 1, 0x74736574, 0x72747320, 0x676e69},
+			in: []uint32{0x0005001f, 1, 0x74736574, 0x6d616e5f, 0x00000065},
 			want: &OpTypeOpaque{
 				ResultId: 1,
-				Name:     "test string",
+				Name:     "test_name",
 			},
 		},
 		{
-			in: []uint32{0x0040014, 1, StorageClassAtomicCounter, 2},
+			in: []uint32{0x00040020, 1, StorageClassPrivate, 2},
 			want: &OpTypePointer{
 				ResultId:     1,
-				StorageClass: StorageClassAtomicCounter,
+				StorageClass: StorageClassPrivate,
 				Type:         2,
 			},
 		},
 		{
-			in: []uint32{0x0030015, 1, 2},
+			in: []uint32{0x00030021, 1, 2},
 			want: &OpTypeFunction{
 				ResultId:   1,
 				ReturnType: 2,
 			},
 		},
 		{
-			in: []uint32{0x0060015, 1, 2, 3, 4, 5},
+			in: []uint32{0x00050021, 1, 2, 3, 4},
 			want: &OpTypeFunction{
 				ResultId:   1,
 				ReturnType: 2,
-				Parameters: []Id{3, 4, 5},
+				Parameters: []Id{3, 4},
 			},
 		},
 		{
-			in: []uint32{0x0020016, 123},
+			in: []uint32{0x00020022, 1},
 			want: &OpTypeEvent{
-				ResultId: 123,
+				ResultId: 1,
 			},
 		},
 		{
-			in: []uint32{0x0020017, 123},
+			in: []uint32{0x00020023, 1},
 			want: &OpTypeDeviceEvent{
-				ResultId: 123,
+				ResultId: 1,
 			},
 		},
 		{
-			in: []uint32{0x0020018, 123},
+			in: []uint32{0x00020024, 1},
 			want: &OpTypeReserveId{
-				ResultId: 123,
+				ResultId: 1,
 			},
 		},
 		{
-			in: []uint32{0x0020019, 123},
+			in: []uint32{0x00020025, 1},
 			want: &OpTypeQueue{
-				ResultId: 123,
+				ResultId: 1,
 			},
 		},
 		{
-			in: []uint32{0x004001a, 1, 2, AccessQualifierReadWrite},
+			in: []uint32{0x00030026, 1, AccessQualifierReadWrite},
 			want: &OpTypePipe{
-				ResultId:        1,
-				Type:            2,
-				AccessQualifier: AccessQualifierReadWrite,
+				ResultId:  1,
+				Qualifier: AccessQualifierReadWrite,
+			},
+		},
+		{
+			in: []uint32{0x00030027, 1, StorageClassPrivate},
+			want: &OpTypeForwardPointer{
+				PointerType:  1,
+				StorageClass: StorageClassPrivate,
 			},
 		},
 	} {
@@ -0,0 +1,217 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"errors"
+	"fmt"
+)
+
+// This file holds the validation rules for instructions which need more
+// than a plain operand layout. The generated Verify method of an
+// instruction calls its verify method if one is defined here.
+//
+// TODO: Context-aware validation. Most flow control instructions require
+// context (i.e., module) to properly validate.
+
+func (c *OpNop) verify() error { return ErrUnacceptable }
+
+func (c *OpDecorate) verify() error {
+	return verifyDecoration("OpDecorate", c.Decoration, c.Argv)
+}
+
+func (c *OpMemberDecorate) verify() error {
+	return verifyDecoration("OpMemberDecorate", c.Decoration, c.Argv)
+}
+
+func (c *OpGroupMemberDecorate) verify() error {
+	if len(c.Targets)%2 != 0 {
+		return fmt.Errorf("OpGroupMemberDecorate: Targets expects array of (Target, Member) pairs")
+	}
+
+	return nil
+}
+
+// verifyDecoration checks the number of arguments for the given decoration.
+func verifyDecoration(name string, dec Decoration, argv []uint32) error {
+	argc := len(argv)
+
+	switch dec {
+	case DecorationSpecId, DecorationArrayStride, DecorationMatrixStride,
+		DecorationBuiltIn, DecorationStream, DecorationLocation,
+		DecorationComponent, DecorationIndex, DecorationBinding,
+		DecorationDescriptorSet, DecorationOffset, DecorationXfbBuffer,
+		DecorationXfbStride, DecorationFuncParamAttr, DecorationFPRoundingMode,
+		DecorationFPFastMathMode, DecorationInputAttachmentIndex,
+		DecorationAlignment:
+		if argc != 1 {
+			return fmt.Errorf("%s: Decoration(%d) must have 1 argument", name, dec)
+		}
+
+		return nil
+
+	case DecorationLinkageAttributes:
+		// A literal name string, followed by the linkage type.
+		if argc < 2 {
+			return fmt.Errorf("%s: Decoration(%d) must have 2 arguments", name, dec)
+		}
+
+		err := LinkageType(argv[argc-1]).Verify()
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+
+		return nil
+	}
+
+	if argc > 0 {
+		return fmt.Errorf("%s: extraneous arguments for Decoration(%d)", name, dec)
+	}
+
+	return nil
+}
+
+func (c *OpConstantSampler) verify() error {
+	switch c.Param {
+	case 0, 1:
+	default:
+		return fmt.Errorf("OpConstantSampler: Param must be 0 or 1")
+	}
+
+	return nil
+}
+
+func (c *OpPhi) verify() error {
+	if len(c.Operands) == 0 {
+		return fmt.Errorf("OpPhi: expected operands")
+	}
+
+	if len(c.Operands)%2 != 0 {
+		return fmt.Errorf("OpPhi: expected array of (Variable, ParentBlock) operand pairs")
+	}
+
+	return nil
+}
+
+func (c *OpBranchConditional) verify() error {
+	if len(c.BranchWeights) != 0 && len(c.BranchWeights) != 2 {
+		return fmt.Errorf("OpBranchConditional: BranchWeights expects 0 or 2 elements")
+	}
+	return nil
+}
+
+func (c *OpSwitch) verify() error {
+	if len(c.Target)%2 != 0 {
+		return fmt.Errorf("OpSwitch: Target expects array of (LiteralNumber, Label) pairs")
+	}
+
+	for j := 0; j < len(c.Target); j += 2 {
+		for k := j + 2; k < len(c.Target); k += 2 {
+			if c.Target[j] == c.Target[k] {
+				return fmt.Errorf("OpSwitch: Target literals must be unique")
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *OpLoad) verify() error {
+	return verifyMemoryAccess("OpLoad", c.MemoryAccess, c.Argv)
+}
+
+func (c *OpStore) verify() error {
+	return verifyMemoryAccess("OpStore", c.MemoryAccess, c.Argv)
+}
+
+func (c *OpCopyMemory) verify() error {
+	return verifyMemoryAccess("OpCopyMemory", c.MemoryAccess, c.Argv)
+}
+
+func (c *OpCopyMemorySized) verify() error {
+	return verifyMemoryAccess("OpCopyMemorySized", c.MemoryAccess, c.Argv)
+}
+
+// verifyMemoryAccess checks the number of arguments for the given
+// memory access flags.
+func verifyMemoryAccess(name string, ma MemoryAccess, argv []uint32) error {
+	argc := 0
+	if ma&MemoryAccessAligned != 0 {
+		argc = 1
+	}
+
+	if len(argv) != argc {
+		return fmt.Errorf("%s: MemoryAccess(%d) must have %d argument(s)", name, ma, argc)
+	}
+
+	return nil
+}
+
+func (c *OpExecutionMode) verify() error {
+	argc := len(c.Argv)
+
+	switch c.Mode {
+	case ExecutionModeInvocations,
+		ExecutionModeOutputVertices,
+		ExecutionModeVecTypeHint:
+		if argc != 1 {
+			return fmt.Errorf("OpExecutionMode: ExecutionMode(%d) must have 1 argument", c.Mode)
+		}
+
+		return nil
+
+	case ExecutionModeLocalSize,
+		ExecutionModeLocalSizeHint:
+		if argc != 3 {
+			return fmt.Errorf("OpExecutionMode: ExecutionMode(%d) must have 3 arguments", c.Mode)
+		}
+
+		return nil
+	}
+
+	if argc > 0 {
+		return fmt.Errorf("OpExecutionMode: extraneous arguments for ExecutionMode(%d)",
+			c.Mode)
+	}
+
+	return nil
+}
+
+func (c *OpTypeInt) verify() error {
+	switch c.Signedness {
+	case 0, 1:
+	default:
+		return fmt.Errorf("OpTypeInt: Signedness must 0 or 1")
+	}
+
+	return nil
+}
+
+func (c *OpTypeImage) verify() error {
+	switch c.Depth {
+	case 0, 1, 2:
+	default:
+		return errors.New("OpTypeImage: Depth must be 0, 1 or 2")
+	}
+
+	switch c.Arrayed {
+	case 0, 1:
+	default:
+		return errors.New("OpTypeImage: Arrayed must be 0 or 1")
+	}
+
+	switch c.MS {
+	case 0, 1:
+	default:
+		return errors.New("OpTypeImage: MS must be 0 or 1")
+	}
+
+	switch c.Sampled {
+	case 0, 1, 2:
+	default:
+		return errors.New("OpTypeImage: Sampled must be 0, 1 or 2")
+	}
+
+	return nil
+}
@@ -3,10 +3,7 @@
 
 package spirv
 
-import (
-	"reflect"
-	"sort"
-)
+import "sort"
 
 // InstructionFunc defines a constructor for an instruction.
 type instructionFunc func() Instruction
@@ -26,31 +23,9 @@ func (set instructionSet) Opcodes() []int {
 	return out
 }
 
-// Global, internal instruction set.
-// This has instructions registered atomically during init.
-var instructions = make(instructionSet)
-
-// Bind registers the given instruction.
-//
-// This call panics if the instruction type defined by the constructor
-// is not a pointer type. Additionally, this panics if there is already an
-// entry for the instruction's opcode.
+// Bind registers the given instruction with the DefaultDialect.
 //
 // All instructions are meant to be registered during package initialisation
 func bind(fun instructionFunc) {
-	obj := fun()
-	rv := reflect.ValueOf(obj)
-
-	if rv.Kind() != reflect.Ptr {
-		panic(ErrInstructionNotPointer)
-	}
-
-	opcode := obj.Opcode()
-
-	_, ok := instructions[opcode]
-	if ok {
-		panic(ErrDuplicateInstruction)
-	}
-
-	instructions[opcode] = fun
+	DefaultDialect.Bind(fun)
 }
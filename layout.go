@@ -0,0 +1,196 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// layoutSection defines a section in the logical layout of a module,
+// as defined in chapter 2.4 of the specification. Sections must appear
+// in the order in which they are defined here.
+type layoutSection int
+
+// Known layout sections.
+const (
+	sectionCapability layoutSection = iota
+	sectionExtension
+	sectionExtInstImport
+	sectionMemoryModel
+	sectionEntryPoint
+	sectionExecutionMode
+	sectionDebugSource
+	sectionDebugName
+	sectionAnnotation
+	sectionType
+	sectionFunctionDeclaration
+	sectionFunctionDefinition
+)
+
+var layoutSectionNames = [...]string{
+	sectionCapability:          "capabilities",
+	sectionExtension:           "extensions",
+	sectionExtInstImport:       "extended instruction imports",
+	sectionMemoryModel:         "the memory model",
+	sectionEntryPoint:          "entry points",
+	sectionExecutionMode:       "execution modes",
+	sectionDebugSource:         "debug source instructions",
+	sectionDebugName:           "debug names",
+	sectionAnnotation:          "annotations",
+	sectionType:                "type declarations",
+	sectionFunctionDeclaration: "function declarations",
+	sectionFunctionDefinition:  "function definitions",
+}
+
+func (s layoutSection) String() string { return layoutSectionNames[s] }
+
+// layoutSectionOf returns the section for the given opcode.
+// Returns false if the instruction may only appear inside a function.
+//
+// OpLine, OpNoLine, OpUndef and OpVariable may appear both in the type
+// section and inside functions.
+func layoutSectionOf(opcode uint32) (layoutSection, bool) {
+	switch opcode {
+	case opcodeCapability:
+		return sectionCapability, true
+
+	case opcodeExtension:
+		return sectionExtension, true
+
+	case opcodeExtInstImport:
+		return sectionExtInstImport, true
+
+	case opcodeMemoryModel:
+		return sectionMemoryModel, true
+
+	case opcodeEntryPoint:
+		return sectionEntryPoint, true
+
+	case opcodeExecutionMode:
+		return sectionExecutionMode, true
+
+	case opcodeString, opcodeSourceExtension, opcodeSource, opcodeSourceContinued:
+		return sectionDebugSource, true
+
+	case opcodeName, opcodeMemberName:
+		return sectionDebugName, true
+
+	case opcodeDecorate, opcodeMemberDecorate, opcodeGroupDecorate,
+		opcodeGroupMemberDecorate, opcodeDecorationGroup:
+		return sectionAnnotation, true
+
+	case opcodeTypeVoid, opcodeTypeBool, opcodeTypeInt, opcodeTypeFloat,
+		opcodeTypeVector, opcodeTypeMatrix, opcodeTypeImage, opcodeTypeSampler,
+		opcodeTypeSampledImage, opcodeTypeArray, opcodeTypeRuntimeArray,
+		opcodeTypeStruct, opcodeTypeOpaque, opcodeTypePointer, opcodeTypeFunction,
+		opcodeTypeEvent, opcodeTypeDeviceEvent, opcodeTypeReserveId,
+		opcodeTypeQueue, opcodeTypePipe, opcodeTypeForwardPointer,
+		opcodeConstantTrue, opcodeConstantFalse, opcodeConstant,
+		opcodeConstantComposite, opcodeConstantSampler, opcodeConstantNull,
+		opcodeSpecConstantTrue, opcodeSpecConstantFalse, opcodeSpecConstant,
+		opcodeSpecConstantComposite, opcodeSpecConstantOp,
+		opcodeVariable, opcodeUndef, opcodeLine, opcodeNoLine:
+		return sectionType, true
+
+	case opcodeFunction:
+		return sectionFunctionDeclaration, true
+	}
+
+	return 0, false
+}
+
+// verifyLayoutOrder checks if all instructions appear in the section
+// of the module they belong in, and if those sections are in the right
+// order. This walks the module section by section. Each instruction which
+// is out of place is reported, along with the section it belongs in and
+// the section the module is in at that point.
+//
+// Function declarations, which have no blocks, must precede function
+// definitions. The block structure inside functions is checked by
+// verifyFunctionStructure.
+func verifyLayoutOrder(set InstructionList) error {
+	var errs ErrorList
+
+	cur := sectionCapability
+	fn := -1 // Address of the current OpFunction; -1 outside functions.
+	body := false
+
+	for addr, instr := range set {
+		opcode := instr.Opcode()
+
+		if fn > -1 {
+			switch opcode {
+			case opcodeFunctionEnd:
+				section := sectionFunctionDeclaration
+				if body {
+					section = sectionFunctionDefinition
+				}
+
+				if section < cur {
+					errs = append(errs, NewLayoutError(fn,
+						"OpFunction appears after %s, but belongs with %s", cur, section))
+				} else {
+					cur = section
+				}
+
+				fn = -1
+				continue
+
+			case opcodeFunction:
+				errs = append(errs, NewLayoutError(addr,
+					"OpFunction appears before the end of the function at $%08x", fn))
+				fn, body = addr, false
+				continue
+
+			case opcodeFunctionParameter:
+				if body {
+					errs = append(errs, NewLayoutError(addr,
+						"OpFunctionParameter appears after the first block"))
+				}
+				continue
+
+			case opcodeLabel:
+				body = true
+				continue
+
+			case opcodeLine, opcodeNoLine, opcodeUndef, opcodeVariable:
+				continue
+			}
+
+			section, ok := layoutSectionOf(opcode)
+			if ok {
+				errs = append(errs, NewLayoutError(addr,
+					"%s appears inside a function, but belongs with %s",
+					instructionName(instr), section))
+			}
+
+			continue
+		}
+
+		section, ok := layoutSectionOf(opcode)
+		if !ok {
+			errs = append(errs, NewLayoutError(addr,
+				"%s appears outside of a function", instructionName(instr)))
+			continue
+		}
+
+		if opcode == opcodeFunction {
+			// Whether this is a declaration or a definition is known once
+			// we reach the end of the function.
+			fn, body = addr, false
+			continue
+		}
+
+		if section < cur {
+			errs = append(errs, NewLayoutError(addr,
+				"%s appears after %s, but belongs with %s",
+				instructionName(instr), cur, section))
+			continue
+		}
+
+		cur = section
+	}
+
+	if fn > -1 {
+		errs = append(errs, NewLayoutError(fn, "function has no OpFunctionEnd"))
+	}
+
+	return errs.err()
+}
@@ -0,0 +1,130 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLayoutOrder(t *testing.T) {
+	for i, st := range []struct {
+		in   InstructionList
+		want error
+	}{
+		{
+			in: InstructionList{
+				&OpCapability{},
+				&OpExtInstImport{},
+				&OpMemoryModel{},
+				&OpEntryPoint{},
+				&OpExecutionMode{},
+				&OpSource{},
+				&OpName{},
+				&OpDecorate{},
+				&OpTypeVoid{},
+				&OpTypeFunction{},
+				&OpVariable{},
+				&OpFunction{},
+				&OpFunctionEnd{},
+				&OpFunction{},
+				&OpFunctionParameter{},
+				&OpLabel{},
+				&OpVariable{},
+				&OpLine{},
+				&OpReturn{},
+				&OpFunctionEnd{},
+			},
+		},
+		{
+			in: InstructionList{
+				&OpMemoryModel{},
+				&OpCapability{},
+			},
+			want: ErrorList{NewLayoutError(1, "OpCapability appears after the memory model, but belongs with capabilities")},
+		},
+		{
+			in: InstructionList{
+				&OpMemoryModel{},
+				&OpTypeVoid{},
+				&OpName{},
+			},
+			want: ErrorList{NewLayoutError(2, "OpName appears after type declarations, but belongs with debug names")},
+		},
+		{
+			in: InstructionList{
+				&OpMemoryModel{},
+				&OpFunction{},
+				&OpLabel{},
+				&OpReturn{},
+				&OpFunctionEnd{},
+				&OpTypeInt{},
+			},
+			want: ErrorList{NewLayoutError(5, "OpTypeInt appears after function definitions, but belongs with type declarations")},
+		},
+		{
+			in: InstructionList{
+				&OpMemoryModel{},
+				&OpFunction{},
+				&OpLabel{},
+				&OpReturn{},
+				&OpFunctionEnd{},
+				&OpFunction{},
+				&OpFunctionEnd{},
+			},
+			want: ErrorList{NewLayoutError(5, "OpFunction appears after function definitions, but belongs with function declarations")},
+		},
+		{
+			in: InstructionList{
+				&OpMemoryModel{},
+				&OpFunction{},
+				&OpLabel{},
+				&OpDecorate{},
+				&OpReturn{},
+				&OpFunctionEnd{},
+			},
+			want: ErrorList{NewLayoutError(3, "OpDecorate appears inside a function, but belongs with annotations")},
+		},
+		{
+			in: InstructionList{
+				&OpMemoryModel{},
+				&OpFunction{},
+				&OpLabel{},
+				&OpFunctionParameter{},
+				&OpReturn{},
+				&OpFunctionEnd{},
+			},
+			want: ErrorList{NewLayoutError(3, "OpFunctionParameter appears after the first block")},
+		},
+		{
+			in: InstructionList{
+				&OpMemoryModel{},
+				&OpFunction{},
+				&OpFunction{},
+				&OpFunctionEnd{},
+			},
+			want: ErrorList{NewLayoutError(2, "OpFunction appears before the end of the function at $00000001")},
+		},
+		{
+			in: InstructionList{
+				&OpMemoryModel{},
+				&OpIAdd{},
+			},
+			want: ErrorList{NewLayoutError(1, "OpIAdd appears outside of a function")},
+		},
+		{
+			in: InstructionList{
+				&OpMemoryModel{},
+				&OpFunction{},
+				&OpLabel{},
+			},
+			want: ErrorList{NewLayoutError(1, "function has no OpFunctionEnd")},
+		},
+	} {
+		have := verifyLayoutOrder(st.in)
+		if !reflect.DeepEqual(have, st.want) {
+			t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v", i, have, st.want)
+		}
+	}
+}
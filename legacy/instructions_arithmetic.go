@@ -0,0 +1,402 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package legacy
+
+import "github.com/jteeuwen/spirv"
+
+// OpSNegate performs signed-integer subtract of Operand from zero.
+type OpSNegate struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand    spirv.Id
+}
+
+func (c *OpSNegate) Opcode() uint32 { return opcodeSNegate }
+func (c *OpSNegate) Optional() bool { return false }
+func (c *OpSNegate) Verify() error  { return nil }
+
+// OpFNegate performs Floating-point subtract of Operand from zero.
+type OpFNegate struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand    spirv.Id
+}
+
+func (c *OpFNegate) Opcode() uint32 { return opcodeFNegate }
+func (c *OpFNegate) Optional() bool { return false }
+func (c *OpFNegate) Verify() error  { return nil }
+
+// OpNot complements the bits of Operand.
+type OpNot struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand    spirv.Id
+}
+
+func (c *OpNot) Opcode() uint32 { return opcodeNot }
+func (c *OpNot) Optional() bool { return false }
+func (c *OpNot) Verify() error  { return nil }
+
+// OpIAdd performs Integer addition of Operand 1 and Operand 2.
+type OpIAdd struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpIAdd) Opcode() uint32 { return opcodeIAdd }
+func (c *OpIAdd) Optional() bool { return false }
+func (c *OpIAdd) Verify() error  { return nil }
+
+// OpFAdd performs Floating-point addition of Operand 1 and Operand 2.
+type OpFAdd struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpFAdd) Opcode() uint32 { return opcodeFAdd }
+func (c *OpFAdd) Optional() bool { return false }
+func (c *OpFAdd) Verify() error  { return nil }
+
+// OpISub performs Integer subtraction of Operand 2 from Operand 1.
+type OpISub struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpISub) Opcode() uint32 { return opcodeISub }
+func (c *OpISub) Optional() bool { return false }
+func (c *OpISub) Verify() error  { return nil }
+
+// OpFSub performs Floating-point subtraction of Operand 2 from Operand 1.
+type OpFSub struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpFSub) Opcode() uint32 { return opcodeFSub }
+func (c *OpFSub) Optional() bool { return false }
+func (c *OpFSub) Verify() error  { return nil }
+
+// OpIMul performs Integer multiplication of Operand 1 and Operand 2.
+type OpIMul struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpIMul) Opcode() uint32 { return opcodeIMul }
+func (c *OpIMul) Optional() bool { return false }
+func (c *OpIMul) Verify() error  { return nil }
+
+// OpFMul performs Floating-point multiplication of Operand 1 and Operand 2.
+type OpFMul struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpFMul) Opcode() uint32 { return opcodeFMul }
+func (c *OpFMul) Optional() bool { return false }
+func (c *OpFMul) Verify() error  { return nil }
+
+// OpUDiv performs Unsigned-integer division of Operand 1 divided by Operand 2.
+type OpUDiv struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpUDiv) Opcode() uint32 { return opcodeUDiv }
+func (c *OpUDiv) Optional() bool { return false }
+func (c *OpUDiv) Verify() error  { return nil }
+
+// OpSDiv performs Signed-integer division of Operand 1 divided by Operand 2.
+type OpSDiv struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpSDiv) Opcode() uint32 { return opcodeSDiv }
+func (c *OpSDiv) Optional() bool { return false }
+func (c *OpSDiv) Verify() error  { return nil }
+
+// OpFDiv performs Floating-point division of Operand 1 divided by Operand 2.
+type OpFDiv struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpFDiv) Opcode() uint32 { return opcodeFDiv }
+func (c *OpFDiv) Optional() bool { return false }
+func (c *OpFDiv) Verify() error  { return nil }
+
+// OpUMod performs Unsigned modulo operation of Operand 1 modulo Operand 2.
+type OpUMod struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpUMod) Opcode() uint32 { return opcodeUMod }
+func (c *OpUMod) Optional() bool { return false }
+func (c *OpUMod) Verify() error  { return nil }
+
+// OpSRem performs Signed remainder operation of Operand 1 divided by Operand 2.
+type OpSRem struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpSRem) Opcode() uint32 { return opcodeSRem }
+func (c *OpSRem) Optional() bool { return false }
+func (c *OpSRem) Verify() error  { return nil }
+
+// OpSMod performs Signed modulo operation of Operand 1 modulo Operand 2.
+type OpSMod struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpSMod) Opcode() uint32 { return opcodeSMod }
+func (c *OpSMod) Optional() bool { return false }
+func (c *OpSMod) Verify() error  { return nil }
+
+// OpFRem performs Floating-point remainder operation of Operand 1 divided by Operand 2.
+type OpFRem struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpFRem) Opcode() uint32 { return opcodeFRem }
+func (c *OpFRem) Optional() bool { return false }
+func (c *OpFRem) Verify() error  { return nil }
+
+// OpFMod performs Floating-point modulo operation of Operand 1 modulo Operand 2.
+type OpFMod struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpFMod) Opcode() uint32 { return opcodeFMod }
+func (c *OpFMod) Optional() bool { return false }
+func (c *OpFMod) Verify() error  { return nil }
+
+// OpVectorTimesScalar scales a floating-point vector.
+type OpVectorTimesScalar struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Vector     spirv.Id
+	Scalar     spirv.Id
+}
+
+func (c *OpVectorTimesScalar) Opcode() uint32 { return opcodeVectorTimesScalar }
+func (c *OpVectorTimesScalar) Optional() bool { return false }
+func (c *OpVectorTimesScalar) Verify() error  { return nil }
+
+// OpMatrixTimesScalar scales a floating-point matrix.
+type OpMatrixTimesScalar struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Vector     spirv.Id
+	Scalar     spirv.Id
+}
+
+func (c *OpMatrixTimesScalar) Opcode() uint32 { return opcodeMatrixTimesScalar }
+func (c *OpMatrixTimesScalar) Optional() bool { return false }
+func (c *OpMatrixTimesScalar) Verify() error  { return nil }
+
+// OpVectorTimesMatrix performs Linear-algebraic Vector X Matrix.
+type OpVectorTimesMatrix struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Vector     spirv.Id
+	Matrix     spirv.Id
+}
+
+func (c *OpVectorTimesMatrix) Opcode() uint32 { return opcodeVectorTimesMatrix }
+func (c *OpVectorTimesMatrix) Optional() bool { return false }
+func (c *OpVectorTimesMatrix) Verify() error  { return nil }
+
+// OpMatrixTimesVector performs Linear-algebraic Vector X Matrix.
+type OpMatrixTimesVector struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Matrix     spirv.Id
+	Vector     spirv.Id
+}
+
+func (c *OpMatrixTimesVector) Opcode() uint32 { return opcodeMatrixTimesVector }
+func (c *OpMatrixTimesVector) Optional() bool { return false }
+func (c *OpMatrixTimesVector) Verify() error  { return nil }
+
+// OpMatrixTimesMatrix performs Linear-algebraic multiply of Left X Right.
+type OpMatrixTimesMatrix struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Left       spirv.Id
+	Right      spirv.Id
+}
+
+func (c *OpMatrixTimesMatrix) Opcode() uint32 { return opcodeMatrixTimesMatrix }
+func (c *OpMatrixTimesMatrix) Optional() bool { return false }
+func (c *OpMatrixTimesMatrix) Verify() error  { return nil }
+
+// OpOuterProduct performs Linear-algebraic outer product of Vector 1 and Vector 2.
+type OpOuterProduct struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Vector1    spirv.Id
+	Vector2    spirv.Id
+}
+
+func (c *OpOuterProduct) Opcode() uint32 { return opcodeOuterProduct }
+func (c *OpOuterProduct) Optional() bool { return false }
+func (c *OpOuterProduct) Verify() error  { return nil }
+
+// OpDot performs Dot product of Vector 1 and Vector 2
+type OpDot struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Vector1    spirv.Id
+	Vector2    spirv.Id
+}
+
+func (c *OpDot) Opcode() uint32 { return opcodeDot }
+func (c *OpDot) Optional() bool { return false }
+func (c *OpDot) Verify() error  { return nil }
+
+// OpShiftRightLogical shifts the bits in Operand 1 right by the number
+// of bits specified in Operand 2
+type OpShiftRightLogical struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpShiftRightLogical) Opcode() uint32 { return opcodeShiftRightLogical }
+func (c *OpShiftRightLogical) Optional() bool { return false }
+func (c *OpShiftRightLogical) Verify() error  { return nil }
+
+// OpShiftRightArithmetic shifts the bits in Operand 1 right by the number of
+// bits specified in Operand 2
+type OpShiftRightArithmetic struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpShiftRightArithmetic) Opcode() uint32 { return opcodeShiftRightArithmetic }
+func (c *OpShiftRightArithmetic) Optional() bool { return false }
+func (c *OpShiftRightArithmetic) Verify() error  { return nil }
+
+// OpShiftLeftLogical shifts the bits in Operand 1 left by the number
+// of bits specified in Operand 2
+type OpShiftLeftLogical struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpShiftLeftLogical) Opcode() uint32 { return opcodeShiftLeftLogical }
+func (c *OpShiftLeftLogical) Optional() bool { return false }
+func (c *OpShiftLeftLogical) Verify() error  { return nil }
+
+// OpBitwiseOr result is 1 if either Operand 1 or Operand 2 is 1.
+// Result is 0 if both Operand 1 and Operand 2 are 0.
+type OpBitwiseOr struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpBitwiseOr) Opcode() uint32 { return opcodeBitwiseOr }
+func (c *OpBitwiseOr) Optional() bool { return false }
+func (c *OpBitwiseOr) Verify() error  { return nil }
+
+// OpBitwiseXor result is 1 if exactly one of Operand 1 or Operand 2 is 1.
+// Result is 0 if Operand 1 and Operand 2 have the same value
+type OpBitwiseXor struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpBitwiseXor) Opcode() uint32 { return opcodeBitwiseXor }
+func (c *OpBitwiseXor) Optional() bool { return false }
+func (c *OpBitwiseXor) Verify() error  { return nil }
+
+// OpBitwiseAnd result is 1 if both Operand 1 and Operand 2 are 1.
+// Result is 0 if either Operand 1 or Operand 2 are 0
+type OpBitwiseAnd struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand1   spirv.Id
+	Operand2   spirv.Id
+}
+
+func (c *OpBitwiseAnd) Opcode() uint32 { return opcodeBitwiseAnd }
+func (c *OpBitwiseAnd) Optional() bool { return false }
+func (c *OpBitwiseAnd) Verify() error  { return nil }
+
+func init() {
+	Dialect.Bind(func() spirv.Instruction { return &OpSNegate{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpFNegate{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpNot{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpIAdd{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpFAdd{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpISub{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpFSub{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpIMul{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpFMul{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpUDiv{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpSDiv{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpFDiv{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpUMod{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpSRem{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpSMod{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpFRem{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpFMod{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpVectorTimesScalar{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpMatrixTimesScalar{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpVectorTimesMatrix{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpMatrixTimesVector{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpMatrixTimesMatrix{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpOuterProduct{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpDot{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpShiftRightLogical{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpShiftRightArithmetic{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpShiftLeftLogical{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpBitwiseOr{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpBitwiseXor{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpBitwiseAnd{} })
+}
@@ -0,0 +1,118 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package legacy
+
+import "github.com/jteeuwen/spirv"
+
+// OpVectorExtractDynamic reads a single, dynamically selected, component of
+// a vector.
+type OpVectorExtractDynamic struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Vector     spirv.Id
+	Index      spirv.Id
+}
+
+func (c *OpVectorExtractDynamic) Opcode() uint32 { return opcodeVectorExtractDynamic }
+func (c *OpVectorExtractDynamic) Optional() bool { return false }
+func (c *OpVectorExtractDynamic) Verify() error  { return nil }
+
+// OpVectorInsertDynamic writes a single, variably selected, component
+// into a vector.
+type OpVectorInsertDynamic struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Vector     spirv.Id
+	Component  spirv.Id
+	Index      spirv.Id
+}
+
+func (c *OpVectorInsertDynamic) Opcode() uint32 { return opcodeVectorInsertDynamic }
+func (c *OpVectorInsertDynamic) Optional() bool { return false }
+func (c *OpVectorInsertDynamic) Verify() error  { return nil }
+
+// OpVectorShuffle selects arbitrary components from two vectors to make
+// a new vector.
+type OpVectorShuffle struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Vector1    spirv.Id
+	Vector2    spirv.Id
+	Components []uint32
+}
+
+func (c *OpVectorShuffle) Opcode() uint32 { return opcodeVectorShuffle }
+func (c *OpVectorShuffle) Optional() bool { return false }
+func (c *OpVectorShuffle) Verify() error  { return nil }
+
+// OpCompositeConstruct constructs a new composite object from a set of
+// constituent objects that will fully form it
+type OpCompositeConstruct struct {
+	ResultType   spirv.Id
+	ResultId     spirv.Id
+	Constituents []spirv.Id
+}
+
+func (c *OpCompositeConstruct) Opcode() uint32 { return opcodeCompositeConstruct }
+func (c *OpCompositeConstruct) Optional() bool { return false }
+func (c *OpCompositeConstruct) Verify() error  { return nil }
+
+// OpCompositeExtract extracts a part of a composite object.
+type OpCompositeExtract struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Composite  spirv.Id
+	Indices    []uint32
+}
+
+func (c *OpCompositeExtract) Opcode() uint32 { return opcodeCompositeExtract }
+func (c *OpCompositeExtract) Optional() bool { return false }
+func (c *OpCompositeExtract) Verify() error  { return nil }
+
+// OpCompositeInsert inserts into a composite object.
+type OpCompositeInsert struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Object     spirv.Id
+	Composite  spirv.Id
+	Indices    []uint32
+}
+
+func (c *OpCompositeInsert) Opcode() uint32 { return opcodeCompositeInsert }
+func (c *OpCompositeInsert) Optional() bool { return false }
+func (c *OpCompositeInsert) Verify() error  { return nil }
+
+// OpCopyObject makes a copy of Operand.
+// There are no dereferences involved.
+type OpCopyObject struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operand    spirv.Id
+}
+
+func (c *OpCopyObject) Opcode() uint32 { return opcodeCopyObject }
+func (c *OpCopyObject) Optional() bool { return false }
+func (c *OpCopyObject) Verify() error  { return nil }
+
+// OpTranspose transposes a matrix.
+type OpTranspose struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Matrix     spirv.Id
+}
+
+func (c *OpTranspose) Opcode() uint32 { return opcodeTranspose }
+func (c *OpTranspose) Optional() bool { return false }
+func (c *OpTranspose) Verify() error  { return nil }
+
+func init() {
+	Dialect.Bind(func() spirv.Instruction { return &OpVectorExtractDynamic{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpVectorInsertDynamic{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpVectorShuffle{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpCompositeConstruct{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpCompositeExtract{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpCompositeInsert{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpCopyObject{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTranspose{} })
+}
@@ -0,0 +1,200 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package legacy
+
+import "github.com/jteeuwen/spirv"
+
+// OpEnqueueMarker enqueues a marker command to to the queue object specified by Q.
+type OpEnqueueMarker struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Q          spirv.Id
+	NumEvents  spirv.Id
+	WaitEvents spirv.Id
+	RetEvent   spirv.Id
+}
+
+func (c *OpEnqueueMarker) Opcode() uint32 { return opcodeEnqueueMarker }
+func (c *OpEnqueueMarker) Optional() bool { return false }
+func (c *OpEnqueueMarker) Verify() error  { return nil }
+
+// OpEnqueueKernel enqueues the function specified by Invoke and NDRange
+// for execution to the queue object specified by Q.
+type OpEnqueueKernel struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Q          spirv.Id
+	Flags      KernelEnqueueFlag
+	NDRange    spirv.Id
+	NumEvents  spirv.Id
+	WaitEvents spirv.Id
+	RetEvent   spirv.Id
+	Invoke     spirv.Id
+	Param      spirv.Id
+	ParamSize  spirv.Id
+	ParamAlign spirv.Id
+	LocalSize  []spirv.Id `spirv:"optional"`
+}
+
+func (c *OpEnqueueKernel) Opcode() uint32 { return opcodeEnqueueKernel }
+func (c *OpEnqueueKernel) Optional() bool { return false }
+func (c *OpEnqueueKernel) Verify() error  { return nil }
+
+// OpGetKernelNDrangeSubGroupCount returns the number of subgroups in each
+// work-group of the dispatch given the combination of NDRange and
+// Invoke.
+type OpGetKernelNDrangeSubGroupCount struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	NDRange    spirv.Id
+	Invoke     spirv.Id
+}
+
+func (c *OpGetKernelNDrangeSubGroupCount) Opcode() uint32 { return opcodeGetKernelNDrangeSubGroupCount }
+func (c *OpGetKernelNDrangeSubGroupCount) Optional() bool { return false }
+func (c *OpGetKernelNDrangeSubGroupCount) Verify() error  { return nil }
+
+// OpGetKernelNDrangeMaxSubGroupSize returns the maximum sub-group size for
+// Invoke and NDRange.
+type OpGetKernelNDrangeMaxSubGroupSize struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	NDRange    spirv.Id
+	Invoke     spirv.Id
+}
+
+func (c *OpGetKernelNDrangeMaxSubGroupSize) Opcode() uint32 {
+	return opcodeGetKernelNDrangeMaxSubGroupSize
+}
+func (c *OpGetKernelNDrangeMaxSubGroupSize) Optional() bool { return false }
+func (c *OpGetKernelNDrangeMaxSubGroupSize) Verify() error  { return nil }
+
+// OpGetKernelWorkGroupSize returns the maximum work-group size that can be
+// used to execute Invoke on the device.
+type OpGetKernelWorkGroupSize struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Invoke     spirv.Id
+}
+
+func (c *OpGetKernelWorkGroupSize) Opcode() uint32 { return opcodeGetKernelWorkGroupSize }
+func (c *OpGetKernelWorkGroupSize) Optional() bool { return false }
+func (c *OpGetKernelWorkGroupSize) Verify() error  { return nil }
+
+// OpGetKernelPreferredWorkGroupSizeMultiple returns the preferred multiple of
+// work-group size for Invoke.
+type OpGetKernelPreferredWorkGroupSizeMultiple struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Invoke     spirv.Id
+}
+
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) Opcode() uint32 {
+	return opcodeGetKernelPreferredWorkGroupSizeMultiple
+}
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) Optional() bool { return false }
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) Verify() error  { return nil }
+
+// OpRetainEvent increments the reference count of Event.
+type OpRetainEvent struct {
+	Event spirv.Id
+}
+
+func (c *OpRetainEvent) Opcode() uint32 { return opcodeRetainEvent }
+func (c *OpRetainEvent) Optional() bool { return false }
+func (c *OpRetainEvent) Verify() error  { return nil }
+
+// OpReleaseEvent decrements the reference count of Event.
+type OpReleaseEvent struct {
+	Event spirv.Id
+}
+
+func (c *OpReleaseEvent) Opcode() uint32 { return 256 }
+func (c *OpReleaseEvent) Optional() bool { return false }
+func (c *OpReleaseEvent) Verify() error  { return nil }
+
+// OpCreateUserEvent creates a user event.
+type OpCreateUserEvent struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+}
+
+func (c *OpCreateUserEvent) Opcode() uint32 { return opcodeCreateUserEvent }
+func (c *OpCreateUserEvent) Optional() bool { return false }
+func (c *OpCreateUserEvent) Verify() error  { return nil }
+
+// OpIsValidEvent returns true if Event is a valid event, otherwise returns
+// false.
+type OpIsValidEvent struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Event      spirv.Id
+}
+
+func (c *OpIsValidEvent) Opcode() uint32 { return opcodeIsValidEvent }
+func (c *OpIsValidEvent) Optional() bool { return false }
+func (c *OpIsValidEvent) Verify() error  { return nil }
+
+// OpSetUserEventStatus sets the execution status of a user event.
+type OpSetUserEventStatus struct {
+	Event  spirv.Id
+	Status spirv.Id
+}
+
+func (c *OpSetUserEventStatus) Opcode() uint32 { return opcodeSetUserEventStatus }
+func (c *OpSetUserEventStatus) Optional() bool { return false }
+func (c *OpSetUserEventStatus) Verify() error  { return nil }
+
+// OpCaptureEventProfilingInfo captures the profiling information specified
+// by info for the command associated with the event specified by event in
+// the memory pointed by value.
+type OpCaptureEventProfilingInfo struct {
+	Event spirv.Id
+	Info  KernelProfilingInfo
+	Value spirv.Id
+}
+
+func (c *OpCaptureEventProfilingInfo) Opcode() uint32 { return opcodeCaptureEventProfilingInfo }
+func (c *OpCaptureEventProfilingInfo) Optional() bool { return false }
+func (c *OpCaptureEventProfilingInfo) Verify() error  { return nil }
+
+// OpGetDefaultQueue returns the default device queue.
+type OpGetDefaultQueue struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+}
+
+func (c *OpGetDefaultQueue) Opcode() uint32 { return opcodeGetDefaultQueue }
+func (c *OpGetDefaultQueue) Optional() bool { return false }
+func (c *OpGetDefaultQueue) Verify() error  { return nil }
+
+// OpBuildNDRange BLAHBLAH.
+type OpBuildNDRange struct {
+	ResultType       spirv.Id
+	ResultId         spirv.Id
+	GlobalWorkSize   spirv.Id
+	LocalWorkSize    spirv.Id
+	GlobalWorkOffset spirv.Id
+}
+
+func (c *OpBuildNDRange) Opcode() uint32 { return opcodeBuildNDRange }
+func (c *OpBuildNDRange) Optional() bool { return false }
+func (c *OpBuildNDRange) Verify() error  { return nil }
+
+func init() {
+	Dialect.Bind(func() spirv.Instruction { return &OpIsValidEvent{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpEnqueueMarker{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpEnqueueKernel{LocalSize: []spirv.Id{}} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGetKernelNDrangeSubGroupCount{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGetKernelNDrangeMaxSubGroupSize{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGetKernelWorkGroupSize{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGetKernelPreferredWorkGroupSizeMultiple{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpRetainEvent{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpReleaseEvent{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpCreateUserEvent{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpSetUserEventStatus{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpCaptureEventProfilingInfo{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGetDefaultQueue{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpBuildNDRange{} })
+}
@@ -0,0 +1,191 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package legacy
+
+import (
+	"fmt"
+
+	"github.com/jteeuwen/spirv"
+)
+
+// TODO: Context-aware validation. Most instructions here require context
+// (i.e., module) to properly validate.
+
+// OpPhi is the SSA phi function.
+type OpPhi struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Operands   []spirv.Id
+}
+
+func (c *OpPhi) Opcode() uint32 { return opcodePhi }
+func (c *OpPhi) Optional() bool { return false }
+func (c *OpPhi) Verify() error {
+	if len(c.Operands) == 0 {
+		return fmt.Errorf("OpPhi: expected operands")
+	}
+
+	if len(c.Operands)%2 != 0 {
+		return fmt.Errorf("OpPhi: expected array of (Variable, ParentBlock) operand pairs")
+	}
+
+	return nil
+}
+
+// OpLoopMerge declares and controls a structured control-flow loop construct.
+type OpLoopMerge struct {
+	Label       spirv.Id
+	LoopControl LoopControl
+}
+
+func (c *OpLoopMerge) Opcode() uint32 { return opcodeLoopMerge }
+func (c *OpLoopMerge) Optional() bool { return false }
+func (c *OpLoopMerge) Verify() error  { return nil }
+
+// OpSelectionMerge declares and controls a structured control-flow selection
+// construct, used with OpBranchConditional or OpSwitch.
+type OpSelectionMerge struct {
+	Label            spirv.Id
+	SelectionControl SelectionControl
+}
+
+func (c *OpSelectionMerge) Opcode() uint32 { return opcodeSelectionMerge }
+func (c *OpSelectionMerge) Optional() bool { return false }
+func (c *OpSelectionMerge) Verify() error  { return nil }
+
+// OpLabel defines a block label.
+type OpLabel struct {
+	ResultId spirv.Id
+}
+
+func (c *OpLabel) Opcode() uint32 { return opcodeLabel }
+func (c *OpLabel) Optional() bool { return false }
+func (c *OpLabel) Verify() error  { return nil }
+
+// OpBranch is an unconditional branch to TargetLabel.
+type OpBranch struct {
+	TargetLabel spirv.Id
+}
+
+func (c *OpBranch) Opcode() uint32 { return opcodeBranch }
+func (c *OpBranch) Optional() bool { return false }
+func (c *OpBranch) Verify() error  { return nil }
+
+// OpBranchConditional branches to TrueLabel if Condition is true, or to
+// False Label if Condition is false.
+type OpBranchConditional struct {
+	Condition     spirv.Id
+	TrueLabel     spirv.Id
+	FalseLabel    spirv.Id
+	BranchWeights []uint32 `spirv:"optional"`
+}
+
+func (c *OpBranchConditional) Opcode() uint32 { return opcodeBranchConditional }
+func (c *OpBranchConditional) Optional() bool { return false }
+func (c *OpBranchConditional) Verify() error {
+	if len(c.BranchWeights) != 0 && len(c.BranchWeights) != 2 {
+		return fmt.Errorf("OpBranchConditional: BranchWeights expects 0 or 2 elements")
+	}
+	return nil
+}
+
+// OpSwitch branches to a matching operand label.
+type OpSwitch struct {
+	Selector spirv.Id
+	Default  spirv.Id
+	// TODO: struct for pair (Value, LabelId)
+	Target []uint32 `spirv:"optional"`
+}
+
+func (c *OpSwitch) Opcode() uint32 { return opcodeSwitch }
+func (c *OpSwitch) Optional() bool { return false }
+func (c *OpSwitch) Verify() error {
+	if len(c.Target)%2 != 0 {
+		return fmt.Errorf("OpSwitch: Target expects array of (LiteralNumber, Label) pairs")
+	}
+
+	for j := 0; j < len(c.Target); j += 2 {
+		for k := j + 2; k < len(c.Target); k += 2 {
+			if c.Target[j] == c.Target[k] {
+				return fmt.Errorf("OpSwitch: Target literals must be unique")
+			}
+		}
+	}
+
+	return nil
+}
+
+// OpKill discards the fragment shader.
+type OpKill struct{}
+
+func (c *OpKill) Opcode() uint32 { return opcodeKill }
+func (c *OpKill) Optional() bool { return false }
+func (c *OpKill) Verify() error  { return nil }
+
+func init() {
+	Dialect.Bind(func() spirv.Instruction {
+		return &OpKill{}
+	})
+}
+
+// OpReturn returns with no value from a function with void return type.
+type OpReturn struct{}
+
+func (c *OpReturn) Opcode() uint32 { return opcodeReturn }
+func (c *OpReturn) Optional() bool { return false }
+func (c *OpReturn) Verify() error  { return nil }
+
+// OpReturnValue returns with a value from a function.
+type OpReturnValue struct {
+	Value spirv.Id
+}
+
+func (c *OpReturnValue) Opcode() uint32 { return opcodeReturnValue }
+func (c *OpReturnValue) Optional() bool { return false }
+func (c *OpReturnValue) Verify() error  { return nil }
+
+// OpUnreachable declares that this block is not reachable in the Control
+// Flow Graph.
+type OpUnreachable struct{}
+
+func (c *OpUnreachable) Opcode() uint32 { return opcodeUnreachable }
+func (c *OpUnreachable) Optional() bool { return false }
+func (c *OpUnreachable) Verify() error  { return nil }
+
+// OpLifetimeStart declares that the content of the object pointed to was
+// not defined before this instruction.
+type OpLifetimeStart struct {
+	Object       spirv.Id
+	MemoryAmount uint32
+}
+
+func (c *OpLifetimeStart) Opcode() uint32 { return opcodeLifetimeStart }
+func (c *OpLifetimeStart) Optional() bool { return false }
+func (c *OpLifetimeStart) Verify() error  { return nil }
+
+// OpLifetimeStop declares that the content of the object pointed to is
+// dead after this instruction.
+type OpLifetimeStop struct {
+	Object       spirv.Id
+	MemoryAmount uint32
+}
+
+func (c *OpLifetimeStop) Opcode() uint32 { return opcodeLifetimeStop }
+func (c *OpLifetimeStop) Optional() bool { return false }
+func (c *OpLifetimeStop) Verify() error  { return nil }
+
+func init() {
+	Dialect.Bind(func() spirv.Instruction { return &OpPhi{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpLoopMerge{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpSelectionMerge{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpLabel{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpBranch{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpBranchConditional{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpSwitch{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpReturn{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpReturnValue{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpUnreachable{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpLifetimeStart{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpLifetimeStop{} })
+}
@@ -0,0 +1,317 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package legacy
+
+import (
+	"errors"
+
+	"github.com/jteeuwen/spirv"
+)
+
+// OpAsyncGroupCopy performs an asynchronous group copy of Num
+// elements from Source to Destination. The asynchronous copy is performed by
+// all work-items in a group.
+type OpAsyncGroupCopy struct {
+	ResultType  spirv.Id
+	ResultId    spirv.Id
+	Scope       ExecutionScope
+	Destination spirv.Id
+	Source      spirv.Id
+	NumElements spirv.Id
+	Stride      spirv.Id
+	Event       spirv.Id
+}
+
+func (c *OpAsyncGroupCopy) Opcode() uint32 { return opcodeAsyncGroupCopy }
+func (c *OpAsyncGroupCopy) Optional() bool { return false }
+func (c *OpAsyncGroupCopy) Verify() error {
+	switch c.Scope {
+	case ExecutionScopeSubgroup, ExecutionScopeWorkgroup:
+	default:
+		return errors.New("OpAsyncGroupCopy: Scope must be Subgroup or Workgroup")
+	}
+
+	return nil
+}
+
+// OpWaitGroupEvents waits for events generated by OpAsyncGroupCopy operations
+// to complete. The event objects pointed by Events List will be released after
+// the wait is performed.
+type OpWaitGroupEvents struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Scope      ExecutionScope
+	NumEvents  spirv.Id
+	EventsList spirv.Id
+}
+
+func (c *OpWaitGroupEvents) Opcode() uint32 { return opcodeWaitGroupEvents }
+func (c *OpWaitGroupEvents) Optional() bool { return false }
+func (c *OpWaitGroupEvents) Verify() error {
+	switch c.Scope {
+	case ExecutionScopeSubgroup, ExecutionScopeWorkgroup:
+	default:
+		return errors.New("OpWaitGroupEvents: Scope must be Subgroup or Workgroup")
+	}
+
+	return nil
+}
+
+// OpGroupAll evaluates a predicate for all work-items in the group and
+// returns true if predicate evaluates to true for all work-items in the
+// group, otherwise returns false.
+type OpGroupAll struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Scope      ExecutionScope
+	Predicate  spirv.Id
+}
+
+func (c *OpGroupAll) Opcode() uint32 { return opcodeGroupAll }
+func (c *OpGroupAll) Optional() bool { return false }
+func (c *OpGroupAll) Verify() error {
+	switch c.Scope {
+	case ExecutionScopeSubgroup, ExecutionScopeWorkgroup:
+	default:
+		return errors.New("OpGroupAll: Scope must be Subgroup or Workgroup")
+	}
+
+	return nil
+}
+
+// OpGroupAny evaluates a predicate for all work-items in the group and
+// returns true if predicate evaluates to true for any work-item in the group,
+// otherwise returns false
+type OpGroupAny struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Scope      ExecutionScope
+	Predicate  spirv.Id
+}
+
+func (c *OpGroupAny) Opcode() uint32 { return opcodeGroupAny }
+func (c *OpGroupAny) Optional() bool { return false }
+func (c *OpGroupAny) Verify() error {
+	switch c.Scope {
+	case ExecutionScopeSubgroup, ExecutionScopeWorkgroup:
+	default:
+		return errors.New("OpGroupAny: Scope must be Subgroup or Workgroup")
+	}
+
+	return nil
+}
+
+// OpGroupBroadcast broadcasts a value for workitem identified by the
+// local id to all work-items in the group.
+type OpGroupBroadcast struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Scope      ExecutionScope
+	Value      spirv.Id
+	LocalId    spirv.Id
+}
+
+func (c *OpGroupBroadcast) Opcode() uint32 { return opcodeGroupBroadcast }
+func (c *OpGroupBroadcast) Optional() bool { return false }
+func (c *OpGroupBroadcast) Verify() error {
+	switch c.Scope {
+	case ExecutionScopeSubgroup, ExecutionScopeWorkgroup:
+	default:
+		return errors.New("OpGroupBroadcast: Scope must be Subgroup or Workgroup")
+	}
+
+	return nil
+}
+
+// OpGroupIAdd performs an integer add group operation specified for all
+// values of X specified by work-items in the group.
+type OpGroupIAdd struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Scope      ExecutionScope
+	Operation  GroupOperation
+	X          spirv.Id
+}
+
+func (c *OpGroupIAdd) Opcode() uint32 { return opcodeGroupIAdd }
+func (c *OpGroupIAdd) Optional() bool { return false }
+func (c *OpGroupIAdd) Verify() error {
+	switch c.Scope {
+	case ExecutionScopeSubgroup, ExecutionScopeWorkgroup:
+	default:
+		return errors.New("OpGroupIAdd: Scope must be Subgroup or Workgroup")
+	}
+
+	return nil
+}
+
+// OpGroupFAdd performs a floating-point add group operation specified for
+// all values of X specified by work-items in the group.
+type OpGroupFAdd struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Scope      ExecutionScope
+	Operation  GroupOperation
+	X          spirv.Id
+}
+
+func (c *OpGroupFAdd) Opcode() uint32 { return opcodeGroupFAdd }
+func (c *OpGroupFAdd) Optional() bool { return false }
+func (c *OpGroupFAdd) Verify() error {
+	switch c.Scope {
+	case ExecutionScopeSubgroup, ExecutionScopeWorkgroup:
+	default:
+		return errors.New("OpGroupFAdd: Scope must be Subgroup or Workgroup")
+	}
+
+	return nil
+}
+
+// OpGroupFMin performs a floating-point minimum group operation specified
+// for all values of X specified by work-items in the group.
+type OpGroupFMin struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Scope      ExecutionScope
+	Operation  GroupOperation
+	X          spirv.Id
+}
+
+func (c *OpGroupFMin) Opcode() uint32 { return opcodeGroupFMin }
+func (c *OpGroupFMin) Optional() bool { return false }
+func (c *OpGroupFMin) Verify() error {
+	switch c.Scope {
+	case ExecutionScopeSubgroup, ExecutionScopeWorkgroup:
+	default:
+		return errors.New("OpGroupFMin: Scope must be Subgroup or Workgroup")
+	}
+
+	return nil
+}
+
+// OpGroupUMin performs an unsigned integer minimum group operation specified
+// for all values of X specified by work-items in the group.
+type OpGroupUMin struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Scope      ExecutionScope
+	Operation  GroupOperation
+	X          spirv.Id
+}
+
+func (c *OpGroupUMin) Opcode() uint32 { return opcodeGroupUMin }
+func (c *OpGroupUMin) Optional() bool { return false }
+func (c *OpGroupUMin) Verify() error {
+	switch c.Scope {
+	case ExecutionScopeSubgroup, ExecutionScopeWorkgroup:
+	default:
+		return errors.New("OpGroupUMin: Scope must be Subgroup or Workgroup")
+	}
+
+	return nil
+}
+
+// OpGroupSMin performs a signed integer minimum group operation specified
+// for all values of X specified by work-items in the group.
+type OpGroupSMin struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Scope      ExecutionScope
+	Operation  GroupOperation
+	X          spirv.Id
+}
+
+func (c *OpGroupSMin) Opcode() uint32 { return opcodeGroupSMin }
+func (c *OpGroupSMin) Optional() bool { return false }
+func (c *OpGroupSMin) Verify() error {
+	switch c.Scope {
+	case ExecutionScopeSubgroup, ExecutionScopeWorkgroup:
+	default:
+		return errors.New("OpGroupSMin: Scope must be Subgroup or Workgroup")
+	}
+
+	return nil
+}
+
+// OpGroupFMax performs a floating-point maximum group operation specified
+// for all values of X specified by work-items in the group
+type OpGroupFMax struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Scope      ExecutionScope
+	Operation  GroupOperation
+	X          spirv.Id
+}
+
+func (c *OpGroupFMax) Opcode() uint32 { return opcodeGroupFMax }
+func (c *OpGroupFMax) Optional() bool { return false }
+func (c *OpGroupFMax) Verify() error {
+	switch c.Scope {
+	case ExecutionScopeSubgroup, ExecutionScopeWorkgroup:
+	default:
+		return errors.New("OpGroupFMax: Scope must be Subgroup or Workgroup")
+	}
+
+	return nil
+}
+
+// OpGroupUMax performs an unsigned integer maximum group operation specified
+// for all values of X specified by work-items in the group
+type OpGroupUMax struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Scope      ExecutionScope
+	Operation  GroupOperation
+	X          spirv.Id
+}
+
+func (c *OpGroupUMax) Opcode() uint32 { return opcodeGroupUMax }
+func (c *OpGroupUMax) Optional() bool { return false }
+func (c *OpGroupUMax) Verify() error {
+	switch c.Scope {
+	case ExecutionScopeSubgroup, ExecutionScopeWorkgroup:
+	default:
+		return errors.New("OpGroupUMax: Scope must be Subgroup or Workgroup")
+	}
+
+	return nil
+}
+
+// OpGroupSMax performs a signed integer maximum group operation specified
+// for all values of X specified by work-items in the group
+type OpGroupSMax struct {
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Scope      ExecutionScope
+	Operation  GroupOperation
+	X          spirv.Id
+}
+
+func (c *OpGroupSMax) Opcode() uint32 { return opcodeGroupSMax }
+func (c *OpGroupSMax) Optional() bool { return false }
+func (c *OpGroupSMax) Verify() error {
+	switch c.Scope {
+	case ExecutionScopeSubgroup, ExecutionScopeWorkgroup:
+	default:
+		return errors.New("OpGroupSMax: Scope must be Subgroup or Workgroup")
+	}
+
+	return nil
+}
+
+func init() {
+	Dialect.Bind(func() spirv.Instruction { return &OpAsyncGroupCopy{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpWaitGroupEvents{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGroupAll{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGroupAny{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGroupBroadcast{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGroupIAdd{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGroupFAdd{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGroupFMin{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGroupUMin{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGroupSMin{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGroupFMax{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGroupUMax{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpGroupSMax{} })
+}
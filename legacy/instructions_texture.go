@@ -1,14 +1,16 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
-package spirv
+package legacy
+
+import "github.com/jteeuwen/spirv"
 
 // OpSampler creates a sampler containing both a filter and texture.
 type OpSampler struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Filter     Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Filter     spirv.Id
 }
 
 func (c *OpSampler) Opcode() uint32 { return opcodeSampler }
@@ -17,11 +19,11 @@ func (c *OpSampler) Verify() error  { return nil }
 
 // OpTextureSample samples a texture with an implicit level of detail.
 type OpTextureSample struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Bias       Id `spirv:"optional"`
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Bias       spirv.Id `spirv:"optional"`
 }
 
 func (c *OpTextureSample) Opcode() uint32 { return opcodeTextureSample }
@@ -31,11 +33,11 @@ func (c *OpTextureSample) Verify() error  { return nil }
 // OpTextureSampleDref samples a cube-map-array texture with depth
 // comparison using an implicit level of detail.
 type OpTextureSampleDref struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Dref       Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Dref       spirv.Id
 }
 
 func (c *OpTextureSampleDref) Opcode() uint32 { return opcodeTextureSampleDref }
@@ -44,11 +46,11 @@ func (c *OpTextureSampleDref) Verify() error  { return nil }
 
 // OpTextureSampleLod samples a texture using an explicit level of detail.
 type OpTextureSampleLod struct {
-	ResultType    Id
-	ResultId      Id
-	Sampler       Id
-	Coordinate    Id
-	LevelofDetail Id
+	ResultType    spirv.Id
+	ResultId      spirv.Id
+	Sampler       spirv.Id
+	Coordinate    spirv.Id
+	LevelofDetail spirv.Id
 }
 
 func (c *OpTextureSampleLod) Opcode() uint32 { return opcodeTextureSampleLod }
@@ -58,11 +60,11 @@ func (c *OpTextureSampleLod) Verify() error  { return nil }
 // OpTextureSampleProj sample a texture with a projective coordinate
 // using an implicit level of detail.
 type OpTextureSampleProj struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Bias       Id `spirv:"optional"`
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Bias       spirv.Id `spirv:"optional"`
 }
 
 func (c *OpTextureSampleProj) Opcode() uint32 { return opcodeTextureSampleProj }
@@ -71,12 +73,12 @@ func (c *OpTextureSampleProj) Verify() error  { return nil }
 
 // OpTextureSampleGrad samples a texture with an explicit gradient.
 type OpTextureSampleGrad struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Dx         Id
-	Dy         Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Dx         spirv.Id
+	Dy         spirv.Id
 }
 
 func (c *OpTextureSampleGrad) Opcode() uint32 { return opcodeTextureSampleGrad }
@@ -86,12 +88,12 @@ func (c *OpTextureSampleGrad) Verify() error  { return nil }
 // OpTextureSampleOffset samples a texture with an offset from a
 // coordinate using an implicit level of detail.
 type OpTextureSampleOffset struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Offset     Id
-	Bias       Id `spirv:"optional"`
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Offset     spirv.Id
+	Bias       spirv.Id `spirv:"optional"`
 }
 
 func (c *OpTextureSampleOffset) Opcode() uint32 { return opcodeTextureSampleOffset }
@@ -101,11 +103,11 @@ func (c *OpTextureSampleOffset) Verify() error  { return nil }
 // OpTextureSampleProjLod samples a texture with a projective
 // coordinate using an explicit level of detail.
 type OpTextureSampleProjLod struct {
-	ResultType    Id
-	ResultId      Id
-	Sampler       Id
-	Coordinate    Id
-	LevelofDetail Id
+	ResultType    spirv.Id
+	ResultId      spirv.Id
+	Sampler       spirv.Id
+	Coordinate    spirv.Id
+	LevelofDetail spirv.Id
 }
 
 func (c *OpTextureSampleProjLod) Opcode() uint32 { return opcodeTextureSampleProjLod }
@@ -115,12 +117,12 @@ func (c *OpTextureSampleProjLod) Verify() error  { return nil }
 // OpTextureSampleProjGrad sample a texture with a projective
 // coordinate using an explicit gradient.
 type OpTextureSampleProjGrad struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Dx         Id
-	Dy         Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Dx         spirv.Id
+	Dy         spirv.Id
 }
 
 func (c *OpTextureSampleProjGrad) Opcode() uint32 { return opcodeTextureSampleProjGrad }
@@ -130,12 +132,12 @@ func (c *OpTextureSampleProjGrad) Verify() error  { return nil }
 // OpTextureSampleLodOffset samples a texture with explicit level of
 // detail using an offset from a coordinate.
 type OpTextureSampleLodOffset struct {
-	ResultType    Id
-	ResultId      Id
-	Sampler       Id
-	Coordinate    Id
-	LevelofDetail Id
-	Offset        Id
+	ResultType    spirv.Id
+	ResultId      spirv.Id
+	Sampler       spirv.Id
+	Coordinate    spirv.Id
+	LevelofDetail spirv.Id
+	Offset        spirv.Id
 }
 
 func (c *OpTextureSampleLodOffset) Opcode() uint32 { return opcodeTextureSampleLodOffset }
@@ -145,12 +147,12 @@ func (c *OpTextureSampleLodOffset) Verify() error  { return nil }
 // OpTextureSampleProjOffset samples a texture with an offset from a
 // projective coordinate using an implicit level of detail.
 type OpTextureSampleProjOffset struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Offset     Id
-	Bias       Id `spirv:"optional"`
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Offset     spirv.Id
+	Bias       spirv.Id `spirv:"optional"`
 }
 
 func (c *OpTextureSampleProjOffset) Opcode() uint32 { return opcodeTextureSampleProjOffset }
@@ -160,13 +162,13 @@ func (c *OpTextureSampleProjOffset) Verify() error  { return nil }
 // OpTextureSampleGradOffset samples a texture with an offset
 // coordinate and an explicit gradient.
 type OpTextureSampleGradOffset struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Dx         Id
-	Dy         Id
-	Offset     Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Dx         spirv.Id
+	Dy         spirv.Id
+	Offset     spirv.Id
 }
 
 func (c *OpTextureSampleGradOffset) Opcode() uint32 { return opcodeTextureSampleGradOffset }
@@ -176,12 +178,12 @@ func (c *OpTextureSampleGradOffset) Verify() error  { return nil }
 // OpTextureSampleProjLodOffset samples a texture with an offset from
 // a projective coordinate and an explicit level of detail.
 type OpTextureSampleProjLodOffset struct {
-	ResultType    Id
-	ResultId      Id
-	Sampler       Id
-	Coordinate    Id
-	LevelofDetail Id
-	Offset        Id
+	ResultType    spirv.Id
+	ResultId      spirv.Id
+	Sampler       spirv.Id
+	Coordinate    spirv.Id
+	LevelofDetail spirv.Id
+	Offset        spirv.Id
 }
 
 func (c *OpTextureSampleProjLodOffset) Opcode() uint32 { return opcodeTextureSampleProjLodOffset }
@@ -191,13 +193,13 @@ func (c *OpTextureSampleProjLodOffset) Verify() error  { return nil }
 // OpTextureSampleProjGradOffset samples a texture with an offset from
 // a projective coordinate and an explicit gradient.
 type OpTextureSampleProjGradOffset struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Dx         Id
-	Dy         Id
-	Offset     Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Dx         spirv.Id
+	Dy         spirv.Id
+	Offset     spirv.Id
 }
 
 func (c *OpTextureSampleProjGradOffset) Opcode() uint32 { return opcodeTextureSampleProjGradOffset }
@@ -206,11 +208,11 @@ func (c *OpTextureSampleProjGradOffset) Verify() error  { return nil }
 
 // OpTextureFetchTexel fetches a single texel from a texture.
 type OpTextureFetchTexel struct {
-	ResultType    Id
-	ResultId      Id
-	Sampler       Id
-	Coordinate    Id
-	LevelofDetail Id
+	ResultType    spirv.Id
+	ResultId      spirv.Id
+	Sampler       spirv.Id
+	Coordinate    spirv.Id
+	LevelofDetail spirv.Id
 }
 
 func (c *OpTextureFetchTexel) Opcode() uint32 { return opcodeTextureFetchTexel }
@@ -219,11 +221,11 @@ func (c *OpTextureFetchTexel) Verify() error  { return nil }
 
 // OpTextureFetchTexelOffset fetches a single offset texel from a texture.
 type OpTextureFetchTexelOffset struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Offset     Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Offset     spirv.Id
 }
 
 func (c *OpTextureFetchTexelOffset) Opcode() uint32 { return opcodeTextureFetchTexelOffset }
@@ -232,11 +234,11 @@ func (c *OpTextureFetchTexelOffset) Verify() error  { return nil }
 
 // OpTextureFetchSample fetches a single sample from a multi-sample texture.
 type OpTextureFetchSample struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Sample     Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Sample     spirv.Id
 }
 
 func (c *OpTextureFetchSample) Opcode() uint32 { return opcodeTextureFetchSample }
@@ -245,10 +247,10 @@ func (c *OpTextureFetchSample) Verify() error  { return nil }
 
 // OpTextureFetchBuffer fetches an element out of a buffer texture.
 type OpTextureFetchBuffer struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Element    Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Element    spirv.Id
 }
 
 func (c *OpTextureFetchBuffer) Opcode() uint32 { return opcodeTextureFetchBuffer }
@@ -257,11 +259,11 @@ func (c *OpTextureFetchBuffer) Verify() error  { return nil }
 
 // OpTextureGather gathers the requested component from four sampled texels.
 type OpTextureGather struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Component  Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Component  spirv.Id
 }
 
 func (c *OpTextureGather) Opcode() uint32 { return opcodeTextureGather }
@@ -271,12 +273,12 @@ func (c *OpTextureGather) Verify() error  { return nil }
 // OpTextureGatherOffset gathers the requested component from four
 // offset sampled texels.
 type OpTextureGatherOffset struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Component  Id
-	Offset     Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Component  spirv.Id
+	Offset     spirv.Id
 }
 
 func (c *OpTextureGatherOffset) Opcode() uint32 { return opcodeTextureGatherOffset }
@@ -286,12 +288,12 @@ func (c *OpTextureGatherOffset) Verify() error  { return nil }
 // OpTextureGatherOffsets gathers the requested component from four
 // offset sampled texels.
 type OpTextureGatherOffsets struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
-	Component  Id
-	Offsets    Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
+	Component  spirv.Id
+	Offsets    spirv.Id
 }
 
 func (c *OpTextureGatherOffsets) Opcode() uint32 { return opcodeTextureGatherOffsets }
@@ -301,10 +303,10 @@ func (c *OpTextureGatherOffsets) Verify() error  { return nil }
 // OpTextureQuerySizeLod queries the dimensions of the texture for
 // Sampler for mipmap level for Level of Detail.
 type OpTextureQuerySizeLod struct {
-	ResultType    Id
-	ResultId      Id
-	Sampler       Id
-	LevelofDetail Id
+	ResultType    spirv.Id
+	ResultId      spirv.Id
+	Sampler       spirv.Id
+	LevelofDetail spirv.Id
 }
 
 func (c *OpTextureQuerySizeLod) Opcode() uint32 { return opcodeTextureQuerySizeLod }
@@ -314,9 +316,9 @@ func (c *OpTextureQuerySizeLod) Verify() error  { return nil }
 // OpTextureQuerySize queries the dimensions of the texture for
 // Sampler, with no level of detail.
 type OpTextureQuerySize struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
 }
 
 func (c *OpTextureQuerySize) Opcode() uint32 { return opcodeTextureQuerySize }
@@ -327,10 +329,10 @@ func (c *OpTextureQuerySize) Verify() error  { return nil }
 // for a hypothetical sampling of Sampler at Coordinate using an
 // implicit level of detail.
 type OpTextureQueryLod struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
-	Coordinate Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
+	Coordinate spirv.Id
 }
 
 func (c *OpTextureQueryLod) Opcode() uint32 { return opcodeTextureQueryLod }
@@ -340,9 +342,9 @@ func (c *OpTextureQueryLod) Verify() error  { return nil }
 // OpTextureQueryLevels queries the number of mipmap levels accessible
 // through Sampler.
 type OpTextureQueryLevels struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
 }
 
 func (c *OpTextureQueryLevels) Opcode() uint32 { return opcodeTextureQueryLevels }
@@ -352,9 +354,9 @@ func (c *OpTextureQueryLevels) Verify() error  { return nil }
 // OpTextureQuerySamples queries the number of samples available per
 // texel fetch in a multisample texture.
 type OpTextureQuerySamples struct {
-	ResultType Id
-	ResultId   Id
-	Sampler    Id
+	ResultType spirv.Id
+	ResultId   spirv.Id
+	Sampler    spirv.Id
 }
 
 func (c *OpTextureQuerySamples) Opcode() uint32 { return opcodeTextureQuerySamples }
@@ -362,30 +364,30 @@ func (c *OpTextureQuerySamples) Optional() bool { return false }
 func (c *OpTextureQuerySamples) Verify() error  { return nil }
 
 func init() {
-	bind(func() Instruction { return &OpSampler{} })
-	bind(func() Instruction { return &OpTextureSample{Bias: 0} })
-	bind(func() Instruction { return &OpTextureSampleDref{} })
-	bind(func() Instruction { return &OpTextureSampleLod{} })
-	bind(func() Instruction { return &OpTextureSampleProj{Bias: 0} })
-	bind(func() Instruction { return &OpTextureSampleGrad{} })
-	bind(func() Instruction { return &OpTextureSampleOffset{Bias: 0} })
-	bind(func() Instruction { return &OpTextureSampleProjLod{} })
-	bind(func() Instruction { return &OpTextureSampleProjGrad{} })
-	bind(func() Instruction { return &OpTextureSampleLodOffset{} })
-	bind(func() Instruction { return &OpTextureSampleProjOffset{Bias: 0} })
-	bind(func() Instruction { return &OpTextureSampleGradOffset{} })
-	bind(func() Instruction { return &OpTextureSampleProjLodOffset{} })
-	bind(func() Instruction { return &OpTextureSampleProjGradOffset{} })
-	bind(func() Instruction { return &OpTextureFetchTexel{} })
-	bind(func() Instruction { return &OpTextureFetchTexelOffset{} })
-	bind(func() Instruction { return &OpTextureFetchSample{} })
-	bind(func() Instruction { return &OpTextureFetchBuffer{} })
-	bind(func() Instruction { return &OpTextureGather{} })
-	bind(func() Instruction { return &OpTextureGatherOffset{} })
-	bind(func() Instruction { return &OpTextureGatherOffsets{} })
-	bind(func() Instruction { return &OpTextureQuerySizeLod{} })
-	bind(func() Instruction { return &OpTextureQuerySize{} })
-	bind(func() Instruction { return &OpTextureQueryLod{} })
-	bind(func() Instruction { return &OpTextureQueryLevels{} })
-	bind(func() Instruction { return &OpTextureQuerySamples{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpSampler{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureSample{Bias: 0} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureSampleDref{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureSampleLod{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureSampleProj{Bias: 0} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureSampleGrad{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureSampleOffset{Bias: 0} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureSampleProjLod{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureSampleProjGrad{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureSampleLodOffset{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureSampleProjOffset{Bias: 0} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureSampleGradOffset{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureSampleProjLodOffset{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureSampleProjGradOffset{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureFetchTexel{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureFetchTexelOffset{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureFetchSample{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureFetchBuffer{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureGather{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureGatherOffset{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureGatherOffsets{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureQuerySizeLod{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureQuerySize{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureQueryLod{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureQueryLevels{} })
+	Dialect.Bind(func() spirv.Instruction { return &OpTextureQuerySamples{} })
 }
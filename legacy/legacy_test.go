@@ -0,0 +1,78 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package legacy
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/jteeuwen/spirv"
+)
+
+func TestLoad(t *testing.T) {
+	fd, err := os.Open("testdata/test.spirv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer fd.Close()
+
+	mod, err := spirv.Load(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mod.Header.Version != SpecificationVersion {
+		t.Fatalf("version mismatch:\nHave: %d\nWant: %d",
+			mod.Header.Version, SpecificationVersion)
+	}
+
+	err = mod.Header.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &OpSource{
+		SourceLanguage: SourceLanguageGLSL,
+		Version:        450,
+	}
+
+	if len(mod.Code) == 0 || !reflect.DeepEqual(mod.Code[0], want) {
+		t.Fatalf("instruction mismatch:\nHave: %v\nWant: %v", mod.Code, want)
+	}
+}
+
+func TestStrip(t *testing.T) {
+	fd, err := os.Open("testdata/test.spirv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer fd.Close()
+
+	mod, err := spirv.Load(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var optional int
+	for _, instr := range mod.Code {
+		if instr.Optional() {
+			optional++
+		}
+	}
+
+	if optional == 0 {
+		t.Fatalf("expected optional instructions in test module")
+	}
+
+	mod.Strip()
+
+	for _, instr := range mod.Code {
+		if instr.Optional() {
+			t.Fatalf("optional instruction not stripped: %v", instr)
+		}
+	}
+}
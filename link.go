@@ -0,0 +1,503 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "fmt"
+
+// Link combines the given modules into a new module. The inputs are
+// left unchanged.
+//
+// The ids of each module are renumbered, so they do not clash with those
+// of the other modules. Capabilities, extensions and extended instruction
+// imports are declared once, as are identical types and constants. Types
+// are only unified if their decorations are identical as well. Debug
+// instructions and annotations are merged section by section, in the
+// order of the modules, with duplicates removed.
+//
+// Functions and global variables decorated with the Import linkage type
+// are resolved against the function or variable decorated with the Export
+// linkage type of the same name. Their declarations are removed, along with
+// their names and decorations, and their uses are replaced with the export.
+// Exports keep their decoration, so the result can be linked again.
+//
+// The ids of the result are compacted, as by CompactIds.
+//
+// Returns an ErrorList of *LinkError values if a module defines the same
+// result id more than once, if the modules declare different memory
+// models, if a name is exported more than once, if an import has no
+// matching export, or if the type of an import differs from that of its
+// export.
+func Link(mods ...*Module) (*Module, error) {
+	l := &linker{
+		source: make(map[Instruction]linkSource),
+		canon:  make(map[Id]Id),
+	}
+
+	// Duplicate ids can not be told apart once they are renumbered.
+	for i, m := range mods {
+		if err := m.verifySSA(); err != nil {
+			for _, e := range err.(ErrorList) {
+				le := e.(*LayoutError)
+				l.errs = append(l.errs, NewLinkError(i, le.Address, "%s", le.Msg))
+			}
+		}
+	}
+
+	if err := l.errs.err(); err != nil {
+		return nil, err
+	}
+
+	out := NewModule()
+	var base Id
+
+	for i, m := range mods {
+		l.add(i, m, base)
+
+		bound := Id(m.Header.Bound)
+		if max := m.maxId() + 1; max > bound {
+			bound = max
+		}
+
+		base += bound
+
+		if i == 0 || m.Header.Version > out.Header.Version {
+			out.Header.Version = m.Header.Version
+		}
+	}
+
+	l.mergeHeaders()
+	l.unify()
+	l.resolve()
+
+	if err := l.errs.err(); err != nil {
+		return nil, err
+	}
+
+	for _, s := range l.sections {
+		for _, instr := range s {
+			RewriteOperands(instr, l.rewrite)
+		}
+		out.Code = append(out.Code, s...)
+	}
+
+	for _, fns := range [][]InstructionList{l.decls, l.defs} {
+		for _, fn := range fns {
+			for _, instr := range fn {
+				RewriteOperands(instr, l.rewrite)
+			}
+			out.Code = append(out.Code, fn...)
+		}
+	}
+
+	out.CompactIds()
+	return out, nil
+}
+
+// linkSource defines where an instruction in a linked module came from.
+type linkSource struct {
+	module int
+	addr   int
+}
+
+// linkSymbol defines a function or variable with linkage attributes.
+type linkSymbol struct {
+	name string
+	id   Id
+	decl Instruction // The LinkageAttributes decoration.
+}
+
+// linker holds the instructions of the modules being linked.
+type linker struct {
+	sections [sectionFunctionDefinition][]Instruction
+	funcs    []InstructionList // All functions, in order.
+	decls    []InstructionList // Function declarations, once resolved.
+	defs     []InstructionList // Function definitions, once resolved.
+
+	source map[Instruction]linkSource
+	canon  map[Id]Id // Replacements for merged ids.
+	errs   ErrorList
+}
+
+// rewrite returns the id which replaces the given one.
+func (l *linker) rewrite(id Id) Id {
+	for {
+		c, ok := l.canon[id]
+		if !ok {
+			return id
+		}
+		id = c
+	}
+}
+
+// replace records that id is replaced with the given one. An id is
+// never replaced with itself, as rewrite would never return.
+func (l *linker) replace(id, with Id) {
+	if id != with {
+		l.canon[id] = with
+	}
+}
+
+// errorf records an error for the given instruction.
+func (l *linker) errorf(instr Instruction, msg string, argv ...interface{}) {
+	s := l.source[instr]
+	l.errs = append(l.errs, NewLinkError(s.module, s.addr, msg, argv...))
+}
+
+// add adds a copy of the instructions in m to their sections. The ids
+// are shifted by base.
+func (l *linker) add(index int, m *Module, base Id) {
+	shift := func(id Id) Id {
+		if id == 0 {
+			return 0
+		}
+		return id + base
+	}
+
+	var fn InstructionList
+
+	for addr, instr := range m.Code {
+		words, err := appendInstruction(nil, instr)
+		if err != nil {
+			l.errs = append(l.errs, NewLinkError(index, addr, "%v", err))
+			continue
+		}
+
+		c, err := DecodeInstruction(words)
+		if err != nil {
+			l.errs = append(l.errs, NewLinkError(index, addr, "%v", err))
+			continue
+		}
+
+		if id, ok := ResultId(c); ok {
+			SetResultId(c, shift(id))
+		}
+
+		RewriteOperands(c, shift)
+
+		// Instructions without operands may all share one address.
+		// None of them are reported through the source map.
+		l.source[c] = linkSource{index, addr}
+
+		if fn != nil || c.Opcode() == opcodeFunction {
+			fn = append(fn, c)
+
+			if c.Opcode() == opcodeFunctionEnd {
+				l.funcs = append(l.funcs, fn)
+				fn = nil
+			}
+			continue
+		}
+
+		section, ok := layoutSectionOf(c.Opcode())
+		if !ok {
+			l.errs = append(l.errs, NewLinkError(index, addr,
+				"%s appears outside of a function", instructionName(c)))
+			continue
+		}
+
+		l.sections[section] = append(l.sections[section], c)
+	}
+
+	if fn != nil {
+		l.errorf(fn[0], "function has no OpFunctionEnd")
+	}
+}
+
+// mergeHeaders removes duplicate capabilities, extensions and extended
+// instruction imports, and checks that all modules use the same memory
+// model.
+func (l *linker) mergeHeaders() {
+	for _, section := range []layoutSection{sectionCapability, sectionExtension} {
+		seen := make(map[string]bool)
+		code := l.sections[section][:0]
+
+		for _, instr := range l.sections[section] {
+			key := linkKey(instr)
+			if !seen[key] {
+				seen[key] = true
+				code = append(code, instr)
+			}
+		}
+
+		l.sections[section] = code
+	}
+
+	imports := make(map[String]Id)
+	code := l.sections[sectionExtInstImport][:0]
+
+	for _, instr := range l.sections[sectionExtInstImport] {
+		v := instr.(*OpExtInstImport)
+		if first, ok := imports[v.Name]; ok {
+			l.replace(v.ResultId, first)
+			continue
+		}
+
+		imports[v.Name] = v.ResultId
+		code = append(code, instr)
+	}
+
+	l.sections[sectionExtInstImport] = code
+
+	if models := l.sections[sectionMemoryModel]; len(models) > 1 {
+		for _, instr := range models[1:] {
+			if linkKey(instr) != linkKey(models[0]) {
+				l.errorf(instr, "memory model differs from that of module %d",
+					l.source[models[0]].module)
+			}
+		}
+
+		l.sections[sectionMemoryModel] = models[:1]
+	}
+}
+
+// unify removes duplicate type and constant declarations. Declarations
+// are duplicates if their operands and decorations are identical.
+// Specialization constants are never unified.
+func (l *linker) unify() {
+	decos := decorationKeys(l.sections[sectionAnnotation])
+
+	seen := make(map[string]Id)
+	code := l.sections[sectionType][:0]
+
+	for _, instr := range l.sections[sectionType] {
+		// Operands are declared before they are used, so
+		// they have been unified already.
+		RewriteOperands(instr, l.rewrite)
+
+		key, ok := unifyKey(instr)
+		if ok {
+			id, _ := ResultId(instr)
+			key += fmt.Sprint(decos[id])
+
+			if first, dup := seen[key]; dup {
+				l.replace(id, first)
+				continue
+			}
+
+			seen[key] = id
+		}
+
+		code = append(code, instr)
+	}
+
+	l.sections[sectionType] = code
+}
+
+// unifyKey returns a key which is the same for all declarations of
+// the same type or constant. Returns false for other instructions.
+func unifyKey(instr Instruction) (string, bool) {
+	switch instr.Opcode() {
+	case opcodeConstantTrue, opcodeConstantFalse, opcodeConstant,
+		opcodeConstantComposite, opcodeConstantSampler, opcodeConstantNull:
+		return instructionKey(instr, 2)
+	}
+
+	if _, ok := typeKindOf(instr.Opcode()); ok {
+		return instructionKey(instr, 1)
+	}
+
+	return "", false
+}
+
+// resolve replaces imports with their exports. The declarations of the
+// imports are removed, along with their names and decorations.
+func (l *linker) resolve() {
+	exports := make(map[string]linkSymbol)
+	var imports []linkSymbol
+
+	for _, instr := range l.sections[sectionAnnotation] {
+		v, ok := instr.(*OpDecorate)
+		if !ok || v.Decoration != DecorationLinkageAttributes || len(v.Argv) < 2 {
+			continue
+		}
+
+		last := len(v.Argv) - 1
+		sym := linkSymbol{
+			name: string(DecodeString(v.Argv[:last])),
+			id:   v.Target,
+			decl: instr,
+		}
+
+		switch LinkageType(v.Argv[last]) {
+		case LinkageTypeExport:
+			if prev, dup := exports[sym.name]; dup {
+				s := l.source[prev.decl]
+				l.errorf(instr, "%q is already exported by module %d at $%08x",
+					sym.name, s.module, s.addr)
+				continue
+			}
+			exports[sym.name] = sym
+
+		case LinkageTypeImport:
+			imports = append(imports, sym)
+		}
+	}
+
+	// The types of functions and variables.
+	types := make(map[Id]Id)
+
+	for _, instr := range l.sections[sectionType] {
+		if v, ok := instr.(*OpVariable); ok {
+			types[v.ResultId] = v.ResultType
+		}
+	}
+
+	for _, fn := range l.funcs {
+		v := fn[0].(*OpFunction)
+		types[v.ResultId] = l.rewrite(v.FunctionType)
+	}
+
+	tt := NewTypeTable(&Module{Code: l.sections[sectionType]})
+	removed := make(map[Id]bool)
+
+	for _, imp := range imports {
+		exp, ok := exports[imp.name]
+		if !ok {
+			l.errorf(imp.decl, "import %q is not exported by any module", imp.name)
+			continue
+		}
+
+		if imp.id == exp.id {
+			l.errorf(imp.decl, "%q is imported and exported by the same declaration", imp.name)
+			continue
+		}
+
+		if types[imp.id] != types[exp.id] {
+			l.errorf(imp.decl, "import %q has type %s, but its export has type %s",
+				imp.name, tt.Type(types[imp.id]), tt.Type(types[exp.id]))
+			continue
+		}
+
+		l.replace(imp.id, exp.id)
+		removed[imp.id] = true
+	}
+
+	l.removeImports(removed)
+}
+
+// removeImports removes the declarations of the given ids, along with
+// their names and decorations. Function declarations and definitions
+// are sorted into their own sections.
+func (l *linker) removeImports(removed map[Id]bool) {
+	for _, fn := range l.funcs {
+		id, _ := ResultId(fn[0])
+		if !removed[id] {
+			if fn.Index(opcodeLabel) == -1 {
+				l.decls = append(l.decls, fn)
+			} else {
+				l.defs = append(l.defs, fn)
+			}
+			continue
+		}
+
+		for _, instr := range fn.Filter(opcodeFunctionParameter) {
+			removed[instr.(*OpFunctionParameter).ResultId] = true
+		}
+	}
+
+	types := l.sections[sectionType][:0]
+
+	for _, instr := range l.sections[sectionType] {
+		if v, ok := instr.(*OpVariable); !ok || !removed[v.ResultId] {
+			types = append(types, instr)
+		}
+	}
+
+	l.sections[sectionType] = types
+
+	// Names and decorations may have become duplicates when
+	// their targets were unified.
+	for _, section := range []layoutSection{sectionDebugName, sectionAnnotation} {
+		seen := make(map[string]bool)
+		code := l.sections[section][:0]
+
+		for _, instr := range l.sections[section] {
+			if !l.keepAnnotation(instr, removed) {
+				continue
+			}
+
+			RewriteOperands(instr, l.rewrite)
+
+			key := linkKey(instr)
+			switch v := instr.(type) {
+			case *OpName:
+				key = fmt.Sprint("name", v.Target)
+			case *OpMemberName:
+				key = fmt.Sprint("member", v.Type, v.Member)
+			}
+
+			if !seen[key] {
+				seen[key] = true
+				code = append(code, instr)
+			}
+		}
+
+		l.sections[section] = code
+	}
+
+	// Linkage is no longer needed once all imports are resolved
+	// and nothing is exported.
+	for _, instr := range l.sections[sectionAnnotation] {
+		if v, ok := instr.(*OpDecorate); ok && v.Decoration == DecorationLinkageAttributes {
+			return
+		}
+	}
+
+	caps := l.sections[sectionCapability][:0]
+
+	for _, instr := range l.sections[sectionCapability] {
+		if instr.(*OpCapability).Capability != CapabilityLinkage {
+			caps = append(caps, instr)
+		}
+	}
+
+	l.sections[sectionCapability] = caps
+}
+
+// keepAnnotation returns false if the given debug name or annotation
+// only refers to removed ids. Removed targets of group decorations
+// are dropped, as are unified ones: the declaration they are unified
+// with has the same decorations already.
+func (l *linker) keepAnnotation(instr Instruction, removed map[Id]bool) bool {
+	drop := func(id Id) bool {
+		_, unified := l.canon[id]
+		return removed[id] || unified
+	}
+
+	switch v := instr.(type) {
+	case *OpName:
+		return !removed[v.Target]
+	case *OpDecorate:
+		return !removed[v.Target]
+
+	case *OpGroupDecorate:
+		targets := v.Targets[:0]
+		for _, id := range v.Targets {
+			if !drop(id) {
+				targets = append(targets, id)
+			}
+		}
+		v.Targets = targets
+		return len(targets) > 0
+
+	case *OpGroupMemberDecorate:
+		targets := v.Targets[:0]
+		for i := 0; i+1 < len(v.Targets); i += 2 {
+			if !drop(Id(v.Targets[i])) {
+				targets = append(targets, v.Targets[i], v.Targets[i+1])
+			}
+		}
+		v.Targets = targets
+		return len(targets) > 0
+	}
+
+	return true
+}
+
+// linkKey returns a key which is the same for all instructions with
+// the same opcode and operands.
+func linkKey(instr Instruction) string {
+	words, _ := appendInstruction(nil, instr)
+	return fmt.Sprint(words)
+}
@@ -0,0 +1,282 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testLinkMain imports a function and a variable from testLinkLibrary.
+const testLinkMain = `
+	     OpCapability Shader
+	     OpCapability Linkage
+	%7 = OpExtInstImport "GLSL.std.450"
+	     OpMemoryModel Logical GLSL450
+	     OpEntryPoint Fragment %10 "main"
+	     OpName %11 "scale"
+	     OpName %12 "gain"
+	     OpDecorate %11 LinkageAttributes "scale" Import
+	     OpDecorate %12 LinkageAttributes "gain" Import
+	%1 = OpTypeVoid
+	%2 = OpTypeFunction %1
+	%3 = OpTypeFloat 32
+	%4 = OpTypeFunction %3 %3
+	%5 = OpTypePointer Private %3
+	%6 = OpConstant %3 2
+	%12 = OpVariable %5 Private
+	%11 = OpFunction %3 None %4
+	%13 = OpFunctionParameter %3
+	     OpFunctionEnd
+	%10 = OpFunction %1 None %2
+	%14 = OpLabel
+	%15 = OpFunctionCall %3 %11 %6
+	     OpStore %12 %15
+	     OpReturn
+	     OpFunctionEnd
+`
+
+const testLinkLibrary = `
+	     OpCapability Shader
+	     OpCapability Linkage
+	%10 = OpExtInstImport "GLSL.std.450"
+	     OpMemoryModel Logical GLSL450
+	     OpName %5 "scale"
+	     OpDecorate %5 LinkageAttributes "scale" Export
+	     OpDecorate %9 LinkageAttributes "gain" Export
+	%1 = OpTypeFloat 32
+	%2 = OpTypeFunction %1 %1
+	%3 = OpConstant %1 2
+	%4 = OpTypePointer Private %1
+	%9 = OpVariable %4 Private %3
+	%5 = OpFunction %1 None %2
+	%6 = OpFunctionParameter %1
+	%7 = OpLabel
+	%11 = OpExtInst %1 %10 4 %6
+	%8 = OpFMul %1 %11 %3
+	     OpReturnValue %8
+	     OpFunctionEnd
+`
+
+func TestLink(t *testing.T) {
+	main, err := Assemble(strings.NewReader(testLinkMain))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lib, err := Assemble(strings.NewReader(testLinkLibrary))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := Assemble(strings.NewReader(`
+		     OpCapability Shader
+		     OpCapability Linkage
+		%1 = OpExtInstImport "GLSL.std.450"
+		     OpMemoryModel Logical GLSL450
+		     OpEntryPoint Fragment %9 "main"
+		     OpName %12 "scale"
+		     OpDecorate %12 LinkageAttributes "scale" Export
+		     OpDecorate %8 LinkageAttributes "gain" Export
+		%2 = OpTypeVoid
+		%3 = OpTypeFunction %2
+		%4 = OpTypeFloat 32
+		%5 = OpTypeFunction %4 %4
+		%6 = OpTypePointer Private %4
+		%7 = OpConstant %4 2
+		%8 = OpVariable %6 Private %7
+		%9 = OpFunction %2 None %3
+		%10 = OpLabel
+		%11 = OpFunctionCall %4 %12 %7
+		     OpStore %8 %11
+		     OpReturn
+		     OpFunctionEnd
+		%12 = OpFunction %4 None %5
+		%13 = OpFunctionParameter %4
+		%14 = OpLabel
+		%15 = OpExtInst %4 %1 4 %13
+		%16 = OpFMul %4 %15 %7
+		     OpReturnValue %16
+		     OpFunctionEnd
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mainCode := append(InstructionList(nil), main.Code...)
+
+	have, err := Link(main, lib)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(have.Code, want.Code) {
+		t.Fatalf("code mismatch:\nHave: %v\nWant: %v", have.Code, want.Code)
+	}
+
+	if err := have.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	if have.Header.Bound != 17 {
+		t.Fatalf("bound mismatch:\nHave: %d\nWant: %d", have.Header.Bound, 17)
+	}
+
+	if !reflect.DeepEqual(main.Code, mainCode) {
+		t.Fatalf("input was modified:\nHave: %v\nWant: %v", main.Code, mainCode)
+	}
+}
+
+func TestLinkGroupDecorations(t *testing.T) {
+	var mods []*Module
+
+	for _, src := range []string{
+		`
+			     OpCapability Shader
+			     OpMemoryModel Logical GLSL450
+			     OpDecorate %10 ArrayStride 16
+			%10 = OpDecorationGroup
+			     OpGroupDecorate %10 %4
+			%1 = OpTypeFloat 32
+			%2 = OpTypeInt 32 0
+			%3 = OpConstant %2 4
+			%4 = OpTypeArray %1 %3
+		`,
+		`
+			     OpCapability Shader
+			     OpMemoryModel Logical GLSL450
+			     OpDecorate %10 ArrayStride 16
+			%10 = OpDecorationGroup
+			     OpGroupDecorate %10 %5
+			%1 = OpTypeFloat 32
+			%2 = OpTypeInt 32 0
+			%3 = OpConstant %2 4
+			%4 = OpTypeArray %1 %3
+			%5 = OpTypeArray %1 %3
+		`,
+	} {
+		m, err := Assemble(strings.NewReader(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		mods = append(mods, m)
+	}
+
+	// The undecorated array is kept apart from the decorated ones.
+	want, err := Assemble(strings.NewReader(`
+		     OpCapability Shader
+		     OpMemoryModel Logical GLSL450
+		     OpDecorate %1 ArrayStride 16
+		%1 = OpDecorationGroup
+		     OpGroupDecorate %1 %6
+		     OpDecorate %2 ArrayStride 16
+		%2 = OpDecorationGroup
+		%3 = OpTypeFloat 32
+		%4 = OpTypeInt 32 0
+		%5 = OpConstant %4 4
+		%6 = OpTypeArray %3 %5
+		%7 = OpTypeArray %3 %5
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	have, err := Link(mods...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(have.Code, want.Code) {
+		t.Fatalf("code mismatch:\nHave: %v\nWant: %v", have.Code, want.Code)
+	}
+}
+
+func TestLinkErrors(t *testing.T) {
+	// testLinkOther exports both symbols with integer types.
+	const testLinkOther = `
+		     OpCapability Linkage
+		     OpMemoryModel Logical Simple
+		     OpDecorate %3 LinkageAttributes "scale" Export
+		     OpDecorate %5 LinkageAttributes "gain" Export
+		%1 = OpTypeInt 32 1
+		%2 = OpTypeFunction %1 %1
+		%4 = OpTypePointer Private %1
+		%5 = OpVariable %4 Private
+		%3 = OpFunction %1 None %2
+		%6 = OpFunctionParameter %1
+		%7 = OpLabel
+		     OpReturnValue %6
+		     OpFunctionEnd
+	`
+
+	for i, st := range []struct {
+		in   []string
+		want ErrorList
+	}{
+		{
+			in: []string{testLinkMain},
+			want: ErrorList{
+				NewLinkError(0, 7, "import \"scale\" is not exported by any module"),
+				NewLinkError(0, 8, "import \"gain\" is not exported by any module"),
+			},
+		},
+		{
+			in: []string{testLinkLibrary, testLinkMain, testLinkLibrary},
+			want: ErrorList{
+				NewLinkError(2, 5, "\"scale\" is already exported by module 0 at $00000005"),
+				NewLinkError(2, 6, "\"gain\" is already exported by module 0 at $00000006"),
+			},
+		},
+		{
+			in: []string{testLinkMain, testLinkOther},
+			want: ErrorList{
+				NewLinkError(1, 1, "memory model differs from that of module 0"),
+				NewLinkError(0, 7, "import \"scale\" has type func(float32) float32, but its export has type func(int32) int32"),
+				NewLinkError(0, 8, "import \"gain\" has type *float32, but its export has type *int32"),
+			},
+		},
+		{
+			in: []string{testLinkLibrary, `
+				     OpCapability Linkage
+				     OpMemoryModel Logical GLSL450
+				%1 = OpTypeInt 32 1
+				%1 = OpTypeInt 32 1
+				%2 = OpTypePointer Private %1
+			`},
+			want: ErrorList{
+				NewLinkError(1, 3, "duplicate ResultId(1); previous definition at: $00000002"),
+			},
+		},
+		{
+			in: []string{`
+				     OpCapability Linkage
+				     OpMemoryModel Logical GLSL450
+				     OpDecorate %3 LinkageAttributes "gain" Export
+				     OpDecorate %3 LinkageAttributes "gain" Import
+				%1 = OpTypeInt 32 1
+				%2 = OpTypePointer Private %1
+				%3 = OpVariable %2 Private
+			`},
+			want: ErrorList{
+				NewLinkError(0, 3, "\"gain\" is imported and exported by the same declaration"),
+			},
+		},
+	} {
+		var mods []*Module
+
+		for _, src := range st.in {
+			m, err := Assemble(strings.NewReader(src))
+			if err != nil {
+				t.Fatalf("case %d: %v", i, err)
+			}
+			mods = append(mods, m)
+		}
+
+		_, have := Link(mods...)
+		if !reflect.DeepEqual(have, st.want) {
+			t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v", i, have, st.want)
+		}
+	}
+}
@@ -25,6 +25,9 @@ func NewModule() *Module {
 }
 
 // Load loads a full module from the given input stream.
+//
+// Modules with an OpSwitch whose selector is wider than 32 bits are
+// not supported. An ErrorList of *LayoutError values is returned for them.
 func Load(r io.Reader) (*Module, error) {
 	var mod Module
 	var err error
@@ -54,11 +57,23 @@ func Load(r io.Reader) (*Module, error) {
 		mod.Code = append(mod.Code, instr)
 	}
 
+	err = mod.verifySwitchSelectors()
+	if err != nil {
+		return nil, err
+	}
+
 	return &mod, nil
 }
 
 // Save writes the module to the given stream.
+//
+// If Header.Bound is not larger than every id used in the module,
+// it is recomputed with RecomputeBound first.
 func (m *Module) Save(w io.Writer) error {
+	if Id(m.Header.Bound) <= m.maxId() {
+		m.RecomputeBound()
+	}
+
 	enc := NewEncoder(w)
 
 	// Write the header.
@@ -81,82 +96,25 @@ func (m *Module) Save(w io.Writer) error {
 // Verify returns an error if the module contains invalid data.
 //
 // This applies a host of different levels of structural and semantic
-// validation as defined in the spec chapter 2.15.
+// validation as defined in the spec chapter 2.16. It returns the first
+// problem found by Validate.
 func (m *Module) Verify() error {
-	// Check the header for structural validity.
-	err := m.Header.Verify()
-	if err != nil {
-		return err
-	}
-
-	// Perform structural validity checks on each instruction, before
-	// we proceed to the semantic checks.
-	//
-	// This uses reflection to call Verify() on all relevant struct fields
-	// and then on the instruction itself. The latter is used by some
-	// instructions to validate parts which can not be caught by the field
-	// types themselves.
-	for _, instr := range m.Code {
-		err := verifyInstruction(instr)
-		if err != nil {
-			return err
-		}
+	d := Validate(m, ValidateOptions{MaxDiagnostics: 1})
+	if len(d) > 0 {
+		return d[0].Err
 	}
-
-	// Ensure logical layout is up to standards.
-	err = m.verifyLogicalLayout()
-	if err != nil {
-		return err
-	}
-
-	// TODO: Non-structure types (scalars, vectors, arrays, etc.) with the same
-	// operand parameterization cannot be type aliases. For nonstructures, two
-	// type <id>s match iff the types match.
-
-	// Perform some checks related to Logical addressing mode.
-	err = m.verifyLogicalAddressing()
-	if err != nil {
-		return err
-	}
-
-	// Check the validity of result IDs.
-	err = m.verifySSA()
-	if err != nil {
-		return err
-	}
-
-	// Check validity of entry point usage.
-	err = m.verifyEntrypoints()
-	if err != nil {
-		return err
-	}
-
 	return nil
 }
 
 // Strip removes all instructions which have no semantic impact on the code.
 // This includes debug symbols like source context and names.
+// Use StripWith to remove only some of them.
 func (m *Module) Strip() {
-	for i := 0; i < len(m.Code); i++ {
-		if !m.Code[i].Optional() {
-			continue
-		}
-
-		copy(m.Code[i:], m.Code[i+1:])
-		m.Code[len(m.Code)-1] = nil
-		m.Code = m.Code[:len(m.Code)-1]
-		i--
-	}
+	m.StripWith(StripOptions{})
 }
 
 // verifyEntrypoints performs some sanity checks on entrypoint definitions.
 func (m *Module) verifyEntrypoints() error {
-	// There must be at least 1 OpEntryPoint, unless a Link capability
-	// is being used.
-	if !m.hasLinkageType() && m.Code.Count(opcodeEntryPoint) == 0 {
-		return NewLayoutError(0, "unless the Linkage capabilities are used, we require at least 1 OpEntryPoint")
-	}
-
 	// No function can be targeted by both an OpEntryPoint instruction and an
 	// OpFunctionCall instruction.
 	entries := m.Code.FilterIndex(opcodeEntryPoint, 0)
@@ -166,35 +124,35 @@ func (m *Module) verifyEntrypoints() error {
 		return nil
 	}
 
+	var errs ErrorList
+
 	for _, c := range calls {
 		fc := m.Code[c].(*OpFunctionCall)
-		addr := m.hasResultId(fc.Function, entries)
-		if addr > -1 {
-			return NewLayoutError(
-				c, "call to function previously defined as entrypoint at $%08x", addr,
-			)
+
+		for _, e := range entries {
+			ep := m.Code[e].(*OpEntryPoint)
+			if ep.EntryPoint == fc.Function {
+				errs = append(errs, NewLayoutError(
+					c, "call to function previously defined as entrypoint at $%08x", e,
+				))
+			}
 		}
 	}
 
-	return nil
+	return errs.err()
 }
 
 // verifySSA performs some sanity checks on result id values, as defined
-// in chapter 2.15.1 of the specification.
+// in chapter 2.16.1 of the specification.
 func (m *Module) verifySSA() error {
 	// Each <id> must appear exactly once as the result <id> of an instruction.
 	//
 	// Create a list of all result ids and ensure there are no duplicates.
 	set := make(map[Id]int)
+	var errs ErrorList
 
 	for addr, instr := range m.Code {
-		if _, ok := instr.(*OpEntryPoint); ok {
-			// ResultId is entry point target. Not id for this
-			// instruction itself -- ignore it.
-			continue
-		}
-
-		id, ok := instructionResultId(instr)
+		id, ok := ResultId(instr)
 		if !ok {
 			continue
 		}
@@ -205,39 +163,183 @@ func (m *Module) verifySSA() error {
 			continue
 		}
 
-		return NewLayoutError(
+		errs = append(errs, NewLayoutError(
 			addr, "duplicate ResultId(%d); previous definition at: $%08x",
 			id, paddr,
-		)
+		))
 	}
 
-	// TODO: The definition of an SSA <id> should dominate all uses of it,
-	// with the following exceptions :... (see spec)
+	return errs.err()
+}
 
-	return nil
+// verifyDominance ensures that the definition of each <id> dominates
+// all of its uses. The specification allows the following exceptions:
+//
+//   - Debug and annotation instructions, OpEntryPoint and OpExecutionMode
+//     may refer to ids which are defined later on.
+//   - OpFunctionCall may call a function which is defined later on.
+//   - A pointer type declared by OpTypeForwardPointer may be used before
+//     its OpTypePointer definition.
+//   - An OpPhi operand need only dominate the end of its parent block.
+//
+// Block labels are exempt as well; branches are checked by the control
+// flow graph. Uses in unreachable blocks are not checked.
+//
+// All violations are returned as an ErrorList of *LayoutError values.
+func (m *Module) verifyDominance() error {
+	code := m.Code
+	du := NewDefUse(m)
+
+	fns, err := m.functions()
+	if err != nil {
+		return nil // Reported by verifyFunctionStructure.
+	}
+
+	// Unpaired OpFunction and OpFunctionEnd instructions yield no
+	// functions at all, so nothing can be said about dominance.
+	if len(fns) != code.Count(opcodeFunction) || len(fns) != code.Count(opcodeFunctionEnd) {
+		return nil // Reported by verifyLayoutOrder.
+	}
+
+	// Map each address to its function and block.
+	funcs := make([]int, len(code))
+	blocks := make([]*Block, len(code))
+
+	for i := range funcs {
+		funcs[i] = -1
+	}
+
+	for i, fn := range fns {
+		for addr := fn.start; addr <= fn.end; addr++ {
+			funcs[addr] = i
+		}
+
+		for _, b := range fn.cfg.Blocks {
+			for k := range b.Code {
+				blocks[fn.start+b.Address+k] = b
+			}
+		}
+	}
+
+	forward := make(map[Id]bool)
+	for _, instr := range code.Filter(opcodeTypeForwardPointer) {
+		forward[instr.(*OpTypeForwardPointer).PointerType] = true
+	}
+
+	// dominates returns true if def dominates the instruction at addr.
+	// If end is true, def need only dominate the end of addr's block.
+	dominates := func(def Def, addr int, end bool) bool {
+		df, uf := funcs[def.Address], funcs[addr]
+		db, ub := blocks[def.Address], blocks[addr]
+
+		switch {
+		case df < 0:
+			return def.Address < addr
+		case df != uf:
+			return false
+		case db == nil:
+			return def.Address < addr
+		case db == ub:
+			return end || def.Address < addr
+		default:
+			return fns[uf].dom.Dominates(db, ub)
+		}
+	}
+
+	var errs ErrorList
+	var operands []Id
+
+	for addr, instr := range code {
+		switch instr.Opcode() {
+		case opcodeName, opcodeMemberName, opcodeDecorate, opcodeMemberDecorate,
+			opcodeGroupDecorate, opcodeGroupMemberDecorate, opcodeEntryPoint,
+			opcodeExecutionMode:
+			continue
+		}
+
+		if b := blocks[addr]; b != nil && !fns[funcs[addr]].dom.Contains(b) {
+			continue
+		}
+
+		var phi *OpPhi
+		var call Id
+
+		switch v := instr.(type) {
+		case *OpPhi:
+			phi = v
+		case *OpFunctionCall:
+			call = v.Function
+		}
+
+		operands = appendOperands(operands[:0], instr)
+
+		for j, id := range operands {
+			def, ok := du.Def(id)
+			if !ok {
+				errs = append(errs, NewLayoutError(addr, "use of undefined id %d", id))
+				continue
+			}
+
+			if def.Instruction.Opcode() == opcodeLabel || forward[id] || (call != 0 && id == call) {
+				continue
+			}
+
+			// Phi operands follow the result type, as (variable, parent) pairs.
+			// A phi outside of a function has no parent blocks; it is
+			// reported by verifyLayoutOrder.
+			if phi != nil && funcs[addr] < 0 {
+				continue
+			}
+
+			if phi != nil && j%2 == 1 && j+1 < len(operands) {
+				fn := fns[funcs[addr]]
+				parent := fn.cfg.Block(operands[j+1])
+				if parent == nil || !fn.dom.Contains(parent) {
+					continue
+				}
+
+				if !dominates(def, fn.start+parent.Address, true) {
+					errs = append(errs, NewLayoutError(addr,
+						"use of %d is not dominated by its definition at $%08x in parent block %d",
+						id, def.Address, parent.Label))
+				}
+
+				continue
+			}
+
+			if !dominates(def, addr, false) {
+				errs = append(errs, NewLayoutError(addr,
+					"use of %d is not dominated by its definition at $%08x", id, def.Address))
+			}
+		}
+	}
+
+	return errs.err()
 }
 
-// hasResultId returns the addr of a list entry, if src has a ResultId
-// matching any of the Result Ids in list. Returns -1 otherwise.
-func (m *Module) hasResultId(src Id, list []int) int {
-	for _, addr := range list {
-		dst, ok := instructionResultId(m.Code[addr])
-		if ok && src == dst {
-			return addr
+// hasCapability returns true if the module declares the given capability.
+func (m *Module) hasCapability(c Capability) bool {
+	for _, i := range m.Code.Filter(opcodeCapability) {
+		v := i.(*OpCapability)
+		if v.Capability == c {
+			return true
 		}
 	}
 
-	return -1
+	return false
 }
 
-// hasLinkageType returns true if there is a OpDecorate instance
-// on a (global) variable or function with a LinkageType defined.
+// hasLinkageType returns true if the module declares the Linkage
+// capability, or if there is a OpDecorate instance on a (global)
+// variable or function with LinkageAttributes defined.
 func (m *Module) hasLinkageType() bool {
-	decorations := m.Code.Filter(opcodeDecorate)
+	if m.hasCapability(CapabilityLinkage) {
+		return true
+	}
 
-	for _, i := range decorations {
+	for _, i := range m.Code.Filter(opcodeDecorate) {
 		v := i.(*OpDecorate)
-		if v.Decoration == DecorationLinkageType {
+		if v.Decoration == DecorationLinkageAttributes {
 			return true
 		}
 	}
@@ -248,10 +350,13 @@ func (m *Module) hasLinkageType() bool {
 // verifyLogicalAddressing performs a number of checks if the logical
 // addressing mode is selected for this module.
 func (m *Module) verifyLogicalAddressing() error {
-	v := m.Code.First(opcodeMemoryModel).(*OpMemoryModel)
+	v, ok := m.Code.First(opcodeMemoryModel).(*OpMemoryModel)
+	if !ok {
+		return nil // Reported by verifyMemoryModel.
+	}
 
-	if v.AddressingModel != AddressingModeLogical {
-		// These rules apply only to AddressingModeLogical
+	if v.AddressingModel != AddressingModelLogical {
+		// These rules apply only to AddressingModelLogical
 		return nil
 	}
 
@@ -264,52 +369,27 @@ func (m *Module) verifyLogicalAddressing() error {
 
 // verifyLogicalLayout ensures the module meets the Logical Layout
 // requirements as defined in the spec chapter 2.4.
+// It returns the first problem found.
 func (m *Module) verifyLogicalLayout() error {
-	// We must have one and only one OpmemoryModel.
-	//
-	// This will be caught by the regex match below, but here we can be
-	// more specific with our error message.
+	return m.firstError(layoutChecks)
+}
+
+// verifyMemoryModel ensures we have one and only one OpMemoryModel.
+//
+// This will be caught by the section order check as well, but here
+// we can be more specific with our error message.
+func (m *Module) verifyMemoryModel() error {
 	if m.Code.Count(opcodeMemoryModel) != 1 {
 		return ErrMemoryModel
 	}
+	return nil
+}
 
-	// We must have at least one OpEntryPoint.
-	//
-	// This will be caught by the regex match below, but here we can be
-	// more specific with our error message.
-	if m.Code.Count(opcodeEntryPoint) == 0 {
+// verifyEntrypointCount ensures we have at least one OpEntryPoint,
+// unless the module uses the Linkage capability.
+func (m *Module) verifyEntrypointCount() error {
+	if !m.hasLinkageType() && m.Code.Count(opcodeEntryPoint) == 0 {
 		return ErrEntrypoint
 	}
-
-	// We must have at least one OpExecutionMode.
-	//
-	// This will be caught by the regex match below, but here we can be
-	// more specific with our error message.
-	if m.Code.Count(opcodeExecutionMode) == 0 {
-		return ErrExecutionMode
-	}
-
-	// Test instruction order.
-	err := verifyLayoutPattern(m.Code)
-	if err != nil {
-		return err
-	}
-
-	// Some instructions have requirements beyond what can be tested
-	// with a regular expression pattern.
-
-	// Global Variables must not have StorageClassFunction.
-	err = verifyGlobalVariables(m.Code)
-	if err != nil {
-		return err
-	}
-
-	// Local Variables must have StorageClassFunction.
-	err = verifyLocalVariables(m.Code)
-	if err != nil {
-		return err
-	}
-
-	// All local variables must be the first instructions in the first block.
-	return verifyFunctionStructure(m.Code)
+	return nil
 }
@@ -5,18 +5,19 @@ package spirv
 
 import (
 	"bytes"
+	"io/ioutil"
 	"reflect"
+	"strings"
 	"testing"
 )
 
 var mod = NewModule()
 
 func TestModuleVerifyLogicalLayout1(t *testing.T) {
-	// CompileFlag instruction is too early.
+	// Capability instruction is too late.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
-		&OpSource{},
 		&OpMemoryModel{},
+		&OpCapability{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
 
@@ -33,16 +34,18 @@ func TestModuleVerifyLogicalLayout1(t *testing.T) {
 func TestModuleVerifyLogicalLayout2(t *testing.T) {
 	// Valid module.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
 
 		&OpFunction{},
 		&OpFunctionParameter{},
-		&OpLabel{},
+		&OpLabel{ResultId: 1},
 		&OpIAdd{},
-		&OpBranch{},
+		&OpBranch{TargetLabel: 2},
+		&OpLabel{ResultId: 2},
+		&OpReturn{},
 		&OpFunctionEnd{},
 	}
 
@@ -118,7 +121,7 @@ func TestModuleVerifyLogicalLayout6(t *testing.T) {
 }
 
 func TestModuleVerifyLogicalLayout7(t *testing.T) {
-	// Missing Execution Mode
+	// Valid module without an execution mode.
 	mod.Code = []Instruction{
 		&OpMemoryModel{},
 		&OpEntryPoint{},
@@ -127,17 +130,15 @@ func TestModuleVerifyLogicalLayout7(t *testing.T) {
 	}
 
 	err := mod.verifyLogicalLayout()
-	want := ErrExecutionMode
-
-	if !reflect.DeepEqual(err, want) {
-		t.Fatalf("error mismatch:\nWant: %v\nHave: %v", want, err)
+	if err != nil {
+		t.Fatal(err)
 	}
 }
 
 func TestModuleVerifyLogicalLayout8(t *testing.T) {
 	// Variables inside functions must have StorageClassFunction
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -163,7 +164,7 @@ func TestModuleVerifyLogicalLayout8(t *testing.T) {
 func TestModuleVerifyLogicalLayout9(t *testing.T) {
 	// Variables inside functions must be contained in the first block.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -171,14 +172,14 @@ func TestModuleVerifyLogicalLayout9(t *testing.T) {
 		&OpFunction{},
 		&OpFunctionParameter{},
 
-		&OpLabel{},
-		&OpBranch{},
+		&OpLabel{ResultId: 1},
+		&OpBranch{TargetLabel: 2},
 
-		&OpLabel{},
+		&OpLabel{ResultId: 2},
 		&OpVariable{
 			StorageClass: StorageClassFunction,
 		},
-		&OpBranch{},
+		&OpBranch{TargetLabel: 2},
 
 		&OpFunctionEnd{},
 	}
@@ -195,7 +196,7 @@ func TestModuleVerifyLogicalLayout10(t *testing.T) {
 	// Variables inside functions must be contained in the first block and
 	// not be preceeded by any other instruction.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -221,13 +222,43 @@ func TestModuleVerifyLogicalLayout10(t *testing.T) {
 	}
 }
 
+func TestModuleVerifyLogicalLayout11(t *testing.T) {
+	// Valid module: variables at the start of the first block.
+	mod.Code = []Instruction{
+		&OpCapability{},
+		&OpMemoryModel{},
+		&OpEntryPoint{},
+		&OpExecutionMode{},
+
+		&OpFunction{},
+		&OpFunctionParameter{},
+
+		&OpLabel{ResultId: 1},
+		&OpVariable{
+			StorageClass: StorageClassFunction,
+		},
+		&OpVariable{
+			StorageClass: StorageClassFunction,
+		},
+		&OpIAdd{},
+		&OpBranch{TargetLabel: 1},
+
+		&OpFunctionEnd{},
+	}
+
+	err := mod.verifyLogicalLayout()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestModuleVerifyLogicalAddressing1(t *testing.T) {
 	// Faulty module: variable allocates pointer type while
 	// memory model is Logical.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{
-			AddressingModel: AddressingModeLogical,
+			AddressingModel: AddressingModelLogical,
 		},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -249,7 +280,7 @@ func TestModuleVerifyLogicalAddressing1(t *testing.T) {
 func TestModuleVerifySSA1(t *testing.T) {
 	// Faulty module: 2 identical result IDs.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -261,7 +292,9 @@ func TestModuleVerifySSA1(t *testing.T) {
 		&OpFunctionEnd{},
 	}
 
-	want := NewLayoutError(6, "duplicate ResultId(%d); previous definition at: $%08x", 1, 4)
+	want := ErrorList{
+		NewLayoutError(6, "duplicate ResultId(%d); previous definition at: $%08x", 1, 4),
+	}
 	have := mod.verifySSA()
 
 	if !reflect.DeepEqual(have, want) {
@@ -269,11 +302,165 @@ func TestModuleVerifySSA1(t *testing.T) {
 	}
 }
 
+const testDominancePrefix = `
+	%1 = OpTypeVoid
+	%2 = OpTypeFunction %1
+	%3 = OpTypeBool
+	%4 = OpTypeInt 32 1
+	%5 = OpConstantTrue %3
+	%6 = OpConstant %4 1
+`
+
+func TestModuleVerifyDominance(t *testing.T) {
+	for i, st := range []struct {
+		src  string
+		want error
+	}{
+		{
+			// Valid forward references, a phi operand which does not
+			// dominate the phi and an unreachable use.
+			src: `
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				%12 = OpFunctionCall %1 %20
+				     OpSelectionMerge %14 None
+				     OpBranchConditional %5 %13 %14
+				%13 = OpLabel
+				%15 = OpIAdd %4 %6 %6
+				     OpBranch %14
+				%14 = OpLabel
+				%16 = OpPhi %4 %15 %13 %6 %11
+				%17 = OpIAdd %4 %16 %6
+				     OpReturn
+				%18 = OpLabel
+				%19 = OpIAdd %4 %30 %17
+				     OpReturn
+				     OpFunctionEnd
+				     OpName %20 "later"
+				%20 = OpFunction %1 None %2
+				%21 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+		},
+		{
+			src: `
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				%12 = OpIAdd %4 %13 %6
+				%13 = OpIAdd %4 %6 %6
+				     OpReturn
+				     OpFunctionEnd`,
+			want: ErrorList{
+				NewLayoutError(8, "use of 13 is not dominated by its definition at $00000009"),
+			},
+		},
+		{
+			src: `
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				     OpSelectionMerge %14 None
+				     OpBranchConditional %5 %12 %13
+				%12 = OpLabel
+				%15 = OpIAdd %4 %6 %6
+				     OpBranch %14
+				%13 = OpLabel
+				%16 = OpIAdd %4 %15 %15
+				     OpBranch %14
+				%14 = OpLabel
+				%17 = OpIAdd %4 %15 %6
+				     OpReturn
+				     OpFunctionEnd`,
+			want: ErrorList{
+				NewLayoutError(14, "use of 15 is not dominated by its definition at $0000000b"),
+				NewLayoutError(14, "use of 15 is not dominated by its definition at $0000000b"),
+				NewLayoutError(17, "use of 15 is not dominated by its definition at $0000000b"),
+			},
+		},
+		{
+			src: `
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				     OpSelectionMerge %14 None
+				     OpBranchConditional %5 %12 %13
+				%12 = OpLabel
+				%15 = OpIAdd %4 %6 %6
+				     OpBranch %14
+				%13 = OpLabel
+				     OpBranch %14
+				%14 = OpLabel
+				%16 = OpPhi %4 %15 %12 %15 %13
+				     OpReturn
+				     OpFunctionEnd`,
+			want: ErrorList{
+				NewLayoutError(16, "use of 15 is not dominated by its definition at $0000000b in parent block 13"),
+			},
+		},
+		{
+			// Uses across functions and of undefined ids.
+			src: `
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				%12 = OpIAdd %4 %6 %6
+				     OpReturn
+				     OpFunctionEnd
+				%20 = OpFunction %1 None %2
+				%21 = OpLabel
+				%22 = OpIAdd %4 %12 %31
+				     OpReturn
+				     OpFunctionEnd`,
+			want: ErrorList{
+				NewLayoutError(13, "use of 12 is not dominated by its definition at $00000008"),
+				NewLayoutError(13, "use of undefined id 31"),
+			},
+		},
+		{
+			// Forward pointers are allowed; other global forward
+			// references are not.
+			src: `
+				     OpTypeForwardPointer %30 Function
+				%31 = OpTypeStruct %30
+				%30 = OpTypePointer Function %31
+				%7 = OpConstant %8 1
+				%8 = OpTypeInt 32 0`,
+			want: ErrorList{
+				NewLayoutError(9, "use of 8 is not dominated by its definition at $0000000a"),
+			},
+		},
+	} {
+		m, err := Assemble(strings.NewReader(testDominancePrefix + st.src))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		have := m.verifyDominance()
+		if !reflect.DeepEqual(have, st.want) {
+			t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v", i, have, st.want)
+		}
+	}
+}
+
+func TestModuleVerify(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/test.spirv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := Load(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = m.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestModuleVerifyEntrypoints1(t *testing.T) {
-	// Faulty module: missing OpEntryPoint and no LinkageType decoration
-	// used to offset its absence.
+	// Faulty module: missing OpEntryPoint and no LinkageAttributes
+	// decoration used to offset its absence.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpExecutionMode{},
 
@@ -281,8 +468,8 @@ func TestModuleVerifyEntrypoints1(t *testing.T) {
 		&OpFunctionEnd{},
 	}
 
-	want := NewLayoutError(0, "unless the Linkage capabilities are used, we require at least 1 OpEntryPoint")
-	have := mod.verifyEntrypoints()
+	want := ErrEntrypoint
+	have := mod.verifyEntrypointCount()
 
 	if !reflect.DeepEqual(have, want) {
 		t.Fatalf("error mismatch:\nWant: %v\nHave: %v", want, have)
@@ -290,21 +477,21 @@ func TestModuleVerifyEntrypoints1(t *testing.T) {
 }
 
 func TestModuleVerifyEntrypoints2(t *testing.T) {
-	// Good module: missing OpEntryPoint but a LinkageType decoration
-	// is present.
+	// Good module: missing OpEntryPoint but a LinkageAttributes
+	// decoration is present.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpExecutionMode{},
 
 		&OpDecorate{
-			Decoration: DecorationLinkageType,
+			Decoration: DecorationLinkageAttributes,
 		},
 		&OpFunction{},
 		&OpFunctionEnd{},
 	}
 
-	err := mod.verifyEntrypoints()
+	err := mod.verifyEntrypointCount()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -315,11 +502,11 @@ func TestModuleVerifyEntrypoints3(t *testing.T) {
 	// OpFunctionCall. This is akin to a C/Go/etc program calling
 	// main() from somewhere in the program. This is not allowed in SPIR-V.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{
 			ExecutionModel: ExecutionModelFragment,
-			ResultId:       1,
+			EntryPoint:     1,
 		},
 		&OpExecutionMode{},
 
@@ -333,7 +520,9 @@ func TestModuleVerifyEntrypoints3(t *testing.T) {
 		&OpFunctionEnd{},
 	}
 
-	want := NewLayoutError(6, "call to function previously defined as entrypoint at $%08x", 2)
+	want := ErrorList{
+		NewLayoutError(6, "call to function previously defined as entrypoint at $%08x", 2),
+	}
 	have := mod.verifyEntrypoints()
 
 	if !reflect.DeepEqual(have, want) {
@@ -341,14 +530,36 @@ func TestModuleVerifyEntrypoints3(t *testing.T) {
 	}
 }
 
+func TestModuleVerifyEntrypoints4(t *testing.T) {
+	// Good module: missing OpEntryPoint but the Linkage capability
+	// is declared.
+	mod.Code = []Instruction{
+		&OpCapability{
+			Capability: CapabilityLinkage,
+		},
+		&OpMemoryModel{},
+
+		&OpFunction{},
+		&OpFunctionEnd{},
+	}
+
+	err := mod.verifyEntrypointCount()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestModuleStrip(t *testing.T) {
 	mod.Code = []Instruction{
-		&OpSource{SourceLanguageGLSL, 450},
-		&OpCompileFlag{
-			Flag: "test",
+		&OpCapability{
+			Capability: CapabilityShader,
+		},
+		&OpSource{
+			SourceLanguage: SourceLanguageGLSL,
+			Version:        450,
 		},
 		&OpMemoryModel{
-			AddressingModel: AddressingModeLogical,
+			AddressingModel: AddressingModelLogical,
 			MemoryModel:     MemoryModelGLSL450,
 		},
 	}
@@ -0,0 +1,211 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "reflect"
+
+// idLister is implemented by instructions which can list the ids they
+// define and use, without the use of reflection. The instructions in
+// this package implement it through generated code.
+type idLister interface {
+	// resultId returns the instruction's result id, provided
+	// it defines one.
+	resultId() (Id, bool)
+
+	// setResultId sets the instruction's result id, provided
+	// it defines one.
+	setResultId(id Id) bool
+
+	// resultType returns the instruction's result type, provided
+	// it has one.
+	resultType() (Id, bool)
+
+	// appendOperands appends the ids used by the instruction to out
+	// and returns the extended slice.
+	appendOperands(out []Id) []Id
+
+	// rewriteOperands replaces each id used by the instruction
+	// with the result of f.
+	rewriteOperands(f func(Id) Id)
+}
+
+// idType is the reflected type of an Id.
+var idType = reflect.TypeOf(Id(0))
+
+// ResultId returns the value of the instruction's result id,
+// provided it defines one.
+func ResultId(i Instruction) (Id, bool) {
+	il, ok := i.(idLister)
+	if ok {
+		return il.resultId()
+	}
+
+	rv := reflect.ValueOf(i)
+	rv = reflect.Indirect(rv)
+
+	field := rv.FieldByName("ResultId")
+	if field.Kind() == reflect.Invalid || field.Type() != idType {
+		return 0, false
+	}
+
+	return Id(field.Uint()), true
+}
+
+// SetResultId sets the instruction's result id, provided it defines one.
+// Returns false if it does not.
+func SetResultId(i Instruction, id Id) bool {
+	il, ok := i.(idLister)
+	if ok {
+		return il.setResultId(id)
+	}
+
+	rv := reflect.ValueOf(i)
+	rv = reflect.Indirect(rv)
+
+	field := rv.FieldByName("ResultId")
+	if field.Kind() == reflect.Invalid || field.Type() != idType {
+		return false
+	}
+
+	field.SetUint(uint64(id))
+	return true
+}
+
+// ResultType returns the id of the instruction's result type,
+// provided it has one.
+func ResultType(i Instruction) (Id, bool) {
+	il, ok := i.(idLister)
+	if ok {
+		return il.resultType()
+	}
+
+	rv := reflect.ValueOf(i)
+	rv = reflect.Indirect(rv)
+
+	field := rv.FieldByName("ResultType")
+	if field.Kind() == reflect.Invalid || field.Type() != idType {
+		return 0, false
+	}
+
+	return Id(field.Uint()), true
+}
+
+// Operands returns the ids used by the given instruction, in the order
+// in which they appear. This includes the result type, but not the
+// result id. Optional ids which are not set, are omitted.
+//
+// For instructions defined outside of this package, the operands are
+// found through reflection. This only considers fields of type Id and
+// []Id, other than ResultId.
+//
+// The case literals of an OpSwitch are taken to be one word wide.
+// Load and Assemble reject modules with selectors wider than 32 bits.
+func Operands(i Instruction) []Id {
+	return appendOperands(nil, i)
+}
+
+// appendOperands appends the ids used by the given instruction to out.
+func appendOperands(out []Id, i Instruction) []Id {
+	il, ok := i.(idLister)
+	if ok {
+		return il.appendOperands(out)
+	}
+
+	rv := reflect.ValueOf(i)
+	rv = reflect.Indirect(rv)
+	rt := rv.Type()
+
+	for j := 0; j < rv.NumField(); j++ {
+		fv := rv.Field(j)
+		ft := rt.Field(j)
+
+		switch {
+		case ft.Name == "ResultId":
+			continue
+
+		case ft.Type == idType:
+			if fv.Uint() == 0 && hasFieldOption(ft.Tag.Get("spirv"), "optional") {
+				continue
+			}
+
+			out = append(out, Id(fv.Uint()))
+
+		case ft.Type.Kind() == reflect.Slice && ft.Type.Elem() == idType:
+			for k := 0; k < fv.Len(); k++ {
+				out = append(out, Id(fv.Index(k).Uint()))
+			}
+		}
+	}
+
+	return out
+}
+
+// RewriteOperands replaces each id used by the given instruction with
+// the result of f. It visits the same ids as Operands, in the same order.
+// The result id is not changed.
+func RewriteOperands(i Instruction, f func(Id) Id) {
+	il, ok := i.(idLister)
+	if ok {
+		il.rewriteOperands(f)
+		return
+	}
+
+	rv := reflect.ValueOf(i)
+	rv = reflect.Indirect(rv)
+	rt := rv.Type()
+
+	for j := 0; j < rv.NumField(); j++ {
+		fv := rv.Field(j)
+		ft := rt.Field(j)
+
+		switch {
+		case ft.Name == "ResultId":
+			continue
+
+		case ft.Type == idType:
+			if fv.Uint() == 0 && hasFieldOption(ft.Tag.Get("spirv"), "optional") {
+				continue
+			}
+
+			fv.SetUint(uint64(f(Id(fv.Uint()))))
+
+		case ft.Type.Kind() == reflect.Slice && ft.Type.Elem() == idType:
+			for k := 0; k < fv.Len(); k++ {
+				ev := fv.Index(k)
+				ev.SetUint(uint64(f(Id(ev.Uint()))))
+			}
+		}
+	}
+}
+
+// verifySwitchSelectors checks that the selector of every OpSwitch in
+// the module is no wider than 32 bits. The case literals of an OpSwitch
+// are as wide as its selector, but this package reads its Target as
+// (literal, label) pairs of one word each. Wider selectors are rejected,
+// rather than having their literals mistaken for labels.
+//
+// All violations are returned as an ErrorList of *LayoutError values.
+func (m *Module) verifySwitchSelectors() error {
+	var errs ErrorList
+	var tt *TypeTable
+
+	for addr, instr := range m.Code {
+		v, ok := instr.(*OpSwitch)
+		if !ok {
+			continue
+		}
+
+		if tt == nil {
+			tt = NewTypeTable(m)
+		}
+
+		if t := tt.TypeOf(v.Selector); t != nil && t.Width > 32 {
+			errs = append(errs, NewLayoutError(addr,
+				"OpSwitch selector %d is %d bits wide; only selectors of up to 32 bits are supported",
+				v.Selector, t.Width))
+		}
+	}
+
+	return errs.err()
+}
@@ -0,0 +1,190 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testReflectIds is an instruction without a generated idLister
+// implementation, so its ids are found through reflection.
+type testReflectIds struct {
+	ResultType Id
+	ResultId   Id
+	Base       Id
+	Literal    uint32
+	Offset     Id `spirv:"optional"`
+	Indices    []Id
+}
+
+func (c *testReflectIds) Opcode() uint32 { return 0xffff }
+func (c *testReflectIds) Optional() bool { return false }
+func (c *testReflectIds) Verify() error  { return nil }
+
+type OperandsTest struct {
+	in       Instruction
+	result   Id
+	ok       bool
+	typ      Id
+	operands []Id
+}
+
+func TestOperands(t *testing.T) {
+	for i, st := range []OperandsTest{
+		{
+			in:     &OpTypeVoid{ResultId: 1},
+			result: 1,
+			ok:     true,
+		},
+		{
+			in:       &OpLoad{ResultType: 1, ResultId: 2, Pointer: 3},
+			result:   2,
+			typ:      1,
+			ok:       true,
+			operands: []Id{1, 3},
+		},
+		{
+			in:       &OpStore{Pointer: 1, Object: 2},
+			operands: []Id{1, 2},
+		},
+		{
+			in:       &OpSource{SourceLanguage: SourceLanguageGLSL, Version: 450},
+			operands: nil,
+		},
+		{
+			in:       &OpSource{SourceLanguage: SourceLanguageGLSL, Version: 450, File: 4},
+			operands: []Id{4},
+		},
+		{
+			in:       &OpPhi{ResultType: 1, ResultId: 2, Operands: []Id{3, 4, 5, 6}},
+			result:   2,
+			typ:      1,
+			ok:       true,
+			operands: []Id{1, 3, 4, 5, 6},
+		},
+		{
+			in:       &OpSwitch{Selector: 1, Default: 2, Target: []uint32{10, 3, 20, 4}},
+			operands: []Id{1, 2, 3, 4},
+		},
+		{
+			in:       &OpGroupMemberDecorate{Group: 1, Targets: []uint32{2, 0, 3, 1}},
+			operands: []Id{1, 2, 3},
+		},
+		{
+			in: &OpImageSampleImplicitLod{
+				ResultType: 1, ResultId: 2, SampledImage: 3, Coordinate: 4,
+				ImageOperands: ImageOperandsBias, Argv: []Id{5},
+			},
+			result:   2,
+			typ:      1,
+			ok:       true,
+			operands: []Id{1, 3, 4, 5},
+		},
+		{
+			in:       &testReflectIds{ResultType: 1, ResultId: 2, Base: 3, Literal: 4, Indices: []Id{5, 6}},
+			result:   2,
+			typ:      1,
+			ok:       true,
+			operands: []Id{1, 3, 5, 6},
+		},
+		{
+			in:       &testReflectIds{ResultType: 1, ResultId: 2, Base: 3, Offset: 7},
+			result:   2,
+			typ:      1,
+			ok:       true,
+			operands: []Id{1, 3, 7},
+		},
+		{
+			in: &testDialectInstruction{A: 1},
+		},
+	} {
+		result, ok := ResultId(st.in)
+		if result != st.result || ok != st.ok {
+			t.Fatalf("case %d: result id mismatch:\nHave: %v, %v\nWant: %v, %v",
+				i, result, ok, st.result, st.ok)
+		}
+
+		typ, ok := ResultType(st.in)
+		if typ != st.typ || ok != (st.typ != 0) {
+			t.Fatalf("case %d: result type mismatch:\nHave: %v, %v\nWant: %v",
+				i, typ, ok, st.typ)
+		}
+
+		operands := Operands(st.in)
+		if !reflect.DeepEqual(operands, st.operands) {
+			t.Fatalf("case %d: operands mismatch:\nHave: %v\nWant: %v",
+				i, operands, st.operands)
+		}
+
+		ok = SetResultId(st.in, 50)
+		result, _ = ResultId(st.in)
+		if ok != st.ok || (ok && result != 50) {
+			t.Fatalf("case %d: set result id mismatch:\nHave: %v, %v\nWant: 50, %v",
+				i, result, ok, st.ok)
+		}
+
+		var want []Id
+		for _, id := range st.operands {
+			want = append(want, id+100)
+		}
+
+		RewriteOperands(st.in, func(id Id) Id { return id + 100 })
+
+		operands = Operands(st.in)
+		if !reflect.DeepEqual(operands, want) {
+			t.Fatalf("case %d: rewritten operands mismatch:\nHave: %v\nWant: %v",
+				i, operands, want)
+		}
+	}
+}
+
+func TestSwitchSelectors(t *testing.T) {
+	for i, st := range []struct {
+		width uint32
+		want  error
+	}{
+		{width: 16},
+		{width: 32},
+		{
+			width: 64,
+			want: ErrorList{
+				NewLayoutError(2, "OpSwitch selector 2 is 64 bits wide; only selectors of up to 32 bits are supported"),
+			},
+		},
+	} {
+		m := NewModule()
+		m.Code = []Instruction{
+			&OpTypeInt{ResultId: 1, Width: st.width},
+			&OpUndef{ResultType: 1, ResultId: 2},
+			&OpSwitch{Selector: 2, Default: 3, Target: []uint32{1, 4}},
+		}
+
+		var buf bytes.Buffer
+		if err := m.Save(&buf); err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		_, err := Load(&buf)
+		if !reflect.DeepEqual(err, st.want) {
+			t.Fatalf("case %d: Load error mismatch:\nHave: %v\nWant: %v", i, err, st.want)
+		}
+
+		src := fmt.Sprintf("%%1 = OpTypeInt %d 0\n%%2 = OpUndef %%1\nOpSwitch %%2 %%3 1 %%4", st.width)
+
+		_, err = Assemble(strings.NewReader(src))
+		if !reflect.DeepEqual(err, st.want) {
+			t.Fatalf("case %d: Assemble error mismatch:\nHave: %v\nWant: %v", i, err, st.want)
+		}
+
+		err = Disassemble(ioutil.Discard, m, DisassembleOptions{})
+		if !reflect.DeepEqual(err, st.want) {
+			t.Fatalf("case %d: Disassemble error mismatch:\nHave: %v\nWant: %v", i, err, st.want)
+		}
+	}
+}
@@ -0,0 +1,400 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "sort"
+
+// Reflection describes the resources used by the shaders in a module.
+type Reflection struct {
+	// EntryPoints lists the entry points, in the order in which they
+	// are declared.
+	EntryPoints []*ReflectEntryPoint
+
+	// Bindings lists all Uniform and UniformConstant variables in the
+	// module, ordered by descriptor set and binding.
+	Bindings []*ReflectVariable
+}
+
+// ReflectEntryPoint describes the interface of a single entry point.
+type ReflectEntryPoint struct {
+	Name           string
+	Function       Id
+	ExecutionModel ExecutionModel
+
+	// Inputs and Outputs list the Input and Output variables used by the
+	// entry point, in the order in which they are declared.
+	Inputs  []*ReflectVariable
+	Outputs []*ReflectVariable
+
+	// Bindings lists the Uniform and UniformConstant variables used
+	// by the entry point, ordered by descriptor set and binding.
+	Bindings []*ReflectVariable
+}
+
+// ReflectVariable describes a global variable.
+type ReflectVariable struct {
+	Id           Id
+	Name         string
+	StorageClass StorageClass
+
+	// Type is the type pointed to by the variable.
+	Type *Type
+
+	// Members describes the members of Type, if it is a struct, or those
+	// of its element type, if it is an array of structs.
+	Members []ReflectMember
+
+	// Set and Binding hold the DescriptorSet and Binding decorations.
+	Set     uint32
+	Binding uint32
+
+	// Location holds the Location decoration, or -1 if there is none.
+	Location int
+
+	// BuiltIn holds the BuiltIn decoration, if IsBuiltIn is true.
+	BuiltIn   BuiltIn
+	IsBuiltIn bool
+
+	// Decorations holds the arguments of all decorations of the
+	// variable, including those applied through decoration groups.
+	Decorations map[Decoration][]uint32
+}
+
+// ReflectMember describes a member of a struct type.
+type ReflectMember struct {
+	Name string
+	Type *Type
+
+	// Offset holds the Offset decoration.
+	Offset uint32
+
+	// BuiltIn holds the BuiltIn decoration, if IsBuiltIn is true.
+	BuiltIn   BuiltIn
+	IsBuiltIn bool
+
+	// Decorations holds the arguments of all decorations of the member,
+	// including those applied through decoration groups.
+	Decorations map[Decoration][]uint32
+}
+
+// Reflect describes the descriptor bindings of the given module, as well
+// as the inputs, outputs and bindings used by each of its entry points.
+//
+// A variable is used by an entry point if it is listed in its interface,
+// or if it is referred to by the entry point's function or any function
+// called from it.
+//
+// Returns an ErrorList of *LayoutError values if a Uniform or
+// UniformConstant variable lacks a DescriptorSet or Binding decoration,
+// or if an entry point refers to an undefined function.
+func Reflect(m *Module) (*Reflection, error) {
+	r := newReflector(m)
+
+	var out Reflection
+	var errs ErrorList
+
+	vars := make(map[Id]*ReflectVariable)
+
+	for addr, instr := range m.Code {
+		v, ok := instr.(*OpVariable)
+		if !ok || r.inFunction[addr] {
+			continue
+		}
+
+		rv := r.variable(v)
+		vars[v.ResultId] = rv
+
+		if !isDescriptor(rv.StorageClass) {
+			continue
+		}
+
+		if _, ok := rv.Decorations[DecorationDescriptorSet]; !ok {
+			errs = append(errs, NewLayoutError(addr, "variable %d has no DescriptorSet decoration", v.ResultId))
+		}
+
+		if _, ok := rv.Decorations[DecorationBinding]; !ok {
+			errs = append(errs, NewLayoutError(addr, "variable %d has no Binding decoration", v.ResultId))
+		}
+
+		out.Bindings = append(out.Bindings, rv)
+	}
+
+	sort.Stable(bindingSlice(out.Bindings))
+
+	for addr, instr := range m.Code {
+		ep, ok := instr.(*OpEntryPoint)
+		if !ok {
+			continue
+		}
+
+		if _, ok := r.funcs[ep.EntryPoint]; !ok {
+			errs = append(errs, NewLayoutError(addr,
+				"entry point %q refers to undefined function %d", ep.Name, ep.EntryPoint))
+			continue
+		}
+
+		rep := &ReflectEntryPoint{
+			Name:           string(ep.Name),
+			Function:       ep.EntryPoint,
+			ExecutionModel: ep.ExecutionModel,
+		}
+
+		used := r.used(ep)
+
+		for _, id := range r.order {
+			v := vars[id]
+			if v == nil || !used[id] {
+				continue
+			}
+
+			switch {
+			case v.StorageClass == StorageClassInput:
+				rep.Inputs = append(rep.Inputs, v)
+			case v.StorageClass == StorageClassOutput:
+				rep.Outputs = append(rep.Outputs, v)
+			case isDescriptor(v.StorageClass):
+				rep.Bindings = append(rep.Bindings, v)
+			}
+		}
+
+		sort.Stable(bindingSlice(rep.Bindings))
+		out.EntryPoints = append(out.EntryPoints, rep)
+	}
+
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// isDescriptor returns true for the storage classes of variables
+// which are bound through descriptor sets.
+func isDescriptor(sc StorageClass) bool {
+	return sc == StorageClassUniform || sc == StorageClassUniformConstant
+}
+
+// reflector holds the information about a module which is needed
+// to describe its variables.
+type reflector struct {
+	types       *TypeTable
+	names       map[Id]string
+	memberNames map[Id]map[uint32]string
+	decos       map[Id]map[Decoration][]uint32
+	memberDecos map[Id]map[uint32]map[Decoration][]uint32
+
+	funcs      map[Id]InstructionList // Function definitions.
+	inFunction []bool                 // Whether each address is inside a function.
+	order      []Id                   // Global variables, in declaration order.
+}
+
+// newReflector collects names, decorations and functions in the module.
+func newReflector(m *Module) *reflector {
+	r := &reflector{
+		types:       NewTypeTable(m),
+		names:       make(map[Id]string),
+		memberNames: make(map[Id]map[uint32]string),
+		decos:       make(map[Id]map[Decoration][]uint32),
+		memberDecos: make(map[Id]map[uint32]map[Decoration][]uint32),
+		funcs:       make(map[Id]InstructionList),
+		inFunction:  make([]bool, len(m.Code)),
+	}
+
+	start, end := m.Code.functionRanges()
+	for i, s := range start {
+		id, _ := ResultId(m.Code[s])
+		r.funcs[id] = m.Code[s : end[i]+1]
+
+		for addr := s; addr <= end[i]; addr++ {
+			r.inFunction[addr] = true
+		}
+	}
+
+	for addr, instr := range m.Code {
+		switch v := instr.(type) {
+		case *OpName:
+			r.names[v.Target] = string(v.Name)
+
+		case *OpMemberName:
+			if r.memberNames[v.Type] == nil {
+				r.memberNames[v.Type] = make(map[uint32]string)
+			}
+			r.memberNames[v.Type][v.Member] = string(v.Name)
+
+		case *OpDecorate:
+			r.decorate(v.Target, v.Decoration, v.Argv)
+
+		case *OpMemberDecorate:
+			r.decorateMember(v.StructType, v.Member, v.Decoration, v.Argv)
+
+		case *OpVariable:
+			if !r.inFunction[addr] {
+				r.order = append(r.order, v.ResultId)
+			}
+		}
+	}
+
+	// Group decorations are applied once all decorations
+	// of the groups are known.
+	for _, instr := range m.Code {
+		switch v := instr.(type) {
+		case *OpGroupDecorate:
+			for _, target := range v.Targets {
+				for d, argv := range r.decos[v.Group] {
+					r.decorate(target, d, argv)
+				}
+			}
+
+		case *OpGroupMemberDecorate:
+			for i := 0; i+1 < len(v.Targets); i += 2 {
+				for d, argv := range r.decos[v.Group] {
+					r.decorateMember(Id(v.Targets[i]), v.Targets[i+1], d, argv)
+				}
+			}
+		}
+	}
+
+	return r
+}
+
+// decorate records a decoration of the given id.
+func (r *reflector) decorate(id Id, d Decoration, argv []uint32) {
+	if r.decos[id] == nil {
+		r.decos[id] = make(map[Decoration][]uint32)
+	}
+	r.decos[id][d] = argv
+}
+
+// decorateMember records a decoration of a struct member.
+func (r *reflector) decorateMember(id Id, member uint32, d Decoration, argv []uint32) {
+	if r.memberDecos[id] == nil {
+		r.memberDecos[id] = make(map[uint32]map[Decoration][]uint32)
+	}
+
+	if r.memberDecos[id][member] == nil {
+		r.memberDecos[id][member] = make(map[Decoration][]uint32)
+	}
+
+	r.memberDecos[id][member][d] = argv
+}
+
+// variable describes the given global variable.
+func (r *reflector) variable(v *OpVariable) *ReflectVariable {
+	decos := r.decos[v.ResultId]
+
+	rv := &ReflectVariable{
+		Id:           v.ResultId,
+		Name:         r.names[v.ResultId],
+		StorageClass: v.StorageClass,
+		Location:     -1,
+		Decorations:  decos,
+	}
+
+	if t := r.types.Type(v.ResultType); t.is(TypePointer) {
+		rv.Type = t.Elem
+	}
+
+	if argv := decos[DecorationDescriptorSet]; len(argv) > 0 {
+		rv.Set = argv[0]
+	}
+
+	if argv := decos[DecorationBinding]; len(argv) > 0 {
+		rv.Binding = argv[0]
+	}
+
+	if argv := decos[DecorationLocation]; len(argv) > 0 {
+		rv.Location = int(argv[0])
+	}
+
+	if argv := decos[DecorationBuiltIn]; len(argv) > 0 {
+		rv.BuiltIn = BuiltIn(argv[0])
+		rv.IsBuiltIn = true
+	}
+
+	st := rv.Type
+	for st.is(TypeArray) || st.is(TypeRuntimeArray) {
+		st = st.Elem
+	}
+
+	if st.is(TypeStruct) {
+		rv.Members = r.members(st)
+	}
+
+	return rv
+}
+
+// members describes the members of the given struct type.
+func (r *reflector) members(t *Type) []ReflectMember {
+	out := make([]ReflectMember, len(t.Members))
+
+	for i, mt := range t.Members {
+		decos := r.memberDecos[t.Id][uint32(i)]
+
+		rm := ReflectMember{
+			Name:        r.memberNames[t.Id][uint32(i)],
+			Type:        mt,
+			Decorations: decos,
+		}
+
+		if argv := decos[DecorationOffset]; len(argv) > 0 {
+			rm.Offset = argv[0]
+		}
+
+		if argv := decos[DecorationBuiltIn]; len(argv) > 0 {
+			rm.BuiltIn = BuiltIn(argv[0])
+			rm.IsBuiltIn = true
+		}
+
+		out[i] = rm
+	}
+
+	return out
+}
+
+// used returns the set of ids which are listed in the interface of the
+// given entry point, or which are referred to by its function or any
+// function called from it.
+func (r *reflector) used(ep *OpEntryPoint) map[Id]bool {
+	used := make(map[Id]bool)
+	for _, id := range ep.Interface {
+		used[id] = true
+	}
+
+	var operands []Id
+	seen := map[Id]bool{ep.EntryPoint: true}
+	work := []Id{ep.EntryPoint}
+
+	for len(work) > 0 {
+		fn := r.funcs[work[len(work)-1]]
+		work = work[:len(work)-1]
+
+		for _, instr := range fn {
+			operands = appendOperands(operands[:0], instr)
+			for _, id := range operands {
+				used[id] = true
+			}
+
+			call, ok := instr.(*OpFunctionCall)
+			if ok && !seen[call.Function] {
+				seen[call.Function] = true
+				work = append(work, call.Function)
+			}
+		}
+	}
+
+	return used
+}
+
+// bindingSlice implements sort.Interface for a slice of variables,
+// ordered by descriptor set and binding.
+type bindingSlice []*ReflectVariable
+
+func (s bindingSlice) Len() int      { return len(s) }
+func (s bindingSlice) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s bindingSlice) Less(i, j int) bool {
+	if s[i].Set != s[j].Set {
+		return s[i].Set < s[j].Set
+	}
+	return s[i].Binding < s[j].Binding
+}
@@ -0,0 +1,215 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testReflectSource = `
+	     OpCapability Shader
+	     OpMemoryModel Logical GLSL450
+	     OpEntryPoint Fragment %10 "main" %20 %21 %22
+	     OpEntryPoint Vertex %40 "vmain" %23
+	     OpExecutionMode %10 OriginUpperLeft
+	     OpName %20 "uv"
+	     OpName %21 "color"
+	     OpName %30 "ubo"
+	     OpMemberName %7 0 "scale"
+	     OpMemberName %7 1 "bias"
+	     OpName %31 "tex"
+	     OpDecorate %20 Location 0
+	     OpDecorate %21 Location 0
+	     OpDecorate %22 BuiltIn FragCoord
+	     OpDecorate %23 BuiltIn VertexIndex
+	     OpMemberDecorate %7 0 Offset 0
+	     OpMemberDecorate %7 1 Offset 4
+	     OpDecorate %50 DescriptorSet 1
+	%50 = OpDecorationGroup
+	     OpGroupDecorate %50 %30 %31
+	     OpDecorate %30 Binding 2
+	     OpDecorate %31 Binding 0
+	%1 = OpTypeVoid
+	%2 = OpTypeFunction %1
+	%3 = OpTypeFloat 32
+	%4 = OpTypeVector %3 4
+	%5 = OpTypePointer Input %4
+	%6 = OpTypePointer Output %4
+	%7 = OpTypeStruct %3 %3
+	%8 = OpTypePointer Uniform %7
+	%9 = OpTypeSampler
+	%11 = OpTypePointer UniformConstant %9
+	%12 = OpTypeInt 32 1
+	%13 = OpTypePointer Input %12
+	%20 = OpVariable %5 Input
+	%21 = OpVariable %6 Output
+	%22 = OpVariable %5 Input
+	%23 = OpVariable %13 Input
+	%30 = OpVariable %8 Uniform
+	%31 = OpVariable %11 UniformConstant
+	%10 = OpFunction %1 None %2
+	%14 = OpLabel
+	%15 = OpFunctionCall %1 %41
+	     OpReturn
+	     OpFunctionEnd
+	%41 = OpFunction %1 None %2
+	%42 = OpLabel
+	%43 = OpLoad %9 %31
+	     OpReturn
+	     OpFunctionEnd
+	%40 = OpFunction %1 None %2
+	%44 = OpLabel
+	%45 = OpLoad %7 %30
+	     OpReturn
+	     OpFunctionEnd
+`
+
+func TestReflect(t *testing.T) {
+	m, err := Assemble(strings.NewReader(testReflectSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := NewTypeTable(m)
+	float := tt.Type(3)
+
+	uv := &ReflectVariable{
+		Id:           20,
+		Name:         "uv",
+		StorageClass: StorageClassInput,
+		Type:         tt.Type(4),
+		Location:     0,
+		Decorations:  map[Decoration][]uint32{DecorationLocation: {0}},
+	}
+
+	color := &ReflectVariable{
+		Id:           21,
+		Name:         "color",
+		StorageClass: StorageClassOutput,
+		Type:         tt.Type(4),
+		Location:     0,
+		Decorations:  map[Decoration][]uint32{DecorationLocation: {0}},
+	}
+
+	fragCoord := &ReflectVariable{
+		Id:           22,
+		StorageClass: StorageClassInput,
+		Type:         tt.Type(4),
+		Location:     -1,
+		BuiltIn:      BuiltInFragCoord,
+		IsBuiltIn:    true,
+		Decorations:  map[Decoration][]uint32{DecorationBuiltIn: {uint32(BuiltInFragCoord)}},
+	}
+
+	vertexIndex := &ReflectVariable{
+		Id:           23,
+		StorageClass: StorageClassInput,
+		Type:         tt.Type(12),
+		Location:     -1,
+		BuiltIn:      BuiltInVertexIndex,
+		IsBuiltIn:    true,
+		Decorations:  map[Decoration][]uint32{DecorationBuiltIn: {uint32(BuiltInVertexIndex)}},
+	}
+
+	ubo := &ReflectVariable{
+		Id:           30,
+		Name:         "ubo",
+		StorageClass: StorageClassUniform,
+		Type:         tt.Type(7),
+		Members: []ReflectMember{
+			{
+				Name:        "scale",
+				Type:        float,
+				Decorations: map[Decoration][]uint32{DecorationOffset: {0}},
+			},
+			{
+				Name:        "bias",
+				Type:        float,
+				Offset:      4,
+				Decorations: map[Decoration][]uint32{DecorationOffset: {4}},
+			},
+		},
+		Set:      1,
+		Binding:  2,
+		Location: -1,
+		Decorations: map[Decoration][]uint32{
+			DecorationDescriptorSet: {1},
+			DecorationBinding:       {2},
+		},
+	}
+
+	tex := &ReflectVariable{
+		Id:           31,
+		Name:         "tex",
+		StorageClass: StorageClassUniformConstant,
+		Type:         tt.Type(9),
+		Set:          1,
+		Location:     -1,
+		Decorations: map[Decoration][]uint32{
+			DecorationDescriptorSet: {1},
+			DecorationBinding:       {0},
+		},
+	}
+
+	want := &Reflection{
+		EntryPoints: []*ReflectEntryPoint{
+			{
+				Name:           "main",
+				Function:       10,
+				ExecutionModel: ExecutionModelFragment,
+				Inputs:         []*ReflectVariable{uv, fragCoord},
+				Outputs:        []*ReflectVariable{color},
+				Bindings:       []*ReflectVariable{tex},
+			},
+			{
+				Name:           "vmain",
+				Function:       40,
+				ExecutionModel: ExecutionModelVertex,
+				Inputs:         []*ReflectVariable{vertexIndex},
+				Bindings:       []*ReflectVariable{ubo},
+			},
+		},
+		Bindings: []*ReflectVariable{tex, ubo},
+	}
+
+	have, err := Reflect(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("reflection mismatch:\nHave: %+v\nWant: %+v", have, want)
+	}
+}
+
+func TestReflectErrors(t *testing.T) {
+	m, err := Assemble(strings.NewReader(`
+		     OpCapability Shader
+		     OpMemoryModel Logical GLSL450
+		     OpEntryPoint Fragment %10 "main"
+		     OpDecorate %5 Binding 0
+		%1 = OpTypeFloat 32
+		%2 = OpTypePointer Uniform %1
+		%3 = OpTypePointer UniformConstant %1
+		%4 = OpVariable %2 Uniform
+		%5 = OpVariable %3 UniformConstant
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := ErrorList{
+		NewLayoutError(7, "variable 4 has no DescriptorSet decoration"),
+		NewLayoutError(7, "variable 4 has no Binding decoration"),
+		NewLayoutError(8, "variable 5 has no DescriptorSet decoration"),
+		NewLayoutError(2, "entry point \"main\" refers to undefined function 10"),
+	}
+
+	_, have := Reflect(m)
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+}
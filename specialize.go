@@ -0,0 +1,268 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// SpecConstant describes a specialization constant.
+type SpecConstant struct {
+	Id   Id
+	Name string
+	Type *Type
+
+	// SpecId holds the SpecId decoration, if HasSpecId is true.
+	// Composites have no SpecId.
+	SpecId    uint32
+	HasSpecId bool
+
+	// Default holds the default value of a scalar. These are the value
+	// words of an OpSpecConstant, or a single word holding 1 or 0 for an
+	// OpSpecConstantTrue or OpSpecConstantFalse. It is nil for composites.
+	Default []uint32
+
+	// Constituents holds the constituents of an OpSpecConstantComposite.
+	Constituents []Id
+}
+
+// SpecConstants lists the OpSpecConstant, OpSpecConstantTrue,
+// OpSpecConstantFalse and OpSpecConstantComposite declarations in the
+// given module, in the order in which they are declared.
+func SpecConstants(m *Module) []*SpecConstant {
+	r := newReflector(m)
+
+	var out []*SpecConstant
+
+	for _, instr := range m.Code {
+		var sc *SpecConstant
+
+		switch v := instr.(type) {
+		case *OpSpecConstant:
+			sc = &SpecConstant{Id: v.ResultId, Default: append([]uint32(nil), v.Value...)}
+		case *OpSpecConstantTrue:
+			sc = &SpecConstant{Id: v.ResultId, Default: []uint32{1}}
+		case *OpSpecConstantFalse:
+			sc = &SpecConstant{Id: v.ResultId, Default: []uint32{0}}
+		case *OpSpecConstantComposite:
+			sc = &SpecConstant{Id: v.ResultId, Constituents: v.Constituents}
+		default:
+			continue
+		}
+
+		sc.Name = r.names[sc.Id]
+		sc.Type = r.types.TypeOf(sc.Id)
+
+		if argv := r.decos[sc.Id][DecorationSpecId]; len(argv) > 0 {
+			sc.SpecId = argv[0]
+			sc.HasSpecId = true
+		}
+
+		out = append(out, sc)
+	}
+
+	return out
+}
+
+// Specialize replaces the specialization constants in the given module
+// with ordinary constants. Values are given by SpecId. Constants without
+// a value keep their default. Values for unknown SpecIds are ignored.
+//
+// OpSpecConstant becomes OpConstant, OpSpecConstantTrue and
+// OpSpecConstantFalse become OpConstantTrue or OpConstantFalse and
+// OpSpecConstantComposite becomes OpConstantComposite. Their SpecId
+// decorations are removed. An OpSpecConstantOp is evaluated as described
+// for FoldConstants, and replaced by the resulting constant declarations.
+// If it can not be evaluated, it is left alone, as are the composites
+// which depend on it.
+//
+// Returns an ErrorList of *LayoutError values, and leaves the module
+// unchanged, if a value for an OpSpecConstant does not have as many words
+// as its default, or if a value for a boolean does not have one word.
+func Specialize(m *Module, values map[uint32][]uint32) error {
+	r := newReflector(m)
+
+	value := func(id Id) ([]uint32, bool) {
+		argv := r.decos[id][DecorationSpecId]
+		if len(argv) == 0 {
+			return nil, false
+		}
+		v, ok := values[argv[0]]
+		return v, ok
+	}
+
+	var errs ErrorList
+
+	for addr, instr := range m.Code {
+		want := 1
+
+		switch v := instr.(type) {
+		case *OpSpecConstant:
+			want = len(v.Value)
+		case *OpSpecConstantTrue, *OpSpecConstantFalse:
+		default:
+			continue
+		}
+
+		id, _ := ResultId(instr)
+		if v, ok := value(id); ok && len(v) != want {
+			errs = append(errs, NewLayoutError(addr,
+				"value for SpecId %d has %d words, want %d", r.decos[id][DecorationSpecId][0], len(v), want))
+		}
+	}
+
+	if err := errs.err(); err != nil {
+		return err
+	}
+
+	f := newFolder(m)
+	frozen := make(map[Id]bool)
+	spec := make(map[Id]bool) // Spec constants which are left alone.
+
+	rewrite := func(id Id) Id {
+		if c, ok := f.canon[id]; ok {
+			return c
+		}
+		return id
+	}
+
+	code := make(InstructionList, 0, len(m.Code))
+
+	for _, instr := range m.Code {
+		// Operands are defined before they are used, so they have
+		// been evaluated already. The operands of an OpSpecConstantOp
+		// include literals; they are rewritten once it is decoded.
+		if instr.Opcode() != opcodeSpecConstantOp {
+			RewriteOperands(instr, rewrite)
+		}
+
+		switch v := instr.(type) {
+		case *OpSpecConstant:
+			c := &OpConstant{ResultType: v.ResultType, ResultId: v.ResultId, Value: v.Value}
+			if val, ok := value(v.ResultId); ok {
+				c.Value = append([]uint32(nil), val...)
+			}
+			instr = c
+
+		case *OpSpecConstantTrue:
+			instr = specBool(v.ResultType, v.ResultId, true, value)
+
+		case *OpSpecConstantFalse:
+			instr = specBool(v.ResultType, v.ResultId, false, value)
+
+		case *OpSpecConstantComposite:
+			if anyId(v.Constituents, spec) {
+				spec[v.ResultId] = true
+				break
+			}
+			instr = &OpConstantComposite{ResultType: v.ResultType, ResultId: v.ResultId, Constituents: v.Constituents}
+
+		case *OpSpecConstantOp:
+			op, ok := specOperation(v)
+			if !ok {
+				spec[v.ResultId] = true
+				break
+			}
+
+			RewriteOperands(op, rewrite)
+
+			if words, err := appendInstruction(nil, op); err == nil {
+				v.Operands = v.Operands[:0]
+				for _, w := range words[3:] {
+					v.Operands = append(v.Operands, Id(w))
+				}
+			}
+
+			if anyId(Operands(op), spec) {
+				spec[v.ResultId] = true
+				break
+			}
+
+			// Any constants declared while evaluating the operation
+			// are kept, since the folder may refer to them later.
+			n := len(f.added)
+			f.resultId = v.ResultId
+			c, ok := f.fold(op)
+			code = append(code, f.added[n:]...)
+
+			if !ok {
+				spec[v.ResultId] = true
+				break
+			}
+
+			if c != v.ResultId {
+				f.canon[v.ResultId] = c
+			}
+
+			frozen[v.ResultId] = true
+			continue
+
+		default:
+			code = append(code, instr)
+			continue
+		}
+
+		id, _ := ResultId(instr)
+		if !spec[id] {
+			frozen[id] = true
+			f.record(instr)
+		}
+
+		code = append(code, instr)
+	}
+
+	// Names and decorations of replaced results are dropped.
+	m.Code = code[:0]
+
+	for _, instr := range code {
+		if f.targetsFolded(instr) {
+			continue
+		}
+
+		if v, ok := instr.(*OpDecorate); ok && v.Decoration == DecorationSpecId && frozen[v.Target] {
+			continue
+		}
+
+		m.Code = append(m.Code, instr)
+	}
+
+	for i := len(m.Code); i < len(code); i++ {
+		code[i] = nil
+	}
+
+	return nil
+}
+
+// specOperation returns the instruction performing the operation of
+// the given OpSpecConstantOp, with the same result type and id.
+func specOperation(v *OpSpecConstantOp) (Instruction, bool) {
+	words := []uint32{uint32(3+len(v.Operands))<<16 | v.Operation, uint32(v.ResultType), uint32(v.ResultId)}
+	for _, id := range v.Operands {
+		words = append(words, uint32(id))
+	}
+
+	op, err := DecodeInstruction(words)
+	return op, err == nil
+}
+
+// specBool returns the constant declaration for a boolean specialization
+// constant with the given default. Its value is looked up with the
+// given function.
+func specBool(typ, id Id, def bool, value func(Id) ([]uint32, bool)) Instruction {
+	if v, ok := value(id); ok {
+		def = v[0] != 0
+	}
+
+	if def {
+		return &OpConstantTrue{ResultType: typ, ResultId: id}
+	}
+
+	return &OpConstantFalse{ResultType: typ, ResultId: id}
+}
+
+// anyId returns true if any of the given ids is in the set.
+func anyId(ids []Id, set map[Id]bool) bool {
+	for _, id := range ids {
+		if set[id] {
+			return true
+		}
+	}
+	return false
+}
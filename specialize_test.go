@@ -0,0 +1,154 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testSpecializePrefix = `
+	     OpCapability Shader
+	     OpMemoryModel Logical GLSL450
+	     OpName %10 "count"
+`
+
+const testSpecializeTypes = `
+	%1 = OpTypeInt 32 1
+	%2 = OpTypeBool
+	%3 = OpTypeVector %1 2
+	%4 = OpTypeFloat 32
+	%5 = OpTypeVector %4 2
+`
+
+// testSpecializeSource declares specialization constants of all kinds.
+// Opcode 128 is OpIAdd, 130 is OpISub and 116 is
+// OpQuantizeToF16, which can not be evaluated.
+const testSpecializeSource = testSpecializePrefix + `
+	     OpDecorate %10 SpecId 0
+	     OpDecorate %11 SpecId 1
+	     OpDecorate %12 SpecId 2
+	     OpDecorate %13 SpecId 3
+` + testSpecializeTypes + `
+	%10 = OpSpecConstant %1 4
+	%11 = OpSpecConstantTrue %2
+	%12 = OpSpecConstantFalse %2
+	%13 = OpSpecConstant %4 1.5
+	%14 = OpSpecConstantComposite %3 %10 %10
+	%15 = OpSpecConstantOp %1 128 %10 %10
+	%16 = OpSpecConstantOp %1 130 %15 %10
+	%17 = OpSpecConstantComposite %3 %16 %15
+	%18 = OpSpecConstantOp %4 116 %13
+	%19 = OpSpecConstantComposite %5 %18 %13
+`
+
+func TestSpecConstants(t *testing.T) {
+	m, err := Assemble(strings.NewReader(testSpecializeSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := NewTypeTable(m)
+
+	want := []*SpecConstant{
+		{Id: 10, Name: "count", Type: tt.Type(1), SpecId: 0, HasSpecId: true, Default: []uint32{4}},
+		{Id: 11, Type: tt.Type(2), SpecId: 1, HasSpecId: true, Default: []uint32{1}},
+		{Id: 12, Type: tt.Type(2), SpecId: 2, HasSpecId: true, Default: []uint32{0}},
+		{Id: 13, Type: tt.Type(4), SpecId: 3, HasSpecId: true, Default: []uint32{0x3fc00000}},
+		{Id: 14, Type: tt.Type(3), Constituents: []Id{10, 10}},
+		{Id: 17, Type: tt.Type(3), Constituents: []Id{16, 15}},
+		{Id: 19, Type: tt.Type(5), Constituents: []Id{18, 13}},
+	}
+
+	have := SpecConstants(m)
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("spec constant mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+}
+
+func TestSpecialize(t *testing.T) {
+	for i, st := range []struct {
+		in     string
+		values map[uint32][]uint32
+		want   string
+	}{
+		{
+			// Operations are evaluated; the result of %16 equals %10.
+			// Those which can not be evaluated stay, along with the
+			// composites depending on them.
+			in:     testSpecializeSource,
+			values: map[uint32][]uint32{0: {3}, 1: {0}, 7: {1}},
+			want: testSpecializePrefix + testSpecializeTypes + `
+				%10 = OpConstant %1 3
+				%11 = OpConstantFalse %2
+				%12 = OpConstantFalse %2
+				%13 = OpConstant %4 1.5
+				%14 = OpConstantComposite %3 %10 %10
+				%15 = OpConstant %1 6
+				%17 = OpConstantComposite %3 %10 %15
+				%18 = OpSpecConstantOp %4 116 %13
+				%19 = OpSpecConstantComposite %5 %18 %13
+			`,
+		},
+		{
+			// Defaults are used for constants without a value.
+			in: testSpecializePrefix + `
+				     OpDecorate %10 SpecId 0
+				     OpDecorate %11 SpecId 1
+			` + testSpecializeTypes + `
+				%10 = OpSpecConstant %1 4
+				%11 = OpSpecConstantFalse %2
+				%12 = OpSpecConstantTrue %2
+			`,
+			values: map[uint32][]uint32{1: {1}},
+			want: testSpecializePrefix + testSpecializeTypes + `
+				%10 = OpConstant %1 4
+				%11 = OpConstantTrue %2
+				%12 = OpConstantTrue %2
+			`,
+		},
+	} {
+		m, err := Assemble(strings.NewReader(st.in))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		want, err := Assemble(strings.NewReader(st.want))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		if err := Specialize(m, st.values); err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		if !reflect.DeepEqual(m.Code, want.Code) {
+			t.Fatalf("case %d: code mismatch:\nHave: %v\nWant: %v", i, m.Code, want.Code)
+		}
+	}
+}
+
+func TestSpecializeErrors(t *testing.T) {
+	m, err := Assemble(strings.NewReader(testSpecializeSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code := append(InstructionList(nil), m.Code...)
+
+	want := ErrorList{
+		NewLayoutError(12, "value for SpecId 0 has 2 words, want 1"),
+		NewLayoutError(13, "value for SpecId 1 has 0 words, want 1"),
+	}
+
+	have := Specialize(m, map[uint32][]uint32{0: {1, 2}, 1: {}, 3: {0}})
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+
+	if !reflect.DeepEqual(m.Code, code) {
+		t.Fatalf("code mismatch:\nHave: %v\nWant: %v", m.Code, code)
+	}
+}
@@ -9,10 +9,11 @@ import (
 	"os"
 
 	"github.com/jteeuwen/spirv"
+	_ "github.com/jteeuwen/spirv/legacy" // Support provisional modules.
 )
 
 func main() {
-	file := parseArgs()
+	file, opts := parseArgs()
 
 	fd, err := os.Open(file)
 	if err != nil {
@@ -28,17 +29,40 @@ func main() {
 		os.Exit(1)
 	}
 
+	if opts.disassemble {
+		err = spirv.Disassemble(os.Stdout, module, spirv.DisassembleOptions{
+			RawIds: opts.rawIds,
+		})
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	dump(module)
 }
 
+// options defines the command line options.
+type options struct {
+	disassemble bool
+	rawIds      bool
+}
+
 // parseArgs parses and validates command line arguments.
-func parseArgs() string {
+func parseArgs() (string, *options) {
+	var opts options
+
 	flag.Usage = func() {
 		fmt.Println("usage:", os.Args[0], "[options] <module file>")
 		flag.PrintDefaults()
 	}
 
 	version := flag.Bool("version", false, "Display version information.")
+	flag.BoolVar(&opts.disassemble, "dis", false, "Print the module as spirv-dis style assembly.")
+	flag.BoolVar(&opts.rawIds, "raw-id", false, "Print numeric ids instead of friendly names. Only used with -dis.")
 	flag.Parse()
 
 	if *version {
@@ -51,7 +75,7 @@ func parseArgs() string {
 		os.Exit(1)
 	}
 
-	return flag.Arg(0)
+	return flag.Arg(0), &opts
 }
 
 func makeNew(file string) {
@@ -63,32 +87,21 @@ func makeNew(file string) {
 
 	defer fd.Close()
 
-	mod := spirv.NewModule()
-	mod.Code = []spirv.Instruction{
-		&spirv.OpSource{spirv.SourceLanguageGLSL, 450},
-		&spirv.OpExtInst{
-			ResultType:  1,
-			ResultId:    2,
-			Set:         3,
-			Instruction: 4,
-			Operands:    []spirv.Id{5, 4, 5},
-		},
-		&spirv.OpFunction{
-			ResultType:   0,
-			ResultId:     1,
-			ControlMask:  spirv.FunctionControlMaskInLine,
-			FunctionType: 2,
-		},
-		&spirv.OpFunctionParameter{
-			ResultType: 0,
-			ResultId:   1,
-		},
-		&spirv.OpFunctionParameter{
-			ResultType: 0,
-			ResultId:   1,
-		},
-		&spirv.OpFunctionEnd{},
-	}
+	b := spirv.NewBuilder(spirv.AddressingModelLogical, spirv.MemoryModelGLSL450)
+	b.Capability(spirv.CapabilityShader)
+	b.Add(&spirv.OpSource{
+		SourceLanguage: spirv.SourceLanguageGLSL,
+		Version:        450,
+	})
+
+	fn := b.Function(b.TypeVoid(), spirv.FunctionControlInline)
+	b.EntryPoint(spirv.ExecutionModelFragment, fn, "main")
+	b.ExecutionMode(fn, spirv.ExecutionModeOriginUpperLeft)
+	b.Name(fn.Id, "main")
+
+	fn.Block().Return()
+
+	mod := b.Module()
 
 	err = mod.Save(fd)
 	if err != nil {
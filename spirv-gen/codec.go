@@ -0,0 +1,266 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// genCodec generates the WordCodec implementations for all instructions.
+// These mirror the reflection based encoder and decoder, without the
+// overhead of reflection.
+func genCodec(g *Grammar) *bytes.Buffer {
+	buf := newFile()
+
+	for _, in := range g.Instructions {
+		fields := g.Fields(in)
+		genEncodedLen(buf, in, fields)
+		genEncodeWords(buf, in, fields)
+		genDecodeWords(buf, in, fields)
+	}
+
+	return buf
+}
+
+// isSlice returns true if the field holds a list of words.
+func (f *Field) isSlice() bool {
+	return strings.HasPrefix(f.Type, "[]")
+}
+
+// isString returns true if the field holds a string literal.
+func (f *Field) isString() bool {
+	return f.Type == "String"
+}
+
+// word returns the expression which yields the field value as a word.
+func (f *Field) word(v string) string {
+	if f.Type == "uint32" {
+		return v
+	}
+	return "uint32(" + v + ")"
+}
+
+// value returns the expression which yields a field value from a word.
+func (f *Field) value(v string) string {
+	if f.Type == "uint32" {
+		return v
+	}
+	return f.Type + "(" + v + ")"
+}
+
+// scalarRun returns the number of consecutive, required single word
+// fields, starting at the first field.
+func scalarRun(fields []Field) int {
+	var n int
+
+	for _, f := range fields {
+		if f.Optional || f.isSlice() || f.isString() {
+			break
+		}
+		n++
+	}
+
+	return n
+}
+
+// genEncodedLen generates the EncodedLen method.
+func genEncodedLen(buf *bytes.Buffer, in *Instruction, fields []Field) {
+	var fixed int
+	var terms []string
+	var body bytes.Buffer
+
+	for _, f := range fields {
+		v := "c." + f.Name
+
+		switch {
+		case f.isSlice():
+			terms = append(terms, "len("+v+")")
+		case f.isString() && f.Optional:
+			fmt.Fprintf(&body, "\tif len(%s) > 0 {\n", v)
+			fmt.Fprintf(&body, "\t\tn += int(%s.EncodedLen())\n", v)
+			fmt.Fprintln(&body, "\t}")
+		case f.isString():
+			terms = append(terms, "int("+v+".EncodedLen())")
+		case f.Optional:
+			fmt.Fprintf(&body, "\tif %s != 0 {\n", v)
+			fmt.Fprintln(&body, "\t\tn++")
+			fmt.Fprintln(&body, "\t}")
+		default:
+			fixed++
+		}
+	}
+
+	if fixed > 0 || len(terms) == 0 {
+		terms = append([]string{fmt.Sprint(fixed)}, terms...)
+	}
+
+	expr := strings.Join(terms, " + ")
+
+	fmt.Fprintln(buf)
+
+	if body.Len() == 0 {
+		fmt.Fprintf(buf, "func (c *%s) EncodedLen() int { return %s }\n", in.Name, expr)
+		return
+	}
+
+	fmt.Fprintf(buf, "func (c *%s) EncodedLen() int {\n", in.Name)
+	fmt.Fprintf(buf, "\tn := %s\n", expr)
+	buf.Write(body.Bytes())
+	fmt.Fprintln(buf, "\treturn n")
+	fmt.Fprintln(buf, "}")
+}
+
+// genEncodeWords generates the EncodeWords method.
+func genEncodeWords(buf *bytes.Buffer, in *Instruction, fields []Field) {
+	fmt.Fprintln(buf)
+
+	if len(fields) == 0 {
+		fmt.Fprintf(buf, "func (c *%s) EncodeWords(out []uint32) []uint32 { return out }\n", in.Name)
+		return
+	}
+
+	fmt.Fprintf(buf, "func (c *%s) EncodeWords(out []uint32) []uint32 {\n", in.Name)
+
+	for i := 0; i < len(fields); i++ {
+		if n := scalarRun(fields[i:]); n > 0 {
+			words := make([]string, n)
+			for j, f := range fields[i : i+n] {
+				words[j] = f.word("c." + f.Name)
+			}
+
+			fmt.Fprintf(buf, "\tout = append(out, %s)\n", strings.Join(words, ", "))
+			i += n - 1
+			continue
+		}
+
+		f := &fields[i]
+		v := "c." + f.Name
+
+		switch {
+		case f.Type == "[]uint32":
+			fmt.Fprintf(buf, "\tout = append(out, %s...)\n", v)
+		case f.Type == "[]Id":
+			fmt.Fprintf(buf, "\tout = appendIds(out, %s)\n", v)
+		case f.isSlice():
+			fmt.Fprintf(buf, "\tfor _, v := range %s {\n", v)
+			fmt.Fprintln(buf, "\t\tout = append(out, uint32(v))")
+			fmt.Fprintln(buf, "\t}")
+		case f.isString() && f.Optional:
+			fmt.Fprintf(buf, "\tif len(%s) > 0 {\n", v)
+			fmt.Fprintf(buf, "\t\tout = appendString(out, %s)\n", v)
+			fmt.Fprintln(buf, "\t}")
+		case f.isString():
+			fmt.Fprintf(buf, "\tout = appendString(out, %s)\n", v)
+		default:
+			fmt.Fprintf(buf, "\tif %s != 0 {\n", v)
+			fmt.Fprintf(buf, "\t\tout = append(out, %s)\n", f.word(v))
+			fmt.Fprintln(buf, "\t}")
+		}
+	}
+
+	fmt.Fprintln(buf, "\treturn out")
+	fmt.Fprintln(buf, "}")
+}
+
+// genDecodeWords generates the DecodeWords method.
+//
+// Optional fields are only decoded if there are words left. Slices
+// consume all remaining words. Surplus words are ignored, just as
+// they are by the reflection based decoder.
+func genDecodeWords(buf *bytes.Buffer, in *Instruction, fields []Field) {
+	fmt.Fprintln(buf)
+
+	if len(fields) == 0 {
+		fmt.Fprintf(buf, "func (c *%s) DecodeWords(argv []uint32) error { return nil }\n", in.Name)
+		return
+	}
+
+	fmt.Fprintf(buf, "func (c *%s) DecodeWords(argv []uint32) error {\n", in.Name)
+
+	for i := 0; i < len(fields); i++ {
+		if n := scalarRun(fields[i:]); n > 0 {
+			fmt.Fprintf(buf, "\tif len(argv) < %d {\n", n)
+			fmt.Fprintln(buf, "\t\treturn ErrMissingInstructionArgs")
+			fmt.Fprintln(buf, "\t}")
+			fmt.Fprintln(buf)
+
+			for j, f := range fields[i : i+n] {
+				fmt.Fprintf(buf, "\tc.%s = %s\n", f.Name, f.value(fmt.Sprintf("argv[%d]", j)))
+			}
+
+			i += n - 1
+			if i < len(fields)-1 {
+				fmt.Fprintf(buf, "\targv = argv[%d:]\n", n)
+			}
+
+			fmt.Fprintln(buf)
+			continue
+		}
+
+		f := &fields[i]
+		last := i == len(fields)-1
+		v := "c." + f.Name
+
+		switch {
+		case f.isSlice():
+			fmt.Fprintln(buf, "\tif len(argv) > 0 {")
+			switch f.Type {
+			case "[]uint32":
+				fmt.Fprintf(buf, "\t\t%s = Copy(argv)\n", v)
+			case "[]Id":
+				fmt.Fprintf(buf, "\t\t%s = decodeIds(argv)\n", v)
+			default:
+				elem := Field{Type: f.Type[2:]}
+				fmt.Fprintf(buf, "\t\t%s = make(%s, len(argv))\n", v, f.Type)
+				fmt.Fprintln(buf, "\t\tfor i, w := range argv {")
+				fmt.Fprintf(buf, "\t\t\t%s[i] = %s\n", v, elem.value("w"))
+				fmt.Fprintln(buf, "\t\t}")
+			}
+			if !last {
+				fmt.Fprintln(buf, "\t\targv = nil")
+			}
+			fmt.Fprintln(buf, "\t}")
+
+		case f.isString():
+			if f.Optional {
+				fmt.Fprintln(buf, "\tif len(argv) > 0 {")
+			} else {
+				fmt.Fprintln(buf, "\tif len(argv) == 0 {")
+				fmt.Fprintln(buf, "\t\treturn ErrMissingInstructionArgs")
+				fmt.Fprintln(buf, "\t}")
+				fmt.Fprintln(buf)
+			}
+
+			indent := "\t"
+			if f.Optional {
+				indent = "\t\t"
+			}
+
+			if last {
+				fmt.Fprintf(buf, "%s%s, _ = decodeStringWords(argv)\n", indent, v)
+			} else {
+				fmt.Fprintf(buf, "%s%s, argv = decodeStringWords(argv)\n", indent, v)
+			}
+
+			if f.Optional {
+				fmt.Fprintln(buf, "\t}")
+			}
+
+		default:
+			fmt.Fprintln(buf, "\tif len(argv) > 0 {")
+			fmt.Fprintf(buf, "\t\t%s = %s\n", v, f.value("argv[0]"))
+			if !last {
+				fmt.Fprintln(buf, "\t\targv = argv[1:]")
+			}
+			fmt.Fprintln(buf, "\t}")
+		}
+
+		fmt.Fprintln(buf)
+	}
+
+	fmt.Fprintln(buf, "\treturn nil")
+	fmt.Fprintln(buf, "}")
+}
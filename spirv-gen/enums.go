@@ -0,0 +1,132 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"unicode"
+)
+
+// genEnums generates the enumeration types, their values and
+// the tables mapping values to names.
+func genEnums(g *Grammar, src *Source) *bytes.Buffer {
+	buf := newFile()
+	enums := g.Enums()
+
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, `import "errors"`)
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "var (")
+	for _, k := range enums {
+		fmt.Fprintf(buf, "\tErrInvalid%s = errors.New(\"invalid %s value\")\n", k.Kind, k.Kind)
+	}
+	fmt.Fprintln(buf, ")")
+
+	for _, k := range enums {
+		genEnum(buf, src, k)
+	}
+
+	return buf
+}
+
+// genEnum generates the definitions for a single enumeration.
+func genEnum(buf *bytes.Buffer, src *Source, k *OperandKind) {
+	table := tableName(k.Kind)
+
+	fmt.Fprintln(buf)
+	writeLines(buf, "", src.Doc(k.Kind, ""))
+	fmt.Fprintf(buf, "type %s uint32\n", k.Kind)
+	fmt.Fprintln(buf)
+	fmt.Fprintf(buf, "func (v %s) Verify() error {\n", k.Kind)
+	fmt.Fprintf(buf, "\tif %s.valid(uint32(v)) {\n", table)
+	fmt.Fprintln(buf, "\t\treturn nil")
+	fmt.Fprintln(buf, "\t}")
+	fmt.Fprintf(buf, "\treturn ErrInvalid%s\n", k.Kind)
+	fmt.Fprintln(buf, "}")
+
+	fmt.Fprintln(buf)
+	if len(k.Enumerants) > 0 {
+		writeLines(buf, "", src.Doc("const "+constName(k, k.Enumerants[0]),
+			fmt.Sprintf("Known %s values.", k.Kind)))
+	}
+	fmt.Fprintln(buf, "const (")
+
+	// Documented values are set apart by blank lines.
+	var prev []string
+	for i, e := range k.Enumerants {
+		name := constName(k, e)
+		doc := src.Doc(name, "")
+
+		if i > 0 && (len(doc) > 0 || len(prev) > 0) {
+			fmt.Fprintln(buf)
+		}
+
+		prev = doc
+
+		writeLines(buf, "\t", doc)
+		fmt.Fprintf(buf, "\t%s = %s", name, constValue(k, e))
+
+		if c := src.Comment(name); len(c) > 0 {
+			fmt.Fprint(buf, " ", c)
+		}
+
+		fmt.Fprintln(buf)
+	}
+
+	fmt.Fprintln(buf, ")")
+
+	fmt.Fprintln(buf)
+	fmt.Fprintf(buf, "var %s = &enumTable{\n", table)
+	if k.IsFlags() {
+		fmt.Fprintln(buf, "\tflags: true,")
+	}
+	fmt.Fprintln(buf, "\tnames: map[uint32]string{")
+	for _, e := range k.Enumerants {
+		fmt.Fprintf(buf, "\t\t%s: %q,\n", constName(k, e), e.Name)
+	}
+	fmt.Fprintln(buf, "\t},")
+	fmt.Fprintln(buf, "}")
+
+	fmt.Fprintln(buf)
+	fmt.Fprintf(buf, "func (v %s) String() string { return %s.String(uint32(v)) }\n", k.Kind, table)
+}
+
+// constName returns the name of the constant for the given enumerant.
+func constName(k *OperandKind, e *Enumerant) string {
+	return k.Kind + e.Name
+}
+
+// constValue returns the constant value for the given enumerant.
+// Bit flags are written in hexadecimal notation.
+func constValue(k *OperandKind, e *Enumerant) string {
+	if k.IsFlags() && e.Value != 0 {
+		return fmt.Sprintf("0x%x", uint32(e.Value))
+	}
+	return fmt.Sprintf("%d", uint32(e.Value))
+}
+
+// tableName returns the name of the enumTable for the given kind.
+// This is the kind name with its leading upper case run lowered,
+// followed by "Names". For example: FPFastMathMode yields
+// fpFastMathModeNames.
+func tableName(kind string) string {
+	r := []rune(kind)
+
+	n := 0
+	for n < len(r) && unicode.IsUpper(r[n]) {
+		n++
+	}
+
+	// Keep the first letter of the next word in upper case.
+	if n > 1 && n < len(r) {
+		n--
+	}
+
+	for i := 0; i < n; i++ {
+		r[i] = unicode.ToLower(r[i])
+	}
+
+	return string(r) + "Names"
+}
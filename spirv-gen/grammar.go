@@ -0,0 +1,343 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Grammar defines the contents of the machine-readable SPIR-V grammar,
+// as published by Khronos in spirv.core.grammar.json.
+type Grammar struct {
+	MagicNumber  string         `json:"magic_number"`
+	MajorVersion int            `json:"major_version"`
+	MinorVersion int            `json:"minor_version"`
+	Revision     int            `json:"revision"`
+	Instructions []*Instruction `json:"instructions"`
+	OperandKinds []*OperandKind `json:"operand_kinds"`
+}
+
+// Instruction describes a single instruction.
+type Instruction struct {
+	Name     string     `json:"opname"`
+	Class    string     `json:"class"`
+	Opcode   uint32     `json:"opcode"`
+	Operands []*Operand `json:"operands"`
+}
+
+// Operand describes a single instruction or enumerant operand.
+type Operand struct {
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Quantifier string `json:"quantifier"`
+}
+
+// OperandKind describes a type of operand.
+//
+// The category is one of "Id", "Literal", "Composite",
+// "ValueEnum" or "BitEnum".
+type OperandKind struct {
+	Category   string       `json:"category"`
+	Kind       string       `json:"kind"`
+	Enumerants []*Enumerant `json:"enumerants"`
+	Bases      []string     `json:"bases"`
+}
+
+// Enumerant describes a single value of an enumeration.
+type Enumerant struct {
+	Name       string     `json:"enumerant"`
+	Value      Value      `json:"value"`
+	Parameters []*Operand `json:"parameters"`
+}
+
+// Value is an enumerant value. The grammar lists value enumerants as
+// numbers and bit enumerants as hexadecimal strings.
+type Value uint32
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	var n json.Number
+
+	err := json.Unmarshal(data, &n)
+	if err != nil {
+		var s string
+
+		err = json.Unmarshal(data, &s)
+		if err != nil {
+			return err
+		}
+
+		n = json.Number(s)
+	}
+
+	x, err := strconv.ParseUint(string(n), 0, 32)
+	*v = Value(x)
+	return err
+}
+
+// loadGrammar reads the grammar from the given file.
+func loadGrammar(file string) (*Grammar, error) {
+	fd, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+
+	defer fd.Close()
+
+	var g Grammar
+	err = json.NewDecoder(fd).Decode(&g)
+	if err != nil {
+		return nil, err
+	}
+
+	return &g, nil
+}
+
+// Kind returns the operand kind with the given name, or nil if
+// there is none.
+func (g *Grammar) Kind(name string) *OperandKind {
+	for _, k := range g.OperandKinds {
+		if k.Kind == name {
+			return k
+		}
+	}
+	return nil
+}
+
+// Enums returns all enumeration kinds, in the order they are defined.
+func (g *Grammar) Enums() []*OperandKind {
+	var out []*OperandKind
+
+	for _, k := range g.OperandKinds {
+		if k.IsEnum() {
+			out = append(out, k)
+		}
+	}
+
+	return out
+}
+
+// IsEnum returns true if k is a value or bit enumeration.
+func (k *OperandKind) IsEnum() bool {
+	return k.Category == "ValueEnum" || k.Category == "BitEnum"
+}
+
+// IsFlags returns true if k is a bit enumeration.
+func (k *OperandKind) IsFlags() bool {
+	return k.Category == "BitEnum"
+}
+
+// HasParameters returns true if any of the enumerants in k
+// take additional operands.
+func (k *OperandKind) HasParameters() bool {
+	for _, e := range k.Enumerants {
+		if len(e.Parameters) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Field defines a single struct field for an instruction.
+type Field struct {
+	Name     string
+	Type     string
+	Optional bool
+
+	// Ids defines which of the field's words are ids used as operands:
+	// "all", "even" or "odd" for alternating literal/id pairs, "param"
+	// if they are ids only for the values in IdValues, or empty if there
+	// are none.
+	Ids string
+
+	// Enum is the name of the field holding the enumerant which selects
+	// the parameters in this field. It is set if Ids is "param".
+	Enum string
+
+	// IdValues lists the enumerant constants whose parameters are all ids.
+	IdValues []string
+}
+
+// Fields returns the struct fields for the given instruction.
+//
+// Operands whose kind is an enumeration with parameterized values are
+// followed by an Argv field, which holds the parameters.
+func (g *Grammar) Fields(in *Instruction) []Field {
+	var out []Field
+
+	for _, op := range in.Operands {
+		out = append(out, Field{
+			Name:     fieldName(op),
+			Type:     g.fieldType(op),
+			Optional: op.Quantifier == "?",
+			Ids:      g.operandIds(op),
+		})
+
+		kind := g.Kind(op.Kind)
+		if kind == nil || !kind.IsEnum() || !kind.HasParameters() {
+			continue
+		}
+
+		argv := Field{
+			Name: "Argv",
+			Type: g.parameterType(kind),
+		}
+
+		if argv.Type == "[]Id" {
+			argv.Ids = "all"
+		} else if values := g.idParameterValues(kind); len(values) > 0 {
+			argv.Ids = "param"
+			argv.Enum = fieldName(op)
+			argv.IdValues = values
+		}
+
+		out = append(out, argv)
+	}
+
+	return out
+}
+
+// operandIds returns which words of the given operand hold ids
+// which are used by the instruction. The result id is a definition,
+// rather than a use, and is therefore excluded.
+func (g *Grammar) operandIds(op *Operand) string {
+	switch op.Kind {
+	case "IdResult":
+		return ""
+	case "PairIdRefIdRef":
+		return "all"
+	case "PairIdRefLiteralInteger":
+		return "even"
+	case "PairLiteralIntegerIdRef":
+		return "odd"
+	}
+
+	k := g.Kind(op.Kind)
+	if k != nil && k.Category == "Id" {
+		return "all"
+	}
+
+	return ""
+}
+
+// fieldName returns the Go field name for the given operand.
+func fieldName(op *Operand) string {
+	switch op.Kind {
+	case "IdResultType":
+		return "ResultType"
+	case "IdResult":
+		return "ResultId"
+	}
+
+	if len(op.Name) == 0 {
+		return op.Kind
+	}
+
+	return goName(op.Name)
+}
+
+// goName turns a grammar operand name like "'Sampled Image'"
+// into a Go identifier: "SampledImage".
+func goName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+
+	return strings.Join(words, "")
+}
+
+// fieldType returns the Go type for the given operand.
+func (g *Grammar) fieldType(op *Operand) string {
+	var typ string
+
+	switch op.Kind {
+	case "LiteralContextDependentNumber":
+		// Its size depends on the type of the result,
+		// so it is always a list of words.
+		return "[]uint32"
+	case "PairIdRefIdRef":
+		typ = "Id"
+	case "PairLiteralIntegerIdRef", "PairIdRefLiteralInteger":
+		typ = "uint32"
+	default:
+		typ = g.baseType(op.Kind)
+	}
+
+	if op.Quantifier == "*" || strings.HasPrefix(op.Kind, "Pair") {
+		return "[]" + typ
+	}
+
+	return typ
+}
+
+// baseType returns the Go type for a single, non-composite operand kind.
+func (g *Grammar) baseType(kind string) string {
+	if kind == "LiteralString" {
+		return "String"
+	}
+
+	k := g.Kind(kind)
+	if k == nil {
+		return "uint32"
+	}
+
+	switch k.Category {
+	case "Id":
+		return "Id"
+	case "ValueEnum", "BitEnum":
+		return kind
+	}
+
+	return "uint32"
+}
+
+// parameterType returns the type of the Argv field which holds the
+// parameters for values of the given enumeration. These are Ids if
+// all parameters are Ids, or plain words otherwise.
+func (g *Grammar) parameterType(k *OperandKind) string {
+	for _, e := range k.Enumerants {
+		for _, p := range e.Parameters {
+			if g.baseType(p.Kind) != "Id" {
+				return "[]uint32"
+			}
+		}
+	}
+	return "[]Id"
+}
+
+// idParameterValues returns the constant names of the enumerants in k
+// whose parameters are all ids. This only applies to value enumerations:
+// the parameters of combined flags can not be told apart.
+func (g *Grammar) idParameterValues(k *OperandKind) []string {
+	if k.IsFlags() {
+		return nil
+	}
+
+	var out []string
+
+outer:
+	for _, e := range k.Enumerants {
+		if len(e.Parameters) == 0 {
+			continue
+		}
+
+		for _, p := range e.Parameters {
+			if g.baseType(p.Kind) != "Id" {
+				continue outer
+			}
+		}
+
+		out = append(out, constName(k, e))
+	}
+
+	return out
+}
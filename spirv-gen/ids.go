@@ -0,0 +1,227 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// genIds generates the methods which list the ids defined and used
+// by each instruction. These back the ResultId, ResultType, Operands
+// and RewriteOperands functions.
+func genIds(g *Grammar) *bytes.Buffer {
+	buf := newFile()
+
+	for _, in := range g.Instructions {
+		fields := g.Fields(in)
+		genResultId(buf, in, fields)
+		genSetResultId(buf, in, fields)
+		genResultType(buf, in, fields)
+		genAppendOperands(buf, in, fields)
+		genRewriteOperands(buf, in, fields)
+	}
+
+	return buf
+}
+
+// genResultId generates the resultId method.
+func genResultId(buf *bytes.Buffer, in *Instruction, fields []Field) {
+	fmt.Fprintln(buf)
+
+	for _, f := range fields {
+		if f.Name == "ResultId" {
+			fmt.Fprintf(buf, "func (c *%s) resultId() (Id, bool) { return c.ResultId, true }\n", in.Name)
+			return
+		}
+	}
+
+	fmt.Fprintf(buf, "func (c *%s) resultId() (Id, bool) { return 0, false }\n", in.Name)
+}
+
+// genSetResultId generates the setResultId method.
+func genSetResultId(buf *bytes.Buffer, in *Instruction, fields []Field) {
+	fmt.Fprintln(buf)
+
+	for _, f := range fields {
+		if f.Name == "ResultId" {
+			fmt.Fprintf(buf, "func (c *%s) setResultId(id Id) bool {\n", in.Name)
+			fmt.Fprintln(buf, "\tc.ResultId = id")
+			fmt.Fprintln(buf, "\treturn true")
+			fmt.Fprintln(buf, "}")
+			return
+		}
+	}
+
+	fmt.Fprintf(buf, "func (c *%s) setResultId(id Id) bool { return false }\n", in.Name)
+}
+
+// genResultType generates the resultType method.
+func genResultType(buf *bytes.Buffer, in *Instruction, fields []Field) {
+	fmt.Fprintln(buf)
+
+	for _, f := range fields {
+		if f.Name == "ResultType" {
+			fmt.Fprintf(buf, "func (c *%s) resultType() (Id, bool) { return c.ResultType, true }\n", in.Name)
+			return
+		}
+	}
+
+	fmt.Fprintf(buf, "func (c *%s) resultType() (Id, bool) { return 0, false }\n", in.Name)
+}
+
+// genAppendOperands generates the appendOperands method.
+func genAppendOperands(buf *bytes.Buffer, in *Instruction, fields []Field) {
+	var ids []Field
+	simple := true
+
+	for _, f := range fields {
+		if len(f.Ids) == 0 {
+			continue
+		}
+
+		ids = append(ids, f)
+		simple = simple && !f.Optional && !f.isSlice()
+	}
+
+	fmt.Fprintln(buf)
+
+	if len(ids) == 0 {
+		fmt.Fprintf(buf, "func (c *%s) appendOperands(out []Id) []Id { return out }\n", in.Name)
+		return
+	}
+
+	if simple {
+		names := make([]string, len(ids))
+		for i, f := range ids {
+			names[i] = "c." + f.Name
+		}
+
+		fmt.Fprintf(buf, "func (c *%s) appendOperands(out []Id) []Id {\n", in.Name)
+		fmt.Fprintf(buf, "\treturn append(out, %s)\n", strings.Join(names, ", "))
+		fmt.Fprintln(buf, "}")
+		return
+	}
+
+	fmt.Fprintf(buf, "func (c *%s) appendOperands(out []Id) []Id {\n", in.Name)
+
+	for i := 0; i < len(ids); i++ {
+		f := ids[i]
+		v := "c." + f.Name
+
+		switch {
+		case !f.isSlice() && f.Optional:
+			fmt.Fprintf(buf, "\tif %s != 0 {\n", v)
+			fmt.Fprintf(buf, "\t\tout = append(out, %s)\n", v)
+			fmt.Fprintln(buf, "\t}")
+
+		case !f.isSlice():
+			// Group consecutive, required ids.
+			names := []string{v}
+			for i+1 < len(ids) && !ids[i+1].Optional && !ids[i+1].isSlice() {
+				i++
+				names = append(names, "c."+ids[i].Name)
+			}
+
+			fmt.Fprintf(buf, "\tout = append(out, %s)\n", strings.Join(names, ", "))
+
+		case f.Type == "[]Id" && f.Ids == "all":
+			fmt.Fprintf(buf, "\tout = append(out, %s...)\n", v)
+
+		case f.Ids == "param":
+			fmt.Fprintf(buf, "\tswitch c.%s {\n", f.Enum)
+			fmt.Fprintf(buf, "\tcase %s:\n", strings.Join(f.IdValues, ", "))
+			fmt.Fprintf(buf, "\t\tfor _, id := range %s {\n", v)
+			fmt.Fprintln(buf, "\t\t\tout = append(out, Id(id))")
+			fmt.Fprintln(buf, "\t\t}")
+			fmt.Fprintln(buf, "\t}")
+
+		default:
+			start, step := 0, 1
+			switch f.Ids {
+			case "even":
+				step = 2
+			case "odd":
+				start, step = 1, 2
+			}
+
+			fmt.Fprintf(buf, "\tfor i := %d; i < len(%s); i += %d {\n", start, v, step)
+			fmt.Fprintf(buf, "\t\tout = append(out, Id(%s[i]))\n", v)
+			fmt.Fprintln(buf, "\t}")
+		}
+	}
+
+	fmt.Fprintln(buf, "\treturn out")
+	fmt.Fprintln(buf, "}")
+}
+
+// genRewriteOperands generates the rewriteOperands method. It visits the
+// same ids as appendOperands, in the same order.
+func genRewriteOperands(buf *bytes.Buffer, in *Instruction, fields []Field) {
+	var ids []Field
+	for _, f := range fields {
+		if len(f.Ids) > 0 {
+			ids = append(ids, f)
+		}
+	}
+
+	fmt.Fprintln(buf)
+
+	if len(ids) == 0 {
+		fmt.Fprintf(buf, "func (c *%s) rewriteOperands(f func(Id) Id) {}\n", in.Name)
+		return
+	}
+
+	fmt.Fprintf(buf, "func (c *%s) rewriteOperands(f func(Id) Id) {\n", in.Name)
+
+	for _, fd := range ids {
+		v := "c." + fd.Name
+
+		switch {
+		case !fd.isSlice() && fd.Optional:
+			fmt.Fprintf(buf, "\tif %s != 0 {\n", v)
+			fmt.Fprintf(buf, "\t\t%s = f(%s)\n", v, v)
+			fmt.Fprintln(buf, "\t}")
+
+		case !fd.isSlice():
+			fmt.Fprintf(buf, "\t%s = f(%s)\n", v, v)
+
+		case fd.Ids == "param":
+			fmt.Fprintf(buf, "\tswitch c.%s {\n", fd.Enum)
+			fmt.Fprintf(buf, "\tcase %s:\n", strings.Join(fd.IdValues, ", "))
+			fmt.Fprintf(buf, "\t\tfor i := range %s {\n", v)
+			fmt.Fprintf(buf, "\t\t\t%s[i] = uint32(f(Id(%s[i])))\n", v, v)
+			fmt.Fprintln(buf, "\t\t}")
+			fmt.Fprintln(buf, "\t}")
+
+		default:
+			start, step := 0, 1
+			switch fd.Ids {
+			case "even":
+				step = 2
+			case "odd":
+				start, step = 1, 2
+			}
+
+			elem := strings.TrimPrefix(fd.Type, "[]")
+			x := v + "[i]"
+			if elem == "Id" {
+				x = fmt.Sprintf("f(%s)", x)
+			} else {
+				x = fmt.Sprintf("%s(f(Id(%s)))", elem, x)
+			}
+
+			if start == 0 && step == 1 {
+				fmt.Fprintf(buf, "\tfor i := range %s {\n", v)
+			} else {
+				fmt.Fprintf(buf, "\tfor i := %d; i < len(%s); i += %d {\n", start, v, step)
+			}
+			fmt.Fprintf(buf, "\t\t%s[i] = %s\n", v, x)
+			fmt.Fprintln(buf, "\t}")
+		}
+	}
+
+	fmt.Fprintln(buf, "}")
+}
@@ -0,0 +1,143 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// classFiles maps instruction classes to the file which holds them.
+// Classes which are not listed here go into a file named after
+// the lower cased class name.
+var classFiles = map[string]string{
+	"Annotation":             "instructions_annotations.go",
+	"Bit":                    "instructions_arithmetic.go",
+	"Control-Flow":           "instructions_flowcontrol.go",
+	"Function":               "instructions_functions.go",
+	"Relational_and_Logical": "instructions_relational.go",
+}
+
+// classFile returns the name of the file for the given instruction class.
+func classFile(class string) string {
+	name, ok := classFiles[class]
+	if ok {
+		return name
+	}
+
+	name = strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(class))
+
+	return "instructions_" + name + ".go"
+}
+
+// opcodeName returns the name of the opcode constant for an instruction.
+func opcodeName(name string) string {
+	return "opcode" + strings.TrimPrefix(name, "Op")
+}
+
+// genInstructions generates the instruction type definitions,
+// grouped into files by instruction class.
+func genInstructions(g *Grammar, src *Source) map[string]*bytes.Buffer {
+	groups := make(map[string][]*Instruction)
+	var order []string
+
+	for _, in := range g.Instructions {
+		file := classFile(in.Class)
+		if _, ok := groups[file]; !ok {
+			order = append(order, file)
+		}
+		groups[file] = append(groups[file], in)
+	}
+
+	files := make(map[string]*bytes.Buffer)
+
+	for _, file := range order {
+		buf := newFile()
+
+		for _, in := range groups[file] {
+			genInstruction(buf, g, src, in)
+		}
+
+		fmt.Fprintln(buf)
+		fmt.Fprintln(buf, "func init() {")
+		for _, in := range groups[file] {
+			fmt.Fprintf(buf, "\tbind(func() Instruction { return &%s{} })\n", in.Name)
+		}
+		fmt.Fprintln(buf, "}")
+
+		files[file] = buf
+	}
+
+	return files
+}
+
+// genInstruction generates the type definition for a single instruction.
+func genInstruction(buf *bytes.Buffer, g *Grammar, src *Source, in *Instruction) {
+	fmt.Fprintln(buf)
+	writeLines(buf, "", src.Doc(in.Name,
+		fmt.Sprintf("%s represents the %s instruction.", in.Name, in.Name)))
+
+	fields := g.Fields(in)
+	if len(fields) == 0 {
+		fmt.Fprintf(buf, "type %s struct{}\n", in.Name)
+	} else {
+		fmt.Fprintf(buf, "type %s struct {\n", in.Name)
+
+		for i, f := range fields {
+			key := in.Name + "." + f.Name
+			doc := src.Doc(key, "")
+
+			if i > 0 && len(doc) > 0 {
+				fmt.Fprintln(buf)
+			}
+
+			writeLines(buf, "\t", doc)
+			fmt.Fprintf(buf, "\t%s %s", f.Name, f.Type)
+
+			if f.Optional {
+				fmt.Fprint(buf, " `spirv:\"optional\"`")
+			}
+
+			if c := src.Comment(key); len(c) > 0 {
+				fmt.Fprint(buf, " ", c)
+			}
+
+			fmt.Fprintln(buf)
+		}
+
+		fmt.Fprintln(buf, "}")
+	}
+
+	verify := "nil"
+	if src.Verifiers[in.Name] {
+		verify = "c.verify()"
+	}
+
+	fmt.Fprintln(buf)
+	fmt.Fprintf(buf, "func (c *%s) Opcode() uint32 { return %s }\n", in.Name, opcodeName(in.Name))
+	fmt.Fprintf(buf, "func (c *%s) Optional() bool { return %v }\n", in.Name, in.Class == "Debug")
+	fmt.Fprintf(buf, "func (c *%s) Verify() error { return %s }\n", in.Name, verify)
+}
+
+// genOpcodes generates the list of opcode constants.
+func genOpcodes(g *Grammar, src *Source) *bytes.Buffer {
+	buf := newFile()
+
+	fmt.Fprintln(buf)
+	writeLines(buf, "", src.Doc("const opcodeNop", "List of known opcodes."))
+	fmt.Fprintln(buf, "const (")
+
+	for _, in := range g.Instructions {
+		fmt.Fprintf(buf, "\t%s = %d\n", opcodeName(in.Name), in.Opcode)
+	}
+
+	fmt.Fprintln(buf, ")")
+	return buf
+}
@@ -0,0 +1,114 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// generatedNote is written at the top of every generated file.
+var generatedNote = []string{
+	"// This file is generated by spirv-gen from the SPIR-V grammar. Apart from",
+	"// documentation comments, which are kept when the file is regenerated,",
+	"// it should not be edited by hand.",
+}
+
+func main() {
+	opts := parseArgs()
+
+	g, err := loadGrammar(opts.grammar)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	src, err := loadSource(opts.out)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	for name, buf := range generate(g, src) {
+		err = writeFile(filepath.Join(opts.out, name), buf)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// generate returns the contents of all generated files, by file name.
+func generate(g *Grammar, src *Source) map[string]*bytes.Buffer {
+	files := genInstructions(g, src)
+	files["opcodes.go"] = genOpcodes(g, src)
+	files["constant.go"] = genEnums(g, src)
+	files["instructioncodec.go"] = genCodec(g)
+	files["instructionids.go"] = genIds(g)
+	return files
+}
+
+// options defines the command line options.
+type options struct {
+	grammar string
+	out     string
+}
+
+// parseArgs parses and validates command line arguments.
+func parseArgs() *options {
+	var opts options
+
+	flag.Usage = func() {
+		fmt.Println("usage:", os.Args[0], "[options]")
+		flag.PrintDefaults()
+	}
+
+	version := flag.Bool("version", false, "Display version information.")
+	flag.StringVar(&opts.grammar, "grammar", "spirv.core.grammar.json", "Path to the SPIR-V grammar file.")
+	flag.StringVar(&opts.out, "out", ".", "Directory holding the spirv package source.")
+	flag.Parse()
+
+	if *version {
+		fmt.Println(Version())
+		os.Exit(0)
+	}
+
+	return &opts
+}
+
+// newFile returns a buffer holding the header for a generated file.
+func newFile() *bytes.Buffer {
+	var buf bytes.Buffer
+
+	fmt.Fprintln(&buf, "// This file is subject to a 1-clause BSD license.")
+	fmt.Fprintln(&buf, "// Its contents can be found in the enclosed LICENSE file.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, strings.Join(generatedNote, "\n"))
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package spirv")
+	return &buf
+}
+
+// writeLines writes the given comment lines with the given indentation.
+func writeLines(buf *bytes.Buffer, indent string, lines []string) {
+	for _, line := range lines {
+		fmt.Fprintf(buf, "%s%s\n", indent, line)
+	}
+}
+
+// writeFile formats the generated source and writes it to the given file.
+func writeFile(file string, buf *bytes.Buffer) error {
+	data, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s: %v", file, err)
+	}
+
+	return ioutil.WriteFile(file, data, 0644)
+}
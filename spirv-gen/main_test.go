@@ -0,0 +1,119 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerated ensures the package source matches the grammar.
+func TestGenerated(t *testing.T) {
+	g, err := loadGrammar("spirv.core.grammar.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := loadSource("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, buf := range generate(g, src) {
+		want, err := format.Source(buf.Bytes())
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		have, err := ioutil.ReadFile(filepath.Join("..", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(have, want) {
+			t.Fatalf("%s is out of date; run go generate", name)
+		}
+	}
+}
+
+// TestParameterIds ensures enumerant parameters which are ids
+// are listed as operands.
+func TestParameterIds(t *testing.T) {
+	g := &Grammar{
+		Instructions: []*Instruction{
+			{
+				Name: "OpDecorate",
+				Operands: []*Operand{
+					{Kind: "IdRef", Name: "'Target'"},
+					{Kind: "Decoration"},
+				},
+			},
+		},
+		OperandKinds: []*OperandKind{
+			{Category: "Id", Kind: "IdRef"},
+			{Category: "Literal", Kind: "LiteralInteger"},
+			{
+				Category: "ValueEnum",
+				Kind:     "Decoration",
+				Enumerants: []*Enumerant{
+					{Name: "RelaxedPrecision", Value: 0},
+					{Name: "SpecId", Value: 1, Parameters: []*Operand{{Kind: "LiteralInteger"}}},
+					{Name: "AlignmentId", Value: 46, Parameters: []*Operand{{Kind: "IdRef"}}},
+				},
+			},
+		},
+	}
+
+	src, err := format.Source(genIds(g).Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"\tswitch c.Decoration {\n\tcase DecorationAlignmentId:\n\t\tfor _, id := range c.Argv {\n\t\t\tout = append(out, Id(id))\n",
+		"\tswitch c.Decoration {\n\tcase DecorationAlignmentId:\n\t\tfor i := range c.Argv {\n\t\t\tc.Argv[i] = uint32(f(Id(c.Argv[i])))\n",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Fatalf("missing code:\n%s\nin:\n%s", want, src)
+		}
+	}
+}
+
+type NameTest struct {
+	in   string
+	want string
+}
+
+func TestGoName(t *testing.T) {
+	for i, st := range []NameTest{
+		{"'Sampled Image'", "SampledImage"},
+		{"'Result Type'", "ResultType"},
+		{"'x'", "X"},
+		{"'Image Operands'", "ImageOperands"},
+		{"'Execution Mode'", "ExecutionMode"},
+	} {
+		have := goName(st.in)
+		if have != st.want {
+			t.Fatalf("case %d: name mismatch:\nHave: %v\nWant: %v", i, have, st.want)
+		}
+	}
+}
+
+func TestTableName(t *testing.T) {
+	for i, st := range []NameTest{
+		{"Dim", "dimNames"},
+		{"FPFastMathMode", "fpFastMathModeNames"},
+		{"ImageOperands", "imageOperandsNames"},
+		{"BuiltIn", "builtInNames"},
+	} {
+		have := tableName(st.in)
+		if have != st.want {
+			t.Fatalf("case %d: name mismatch:\nHave: %v\nWant: %v", i, have, st.want)
+		}
+	}
+}
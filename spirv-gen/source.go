@@ -0,0 +1,160 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+)
+
+// Source holds the parts of the existing package source which are
+// written by hand and must survive regeneration.
+type Source struct {
+	// Docs maps declaration keys to their documentation comment lines.
+	// Keys are type names ("OpPhi"), struct fields ("OpPhi.Operands") and
+	// constant names ("DimCube"). Constant blocks are keyed by the name of
+	// their first constant, prefixed with "const ".
+	Docs map[string][]string
+
+	// Comments maps declaration keys to trailing line comments.
+	Comments map[string][]string
+
+	// Verifiers holds the names of all types which define
+	// a hand-written verify method.
+	Verifiers map[string]bool
+}
+
+// loadSource parses all non-test Go files in the given directory.
+func loadSource(dir string) (*Source, error) {
+	src := &Source{
+		Docs:      make(map[string][]string),
+		Comments:  make(map[string][]string),
+		Verifiers: make(map[string]bool),
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				src.addGenDecl(d)
+			case *ast.FuncDecl:
+				src.addFuncDecl(d)
+			}
+		}
+	}
+
+	return src, nil
+}
+
+// addGenDecl records the documentation for type and constant declarations.
+func (s *Source) addGenDecl(d *ast.GenDecl) {
+	switch d.Tok {
+	case token.TYPE:
+		for _, spec := range d.Specs {
+			ts := spec.(*ast.TypeSpec)
+			name := ts.Name.Name
+
+			if len(d.Specs) == 1 {
+				s.set(s.Docs, name, d.Doc)
+			} else {
+				s.set(s.Docs, name, ts.Doc)
+			}
+
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+
+			for _, fld := range st.Fields.List {
+				for _, id := range fld.Names {
+					s.set(s.Docs, name+"."+id.Name, fld.Doc)
+					s.set(s.Comments, name+"."+id.Name, fld.Comment)
+				}
+			}
+		}
+
+	case token.CONST:
+		for i, spec := range d.Specs {
+			vs := spec.(*ast.ValueSpec)
+
+			for _, id := range vs.Names {
+				if i == 0 && d.Lparen.IsValid() {
+					s.set(s.Docs, "const "+id.Name, d.Doc)
+				}
+
+				s.set(s.Docs, id.Name, vs.Doc)
+				s.set(s.Comments, id.Name, vs.Comment)
+			}
+		}
+	}
+}
+
+// addFuncDecl records hand-written verify methods.
+func (s *Source) addFuncDecl(d *ast.FuncDecl) {
+	if d.Recv == nil || d.Name.Name != "verify" || len(d.Recv.List) != 1 {
+		return
+	}
+
+	typ := d.Recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+
+	if id, ok := typ.(*ast.Ident); ok {
+		s.Verifiers[id.Name] = true
+	}
+}
+
+// set stores the raw comment lines of cg under the given key.
+func (s *Source) set(m map[string][]string, key string, cg *ast.CommentGroup) {
+	if cg == nil {
+		return
+	}
+
+	lines := make([]string, len(cg.List))
+	for i, c := range cg.List {
+		lines[i] = c.Text
+	}
+
+	m[key] = lines
+}
+
+// Doc returns the documentation lines for the given key. If there are
+// none, the default text is returned as a single comment line.
+func (s *Source) Doc(key, def string) []string {
+	doc, ok := s.Docs[key]
+	if ok {
+		return doc
+	}
+
+	if len(def) == 0 {
+		return nil
+	}
+
+	return []string{"// " + def}
+}
+
+// Comment returns the trailing line comment for the given key.
+func (s *Source) Comment(key string) string {
+	return strings.Join(s.Comments[key], " ")
+}
@@ -0,0 +1,363 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"unicode"
+
+	"github.com/jteeuwen/spirv"
+)
+
+// generate returns the Go source describing the interface of the given
+// module, as part of the given package.
+func generate(m *spirv.Module, pkg string) ([]byte, error) {
+	r, err := spirv.Reflect(m)
+	if err != nil {
+		return nil, err
+	}
+
+	g := newGenerator(m)
+
+	var bindings, locations bytes.Buffer
+
+	for _, v := range r.Bindings {
+		name := g.ident(g.varName(v), "Set", "Binding")
+		fmt.Fprintf(&bindings, "\t%sSet = %d\n", name, v.Set)
+		fmt.Fprintf(&bindings, "\t%sBinding = %d\n", name, v.Binding)
+
+		if err := g.block(v, name); err != nil {
+			return nil, err
+		}
+	}
+
+	seen := make(map[spirv.Id]bool)
+
+	for _, ep := range r.EntryPoints {
+		for _, v := range ep.Inputs {
+			if v.Location < 0 || seen[v.Id] {
+				continue
+			}
+
+			seen[v.Id] = true
+			name := g.ident(g.varName(v), "Location")
+			fmt.Fprintf(&locations, "\t%sLocation = %d\n", name, v.Location)
+		}
+	}
+
+	var buf bytes.Buffer
+
+	fmt.Fprintln(&buf, "// Code generated by spirv-gogen. DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintf(&buf, "package %s\n", pkg)
+
+	if bindings.Len() > 0 {
+		fmt.Fprintln(&buf)
+		fmt.Fprintln(&buf, "// Descriptor sets and bindings.")
+		fmt.Fprintf(&buf, "const (\n%s)\n", bindings.Bytes())
+	}
+
+	if locations.Len() > 0 {
+		fmt.Fprintln(&buf)
+		fmt.Fprintln(&buf, "// Input locations.")
+		fmt.Fprintf(&buf, "const (\n%s)\n", locations.Bytes())
+	}
+
+	buf.Write(g.decls.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+// generator holds the state needed to generate Go types.
+type generator struct {
+	m       *spirv.Module
+	names   map[spirv.Id]string // Names given by OpName.
+	buffer  map[spirv.Id]bool   // Structs decorated with BufferBlock.
+	types   map[typeKey]string  // Go names of the generated struct types.
+	structs map[spirv.Id]bool   // Structs with at least one generated type.
+	used    map[string]bool     // Go identifiers in use.
+	decls   bytes.Buffer        // Generated type declarations.
+}
+
+// typeKey identifies a generated struct type. A struct used under
+// different layout rules gets a Go type for each of them.
+type typeKey struct {
+	id    spirv.Id
+	rules spirv.LayoutRules
+}
+
+// newGenerator creates a generator for the given module.
+func newGenerator(m *spirv.Module) *generator {
+	g := &generator{
+		m:       m,
+		names:   make(map[spirv.Id]string),
+		buffer:  make(map[spirv.Id]bool),
+		types:   make(map[typeKey]string),
+		structs: make(map[spirv.Id]bool),
+		used:    make(map[string]bool),
+	}
+
+	for _, instr := range m.Code {
+		switch v := instr.(type) {
+		case *spirv.OpName:
+			g.names[v.Target] = string(v.Name)
+		case *spirv.OpDecorate:
+			if v.Decoration == spirv.DecorationBufferBlock {
+				g.buffer[v.Target] = true
+			}
+		}
+	}
+
+	return g
+}
+
+// varName returns the Go name for the given variable. It is named after
+// the variable, or after its type if the variable has no name.
+func (g *generator) varName(v *spirv.ReflectVariable) string {
+	if name := goName(v.Name); name != "" {
+		return name
+	}
+
+	if v.Type != nil {
+		if name := goName(g.names[v.Type.Id]); name != "" {
+			return name
+		}
+	}
+
+	return fmt.Sprintf("Var%d", v.Id)
+}
+
+// ident returns an unused identifier, starting with the given name.
+// If suffixes are given, the identifiers formed by appending each of
+// them to the name are returned unused instead, while the name itself
+// is not. The identifiers are marked as used.
+func (g *generator) ident(name string, suffixes ...string) string {
+	if len(suffixes) == 0 {
+		suffixes = []string{""}
+	}
+
+	base := name
+
+	for n := 2; ; n++ {
+		ok := true
+		for _, s := range suffixes {
+			ok = ok && !g.used[name+s]
+		}
+
+		if ok {
+			break
+		}
+
+		name = fmt.Sprintf("%s%d", base, n)
+	}
+
+	for _, s := range suffixes {
+		g.used[name+s] = true
+	}
+
+	return name
+}
+
+// block generates the Go type for the struct held by the given variable,
+// if any. Uniform blocks are laid out with the std140 rules, storage
+// blocks with the std430 rules. Explicit offsets and strides take
+// precedence over both.
+func (g *generator) block(v *spirv.ReflectVariable, name string) error {
+	t := v.Type
+	for t != nil && (t.Kind == spirv.TypeArray || t.Kind == spirv.TypeRuntimeArray) {
+		t = t.Elem
+	}
+
+	if t == nil || t.Kind != spirv.TypeStruct {
+		return nil
+	}
+
+	rules := spirv.LayoutStd140
+	if g.buffer[t.Id] {
+		rules = spirv.LayoutStd430
+	}
+
+	if _, ok := g.types[typeKey{t.Id, rules}]; ok {
+		return nil
+	}
+
+	l, err := spirv.NewBlockLayout(g.m, t.Id, rules)
+	if err != nil {
+		return err
+	}
+
+	g.structType(l, name, rules)
+	return nil
+}
+
+// structType generates the Go type for the given struct layout.
+// Returns its name. The type is named after the struct, or after
+// the given hint if the struct has no name. If a type was generated
+// for the struct under other layout rules already, the name of the
+// rules is appended, as in "LightStd430".
+func (g *generator) structType(l *spirv.BlockLayout, hint string, rules spirv.LayoutRules) string {
+	key := typeKey{l.Type.Id, rules}
+	if name, ok := g.types[key]; ok {
+		return name
+	}
+
+	name := goName(g.names[l.Type.Id])
+	if name == "" {
+		name = hint
+	}
+
+	if g.structs[l.Type.Id] {
+		name += goName(rules.String())
+	}
+
+	name = g.ident(name)
+	g.types[key] = name
+	g.structs[l.Type.Id] = true
+
+	var body, tail bytes.Buffer
+	var offset uint32
+	fields := make(map[string]bool)
+
+	for i, ml := range l.Members {
+		base := goName(ml.Name)
+		if base == "" {
+			base = fmt.Sprintf("Member%d", i)
+		}
+
+		field := base
+		for n := 2; fields[field]; n++ {
+			field = fmt.Sprintf("%s%d", base, n)
+		}
+
+		fields[field] = true
+
+		if ml.Offset > offset {
+			fmt.Fprintf(&body, "\t_ [%d]byte\n", ml.Offset-offset)
+		}
+
+		// A runtime array has no size. Its elements follow the struct,
+		// so it is described by an element type and its position.
+		if ml.Type.Kind == spirv.TypeRuntimeArray {
+			elem := g.ident(name+field, "Offset", "Stride", "Elem")
+
+			fmt.Fprintln(&tail)
+			fmt.Fprintf(&tail, "// Position of the elements of %s.%s.\n", name, field)
+			fmt.Fprintln(&tail, "const (")
+			fmt.Fprintf(&tail, "\t%sOffset = %d\n", elem, ml.Offset)
+			fmt.Fprintf(&tail, "\t%sStride = %d\n", elem, ml.Stride)
+			fmt.Fprintln(&tail, ")")
+			fmt.Fprintln(&tail)
+			fmt.Fprintf(&tail, "// %sElem is an element of %s.%s.\n", elem, name, field)
+			fmt.Fprintf(&tail, "type %sElem %s\n", elem, g.elemType(ml, name+field, rules))
+			offset = ml.Offset
+			continue
+		}
+
+		fmt.Fprintf(&body, "\t%s %s\n", field, g.goType(ml, name+field, rules))
+		offset = ml.Offset + ml.Size
+	}
+
+	if l.Size > offset {
+		fmt.Fprintf(&body, "\t_ [%d]byte\n", l.Size-offset)
+	}
+
+	fmt.Fprintln(&g.decls)
+	fmt.Fprintf(&g.decls, "// %s mirrors the %s layout of struct %%%d.\n", name, rules, l.Type.Id)
+	fmt.Fprintf(&g.decls, "type %s struct {\n%s}\n", name, body.Bytes())
+	g.decls.Write(tail.Bytes())
+	return name
+}
+
+// goType returns the Go type for the given layout. Struct types are
+// generated as needed, named after the given hint if they have no name.
+func (g *generator) goType(l *spirv.BlockLayout, hint string, rules spirv.LayoutRules) string {
+	switch l.Type.Kind {
+	case spirv.TypeInt, spirv.TypeFloat:
+		return scalarType(l.Type)
+
+	case spirv.TypeVector:
+		return fmt.Sprintf("[%d]%s", l.Type.Len, scalarType(l.Type.Elem))
+
+	case spirv.TypeMatrix, spirv.TypeArray:
+		var n uint32
+		if l.Stride > 0 {
+			n = l.Size / l.Stride
+		}
+		return fmt.Sprintf("[%d]%s", n, g.elemType(l, hint, rules))
+
+	case spirv.TypeStruct:
+		return g.structType(l, hint, rules)
+	}
+
+	return "struct{}"
+}
+
+// elemType returns the Go type for the elements of the given array, or
+// the columns of the given matrix, padded to the stride. Scalars and
+// vectors are padded with extra components, if possible.
+func (g *generator) elemType(l *spirv.BlockLayout, hint string, rules spirv.LayoutRules) string {
+	e := l.Elem
+	t := g.goType(e, hint, rules)
+
+	if l.Stride == e.Size {
+		return t
+	}
+
+	s := e.Type
+	if s.Kind == spirv.TypeVector {
+		s = s.Elem
+	}
+
+	if size := s.Width / 8; (s.Kind == spirv.TypeInt || s.Kind == spirv.TypeFloat) && l.Stride%size == 0 {
+		return fmt.Sprintf("[%d]%s", l.Stride/size, scalarType(s))
+	}
+
+	return fmt.Sprintf("struct {\n\tValue %s\n\t_ [%d]byte\n}", t, l.Stride-e.Size)
+}
+
+// scalarType returns the Go type for the given integer or float type.
+// 16-bit floats have no Go equivalent; they are represented by their bits.
+func scalarType(t *spirv.Type) string {
+	switch {
+	case t.Kind == spirv.TypeFloat && t.Width == 16:
+		return "uint16"
+	case t.Kind == spirv.TypeFloat:
+		return fmt.Sprintf("float%d", t.Width)
+	case t.Signed:
+		return fmt.Sprintf("int%d", t.Width)
+	}
+	return fmt.Sprintf("uint%d", t.Width)
+}
+
+// goName turns the given name into an exported Go identifier. Letters
+// following characters other than letters and digits are capitalized,
+// while those characters are dropped: "light_dir" becomes "LightDir".
+// Names which do not start with an upper case letter after that, like
+// "2d", are prefixed with an X. Returns an empty string if the name holds
+// no letters or digits.
+func goName(s string) string {
+	var out []rune
+	upper := true
+
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+
+		out = append(out, r)
+	}
+
+	if len(out) > 0 && !unicode.IsUpper(out[0]) {
+		return "X" + string(out)
+	}
+
+	return string(out)
+}
@@ -0,0 +1,441 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/jteeuwen/spirv"
+)
+
+const testGenerateSource = `
+	     OpCapability Shader
+	     OpMemoryModel Logical GLSL450
+	     OpEntryPoint Vertex %10 "main" %20 %21
+	     OpName %20 "in_position"
+	     OpName %21 "uv"
+	     OpName %30 "globals"
+	     OpName %7 "Globals"
+	     OpMemberName %7 0 "transform"
+	     OpMemberName %7 1 "weights"
+	     OpMemberName %7 2 "light"
+	     OpMemberName %7 3 "scale"
+	     OpName %6 "Light"
+	     OpMemberName %6 0 "dir"
+	     OpMemberName %6 1 "power"
+	     OpMemberName %14 0 "count"
+	     OpMemberName %14 1 "data"
+	     OpDecorate %20 Location 0
+	     OpDecorate %21 Location 1
+	     OpDecorate %7 Block
+	     OpMemberDecorate %7 0 ColMajor
+	     OpMemberDecorate %7 0 MatrixStride 16
+	     OpMemberDecorate %7 0 Offset 0
+	     OpMemberDecorate %7 1 Offset 48
+	     OpMemberDecorate %7 2 Offset 96
+	     OpMemberDecorate %7 3 Offset 128
+	     OpDecorate %9 ArrayStride 16
+	     OpMemberDecorate %6 0 Offset 0
+	     OpMemberDecorate %6 1 Offset 12
+	     OpDecorate %14 BufferBlock
+	     OpMemberDecorate %14 0 Offset 0
+	     OpMemberDecorate %14 1 Offset 16
+	     OpDecorate %13 ArrayStride 16
+	     OpDecorate %30 DescriptorSet 0
+	     OpDecorate %30 Binding 1
+	     OpDecorate %31 DescriptorSet 1
+	     OpDecorate %31 Binding 0
+	%1 = OpTypeVoid
+	%2 = OpTypeFunction %1
+	%3 = OpTypeFloat 32
+	%4 = OpTypeVector %3 3
+	%5 = OpTypeMatrix %4 3
+	%6 = OpTypeStruct %4 %3
+	%11 = OpTypeInt 32 0
+	%12 = OpConstant %11 3
+	%9 = OpTypeArray %3 %12
+	%7 = OpTypeStruct %5 %9 %6 %3
+	%8 = OpTypePointer Uniform %7
+	%15 = OpTypeVector %3 4
+	%13 = OpTypeRuntimeArray %4
+	%14 = OpTypeStruct %11 %13
+	%16 = OpTypePointer Uniform %14
+	%17 = OpTypePointer Input %15
+	%18 = OpTypeVector %3 2
+	%19 = OpTypePointer Input %18
+	%20 = OpVariable %17 Input
+	%21 = OpVariable %19 Input
+	%30 = OpVariable %8 Uniform
+	%31 = OpVariable %16 Uniform
+	%10 = OpFunction %1 None %2
+	%22 = OpLabel
+	     OpReturn
+	     OpFunctionEnd
+`
+
+const testGenerateWant = `// Code generated by spirv-gogen. DO NOT EDIT.
+
+package shaders
+
+// Descriptor sets and bindings.
+const (
+	GlobalsSet     = 0
+	GlobalsBinding = 1
+	Var31Set       = 1
+	Var31Binding   = 0
+)
+
+// Input locations.
+const (
+	InPositionLocation = 0
+	UvLocation         = 1
+)
+
+// Light mirrors the std140 layout of struct %6.
+type Light struct {
+	Dir   [3]float32
+	Power float32
+}
+
+// Globals mirrors the std140 layout of struct %7.
+type Globals struct {
+	Transform [3][4]float32
+	Weights   [3][4]float32
+	Light     Light
+	_         [16]byte
+	Scale     float32
+	_         [12]byte
+}
+
+// Var31 mirrors the std430 layout of struct %14.
+type Var31 struct {
+	Count uint32
+	_     [12]byte
+}
+
+// Position of the elements of Var31.Data.
+const (
+	Var31DataOffset = 16
+	Var31DataStride = 16
+)
+
+// Var31DataElem is an element of Var31.Data.
+type Var31DataElem [4]float32
+`
+
+func TestGenerate(t *testing.T) {
+	m, err := spirv.Assemble(strings.NewReader(testGenerateSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	have, err := generate(m, "shaders")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(have) != testGenerateWant {
+		t.Fatalf("source mismatch:\nHave: %s\nWant: %s", have, testGenerateWant)
+	}
+}
+
+// testLayoutSource holds a uniform and a storage block, sharing a struct.
+// The types generated from it are checked in as layout_fixture_test.go,
+// so the tests can verify their memory layout.
+const testLayoutSource = `
+	     OpCapability Shader
+	     OpMemoryModel Logical GLSL450
+	     OpEntryPoint Vertex %10 "main"
+	     OpName %5 "Params"
+	     OpMemberName %5 0 "dir"
+	     OpMemberName %5 1 "power"
+	     OpMemberName %5 2 "uv"
+	     OpMemberName %5 3 "weights"
+	     OpName %6 "Globals"
+	     OpMemberName %6 0 "scale"
+	     OpMemberName %6 1 "color"
+	     OpMemberName %6 2 "transform"
+	     OpMemberName %6 3 "params"
+	     OpMemberName %6 4 "tail"
+	     OpName %7 "Storage"
+	     OpMemberName %7 0 "params"
+	     OpMemberName %7 1 "offset"
+	     OpMemberName %7 2 "count"
+	     OpMemberName %7 3 "normal"
+	     OpDecorate %6 Block
+	     OpMemberDecorate %6 2 ColMajor
+	     OpDecorate %7 BufferBlock
+	     OpDecorate %30 DescriptorSet 0
+	     OpDecorate %30 Binding 0
+	     OpDecorate %31 DescriptorSet 0
+	     OpDecorate %31 Binding 1
+	%1 = OpTypeVoid
+	%2 = OpTypeFunction %1
+	%3 = OpTypeFloat 32
+	%11 = OpTypeInt 32 0
+	%12 = OpConstant %11 3
+	%13 = OpTypeVector %3 2
+	%14 = OpTypeVector %3 3
+	%15 = OpTypeMatrix %14 3
+	%16 = OpTypeArray %3 %12
+	%5 = OpTypeStruct %14 %3 %13 %16
+	%6 = OpTypeStruct %3 %14 %15 %5 %3
+	%7 = OpTypeStruct %5 %13 %11 %14
+	%8 = OpTypePointer Uniform %6
+	%9 = OpTypePointer Uniform %7
+	%30 = OpVariable %8 Uniform
+	%31 = OpVariable %9 Uniform
+	%10 = OpFunction %1 None %2
+	%17 = OpLabel
+	     OpReturn
+	     OpFunctionEnd
+`
+
+func TestGenerateLayoutFixture(t *testing.T) {
+	m, err := spirv.Assemble(strings.NewReader(testLayoutSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	have, err := generate(m, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := ioutil.ReadFile("layout_fixture_test.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(have) != string(want) {
+		t.Fatalf("source mismatch:\nHave: %s\nWant: %s", have, want)
+	}
+}
+
+// TestGenerateLayout checks that the Go types in layout_fixture_test.go
+// have the size and member offsets computed by NewBlockLayout.
+func TestGenerateLayout(t *testing.T) {
+	m, err := spirv.Assemble(strings.NewReader(testLayoutSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		params  Params
+		params4 ParamsStd430
+		globals Globals
+		storage Storage
+	)
+
+	for i, st := range []struct {
+		id      spirv.Id
+		rules   spirv.LayoutRules
+		size    uintptr
+		offsets []uintptr
+	}{
+		{
+			id:    5,
+			rules: spirv.LayoutStd140,
+			size:  unsafe.Sizeof(params),
+			offsets: []uintptr{
+				unsafe.Offsetof(params.Dir),
+				unsafe.Offsetof(params.Power),
+				unsafe.Offsetof(params.Uv),
+				unsafe.Offsetof(params.Weights),
+			},
+		},
+		{
+			id:    5,
+			rules: spirv.LayoutStd430,
+			size:  unsafe.Sizeof(params4),
+			offsets: []uintptr{
+				unsafe.Offsetof(params4.Dir),
+				unsafe.Offsetof(params4.Power),
+				unsafe.Offsetof(params4.Uv),
+				unsafe.Offsetof(params4.Weights),
+			},
+		},
+		{
+			id:    6,
+			rules: spirv.LayoutStd140,
+			size:  unsafe.Sizeof(globals),
+			offsets: []uintptr{
+				unsafe.Offsetof(globals.Scale),
+				unsafe.Offsetof(globals.Color),
+				unsafe.Offsetof(globals.Transform),
+				unsafe.Offsetof(globals.Params),
+				unsafe.Offsetof(globals.Tail),
+			},
+		},
+		{
+			id:    7,
+			rules: spirv.LayoutStd430,
+			size:  unsafe.Sizeof(storage),
+			offsets: []uintptr{
+				unsafe.Offsetof(storage.Params),
+				unsafe.Offsetof(storage.Offset),
+				unsafe.Offsetof(storage.Count),
+				unsafe.Offsetof(storage.Normal),
+			},
+		},
+	} {
+		l, err := spirv.NewBlockLayout(m, st.id, st.rules)
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		if st.size != uintptr(l.Size) {
+			t.Fatalf("case %d: size mismatch:\nHave: %d\nWant: %d", i, st.size, l.Size)
+		}
+
+		if len(st.offsets) != len(l.Members) {
+			t.Fatalf("case %d: member count mismatch:\nHave: %d\nWant: %d", i, len(st.offsets), len(l.Members))
+		}
+
+		for j, ml := range l.Members {
+			if st.offsets[j] != uintptr(ml.Offset) {
+				t.Fatalf("case %d: offset mismatch for member %d:\nHave: %d\nWant: %d", i, j, st.offsets[j], ml.Offset)
+			}
+		}
+	}
+}
+
+func TestGenerateSharedStruct(t *testing.T) {
+	m, err := spirv.Assemble(strings.NewReader(`
+		     OpCapability Shader
+		     OpMemoryModel Logical GLSL450
+		     OpEntryPoint Vertex %10 "main"
+		     OpName %5 "Light"
+		     OpMemberName %5 0 "power"
+		     OpMemberName %6 0 "light"
+		     OpMemberName %7 0 "light"
+		     OpDecorate %6 Block
+		     OpDecorate %7 BufferBlock
+		     OpDecorate %20 DescriptorSet 0
+		     OpDecorate %20 Binding 0
+		     OpDecorate %21 DescriptorSet 0
+		     OpDecorate %21 Binding 1
+		%1 = OpTypeVoid
+		%2 = OpTypeFunction %1
+		%3 = OpTypeFloat 32
+		%11 = OpTypeInt 32 0
+		%12 = OpConstant %11 2
+		%4 = OpTypeArray %3 %12
+		%5 = OpTypeStruct %4
+		%6 = OpTypeStruct %5
+		%7 = OpTypeStruct %5
+		%8 = OpTypePointer Uniform %6
+		%9 = OpTypePointer Uniform %7
+		%20 = OpVariable %8 Uniform
+		%21 = OpVariable %9 Uniform
+		%10 = OpFunction %1 None %2
+		%13 = OpLabel
+		     OpReturn
+		     OpFunctionEnd
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	have, err := generate(m, "shaders")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Light is used by a uniform and a storage block, so it is
+	// generated for the std140 and the std430 layout.
+	for _, want := range []string{`
+type Light struct {
+	Power [2][4]float32
+}
+`, `
+type LightStd430 struct {
+	Power [2]float32
+}
+`, `
+type Var21 struct {
+	Light LightStd430
+}
+`} {
+		if !strings.Contains(string(have), want) {
+			t.Fatalf("source mismatch:\nHave: %s\nWant: %s", have, want)
+		}
+	}
+}
+
+func TestGenerateFieldNames(t *testing.T) {
+	m, err := spirv.Assemble(strings.NewReader(`
+		     OpCapability Shader
+		     OpMemoryModel Logical GLSL450
+		     OpEntryPoint Vertex %10 "main"
+		     OpName %5 "Names"
+		     OpMemberName %5 0 "member1"
+		     OpMemberName %5 2 "1x"
+		     OpMemberName %5 3 "1x"
+		     OpDecorate %5 Block
+		     OpMemberDecorate %5 0 Offset 0
+		     OpMemberDecorate %5 1 Offset 4
+		     OpMemberDecorate %5 2 Offset 8
+		     OpMemberDecorate %5 3 Offset 12
+		     OpDecorate %6 DescriptorSet 0
+		     OpDecorate %6 Binding 0
+		%1 = OpTypeVoid
+		%2 = OpTypeFunction %1
+		%3 = OpTypeFloat 32
+		%5 = OpTypeStruct %3 %3 %3 %3
+		%4 = OpTypePointer Uniform %5
+		%6 = OpVariable %4 Uniform
+		%10 = OpFunction %1 None %2
+		%11 = OpLabel
+		     OpReturn
+		     OpFunctionEnd
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	have, err := generate(m, "shaders")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The unnamed member is named Member1, which is taken already.
+	// Duplicates keep their name and are numbered.
+	want := `
+type Names struct {
+	Member1  float32
+	Member12 float32
+	X1x      float32
+	X1x2     float32
+}
+`
+	if !strings.Contains(string(have), want) {
+		t.Fatalf("source mismatch:\nHave: %s\nWant: %s", have, want)
+	}
+}
+
+func TestGoName(t *testing.T) {
+	for i, st := range []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"scale", "Scale"},
+		{"light_dir", "LightDir"},
+		{"in.position", "InPosition"},
+		{"Globals", "Globals"},
+		{"_", ""},
+		{"2d", "X2d"},
+		{"1x", "X1x"},
+		{"名前", "X名前"},
+	} {
+		have := goName(st.in)
+		if have != st.want {
+			t.Fatalf("case %d: name mismatch:\nHave: %q\nWant: %q", i, have, st.want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/jteeuwen/spirv"
+)
+
+func main() {
+	file, opts := parseArgs()
+
+	fd, err := os.Open(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	defer fd.Close()
+
+	module, err := spirv.Load(fd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	src, err := generate(module, opts.pkg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if opts.out == "" {
+		os.Stdout.Write(src)
+		return
+	}
+
+	err = ioutil.WriteFile(opts.out, src, 0644)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// options defines the command line options.
+type options struct {
+	pkg string
+	out string
+}
+
+// parseArgs parses and validates command line arguments.
+func parseArgs() (string, *options) {
+	var opts options
+
+	flag.Usage = func() {
+		fmt.Println("usage:", os.Args[0], "[options] <module file>")
+		flag.PrintDefaults()
+	}
+
+	version := flag.Bool("version", false, "Display version information.")
+	flag.StringVar(&opts.pkg, "pkg", "shaders", "Package name of the generated source.")
+	flag.StringVar(&opts.out, "out", "", "File to write the generated source to. Defaults to stdout.")
+	flag.Parse()
+
+	if *version {
+		fmt.Println(Version())
+		os.Exit(0)
+	}
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	return flag.Arg(0), &opts
+}
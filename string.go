@@ -20,6 +20,9 @@ type String string
 
 // DecodeString reads the Go string from the given slice of words.
 // Refer to fromGoString() documentation for details on the expected encoding.
+//
+// Decoding stops at the first word holding the nul-terminator. Any words
+// which follow it are not part of the string.
 func DecodeString(words []uint32) String {
 	out := make([]byte, 0, len(words)*4)
 
@@ -32,15 +35,20 @@ func DecodeString(words []uint32) String {
 			byte(w>>16),
 			byte(w>>24),
 		)
+
+		if w&0xff == 0 || w&0xff00 == 0 || w&0xff0000 == 0 || w&0xff000000 == 0 {
+			break
+		}
 	}
 
-	// Remove any trailing nul bytes.
-	sz := len(out)
-	for sz > 0 && out[sz-1] == 0 {
-		sz--
+	// Remove anything from the nul-terminator onwards.
+	for i, b := range out {
+		if b == 0 {
+			return String(out[:i])
+		}
 	}
 
-	return String(out[:sz])
+	return String(out)
 }
 
 // EncodedLen returns the number of words occupied by the string, once encoded.
@@ -50,3 +50,13 @@ func TestString(t *testing.T) {
 		}
 	}
 }
+
+func TestDecodeStringTrailingWords(t *testing.T) {
+	// Words following the nul-terminator belong to the next operand.
+	have := DecodeString([]uint32{0x206f6f66, 0x00726162, 0x00000001, 0x00000002})
+	want := String("foo bar")
+
+	if have != want {
+		t.Fatalf("decode mismatch:\nHave: %q\nWant: %q", have, want)
+	}
+}
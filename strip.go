@@ -0,0 +1,145 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// StripOptions defines which optional instructions are removed
+// by Module.StripWith.
+type StripOptions struct {
+	// Instructions selects the kinds of optional instructions to remove.
+	// Each entry is a prototype, whose fields are ignored. For example,
+	// OpLine and OpSourceExtension are selected with:
+	//
+	//     []spirv.Instruction{&spirv.OpLine{}, &spirv.OpSourceExtension{}}
+	//
+	// The optional instructions are OpSource, OpSourceContinued,
+	// OpSourceExtension, OpString, OpName, OpMemberName, OpLine and
+	// OpNoLine. Entries of any other kind are ignored, as instructions
+	// which are not optional are never removed.
+	//
+	// All optional instructions are removed if the list is empty.
+	Instructions []Instruction
+
+	// KeepInterfaceNames preserves the names of entry points and of the
+	// variables in their interfaces. Member names of the structures used
+	// by those variables are preserved as well.
+	KeepInterfaceNames bool
+}
+
+// StripWith removes the optional instructions selected by opts and
+// returns the number of words they occupied in the encoded module.
+//
+// Optional instructions which declare an id, like OpString, are kept
+// if a remaining instruction still refers to them.
+func (m *Module) StripWith(opts StripOptions) int {
+	all := len(opts.Instructions) == 0
+
+	selected := make(map[uint32]bool, len(opts.Instructions))
+	for _, instr := range opts.Instructions {
+		if instr.Optional() {
+			selected[instr.Opcode()] = true
+		}
+	}
+
+	var keepNames map[Id]bool
+	if opts.KeepInterfaceNames {
+		keepNames = m.interfaceIds()
+	}
+
+	drop := make([]bool, len(m.Code))
+
+	for addr, instr := range m.Code {
+		if !instr.Optional() || (!all && !selected[instr.Opcode()]) {
+			continue
+		}
+
+		switch v := instr.(type) {
+		case *OpName:
+			drop[addr] = !keepNames[v.Target]
+		case *OpMemberName:
+			drop[addr] = !keepNames[v.Type]
+		default:
+			drop[addr] = true
+		}
+	}
+
+	// Declarations can only be judged once we know which of the
+	// instructions referring to them remain.
+	used := make(map[Id]bool)
+	var operands []Id
+
+	for addr, instr := range m.Code {
+		if drop[addr] {
+			continue
+		}
+
+		operands = appendOperands(operands[:0], instr)
+		for _, id := range operands {
+			used[id] = true
+		}
+	}
+
+	for addr, instr := range m.Code {
+		if id, ok := ResultId(instr); ok && drop[addr] && used[id] {
+			drop[addr] = false
+		}
+	}
+
+	var words []uint32
+	var removed int
+
+	code := m.Code[:0]
+
+	for addr, instr := range m.Code {
+		if !drop[addr] {
+			code = append(code, instr)
+			continue
+		}
+
+		words, _ = appendInstruction(words[:0], instr)
+		removed += len(words)
+	}
+
+	for i := len(code); i < len(m.Code); i++ {
+		m.Code[i] = nil
+	}
+
+	m.Code = code
+	return removed
+}
+
+// interfaceIds returns the ids of all entry points, the variables in
+// their interfaces and the structure types used by those variables.
+func (m *Module) interfaceIds() map[Id]bool {
+	ids := make(map[Id]bool)
+	tt := NewTypeTable(m)
+
+	var walk func(t *Type)
+	walk = func(t *Type) {
+		if t == nil || (t.Kind == TypeStruct && ids[t.Id]) {
+			return
+		}
+
+		switch t.Kind {
+		case TypePointer, TypeArray, TypeRuntimeArray:
+			walk(t.Elem)
+		case TypeStruct:
+			ids[t.Id] = true
+			for _, mt := range t.Members {
+				walk(mt)
+			}
+		}
+	}
+
+	for _, instr := range m.Code.Filter(opcodeEntryPoint) {
+		v := instr.(*OpEntryPoint)
+		ids[v.EntryPoint] = true
+
+		for _, id := range v.Interface {
+			ids[id] = true
+			walk(tt.TypeOf(id))
+		}
+	}
+
+	return ids
+}
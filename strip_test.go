@@ -0,0 +1,176 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testStripSource = `
+	     OpCapability Shader
+	     OpMemoryModel Logical GLSL450
+	     OpEntryPoint Fragment %10 "main" %6
+	%20 = OpString "a.frag"
+	     OpSource GLSL 450 %20
+	     OpSourceExtension "GL_EXT"
+	     OpName %10 "main"
+	     OpName %6 "color"
+	     OpName %7 "scratch"
+	     OpName %4 "Block"
+	     OpMemberName %4 0 "rgba"
+	     OpMemberName %5 0 "unused"
+	%1 = OpTypeVoid
+	%2 = OpTypeFunction %1
+	%3 = OpTypeFloat 32
+	%4 = OpTypeStruct %3
+	%5 = OpTypeStruct %3
+	%8 = OpTypePointer Output %4
+	%9 = OpTypePointer Private %3
+	%6 = OpVariable %8 Output
+	%7 = OpVariable %9 Private
+	%10 = OpFunction %1 None %2
+	     OpLine %20 1 1
+	%11 = OpLabel
+	     OpNoLine
+	     OpReturn
+	     OpFunctionEnd
+`
+
+func TestModuleStripWith(t *testing.T) {
+	for i, st := range []struct {
+		opts  StripOptions
+		words int
+		want  string
+	}{
+		{
+			// Names and interface names are kept. The string is still
+			// used by OpSource, so it stays as well.
+			opts: StripOptions{
+				Instructions: []Instruction{&OpString{}, &OpLine{}, &OpNoLine{}},
+			},
+			words: 4 + 1,
+			want: `
+				     OpCapability Shader
+				     OpMemoryModel Logical GLSL450
+				     OpEntryPoint Fragment %10 "main" %6
+				%20 = OpString "a.frag"
+				     OpSource GLSL 450 %20
+				     OpSourceExtension "GL_EXT"
+				     OpName %10 "main"
+				     OpName %6 "color"
+				     OpName %7 "scratch"
+				     OpName %4 "Block"
+				     OpMemberName %4 0 "rgba"
+				     OpMemberName %5 0 "unused"
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%3 = OpTypeFloat 32
+				%4 = OpTypeStruct %3
+				%5 = OpTypeStruct %3
+				%8 = OpTypePointer Output %4
+				%9 = OpTypePointer Private %3
+				%6 = OpVariable %8 Output
+				%7 = OpVariable %9 Private
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				     OpReturn
+				     OpFunctionEnd
+			`,
+		},
+		{
+			// OpSourceExtension is dropped, OpSource is not. OpTypeVoid
+			// is not optional, so it is ignored.
+			opts: StripOptions{
+				Instructions: []Instruction{&OpSourceExtension{}, &OpTypeVoid{}},
+			},
+			words: 3,
+			want: `
+				     OpCapability Shader
+				     OpMemoryModel Logical GLSL450
+				     OpEntryPoint Fragment %10 "main" %6
+				%20 = OpString "a.frag"
+				     OpSource GLSL 450 %20
+				     OpName %10 "main"
+				     OpName %6 "color"
+				     OpName %7 "scratch"
+				     OpName %4 "Block"
+				     OpMemberName %4 0 "rgba"
+				     OpMemberName %5 0 "unused"
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%3 = OpTypeFloat 32
+				%4 = OpTypeStruct %3
+				%5 = OpTypeStruct %3
+				%8 = OpTypePointer Output %4
+				%9 = OpTypePointer Private %3
+				%6 = OpVariable %8 Output
+				%7 = OpVariable %9 Private
+				%10 = OpFunction %1 None %2
+				     OpLine %20 1 1
+				%11 = OpLabel
+				     OpNoLine
+				     OpReturn
+				     OpFunctionEnd
+			`,
+		},
+		{
+			// Only instructions which are not optional are selected,
+			// so nothing is removed.
+			opts: StripOptions{
+				Instructions: []Instruction{&OpTypeVoid{}},
+			},
+			words: 0,
+			want:  testStripSource,
+		},
+		{
+			opts: StripOptions{
+				KeepInterfaceNames: true,
+			},
+			words: 4 + 4 + 3 + 4 + 5 + 4 + 1,
+			want: `
+				     OpCapability Shader
+				     OpMemoryModel Logical GLSL450
+				     OpEntryPoint Fragment %10 "main" %6
+				     OpName %10 "main"
+				     OpName %6 "color"
+				     OpName %4 "Block"
+				     OpMemberName %4 0 "rgba"
+				%1 = OpTypeVoid
+				%2 = OpTypeFunction %1
+				%3 = OpTypeFloat 32
+				%4 = OpTypeStruct %3
+				%5 = OpTypeStruct %3
+				%8 = OpTypePointer Output %4
+				%9 = OpTypePointer Private %3
+				%6 = OpVariable %8 Output
+				%7 = OpVariable %9 Private
+				%10 = OpFunction %1 None %2
+				%11 = OpLabel
+				     OpReturn
+				     OpFunctionEnd
+			`,
+		},
+	} {
+		m, err := Assemble(strings.NewReader(testStripSource))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		want, err := Assemble(strings.NewReader(st.want))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		words := m.StripWith(st.opts)
+		if words != st.words {
+			t.Fatalf("case %d: word count mismatch:\nHave: %d\nWant: %d", i, words, st.words)
+		}
+
+		if !reflect.DeepEqual(m.Code, want.Code) {
+			t.Fatalf("case %d: code mismatch:\nHave: %v\nWant: %v", i, m.Code, want.Code)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// construct defines a structured selection or loop construct.
+type construct struct {
+	header *Block
+	merge  *Block
+	cont   *Block // Continue target; nil for selection constructs.
+	addr   int    // Address of the merge instruction.
+}
+
+// contains returns true if b is part of the construct. These are all
+// blocks dominated by the header, but not by the merge block.
+func (c *construct) contains(dom *DomTree, b *Block) bool {
+	return dom.Dominates(c.header, b) && !dom.Dominates(c.merge, b)
+}
+
+// verifyStructuredFlow checks the rules for structured control flow,
+// as defined in chapter 2.11 of the specification. These apply only
+// to modules which declare the Shader capability.
+//
+// All violations are returned as an ErrorList of *LayoutError values.
+func (m *Module) verifyStructuredFlow() error {
+	if !m.hasCapability(CapabilityShader) {
+		return nil
+	}
+
+	fns, err := m.functions()
+	if err != nil {
+		return nil // Reported by verifyFunctionStructure.
+	}
+
+	var errs ErrorList
+	for _, fn := range fns {
+		errs = verifyStructuredFunction(errs, fn)
+	}
+
+	return errs.err()
+}
+
+// verifyStructuredFunction checks the structured control flow of a
+// single function. Violations are appended to errs.
+func verifyStructuredFunction(errs ErrorList, fn *function) ErrorList {
+	dom := fn.dom
+	headers := make(map[*Block]*construct)
+	merges := make(map[*Block]*construct)
+
+	for _, b := range fn.cfg.Blocks {
+		if !dom.Contains(b) {
+			continue
+		}
+
+		for k, instr := range b.Code {
+			addr := fn.start + b.Address + k
+			term := b.Terminator().Opcode()
+			last := k == len(b.Code)-2
+
+			var mergeLabel, contLabel Id
+
+			switch v := instr.(type) {
+			case *OpSelectionMerge:
+				if !last || (term != opcodeBranchConditional && term != opcodeSwitch) {
+					errs = append(errs, NewLayoutError(addr,
+						"OpSelectionMerge must directly precede an OpBranchConditional or OpSwitch"))
+					continue
+				}
+
+				mergeLabel = v.MergeBlock
+
+			case *OpLoopMerge:
+				if !last || (term != opcodeBranch && term != opcodeBranchConditional) {
+					errs = append(errs, NewLayoutError(addr,
+						"OpLoopMerge must directly precede an OpBranch or OpBranchConditional"))
+					continue
+				}
+
+				mergeLabel = v.MergeBlock
+				contLabel = v.ContinueTarget
+
+			default:
+				continue
+			}
+
+			c := &construct{header: b, addr: addr}
+
+			c.merge = fn.cfg.Block(mergeLabel)
+			if c.merge == nil || c.merge == b {
+				errs = append(errs, NewLayoutError(addr, "invalid merge block %d", mergeLabel))
+				continue
+			}
+
+			if contLabel != 0 {
+				c.cont = fn.cfg.Block(contLabel)
+				if c.cont == nil {
+					errs = append(errs, NewLayoutError(addr, "invalid continue target %d", contLabel))
+					continue
+				}
+			}
+
+			if prev, ok := merges[c.merge]; ok {
+				errs = append(errs, NewLayoutError(addr,
+					"block %d is already the merge block for the header at $%08x",
+					mergeLabel, prev.addr))
+				continue
+			}
+
+			if dom.Contains(c.merge) && !dom.Dominates(b, c.merge) {
+				errs = append(errs, NewLayoutError(addr,
+					"header block %d does not dominate its merge block %d", b.Label, mergeLabel))
+			}
+
+			if c.cont != nil && dom.Contains(c.cont) && !dom.Dominates(b, c.cont) {
+				errs = append(errs, NewLayoutError(addr,
+					"loop header %d does not dominate its continue target %d", b.Label, contLabel))
+			}
+
+			merges[c.merge] = c
+			headers[b] = c
+		}
+	}
+
+	// innermost returns the innermost construct which contains b and for
+	// which pred returns true.
+	innermost := func(b *Block, pred func(*construct) bool) *construct {
+		for x := b; x != nil; x = dom.Idom(x) {
+			c, ok := headers[x]
+			if ok && pred(c) && c.contains(dom, b) {
+				return c
+			}
+		}
+		return nil
+	}
+
+	isLoop := func(c *construct) bool { return c.cont != nil }
+	backEdges := make(map[*Block]bool)
+
+	for _, b := range fn.cfg.Blocks {
+		if !dom.Contains(b) {
+			continue
+		}
+
+		addr := fn.start + b.Address + len(b.Code) - 1
+
+		for _, s := range b.Successors {
+			if dom.Dominates(s, b) {
+				errs = verifyBackEdge(errs, addr, dom, headers[s], b, s, backEdges)
+				continue
+			}
+
+			// Find the construct this branch exits, if any.
+			exits := func(c *construct) bool { return !c.contains(dom, s) }
+			c := innermost(b, exits)
+			if c == nil || s == c.merge {
+				continue
+			}
+
+			// A branch to the innermost loop's merge block or continue
+			// target is a break or continue.
+			loop := innermost(b, isLoop)
+			if loop != nil && (s == loop.merge || s == loop.cont) {
+				continue
+			}
+
+			errs = append(errs, NewLayoutError(addr,
+				"branch from block %d to %d exits the construct with header %d",
+				b.Label, s.Label, c.header.Label))
+		}
+	}
+
+	return errs
+}
+
+// verifyBackEdge checks a back edge from block b to header s.
+// The set of loop headers with a back edge is updated.
+func verifyBackEdge(errs ErrorList, addr int, dom *DomTree, c *construct, b, s *Block, seen map[*Block]bool) ErrorList {
+	if c == nil || c.cont == nil {
+		return append(errs, NewLayoutError(addr,
+			"back edge to block %d, which is not a loop header", s.Label))
+	}
+
+	if seen[s] {
+		return append(errs, NewLayoutError(addr,
+			"loop header %d has more than one back edge", s.Label))
+	}
+
+	seen[s] = true
+
+	if !dom.Dominates(c.cont, b) {
+		return append(errs, NewLayoutError(addr,
+			"back edge block %d is not dominated by continue target %d",
+			b.Label, c.cont.Label))
+	}
+
+	return errs
+}
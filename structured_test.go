@@ -0,0 +1,184 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testStructuredPrefix = `
+	%1 = OpTypeVoid
+	%2 = OpTypeFunction %1
+	%3 = OpTypeBool
+	%5 = OpConstantTrue %3
+	%10 = OpFunction %1 None %2
+	%11 = OpLabel
+`
+
+type StructuredFlowTest struct {
+	src    string
+	kernel bool // Omit the Shader capability.
+	want   error
+}
+
+func TestModuleVerifyStructuredFlow(t *testing.T) {
+	for i, st := range []StructuredFlowTest{
+		{
+			// Loop with a selection, a break and a continue.
+			src: `
+				     OpBranch %12
+				%12 = OpLabel
+				     OpLoopMerge %13 %14 None
+				     OpBranchConditional %5 %15 %13
+				%15 = OpLabel
+				     OpSelectionMerge %16 None
+				     OpBranchConditional %5 %17 %16
+				%17 = OpLabel
+				     OpBranchConditional %5 %13 %14
+				%16 = OpLabel
+				     OpBranch %14
+				%14 = OpLabel
+				     OpBranch %12
+				%13 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+		},
+		{
+			src: `
+				     OpSelectionMerge %12 None
+				     OpBranch %12
+				%12 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+			want: ErrorList{
+				NewLayoutError(7, "OpSelectionMerge must directly precede an OpBranchConditional or OpSwitch"),
+			},
+		},
+		{
+			src: `
+				     OpBranch %12
+				%12 = OpLabel
+				     OpLoopMerge %13 %12 None
+				     OpNop
+				     OpBranchConditional %5 %12 %13
+				%13 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+			want: ErrorList{
+				NewLayoutError(9, "OpLoopMerge must directly precede an OpBranch or OpBranchConditional"),
+				NewLayoutError(11, "back edge to block 12, which is not a loop header"),
+			},
+		},
+		{
+			src: `
+				     OpSelectionMerge %14 None
+				     OpBranchConditional %5 %12 %14
+				%12 = OpLabel
+				     OpSelectionMerge %14 None
+				     OpBranchConditional %5 %13 %14
+				%13 = OpLabel
+				     OpBranch %14
+				%14 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+			want: ErrorList{
+				NewLayoutError(10, "block 14 is already the merge block for the header at $00000007"),
+			},
+		},
+		{
+			src: `
+				     OpBranchConditional %5 %12 %13
+				%12 = OpLabel
+				     OpSelectionMerge %13 None
+				     OpBranchConditional %5 %14 %13
+				%14 = OpLabel
+				     OpBranch %13
+				%13 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+			want: ErrorList{
+				NewLayoutError(9, "header block 12 does not dominate its merge block 13"),
+			},
+		},
+		{
+			// Branch out of a nested selection, to the outer merge block.
+			src: `
+				     OpSelectionMerge %15 None
+				     OpBranchConditional %5 %12 %15
+				%12 = OpLabel
+				     OpSelectionMerge %14 None
+				     OpBranchConditional %5 %13 %14
+				%13 = OpLabel
+				     OpBranch %15
+				%14 = OpLabel
+				     OpBranch %15
+				%15 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+			want: ErrorList{
+				NewLayoutError(13, "branch from block 13 to 15 exits the construct with header 12"),
+			},
+		},
+		{
+			src: `
+				     OpBranch %12
+				%12 = OpLabel
+				     OpBranchConditional %5 %12 %13
+				%13 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+			want: ErrorList{
+				NewLayoutError(9, "back edge to block 12, which is not a loop header"),
+			},
+		},
+		{
+			// Unstructured control flow is allowed without the
+			// Shader capability.
+			kernel: true,
+			src: `
+				     OpBranch %12
+				%12 = OpLabel
+				     OpBranchConditional %5 %12 %13
+				%13 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+		},
+		{
+			// Back edges from the loop body.
+			src: `
+				     OpBranch %12
+				%12 = OpLabel
+				     OpLoopMerge %13 %14 None
+				     OpBranchConditional %5 %15 %13
+				%15 = OpLabel
+				     OpBranchConditional %5 %12 %14
+				%14 = OpLabel
+				     OpBranch %12
+				%13 = OpLabel
+				     OpReturn
+				     OpFunctionEnd`,
+			want: ErrorList{
+				NewLayoutError(12, "back edge block 15 is not dominated by continue target 14"),
+				NewLayoutError(14, "loop header 12 has more than one back edge"),
+			},
+		},
+	} {
+		src := "OpCapability Shader\n" + testStructuredPrefix + st.src
+		if st.kernel {
+			src = "OpCapability Kernel\n" + testStructuredPrefix + st.src
+		}
+
+		m, err := Assemble(strings.NewReader(src))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		have := m.verifyStructuredFlow()
+		if !reflect.DeepEqual(have, st.want) {
+			t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v", i, have, st.want)
+		}
+	}
+}
@@ -0,0 +1,884 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "fmt"
+
+// verifyTypes checks the result and operand types of the arithmetic,
+// conversion, relational and logical, composite and memory instructions,
+// as defined in chapter 3.32 of the specification.
+//
+// Operands whose type is not known are not checked. Undefined ids are
+// reported by verifyDominance.
+//
+// All violations are returned as an ErrorList of *LayoutError values.
+func (m *Module) verifyTypes() error {
+	tc := typeChecker{types: NewTypeTable(m)}
+
+	for addr, instr := range m.Code {
+		tc.addr = addr
+		tc.check(instr)
+	}
+
+	return tc.errs.err()
+}
+
+// typeChecker applies the typing rules to individual instructions.
+type typeChecker struct {
+	types *TypeTable
+	addr  int // Address of the current instruction.
+	errs  ErrorList
+}
+
+// errorf reports an error for the current instruction.
+func (tc *typeChecker) errorf(f string, argv ...interface{}) {
+	tc.errs = append(tc.errs, NewLayoutError(tc.addr, f, argv...))
+}
+
+// want returns true if t is known and ok is true. If t is known, but
+// ok is false, this reports that the named field must be as described.
+func (tc *typeChecker) want(field string, t *Type, ok bool, desc string) bool {
+	if t == nil {
+		return false
+	}
+
+	if !ok {
+		tc.errorf("%s must be %s, not %s", field, desc, t)
+	}
+
+	return ok
+}
+
+// same reports an error if the named field does not have type want.
+func (tc *typeChecker) same(field string, t, want *Type) {
+	if t != nil && want != nil && t != want {
+		tc.errorf("%s must have type %s, not %s", field, want, t)
+	}
+}
+
+// sameComponents reports an error if the named field does not have
+// the same number of components as want.
+func (tc *typeChecker) sameComponents(field string, t, want *Type) bool {
+	if t == nil || want == nil {
+		return false
+	}
+
+	if t.components() != want.components() {
+		tc.errorf("%s must have %d components, not %d", field, want.components(), t.components())
+		return false
+	}
+
+	return true
+}
+
+// sameWidth reports an error if the components of the named field
+// do not have the same width as those of want.
+func (tc *typeChecker) sameWidth(field string, t, want *Type) {
+	if t == nil || want == nil {
+		return
+	}
+
+	a, b := t.scalar(), want.scalar()
+	if a != nil && b != nil && a.Width != b.Width {
+		tc.errorf("%s must have a component width of %d, not %d", field, b.Width, a.Width)
+	}
+}
+
+// integer checks that the named field is an integer scalar or vector
+// with the same shape as want.
+func (tc *typeChecker) integer(field string, id Id, want *Type) {
+	t := tc.types.TypeOf(id)
+	if tc.want(field, t, t.scalar().is(TypeInt), "an integer scalar or vector") &&
+		tc.sameComponents(field, t, want) {
+		tc.sameWidth(field, t, want)
+	}
+}
+
+// intScalar checks that the named field is an integer scalar.
+func (tc *typeChecker) intScalar(field string, id Id) {
+	t := tc.types.TypeOf(id)
+	tc.want(field, t, t.is(TypeInt), "an integer scalar")
+}
+
+// result returns the result type of the given instruction. If the type
+// is known, it must be of the given kind, as either a scalar or vector.
+func (tc *typeChecker) result(id Id, k TypeKind, desc string) *Type {
+	t := tc.types.Type(id)
+	if !tc.want("ResultType", t, t.scalar().is(k), desc) {
+		return nil
+	}
+	return t
+}
+
+// check applies the typing rules for the given instruction.
+func (tc *typeChecker) check(instr Instruction) {
+	switch v := instr.(type) {
+	case *OpSNegate:
+		tc.intUnary(v.ResultType, v.Operand)
+	case *OpNot:
+		tc.intUnary(v.ResultType, v.Operand)
+	case *OpFNegate:
+		tc.floatUnary(v.ResultType, v.Operand)
+
+	case *OpIAdd:
+		tc.intBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpISub:
+		tc.intBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpIMul:
+		tc.intBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpUDiv:
+		tc.intBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpSDiv:
+		tc.intBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpUMod:
+		tc.intBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpSRem:
+		tc.intBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpSMod:
+		tc.intBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpBitwiseOr:
+		tc.intBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpBitwiseXor:
+		tc.intBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpBitwiseAnd:
+		tc.intBinary(v.ResultType, v.Operand1, v.Operand2)
+
+	case *OpFAdd:
+		tc.floatBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpFSub:
+		tc.floatBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpFMul:
+		tc.floatBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpFDiv:
+		tc.floatBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpFRem:
+		tc.floatBinary(v.ResultType, v.Operand1, v.Operand2)
+	case *OpFMod:
+		tc.floatBinary(v.ResultType, v.Operand1, v.Operand2)
+
+	case *OpShiftRightLogical:
+		tc.shift(v.ResultType, v.Base, v.Shift)
+	case *OpShiftRightArithmetic:
+		tc.shift(v.ResultType, v.Base, v.Shift)
+	case *OpShiftLeftLogical:
+		tc.shift(v.ResultType, v.Base, v.Shift)
+
+	case *OpVectorTimesScalar:
+		tc.checkVectorTimesScalar(v)
+	case *OpMatrixTimesScalar:
+		tc.checkMatrixTimesScalar(v)
+	case *OpVectorTimesMatrix:
+		tc.checkVectorTimesMatrix(v)
+	case *OpMatrixTimesVector:
+		tc.checkMatrixTimesVector(v)
+	case *OpMatrixTimesMatrix:
+		tc.checkMatrixTimesMatrix(v)
+	case *OpOuterProduct:
+		tc.checkOuterProduct(v)
+	case *OpDot:
+		tc.checkDot(v)
+
+	case *OpConvertFToU:
+		tc.convert(v.ResultType, TypeInt, "FloatValue", v.FloatValue, TypeFloat)
+	case *OpConvertFToS:
+		tc.convert(v.ResultType, TypeInt, "FloatValue", v.FloatValue, TypeFloat)
+	case *OpConvertSToF:
+		tc.convert(v.ResultType, TypeFloat, "SignedValue", v.SignedValue, TypeInt)
+	case *OpConvertUToF:
+		tc.convert(v.ResultType, TypeFloat, "UnsignedValue", v.UnsignedValue, TypeInt)
+	case *OpUConvert:
+		tc.convert(v.ResultType, TypeInt, "UnsignedValue", v.UnsignedValue, TypeInt)
+	case *OpSConvert:
+		tc.convert(v.ResultType, TypeInt, "SignedValue", v.SignedValue, TypeInt)
+	case *OpFConvert:
+		tc.convert(v.ResultType, TypeFloat, "FloatValue", v.FloatValue, TypeFloat)
+	case *OpConvertPtrToU:
+		tc.checkConvertPtrToU(v)
+	case *OpConvertUToPtr:
+		tc.checkConvertUToPtr(v)
+	case *OpBitcast:
+		tc.checkBitcast(v)
+
+	case *OpAny:
+		tc.anyAll(v.ResultType, v.Vector)
+	case *OpAll:
+		tc.anyAll(v.ResultType, v.Vector)
+
+	case *OpIsNan:
+		tc.floatTest(v.ResultType, v.X)
+	case *OpIsInf:
+		tc.floatTest(v.ResultType, v.X)
+	case *OpIsFinite:
+		tc.floatTest(v.ResultType, v.X)
+	case *OpIsNormal:
+		tc.floatTest(v.ResultType, v.X)
+	case *OpSignBitSet:
+		tc.floatTest(v.ResultType, v.X)
+	case *OpLessOrGreater:
+		tc.floatCompare(v.ResultType, "X", v.X, "Y", v.Y)
+	case *OpOrdered:
+		tc.floatCompare(v.ResultType, "X", v.X, "Y", v.Y)
+	case *OpUnordered:
+		tc.floatCompare(v.ResultType, "X", v.X, "Y", v.Y)
+
+	case *OpLogicalEqual:
+		tc.logical(v.ResultType, v.Operand1, v.Operand2)
+	case *OpLogicalNotEqual:
+		tc.logical(v.ResultType, v.Operand1, v.Operand2)
+	case *OpLogicalOr:
+		tc.logical(v.ResultType, v.Operand1, v.Operand2)
+	case *OpLogicalAnd:
+		tc.logical(v.ResultType, v.Operand1, v.Operand2)
+	case *OpLogicalNot:
+		if tc.result(v.ResultType, TypeBool, "a boolean scalar or vector") != nil {
+			tc.same("Operand", tc.types.TypeOf(v.Operand), tc.types.Type(v.ResultType))
+		}
+	case *OpSelect:
+		tc.checkSelect(v)
+
+	case *OpIEqual:
+		tc.intCompare(v.ResultType, v.Operand1, v.Operand2)
+	case *OpINotEqual:
+		tc.intCompare(v.ResultType, v.Operand1, v.Operand2)
+	case *OpUGreaterThan:
+		tc.intCompare(v.ResultType, v.Operand1, v.Operand2)
+	case *OpSGreaterThan:
+		tc.intCompare(v.ResultType, v.Operand1, v.Operand2)
+	case *OpUGreaterThanEqual:
+		tc.intCompare(v.ResultType, v.Operand1, v.Operand2)
+	case *OpSGreaterThanEqual:
+		tc.intCompare(v.ResultType, v.Operand1, v.Operand2)
+	case *OpULessThan:
+		tc.intCompare(v.ResultType, v.Operand1, v.Operand2)
+	case *OpSLessThan:
+		tc.intCompare(v.ResultType, v.Operand1, v.Operand2)
+	case *OpULessThanEqual:
+		tc.intCompare(v.ResultType, v.Operand1, v.Operand2)
+	case *OpSLessThanEqual:
+		tc.intCompare(v.ResultType, v.Operand1, v.Operand2)
+
+	case *OpFOrdEqual:
+		tc.floatCompare(v.ResultType, "Operand1", v.Operand1, "Operand2", v.Operand2)
+	case *OpFUnordEqual:
+		tc.floatCompare(v.ResultType, "Operand1", v.Operand1, "Operand2", v.Operand2)
+	case *OpFOrdNotEqual:
+		tc.floatCompare(v.ResultType, "Operand1", v.Operand1, "Operand2", v.Operand2)
+	case *OpFUnordNotEqual:
+		tc.floatCompare(v.ResultType, "Operand1", v.Operand1, "Operand2", v.Operand2)
+	case *OpFOrdLessThan:
+		tc.floatCompare(v.ResultType, "Operand1", v.Operand1, "Operand2", v.Operand2)
+	case *OpFUnordLessThan:
+		tc.floatCompare(v.ResultType, "Operand1", v.Operand1, "Operand2", v.Operand2)
+	case *OpFOrdGreaterThan:
+		tc.floatCompare(v.ResultType, "Operand1", v.Operand1, "Operand2", v.Operand2)
+	case *OpFUnordGreaterThan:
+		tc.floatCompare(v.ResultType, "Operand1", v.Operand1, "Operand2", v.Operand2)
+	case *OpFOrdLessThanEqual:
+		tc.floatCompare(v.ResultType, "Operand1", v.Operand1, "Operand2", v.Operand2)
+	case *OpFUnordLessThanEqual:
+		tc.floatCompare(v.ResultType, "Operand1", v.Operand1, "Operand2", v.Operand2)
+	case *OpFOrdGreaterThanEqual:
+		tc.floatCompare(v.ResultType, "Operand1", v.Operand1, "Operand2", v.Operand2)
+	case *OpFUnordGreaterThanEqual:
+		tc.floatCompare(v.ResultType, "Operand1", v.Operand1, "Operand2", v.Operand2)
+
+	case *OpVectorExtractDynamic:
+		tc.checkVectorExtractDynamic(v)
+	case *OpVectorInsertDynamic:
+		tc.checkVectorInsertDynamic(v)
+	case *OpVectorShuffle:
+		tc.checkVectorShuffle(v)
+	case *OpCompositeConstruct:
+		tc.checkCompositeConstruct(v)
+	case *OpCompositeExtract:
+		tc.checkCompositeExtract(v)
+	case *OpCompositeInsert:
+		tc.checkCompositeInsert(v)
+	case *OpCopyObject:
+		tc.same("Operand", tc.types.TypeOf(v.Operand), tc.types.Type(v.ResultType))
+	case *OpTranspose:
+		tc.checkTranspose(v)
+
+	case *OpVariable:
+		tc.checkVariable(v)
+	case *OpLoad:
+		tc.checkLoad(v)
+	case *OpStore:
+		tc.checkStore(v)
+	case *OpCopyMemory:
+		tc.checkCopyMemory(v)
+	case *OpAccessChain:
+		tc.accessChain(v.ResultType, v.Base, v.Indices)
+	case *OpInBoundsAccessChain:
+		tc.accessChain(v.ResultType, v.Base, v.Indices)
+	}
+}
+
+// intUnary checks an integer operation with a single operand.
+func (tc *typeChecker) intUnary(rt, a Id) {
+	res := tc.result(rt, TypeInt, "an integer scalar or vector")
+	if res != nil {
+		tc.integer("Operand", a, res)
+	}
+}
+
+// intBinary checks an integer operation with two operands.
+func (tc *typeChecker) intBinary(rt, a, b Id) {
+	res := tc.result(rt, TypeInt, "an integer scalar or vector")
+	if res != nil {
+		tc.integer("Operand1", a, res)
+		tc.integer("Operand2", b, res)
+	}
+}
+
+// floatUnary checks a float operation with a single operand.
+func (tc *typeChecker) floatUnary(rt, a Id) {
+	res := tc.result(rt, TypeFloat, "a float scalar or vector")
+	if res != nil {
+		tc.same("Operand", tc.types.TypeOf(a), res)
+	}
+}
+
+// floatBinary checks a float operation with two operands.
+func (tc *typeChecker) floatBinary(rt, a, b Id) {
+	res := tc.result(rt, TypeFloat, "a float scalar or vector")
+	if res != nil {
+		tc.same("Operand1", tc.types.TypeOf(a), res)
+		tc.same("Operand2", tc.types.TypeOf(b), res)
+	}
+}
+
+// shift checks a bit shift. The shift amount may have any width.
+func (tc *typeChecker) shift(rt, base, shift Id) {
+	res := tc.result(rt, TypeInt, "an integer scalar or vector")
+	if res == nil {
+		return
+	}
+
+	tc.integer("Base", base, res)
+
+	t := tc.types.TypeOf(shift)
+	if tc.want("Shift", t, t.scalar().is(TypeInt), "an integer scalar or vector") {
+		tc.sameComponents("Shift", t, res)
+	}
+}
+
+// floatVector returns the type of the named field, if it is a float vector.
+func (tc *typeChecker) floatVector(field string, t *Type) *Type {
+	if !tc.want(field, t, t.is(TypeVector) && t.scalar().is(TypeFloat), "a float vector") {
+		return nil
+	}
+	return t
+}
+
+// floatMatrix returns the type of the named field, if it is a float matrix.
+func (tc *typeChecker) floatMatrix(field string, t *Type) *Type {
+	ok := t != nil && t.is(TypeMatrix) && t.Elem.scalar().is(TypeFloat)
+	if !tc.want(field, t, ok, "a float matrix") {
+		return nil
+	}
+	return t
+}
+
+func (tc *typeChecker) checkVectorTimesScalar(v *OpVectorTimesScalar) {
+	res := tc.floatVector("ResultType", tc.types.Type(v.ResultType))
+	if res != nil {
+		tc.same("Vector", tc.types.TypeOf(v.Vector), res)
+		tc.same("Scalar", tc.types.TypeOf(v.Scalar), res.Elem)
+	}
+}
+
+func (tc *typeChecker) checkMatrixTimesScalar(v *OpMatrixTimesScalar) {
+	res := tc.floatMatrix("ResultType", tc.types.Type(v.ResultType))
+	if res != nil {
+		tc.same("Matrix", tc.types.TypeOf(v.Matrix), res)
+		tc.same("Scalar", tc.types.TypeOf(v.Scalar), res.Elem.Elem)
+	}
+}
+
+func (tc *typeChecker) checkVectorTimesMatrix(v *OpVectorTimesMatrix) {
+	res := tc.floatVector("ResultType", tc.types.Type(v.ResultType))
+	if res == nil {
+		return
+	}
+
+	mat := tc.floatMatrix("Matrix", tc.types.TypeOf(v.Matrix))
+	if mat == nil {
+		return
+	}
+
+	tc.same("Vector", tc.types.TypeOf(v.Vector), mat.Elem)
+
+	if mat.Len != res.Len || mat.Elem.Elem != res.Elem {
+		tc.errorf("Matrix must have %d columns of %s, not %s", res.Len, res.Elem, mat)
+	}
+}
+
+func (tc *typeChecker) checkMatrixTimesVector(v *OpMatrixTimesVector) {
+	res := tc.floatVector("ResultType", tc.types.Type(v.ResultType))
+	if res == nil {
+		return
+	}
+
+	mat := tc.floatMatrix("Matrix", tc.types.TypeOf(v.Matrix))
+	if mat == nil {
+		return
+	}
+
+	tc.same("Matrix column", mat.Elem, res)
+
+	vec := tc.floatVector("Vector", tc.types.TypeOf(v.Vector))
+	if vec != nil && (vec.Len != mat.Len || vec.Elem != res.Elem) {
+		tc.errorf("Vector must have %d components of %s, not %s", mat.Len, res.Elem, vec)
+	}
+}
+
+func (tc *typeChecker) checkMatrixTimesMatrix(v *OpMatrixTimesMatrix) {
+	res := tc.floatMatrix("ResultType", tc.types.Type(v.ResultType))
+	if res == nil {
+		return
+	}
+
+	left := tc.floatMatrix("LeftMatrix", tc.types.TypeOf(v.LeftMatrix))
+	if left == nil {
+		return
+	}
+
+	tc.same("LeftMatrix column", left.Elem, res.Elem)
+
+	right := tc.floatMatrix("RightMatrix", tc.types.TypeOf(v.RightMatrix))
+	if right == nil {
+		return
+	}
+
+	if right.Len != res.Len || right.Elem.components() != left.Len || right.Elem.scalar() != res.Elem.scalar() {
+		tc.errorf("RightMatrix must have %d columns of %d components, not %s",
+			res.Len, left.Len, right)
+	}
+}
+
+func (tc *typeChecker) checkOuterProduct(v *OpOuterProduct) {
+	res := tc.floatMatrix("ResultType", tc.types.Type(v.ResultType))
+	if res == nil {
+		return
+	}
+
+	tc.same("Vector1", tc.types.TypeOf(v.Vector1), res.Elem)
+
+	vec := tc.floatVector("Vector2", tc.types.TypeOf(v.Vector2))
+	if vec != nil && (vec.Len != res.Len || vec.Elem != res.Elem.Elem) {
+		tc.errorf("Vector2 must have %d components of %s, not %s", res.Len, res.Elem.Elem, vec)
+	}
+}
+
+func (tc *typeChecker) checkDot(v *OpDot) {
+	res := tc.types.Type(v.ResultType)
+	if !tc.want("ResultType", res, res.is(TypeFloat), "a float scalar") {
+		return
+	}
+
+	vec := tc.floatVector("Vector1", tc.types.TypeOf(v.Vector1))
+	if vec != nil {
+		tc.same("Vector1 component", vec.Elem, res)
+		tc.same("Vector2", tc.types.TypeOf(v.Vector2), vec)
+	}
+}
+
+// convert checks a numerical conversion from a scalar or vector of
+// kind from to one of kind to.
+func (tc *typeChecker) convert(rt Id, to TypeKind, field string, id Id, from TypeKind) {
+	res := tc.result(rt, to, "a"+kindDesc(to)+" scalar or vector")
+	if res == nil {
+		return
+	}
+
+	t := tc.types.TypeOf(id)
+	if tc.want(field, t, t.scalar().is(from), "a"+kindDesc(from)+" scalar or vector") {
+		tc.sameComponents(field, t, res)
+	}
+}
+
+// kindDesc returns the description of a numerical type kind, prefixed
+// with a space or, for integers, with "n ".
+func kindDesc(k TypeKind) string {
+	if k == TypeInt {
+		return "n integer"
+	}
+	return " " + k.String()
+}
+
+func (tc *typeChecker) checkConvertPtrToU(v *OpConvertPtrToU) {
+	res := tc.types.Type(v.ResultType)
+	tc.want("ResultType", res, res.is(TypeInt), "an integer scalar")
+
+	t := tc.types.TypeOf(v.Pointer)
+	tc.want("Pointer", t, t.is(TypePointer), "a pointer")
+}
+
+func (tc *typeChecker) checkConvertUToPtr(v *OpConvertUToPtr) {
+	res := tc.types.Type(v.ResultType)
+	tc.want("ResultType", res, res.is(TypePointer), "a pointer")
+	tc.intScalar("IntegerValue", v.IntegerValue)
+}
+
+func (tc *typeChecker) checkBitcast(v *OpBitcast) {
+	numeric := func(t *Type) bool {
+		return t.is(TypePointer) || t.scalar().is(TypeInt) || t.scalar().is(TypeFloat)
+	}
+
+	const desc = "a pointer or a numerical scalar or vector"
+
+	res := tc.types.Type(v.ResultType)
+	t := tc.types.TypeOf(v.Operand)
+	if !tc.want("ResultType", res, numeric(res), desc) || !tc.want("Operand", t, numeric(t), desc) {
+		return
+	}
+
+	if res.is(TypePointer) || t.is(TypePointer) {
+		return
+	}
+
+	a := res.components() * res.scalar().Width
+	b := t.components() * t.scalar().Width
+	if a != b {
+		tc.errorf("Operand must have %d bits, not %d", a, b)
+	}
+}
+
+// anyAll checks OpAny and OpAll.
+func (tc *typeChecker) anyAll(rt, vector Id) {
+	res := tc.types.Type(rt)
+	tc.want("ResultType", res, res.is(TypeBool), "a boolean scalar")
+
+	t := tc.types.TypeOf(vector)
+	tc.want("Vector", t, t.is(TypeVector) && t.scalar().is(TypeBool), "a boolean vector")
+}
+
+// floatTest checks a test on a float scalar or vector, like OpIsNan.
+func (tc *typeChecker) floatTest(rt, x Id) {
+	res := tc.result(rt, TypeBool, "a boolean scalar or vector")
+	if res == nil {
+		return
+	}
+
+	t := tc.types.TypeOf(x)
+	if tc.want("X", t, t.scalar().is(TypeFloat), "a float scalar or vector") {
+		tc.sameComponents("X", t, res)
+	}
+}
+
+// floatCompare checks a comparison of two float scalars or vectors.
+func (tc *typeChecker) floatCompare(rt Id, fa string, a Id, fb string, b Id) {
+	res := tc.result(rt, TypeBool, "a boolean scalar or vector")
+	if res == nil {
+		return
+	}
+
+	t := tc.types.TypeOf(a)
+	if tc.want(fa, t, t.scalar().is(TypeFloat), "a float scalar or vector") && tc.sameComponents(fa, t, res) {
+		tc.same(fb, tc.types.TypeOf(b), t)
+	}
+}
+
+// intCompare checks a comparison of two integer scalars or vectors.
+func (tc *typeChecker) intCompare(rt, a, b Id) {
+	res := tc.result(rt, TypeBool, "a boolean scalar or vector")
+	if res == nil {
+		return
+	}
+
+	t := tc.types.TypeOf(a)
+	if tc.want("Operand1", t, t.scalar().is(TypeInt), "an integer scalar or vector") && tc.sameComponents("Operand1", t, res) {
+		tc.integer("Operand2", b, t)
+	}
+}
+
+// logical checks a logical operation with two operands.
+func (tc *typeChecker) logical(rt, a, b Id) {
+	res := tc.result(rt, TypeBool, "a boolean scalar or vector")
+	if res != nil {
+		tc.same("Operand1", tc.types.TypeOf(a), res)
+		tc.same("Operand2", tc.types.TypeOf(b), res)
+	}
+}
+
+func (tc *typeChecker) checkSelect(v *OpSelect) {
+	res := tc.types.Type(v.ResultType)
+	tc.same("Object1", tc.types.TypeOf(v.Object1), res)
+	tc.same("Object2", tc.types.TypeOf(v.Object2), res)
+
+	t := tc.types.TypeOf(v.Condition)
+	if tc.want("Condition", t, t.scalar().is(TypeBool), "a boolean scalar or vector") && t.is(TypeVector) {
+		tc.sameComponents("Condition", t, res)
+	}
+}
+
+func (tc *typeChecker) checkVectorExtractDynamic(v *OpVectorExtractDynamic) {
+	t := tc.types.TypeOf(v.Vector)
+	if tc.want("Vector", t, t.is(TypeVector), "a vector") {
+		tc.same("ResultType", tc.types.Type(v.ResultType), t.Elem)
+	}
+
+	tc.intScalar("Index", v.Index)
+}
+
+func (tc *typeChecker) checkVectorInsertDynamic(v *OpVectorInsertDynamic) {
+	res := tc.types.Type(v.ResultType)
+	if tc.want("ResultType", res, res.is(TypeVector), "a vector") {
+		tc.same("Vector", tc.types.TypeOf(v.Vector), res)
+		tc.same("Component", tc.types.TypeOf(v.Component), res.Elem)
+	}
+
+	tc.intScalar("Index", v.Index)
+}
+
+func (tc *typeChecker) checkVectorShuffle(v *OpVectorShuffle) {
+	res := tc.types.Type(v.ResultType)
+	if !tc.want("ResultType", res, res.is(TypeVector), "a vector") {
+		return
+	}
+
+	if int(res.Len) != len(v.Components) {
+		tc.errorf("ResultType must have %d components, not %d", len(v.Components), res.Len)
+	}
+
+	a := tc.types.TypeOf(v.Vector1)
+	b := tc.types.TypeOf(v.Vector2)
+	okA := tc.want("Vector1", a, a.is(TypeVector), "a vector")
+	okB := tc.want("Vector2", b, b.is(TypeVector), "a vector")
+
+	if okA {
+		tc.same("Vector1 component", a.Elem, res.Elem)
+	}
+
+	if okB {
+		tc.same("Vector2 component", b.Elem, res.Elem)
+	}
+
+	if !okA || !okB {
+		return
+	}
+
+	n := a.Len + b.Len
+	for i, c := range v.Components {
+		// 0xFFFFFFFF denotes an undefined component.
+		if c >= n && c != 0xffffffff {
+			tc.errorf("Components[%d] is %d, which is out of range for %d components", i, c, n)
+		}
+	}
+}
+
+func (tc *typeChecker) checkCompositeConstruct(v *OpCompositeConstruct) {
+	res := tc.types.Type(v.ResultType)
+	if !tc.want("ResultType", res, res.isComposite() && res.Kind != TypeRuntimeArray, "a composite") {
+		return
+	}
+
+	switch res.Kind {
+	case TypeVector:
+		var n uint32
+		for i, id := range v.Constituents {
+			t := tc.types.TypeOf(id)
+			if t == nil {
+				return
+			}
+
+			if t.scalar() != res.Elem {
+				tc.errorf("Constituents[%d] must be %s or a vector of it, not %s", i, res.Elem, t)
+				return
+			}
+
+			n += t.components()
+		}
+
+		if n != res.Len {
+			tc.errorf("Constituents must have %d components in total, not %d", res.Len, n)
+		}
+		return
+
+	case TypeStruct:
+		if len(v.Constituents) != len(res.Members) {
+			tc.errorf("Constituents must have %d elements, not %d", len(res.Members), len(v.Constituents))
+			return
+		}
+
+		for i, id := range v.Constituents {
+			tc.same(fmt.Sprintf("Constituents[%d]", i), tc.types.TypeOf(id), res.Members[i])
+		}
+		return
+	}
+
+	if res.Len > 0 && len(v.Constituents) != int(res.Len) {
+		tc.errorf("Constituents must have %d elements, not %d", res.Len, len(v.Constituents))
+		return
+	}
+
+	for i, id := range v.Constituents {
+		tc.same(fmt.Sprintf("Constituents[%d]", i), tc.types.TypeOf(id), res.Elem)
+	}
+}
+
+// element returns the type of the element at the given index in the
+// composite type t. The index is only known for constant indices.
+// Reports an error and returns nil if the index is not valid for t.
+func (tc *typeChecker) element(field string, t *Type, index uint64, known bool) *Type {
+	switch t.Kind {
+	case TypeVector, TypeMatrix, TypeArray:
+		if known && t.Len > 0 && index >= uint64(t.Len) {
+			tc.errorf("%s is %d, which is out of range for %s", field, index, t)
+			return nil
+		}
+		return t.Elem
+
+	case TypeRuntimeArray:
+		return t.Elem
+
+	case TypeStruct:
+		if !known {
+			tc.errorf("%s must be a constant to index %s", field, t)
+			return nil
+		}
+
+		if index >= uint64(len(t.Members)) {
+			tc.errorf("%s is %d, which is out of range for %s", field, index, t)
+			return nil
+		}
+		return t.Members[index]
+	}
+
+	tc.errorf("%s indexes %s, which is not a composite", field, t)
+	return nil
+}
+
+// walk returns the type reached by applying the literal indices to
+// the composite type t. Returns nil if t is unknown or an index is
+// not valid.
+func (tc *typeChecker) walk(t *Type, indices []uint32) *Type {
+	for i, index := range indices {
+		if t == nil {
+			return nil
+		}
+
+		t = tc.element(fmt.Sprintf("Indices[%d]", i), t, uint64(index), true)
+	}
+
+	return t
+}
+
+func (tc *typeChecker) checkCompositeExtract(v *OpCompositeExtract) {
+	t := tc.types.TypeOf(v.Composite)
+	if !tc.want("Composite", t, t.isComposite(), "a composite") {
+		return
+	}
+
+	tc.same("ResultType", tc.types.Type(v.ResultType), tc.walk(t, v.Indices))
+}
+
+func (tc *typeChecker) checkCompositeInsert(v *OpCompositeInsert) {
+	t := tc.types.TypeOf(v.Composite)
+	if !tc.want("Composite", t, t.isComposite(), "a composite") {
+		return
+	}
+
+	tc.same("ResultType", tc.types.Type(v.ResultType), t)
+	tc.same("Object", tc.types.TypeOf(v.Object), tc.walk(t, v.Indices))
+}
+
+func (tc *typeChecker) checkTranspose(v *OpTranspose) {
+	res := tc.floatMatrix("ResultType", tc.types.Type(v.ResultType))
+	if res == nil {
+		return
+	}
+
+	t := tc.floatMatrix("Matrix", tc.types.TypeOf(v.Matrix))
+	if t == nil {
+		return
+	}
+
+	if t.Len != res.Elem.components() || t.Elem.components() != res.Len || t.Elem.scalar() != res.Elem.scalar() {
+		tc.errorf("Matrix must have %d columns of %d components, not %s",
+			res.Elem.components(), res.Len, t)
+	}
+}
+
+// pointer returns the type of the named field, if it is a pointer.
+func (tc *typeChecker) pointer(field string, t *Type) *Type {
+	if !tc.want(field, t, t.is(TypePointer), "a pointer") {
+		return nil
+	}
+	return t
+}
+
+func (tc *typeChecker) checkVariable(v *OpVariable) {
+	res := tc.pointer("ResultType", tc.types.Type(v.ResultType))
+	if res == nil {
+		return
+	}
+
+	if res.StorageClass != v.StorageClass {
+		tc.errorf("StorageClass must be %v, not %v", res.StorageClass, v.StorageClass)
+	}
+
+	if v.Initializer != 0 {
+		tc.same("Initializer", tc.types.TypeOf(v.Initializer), res.Elem)
+	}
+}
+
+func (tc *typeChecker) checkLoad(v *OpLoad) {
+	ptr := tc.pointer("Pointer", tc.types.TypeOf(v.Pointer))
+	if ptr != nil {
+		tc.same("ResultType", tc.types.Type(v.ResultType), ptr.Elem)
+	}
+}
+
+func (tc *typeChecker) checkStore(v *OpStore) {
+	ptr := tc.pointer("Pointer", tc.types.TypeOf(v.Pointer))
+	if ptr != nil {
+		tc.same("Object", tc.types.TypeOf(v.Object), ptr.Elem)
+	}
+}
+
+func (tc *typeChecker) checkCopyMemory(v *OpCopyMemory) {
+	target := tc.pointer("Target", tc.types.TypeOf(v.Target))
+	source := tc.pointer("Source", tc.types.TypeOf(v.Source))
+	if target != nil && source != nil {
+		tc.same("Source", source.Elem, target.Elem)
+	}
+}
+
+// accessChain checks OpAccessChain and OpInBoundsAccessChain.
+func (tc *typeChecker) accessChain(rt, base Id, indices []Id) {
+	res := tc.pointer("ResultType", tc.types.Type(rt))
+	ptr := tc.pointer("Base", tc.types.TypeOf(base))
+	if ptr == nil {
+		return
+	}
+
+	t := ptr.Elem
+	for i, id := range indices {
+		if t == nil {
+			return
+		}
+
+		field := fmt.Sprintf("Indices[%d]", i)
+		if tc.types.TypeOf(id) != nil {
+			tc.intScalar(field, id)
+		}
+
+		index, known := tc.types.Constant(id)
+		t = tc.element(field, t, index, known)
+	}
+
+	if res == nil || t == nil {
+		return
+	}
+
+	if res.Elem != t {
+		tc.errorf("ResultType must point to %s, not %s", t, res.Elem)
+	}
+
+	if res.StorageClass != ptr.StorageClass {
+		tc.errorf("ResultType must have storage class %v, not %v", ptr.StorageClass, res.StorageClass)
+	}
+}
@@ -0,0 +1,120 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testTypesPrefix declares the types and values used by
+// TestModuleVerifyTypes. It holds 23 instructions.
+const testTypesPrefix = `
+	%1 = OpTypeVoid
+	%2 = OpTypeBool
+	%3 = OpTypeInt 32 1
+	%4 = OpTypeInt 32 0
+	%5 = OpTypeFloat 32
+	%6 = OpTypeVector %5 4
+	%7 = OpTypeVector %5 2
+	%8 = OpTypeMatrix %6 2
+	%9 = OpTypeMatrix %7 4
+	%10 = OpTypeVector %2 4
+	%11 = OpConstant %3 1
+	%12 = OpUndef %5
+	%13 = OpUndef %6
+	%14 = OpUndef %7
+	%15 = OpUndef %8
+	%16 = OpUndef %10
+	%17 = OpTypeStruct %3 %6
+	%18 = OpTypePointer Function %17
+	%19 = OpTypeArray %5 %11
+	%20 = OpTypePointer Function %6
+	%21 = OpTypePointer Function %5
+	%22 = OpUndef %18
+	%23 = OpUndef %4
+`
+
+func TestModuleVerifyTypes(t *testing.T) {
+	for i, st := range []struct {
+		src  string
+		want error
+	}{
+		{
+			src: `
+				%30 = OpIAdd %3 %11 %11
+				%31 = OpFAdd %6 %13 %13
+				%32 = OpVectorTimesScalar %6 %13 %12
+				%33 = OpMatrixTimesVector %6 %15 %14
+				%34 = OpDot %5 %13 %13
+				%35 = OpConvertFToS %3 %12
+				%36 = OpIEqual %2 %11 %11
+				%37 = OpFOrdLessThan %10 %13 %13
+				%38 = OpAll %2 %16
+				%39 = OpSelect %6 %16 %13 %13
+				%40 = OpVectorShuffle %7 %13 %14 0 5
+				%41 = OpCompositeConstruct %6 %14 %12 %12
+				%42 = OpCompositeExtract %5 %15 1 3
+				%43 = OpAccessChain %20 %22 %11
+				%44 = OpLoad %6 %43
+				      OpStore %43 %13
+				%45 = OpBitcast %4 %12
+				%46 = OpVariable %21 Function %12
+				%47 = OpTranspose %9 %15
+				%48 = OpVectorTimesMatrix %7 %13 %15
+				%49 = OpOuterProduct %8 %13 %14
+				%50 = OpShiftLeftLogical %4 %23 %11`,
+		},
+		{
+			src: `
+				%30 = OpIAdd %3 %12 %11
+				%31 = OpLoad %5 %12
+				%32 = OpVectorShuffle %7 %13 %14 0 6`,
+			want: ErrorList{
+				NewLayoutError(23, "Operand1 must be an integer scalar or vector, not float32"),
+				NewLayoutError(24, "Pointer must be a pointer, not float32"),
+				NewLayoutError(25, "Components[1] is 6, which is out of range for 6 components"),
+			},
+		},
+		{
+			src: `
+				%30 = OpFAdd %6 %13 %14
+				%31 = OpIAdd %5 %11 %11
+				%32 = OpFOrdEqual %10 %14 %14
+				%33 = OpCompositeExtract %5 %15 2 0
+				%34 = OpAccessChain %21 %22 %11
+				      OpStore %22 %13
+				%35 = OpConvertSToF %5 %13
+				%36 = OpBitcast %4 %14
+				%37 = OpCompositeConstruct %6 %14 %12
+				%38 = OpVariable %21 Private
+				%39 = OpSelect %7 %16 %14 %14
+				%40 = OpIAdd %3 %11 %23`,
+			want: ErrorList{
+				NewLayoutError(23, "Operand2 must have type vec4<float32>, not vec2<float32>"),
+				NewLayoutError(24, "ResultType must be an integer scalar or vector, not float32"),
+				NewLayoutError(25, "Operand1 must have 4 components, not 2"),
+				NewLayoutError(26, "Indices[0] is 2, which is out of range for mat2<vec4<float32>>"),
+				NewLayoutError(27, "ResultType must point to vec4<float32>, not float32"),
+				NewLayoutError(28, "Object must have type struct %%17, not vec4<float32>"),
+				NewLayoutError(29, "SignedValue must be an integer scalar or vector, not vec4<float32>"),
+				NewLayoutError(30, "Operand must have 32 bits, not 64"),
+				NewLayoutError(31, "Constituents must have 4 components in total, not 3"),
+				NewLayoutError(32, "StorageClass must be Function, not Private"),
+				NewLayoutError(33, "Condition must have 2 components, not 4"),
+			},
+		},
+	} {
+		m, err := Assemble(strings.NewReader(testTypesPrefix + st.src))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		have := m.verifyTypes()
+		if !reflect.DeepEqual(have, st.want) {
+			t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v", i, have, st.want)
+		}
+	}
+}
@@ -0,0 +1,377 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TypeKind defines the kind of a type. There is one kind for each
+// type declaration instruction.
+type TypeKind int
+
+// Known type kinds.
+const (
+	TypeVoid TypeKind = iota
+	TypeBool
+	TypeInt
+	TypeFloat
+	TypeVector
+	TypeMatrix
+	TypeImage
+	TypeSampler
+	TypeSampledImage
+	TypeArray
+	TypeRuntimeArray
+	TypeStruct
+	TypeOpaque
+	TypePointer
+	TypeFunction
+	TypeEvent
+	TypeDeviceEvent
+	TypeReserveId
+	TypeQueue
+	TypePipe
+)
+
+var typeKindNames = [...]string{
+	TypeVoid:         "void",
+	TypeBool:         "bool",
+	TypeInt:          "int",
+	TypeFloat:        "float",
+	TypeVector:       "vector",
+	TypeMatrix:       "matrix",
+	TypeImage:        "image",
+	TypeSampler:      "sampler",
+	TypeSampledImage: "sampled image",
+	TypeArray:        "array",
+	TypeRuntimeArray: "runtime array",
+	TypeStruct:       "struct",
+	TypeOpaque:       "opaque",
+	TypePointer:      "pointer",
+	TypeFunction:     "function",
+	TypeEvent:        "event",
+	TypeDeviceEvent:  "device event",
+	TypeReserveId:    "reserve id",
+	TypeQueue:        "queue",
+	TypePipe:         "pipe",
+}
+
+func (k TypeKind) String() string {
+	if k < 0 || int(k) >= len(typeKindNames) {
+		return fmt.Sprintf("TypeKind(%d)", int(k))
+	}
+	return typeKindNames[k]
+}
+
+// Type defines the structure of a type declared in a module.
+//
+// Types are unique within a TypeTable: two values have the same type
+// if their *Type pointers are equal.
+type Type struct {
+	Kind TypeKind
+	Id   Id
+
+	// Width is the bit width of an integer or float type.
+	Width uint32
+
+	// Signed is true for signed integer types.
+	Signed bool
+
+	// Elem is the component type of a vector, the column type of a
+	// matrix, the element type of an array, the type pointed to by a
+	// pointer, the image type of a sampled image and the sampled type
+	// of an image.
+	Elem *Type
+
+	// Len is the number of components in a vector, the number of
+	// columns in a matrix, or the length of an array. For arrays, it is
+	// 0 if the length is not defined by an integer constant.
+	Len uint32
+
+	// Members holds the member types of a struct, or the parameter types
+	// of a function.
+	Members []*Type
+
+	// Return is the return type of a function.
+	Return *Type
+
+	// StorageClass is the storage class of a pointer.
+	StorageClass StorageClass
+
+	// Decl is the instruction which declares the type.
+	Decl Instruction
+}
+
+// String returns a short description of the type, like "vec4<float32>".
+// Structs are referred to by their id, since they may contain
+// themselves through a pointer.
+func (t *Type) String() string {
+	if t == nil {
+		return "?"
+	}
+
+	switch t.Kind {
+	case TypeInt:
+		if t.Signed {
+			return fmt.Sprintf("int%d", t.Width)
+		}
+		return fmt.Sprintf("uint%d", t.Width)
+
+	case TypeFloat:
+		return fmt.Sprintf("float%d", t.Width)
+
+	case TypeVector:
+		return fmt.Sprintf("vec%d<%s>", t.Len, t.Elem)
+
+	case TypeMatrix:
+		return fmt.Sprintf("mat%d<%s>", t.Len, t.Elem)
+
+	case TypeImage, TypeSampledImage:
+		return fmt.Sprintf("%s<%s>", t.Kind, t.Elem)
+
+	case TypeArray:
+		if t.Len == 0 {
+			return fmt.Sprintf("[?]%s", t.Elem)
+		}
+		return fmt.Sprintf("[%d]%s", t.Len, t.Elem)
+
+	case TypeRuntimeArray:
+		return fmt.Sprintf("[]%s", t.Elem)
+
+	case TypeStruct:
+		return fmt.Sprintf("struct %%%d", t.Id)
+
+	case TypePointer:
+		return fmt.Sprintf("*%s", t.Elem)
+
+	case TypeFunction:
+		params := make([]string, len(t.Members))
+		for i, p := range t.Members {
+			params[i] = p.String()
+		}
+		return fmt.Sprintf("func(%s) %s", strings.Join(params, ", "), t.Return)
+	}
+
+	return t.Kind.String()
+}
+
+// scalar returns the component type of a vector, or t itself
+// for all other types.
+func (t *Type) scalar() *Type {
+	if t != nil && t.Kind == TypeVector {
+		return t.Elem
+	}
+	return t
+}
+
+// components returns the number of components in a vector,
+// or 1 for all other types.
+func (t *Type) components() uint32 {
+	if t != nil && t.Kind == TypeVector {
+		return t.Len
+	}
+	return 1
+}
+
+// is returns true if t is known and of the given kind.
+// Use t.scalar().is(k) to test for a scalar or vector of kind k.
+func (t *Type) is(k TypeKind) bool {
+	return t != nil && t.Kind == k
+}
+
+// isComposite returns true if t is a vector, matrix, array or struct.
+func (t *Type) isComposite() bool {
+	if t == nil {
+		return false
+	}
+
+	switch t.Kind {
+	case TypeVector, TypeMatrix, TypeArray, TypeRuntimeArray, TypeStruct:
+		return true
+	}
+
+	return false
+}
+
+// TypeTable maps the ids in a module to their types.
+type TypeTable struct {
+	types     map[Id]*Type
+	values    map[Id]*Type
+	constants map[Id]uint64
+}
+
+// NewTypeTable builds the type table for the given module.
+//
+// Ids which refer to undeclared types are resolved to nil. This
+// includes the members of types which refer to them.
+func NewTypeTable(m *Module) *TypeTable {
+	tt := &TypeTable{
+		types:     make(map[Id]*Type),
+		values:    make(map[Id]*Type),
+		constants: make(map[Id]uint64),
+	}
+
+	// Create all types first, so types may refer to pointers which
+	// are declared later through OpTypeForwardPointer.
+	for _, instr := range m.Code {
+		kind, ok := typeKindOf(instr.Opcode())
+		if !ok {
+			continue
+		}
+
+		id, _ := ResultId(instr)
+		tt.types[id] = &Type{Kind: kind, Id: id, Decl: instr}
+	}
+
+	for _, instr := range m.Code {
+		id, _ := ResultId(instr)
+
+		switch v := instr.(type) {
+		case *OpTypeInt:
+			t := tt.types[id]
+			t.Width = v.Width
+			t.Signed = v.Signedness == 1
+
+		case *OpTypeFloat:
+			tt.types[id].Width = v.Width
+
+		case *OpTypeVector:
+			t := tt.types[id]
+			t.Elem = tt.types[v.ComponentType]
+			t.Len = v.ComponentCount
+
+		case *OpTypeMatrix:
+			t := tt.types[id]
+			t.Elem = tt.types[v.ColumnType]
+			t.Len = v.ColumnCount
+
+		case *OpTypeImage:
+			tt.types[id].Elem = tt.types[v.SampledType]
+
+		case *OpTypeSampledImage:
+			tt.types[id].Elem = tt.types[v.ImageType]
+
+		case *OpTypeArray:
+			t := tt.types[id]
+			t.Elem = tt.types[v.ElementType]
+			t.Len = uint32(tt.constants[v.Length])
+
+		case *OpTypeRuntimeArray:
+			tt.types[id].Elem = tt.types[v.ElementType]
+
+		case *OpTypeStruct:
+			tt.types[id].Members = tt.typeList(v.Members)
+
+		case *OpTypePointer:
+			t := tt.types[id]
+			t.Elem = tt.types[v.Type]
+			t.StorageClass = v.StorageClass
+
+		case *OpTypeFunction:
+			t := tt.types[id]
+			t.Return = tt.types[v.ReturnType]
+			t.Members = tt.typeList(v.Parameters)
+
+		case *OpConstant:
+			t := tt.types[v.ResultType]
+			if t != nil && t.Kind == TypeInt && len(v.Value) > 0 {
+				n := uint64(v.Value[0])
+				if len(v.Value) > 1 {
+					n |= uint64(v.Value[1]) << 32
+				}
+				tt.constants[id] = n
+			}
+
+		case *OpFunction:
+			// The type of a function is its function type,
+			// not its return type.
+			tt.values[id] = tt.types[v.FunctionType]
+			continue
+		}
+
+		rt, ok := ResultType(instr)
+		if ok && id != 0 {
+			tt.values[id] = tt.types[rt]
+		}
+	}
+
+	return tt
+}
+
+// typeList returns the types for the given ids.
+func (tt *TypeTable) typeList(ids []Id) []*Type {
+	out := make([]*Type, len(ids))
+	for i, id := range ids {
+		out[i] = tt.types[id]
+	}
+	return out
+}
+
+// Type returns the type declared with the given id.
+// Returns nil if id does not refer to a type declaration.
+func (tt *TypeTable) Type(id Id) *Type { return tt.types[id] }
+
+// TypeOf returns the type of the value with the given id.
+// For functions, this is their function type.
+// Returns nil if the type is not known.
+func (tt *TypeTable) TypeOf(id Id) *Type { return tt.values[id] }
+
+// Constant returns the value of the scalar integer constant with the
+// given id, zero extended to 64 bits. Returns false if id does not
+// refer to an OpConstant of an integer type.
+func (tt *TypeTable) Constant(id Id) (uint64, bool) {
+	v, ok := tt.constants[id]
+	return v, ok
+}
+
+// typeKindOf returns the kind of type declared by the given opcode.
+// Returns false if the opcode does not declare a type.
+func typeKindOf(opcode uint32) (TypeKind, bool) {
+	switch opcode {
+	case opcodeTypeVoid:
+		return TypeVoid, true
+	case opcodeTypeBool:
+		return TypeBool, true
+	case opcodeTypeInt:
+		return TypeInt, true
+	case opcodeTypeFloat:
+		return TypeFloat, true
+	case opcodeTypeVector:
+		return TypeVector, true
+	case opcodeTypeMatrix:
+		return TypeMatrix, true
+	case opcodeTypeImage:
+		return TypeImage, true
+	case opcodeTypeSampler:
+		return TypeSampler, true
+	case opcodeTypeSampledImage:
+		return TypeSampledImage, true
+	case opcodeTypeArray:
+		return TypeArray, true
+	case opcodeTypeRuntimeArray:
+		return TypeRuntimeArray, true
+	case opcodeTypeStruct:
+		return TypeStruct, true
+	case opcodeTypeOpaque:
+		return TypeOpaque, true
+	case opcodeTypePointer:
+		return TypePointer, true
+	case opcodeTypeFunction:
+		return TypeFunction, true
+	case opcodeTypeEvent:
+		return TypeEvent, true
+	case opcodeTypeDeviceEvent:
+		return TypeDeviceEvent, true
+	case opcodeTypeReserveId:
+		return TypeReserveId, true
+	case opcodeTypeQueue:
+		return TypeQueue, true
+	case opcodeTypePipe:
+		return TypePipe, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,85 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"strings"
+	"testing"
+)
+
+const testTypeTableSource = `
+	     OpTypeForwardPointer %8 CrossWorkgroup
+	%1 = OpTypeInt 32 0
+	%2 = OpConstant %1 4
+	%3 = OpTypeFloat 32
+	%4 = OpTypeVector %3 3
+	%5 = OpTypeMatrix %4 3
+	%6 = OpTypeArray %4 %2
+	%7 = OpTypeStruct %1 %8
+	%8 = OpTypePointer CrossWorkgroup %7
+	%9 = OpTypeRuntimeArray %5
+	%10 = OpTypeFunction %3 %1 %8
+	%11 = OpTypeVoid
+	%12 = OpFunction %3 None %10
+	%13 = OpFunctionParameter %1
+	%14 = OpFunctionParameter %8
+	%15 = OpLabel
+	%16 = OpUndef %3
+	     OpReturnValue %16
+	     OpFunctionEnd
+`
+
+func TestTypeTable(t *testing.T) {
+	m, err := Assemble(strings.NewReader(testTypeTableSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := NewTypeTable(m)
+
+	for i, st := range []struct {
+		id    Id
+		typ   string
+		value string
+	}{
+		{id: 1, typ: "uint32", value: "?"},
+		{id: 2, typ: "?", value: "uint32"},
+		{id: 4, typ: "vec3<float32>", value: "?"},
+		{id: 5, typ: "mat3<vec3<float32>>", value: "?"},
+		{id: 6, typ: "[4]vec3<float32>", value: "?"},
+		{id: 7, typ: "struct %7", value: "?"},
+		{id: 8, typ: "*struct %7", value: "?"},
+		{id: 9, typ: "[]mat3<vec3<float32>>", value: "?"},
+		{id: 10, typ: "func(uint32, *struct %7) float32", value: "?"},
+		{id: 11, typ: "void", value: "?"},
+		{id: 12, typ: "?", value: "func(uint32, *struct %7) float32"},
+		{id: 14, typ: "?", value: "*struct %7"},
+		{id: 16, typ: "?", value: "float32"},
+		{id: 99, typ: "?", value: "?"},
+	} {
+		typ := tt.Type(st.id).String()
+		if typ != st.typ {
+			t.Fatalf("case %d: type mismatch:\nHave: %v\nWant: %v", i, typ, st.typ)
+		}
+
+		value := tt.TypeOf(st.id).String()
+		if value != st.value {
+			t.Fatalf("case %d: value type mismatch:\nHave: %v\nWant: %v", i, value, st.value)
+		}
+	}
+
+	if tt.Type(7).Members[1] != tt.Type(8) {
+		t.Fatalf("forward pointer not resolved")
+	}
+
+	n, ok := tt.Constant(2)
+	if n != 4 || !ok {
+		t.Fatalf("constant mismatch:\nHave: %v, %v\nWant: 4, true", n, ok)
+	}
+
+	_, ok = tt.Constant(16)
+	if ok {
+		t.Fatalf("unexpected constant for id 16")
+	}
+}
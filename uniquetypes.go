@@ -0,0 +1,243 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"fmt"
+	"sort"
+)
+
+// verifyUniqueTypes checks that no two non-structure types have the same
+// operand parameterization, as defined in chapter 2.16.1 of the
+// specification. Each duplicate is reported at its own address, along
+// with the address of the first declaration of the type.
+//
+// Declarations are compared by their operands, as they appear in the
+// module. Types which only differ in the ids of duplicate component
+// types, are not reported: they become identical once MergeTypes has
+// replaced those components. Neither are types with different
+// decorations, such as arrays with different ArrayStride values.
+//
+// All violations are returned as an ErrorList of *LayoutError values.
+func (m *Module) verifyUniqueTypes() error {
+	var errs ErrorList
+	seen := make(map[string]int)
+	decos := decorationKeys(m.Code)
+
+	for addr, instr := range m.Code {
+		key, ok := typeKey(instr)
+		if !ok {
+			continue
+		}
+
+		id, _ := ResultId(instr)
+		key += fmt.Sprint(decos[id])
+
+		prev, dup := seen[key]
+		if !dup {
+			seen[key] = addr
+			continue
+		}
+
+		pid, _ := ResultId(m.Code[prev])
+
+		errs = append(errs, NewLayoutError(addr,
+			"type %d duplicates type %d declared at $%08x", id, pid, prev))
+	}
+
+	return errs.err()
+}
+
+// MergeTypes removes duplicate declarations of non-structure types and
+// replaces all uses of the duplicates with the id of the first declaration.
+// This includes types which become duplicates when their component types
+// are merged. Types are only merged if they have the same decorations.
+//
+// Debug names and decorations of removed types are removed along with
+// them. The type they are merged with keeps its own.
+func (m *Module) MergeTypes() {
+	canon := make(map[Id]Id)
+	seen := make(map[string]Id)
+	decos := decorationKeys(m.Code)
+
+	rewrite := func(id Id) Id {
+		if c, ok := canon[id]; ok {
+			return c
+		}
+		return id
+	}
+
+	code := m.Code[:0]
+
+	for _, instr := range m.Code {
+		key, ok := typeKey(instr)
+		if ok {
+			// Component types precede their uses, so they
+			// have been merged already.
+			RewriteOperands(instr, rewrite)
+			key, _ = typeKey(instr)
+
+			id, _ := ResultId(instr)
+			key += fmt.Sprint(decos[id])
+
+			if first, dup := seen[key]; dup {
+				canon[id] = first
+				continue
+			}
+
+			seen[key] = id
+		}
+
+		code = append(code, instr)
+	}
+
+	if len(canon) > 0 {
+		// Names and decorations of removed types are dropped.
+		// Surviving types carry the same decorations already.
+		n := len(code)
+		code = code[:0]
+
+		for _, instr := range m.Code[:n] {
+			if mergedTarget(instr, canon) {
+				continue
+			}
+
+			RewriteOperands(instr, rewrite)
+			code = append(code, instr)
+		}
+	}
+
+	for i := len(code); i < len(m.Code); i++ {
+		m.Code[i] = nil
+	}
+
+	m.Code = code
+}
+
+// mergedTarget returns true if the given debug name or decoration targets
+// one of the removed types in canon. Removed types are also dropped from
+// the targets of group decorations.
+func mergedTarget(instr Instruction, canon map[Id]Id) bool {
+	var target Id
+
+	switch v := instr.(type) {
+	case *OpName:
+		target = v.Target
+	case *OpMemberName:
+		target = v.Type
+	case *OpDecorate:
+		target = v.Target
+	case *OpMemberDecorate:
+		target = v.StructType
+
+	case *OpGroupDecorate:
+		var targets []Id
+		for _, id := range v.Targets {
+			if _, ok := canon[id]; !ok {
+				targets = append(targets, id)
+			}
+		}
+		v.Targets = targets
+		return false
+
+	case *OpGroupMemberDecorate:
+		var targets []uint32
+		for i := 0; i+1 < len(v.Targets); i += 2 {
+			if _, ok := canon[Id(v.Targets[i])]; !ok {
+				targets = append(targets, v.Targets[i], v.Targets[i+1])
+			}
+		}
+		v.Targets = targets
+		return false
+
+	default:
+		return false
+	}
+
+	_, ok := canon[target]
+	return ok
+}
+
+// decorationKeys returns, for each decorated id, a sorted list of keys
+// describing its decorations. Ids have the same list if they have the
+// same decorations. Decorations applied through a decoration group are
+// included as if they were applied directly.
+func decorationKeys(code []Instruction) map[Id][]string {
+	groups := make(map[Id][]*OpDecorate)
+	for _, instr := range code {
+		if v, ok := instr.(*OpDecorate); ok {
+			groups[v.Target] = append(groups[v.Target], v)
+		}
+	}
+
+	keys := make(map[Id][]string)
+
+	decorate := func(target Id, d Decoration, argv []uint32) {
+		keys[target] = append(keys[target], fmt.Sprintf("%v %v", d, argv))
+	}
+
+	decorateMember := func(target Id, member uint32, d Decoration, argv []uint32) {
+		keys[target] = append(keys[target], fmt.Sprintf("member %d: %v %v", member, d, argv))
+	}
+
+	for _, instr := range code {
+		switch v := instr.(type) {
+		case *OpDecorate:
+			decorate(v.Target, v.Decoration, v.Argv)
+
+		case *OpMemberDecorate:
+			decorateMember(v.StructType, v.Member, v.Decoration, v.Argv)
+
+		case *OpGroupDecorate:
+			for _, target := range v.Targets {
+				for _, d := range groups[v.Group] {
+					decorate(target, d.Decoration, d.Argv)
+				}
+			}
+
+		case *OpGroupMemberDecorate:
+			for i := 0; i+1 < len(v.Targets); i += 2 {
+				for _, d := range groups[v.Group] {
+					decorateMember(Id(v.Targets[i]), v.Targets[i+1], d.Decoration, d.Argv)
+				}
+			}
+		}
+	}
+
+	for _, list := range keys {
+		sort.Strings(list)
+	}
+
+	return keys
+}
+
+// typeKey returns a key which is the same for all declarations of
+// the same type. Returns false if the instruction does not declare
+// a non-structure type.
+func typeKey(instr Instruction) (string, bool) {
+	opcode := instr.Opcode()
+	if opcode == opcodeTypeStruct {
+		return "", false
+	}
+
+	if _, ok := typeKindOf(opcode); !ok {
+		return "", false
+	}
+
+	// The result id is the first operand of every type declaration.
+	return instructionKey(instr, 1)
+}
+
+// instructionKey returns a key which is the same for all instructions
+// with the same opcode and operands, apart from the result id at the
+// given word offset. Returns false if the instruction can not be encoded.
+func instructionKey(instr Instruction, resultWord int) (string, bool) {
+	words, err := appendInstruction(nil, instr)
+	if err != nil || len(words) <= resultWord {
+		return "", false
+	}
+
+	words = append(words[:resultWord], words[resultWord+1:]...)
+	return fmt.Sprint(words), true
+}
@@ -0,0 +1,105 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testUniqueTypesSource declares int32 twice, along with types built
+// from each declaration. Arrays are only duplicates if they have the
+// same decorations.
+const testUniqueTypesSource = `
+	     OpName %2 "i32"
+	     OpName %3 "int"
+	     OpDecorate %12 ArrayStride 16
+	     OpDecorate %13 ArrayStride 4
+	     OpDecorate %14 ArrayStride 4
+	     OpDecorate %19 ArrayStride 4
+	%19 = OpDecorationGroup
+	     OpGroupDecorate %19 %15
+	%1 = OpTypeVoid
+	%2 = OpTypeInt 32 1
+	%3 = OpTypeInt 32 1
+	%4 = OpTypeVector %2 4
+	%5 = OpTypeVector %3 4
+	%6 = OpTypeStruct %2
+	%7 = OpTypeStruct %2
+	%8 = OpTypeFunction %1
+	%9 = OpTypeFunction %1
+	%10 = OpConstant %3 1
+	%11 = OpTypePointer Function %5
+	%12 = OpTypeArray %2 %10
+	%13 = OpTypeArray %2 %10
+	%14 = OpTypeArray %2 %10
+	%15 = OpTypeArray %3 %10
+	%16 = OpTypeArray %2 %10
+	%17 = OpTypeOpaque "foo"
+	%18 = OpTypeOpaque "foo"
+`
+
+func TestModuleVerifyUniqueTypes(t *testing.T) {
+	m, err := Assemble(strings.NewReader(testUniqueTypesSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := ErrorList{
+		NewLayoutError(10, "type 3 duplicates type 2 declared at $00000009"),
+		NewLayoutError(16, "type 9 duplicates type 8 declared at $0000000f"),
+		NewLayoutError(21, "type 14 duplicates type 13 declared at $00000014"),
+		NewLayoutError(25, "type 18 duplicates type 17 declared at $00000018"),
+	}
+
+	have := m.verifyUniqueTypes()
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+
+	m.MergeTypes()
+
+	have = m.verifyUniqueTypes()
+	if have != nil {
+		t.Fatalf("unexpected error after MergeTypes: %v", have)
+	}
+}
+
+func TestModuleMergeTypes(t *testing.T) {
+	m, err := Assemble(strings.NewReader(testUniqueTypesSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := Assemble(strings.NewReader(`
+		     OpName %2 "i32"
+		     OpDecorate %12 ArrayStride 16
+		     OpDecorate %13 ArrayStride 4
+		     OpDecorate %19 ArrayStride 4
+		%19 = OpDecorationGroup
+		     OpGroupDecorate %19
+		%1 = OpTypeVoid
+		%2 = OpTypeInt 32 1
+		%4 = OpTypeVector %2 4
+		%6 = OpTypeStruct %2
+		%7 = OpTypeStruct %2
+		%8 = OpTypeFunction %1
+		%10 = OpConstant %2 1
+		%11 = OpTypePointer Function %4
+		%12 = OpTypeArray %2 %10
+		%13 = OpTypeArray %2 %10
+		%16 = OpTypeArray %2 %10
+		%17 = OpTypeOpaque "foo"
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.MergeTypes()
+
+	if !reflect.DeepEqual(m.Code, want.Code) {
+		t.Fatalf("code mismatch:\nHave: %v\nWant: %v", m.Code, want.Code)
+	}
+}
@@ -0,0 +1,281 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "fmt"
+
+// Severity defines the severity of a diagnostic.
+type Severity int
+
+// Known severities.
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// Rule identifies a validation rule. It starts with the number of the
+// section in the specification which defines the rule.
+type Rule string
+
+// Known validation rules.
+const (
+	RuleHeader            Rule = "2.3/header"
+	RuleInstruction       Rule = "3/operands"
+	RuleIdBound           Rule = "2.3/id-bound"
+	RuleMemoryModel       Rule = "2.4/memory-model"
+	RuleEntryPoint        Rule = "2.4/entry-point"
+	RuleLayoutOrder       Rule = "2.4/order"
+	RuleGlobalVariable    Rule = "2.4/global-variable"
+	RuleLocalVariable     Rule = "2.4/local-variable"
+	RuleFunctionLayout    Rule = "2.4/function-layout"
+	RuleUniqueType        Rule = "2.16.1/unique-type"
+	RuleLogicalAddressing Rule = "2.16.1/logical-addressing"
+	RuleUniqueId          Rule = "2.16.1/unique-id"
+	RuleDominance         Rule = "2.16.1/dominance"
+	RuleTypes             Rule = "3.32/types"
+	RuleStructuredFlow    Rule = "2.11/structured-flow"
+	RuleEntryPointCall    Rule = "2.16.1/entry-point-call"
+)
+
+// Diagnostic describes a single problem found in a module.
+type Diagnostic struct {
+	Severity Severity
+	Rule     Rule
+
+	// Address is the index of the offending instruction in Module.Code.
+	// It is -1 if the problem is not tied to a specific instruction.
+	Address int
+
+	// Opcode is the opcode of the offending instruction.
+	// It is 0 if Address is -1.
+	Opcode uint32
+
+	// Err describes the problem.
+	Err error
+}
+
+func (d Diagnostic) Error() string {
+	msg := d.Err.Error()
+	if le, ok := d.Err.(*LayoutError); ok {
+		msg = le.Msg
+	}
+
+	if d.Address < 0 {
+		return fmt.Sprintf("%s: %s [%s]", d.Severity, msg, d.Rule)
+	}
+
+	return fmt.Sprintf("%s: at $%08x: %s: %s [%s]",
+		d.Severity, d.Address, opcodeInstructionName(d.Opcode), msg, d.Rule)
+}
+
+// ValidateOptions controls the behaviour of Validate.
+// The zero value checks all rules and reports every problem.
+type ValidateOptions struct {
+	// MaxDiagnostics limits the number of diagnostics. Validation
+	// stops once the limit is reached. Zero means there is no limit.
+	MaxDiagnostics int
+
+	// Disable lists the rules which should not be checked.
+	Disable []Rule
+}
+
+// Validate checks the module against all validation rules and returns
+// every problem it finds. Diagnostics are ordered by rule, in the order
+// in which Module.Verify applies them, and then by address.
+//
+// Rules which apply to the control flow within functions are not checked
+// if the functions themselves are malformed. Only the latter is reported.
+//
+// Returns nil if the module is valid.
+func Validate(m *Module, opts ValidateOptions) []Diagnostic {
+	var out []Diagnostic
+
+	for _, c := range moduleChecks {
+		if hasRule(opts.Disable, c.rule) {
+			continue
+		}
+
+		out = m.diagnose(out, c.rule, c.run(m))
+
+		if opts.MaxDiagnostics > 0 && len(out) >= opts.MaxDiagnostics {
+			return out[:opts.MaxDiagnostics]
+		}
+	}
+
+	return out
+}
+
+// check defines a single validation rule. Run returns nil, a single
+// error or an ErrorList with all violations.
+type check struct {
+	rule Rule
+	run  func(*Module) error
+}
+
+// layoutChecks verify the logical layout of a module, as defined in
+// chapter 2.4 of the specification.
+var layoutChecks = []check{
+	{RuleMemoryModel, (*Module).verifyMemoryModel},
+	{RuleEntryPoint, (*Module).verifyEntrypointCount},
+	{RuleLayoutOrder, func(m *Module) error { return verifyLayoutOrder(m.Code) }},
+	{RuleGlobalVariable, func(m *Module) error { return verifyGlobalVariables(m.Code) }},
+	{RuleLocalVariable, func(m *Module) error { return verifyLocalVariables(m.Code) }},
+	{RuleFunctionLayout, (*Module).verifyFunctionStructure},
+}
+
+// moduleChecks lists all validation rules, in the order in which
+// they are applied.
+var moduleChecks []check
+
+func init() {
+	moduleChecks = append(moduleChecks,
+		check{RuleHeader, func(m *Module) error { return m.Header.Verify() }},
+		check{RuleInstruction, (*Module).verifyInstructions},
+		check{RuleIdBound, (*Module).verifyIdBound},
+	)
+
+	moduleChecks = append(moduleChecks, layoutChecks...)
+
+	moduleChecks = append(moduleChecks,
+		check{RuleUniqueType, (*Module).verifyUniqueTypes},
+		check{RuleLogicalAddressing, (*Module).verifyLogicalAddressing},
+		check{RuleUniqueId, (*Module).verifySSA},
+		check{RuleDominance, withFunctions((*Module).verifyDominance)},
+		check{RuleTypes, (*Module).verifyTypes},
+		check{RuleStructuredFlow, withFunctions((*Module).verifyStructuredFlow)},
+		check{RuleEntryPointCall, (*Module).verifyEntrypoints},
+	)
+}
+
+// withFunctions wraps a check which requires all functions to consist of
+// well-formed blocks. The check is skipped if they do not. The problem is
+// reported by verifyLayoutOrder or verifyFunctionStructure instead.
+func withFunctions(run func(*Module) error) func(*Module) error {
+	return func(m *Module) error {
+		if !m.hasWellFormedFunctions() {
+			return nil
+		}
+		return run(m)
+	}
+}
+
+// hasWellFormedFunctions returns true if every OpFunction is paired with
+// an OpFunctionEnd and every function consists of well-formed blocks.
+func (m *Module) hasWellFormedFunctions() bool {
+	start, _ := m.Code.functionRanges()
+	if len(start) != m.Code.Count(opcodeFunction) || len(start) != m.Code.Count(opcodeFunctionEnd) {
+		return false
+	}
+
+	return m.verifyFunctionStructure() == nil
+}
+
+// firstError applies the given checks and returns the first error.
+func (m *Module) firstError(checks []check) error {
+	for _, c := range checks {
+		d := m.diagnose(nil, c.rule, c.run(m))
+		if len(d) > 0 {
+			return d[0].Err
+		}
+	}
+
+	return nil
+}
+
+// diagnose appends diagnostics for the given error to out.
+func (m *Module) diagnose(out []Diagnostic, rule Rule, err error) []Diagnostic {
+	if err == nil {
+		return out
+	}
+
+	if list, ok := err.(ErrorList); ok {
+		for _, err := range list {
+			out = m.diagnose(out, rule, err)
+		}
+		return out
+	}
+
+	addr := -1
+	switch v := err.(type) {
+	case *LayoutError:
+		addr = v.Address
+	case *instructionError:
+		addr = v.Address
+		err = v.Err
+	}
+
+	d := Diagnostic{
+		Severity: SeverityError,
+		Rule:     rule,
+		Address:  -1,
+		Err:      err,
+	}
+
+	if addr > -1 && addr < len(m.Code) {
+		d.Address = addr
+		d.Opcode = m.Code[addr].Opcode()
+	}
+
+	return append(out, d)
+}
+
+// instructionError defines an error returned by the Verify method
+// of the instruction at the given address.
+type instructionError struct {
+	Address int
+	Err     error
+}
+
+func (e *instructionError) Error() string {
+	return fmt.Sprintf("at $%08x: %v", e.Address, e.Err)
+}
+
+// verifyInstructions performs structural validity checks on each
+// instruction.
+//
+// This uses reflection to call Verify() on all relevant struct fields
+// and then on the instruction itself. The latter is used by some
+// instructions to validate parts which can not be caught by the field
+// types themselves.
+func (m *Module) verifyInstructions() error {
+	var errs ErrorList
+
+	for addr, instr := range m.Code {
+		err := verifyInstruction(instr)
+		if err != nil {
+			errs = append(errs, &instructionError{addr, err})
+		}
+	}
+
+	return errs.err()
+}
+
+// hasRule returns true if set contains r.
+func hasRule(set []Rule, r Rule) bool {
+	for _, v := range set {
+		if v == r {
+			return true
+		}
+	}
+	return false
+}
+
+// opcodeInstructionName returns the name of the instruction with the given opcode.
+func opcodeInstructionName(opcode uint32) string {
+	instr, ok := DefaultDialect.New(opcode)
+	if !ok {
+		return fmt.Sprintf("opcode %d", opcode)
+	}
+	return instructionName(instr)
+}
@@ -0,0 +1,224 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testValidateSource holds a module with a number of problems.
+const testValidateSource = `
+               OpCapability Shader
+               OpMemoryModel Logical GLSL450
+               OpEntryPoint Fragment %4 "main"
+               OpExecutionMode %4 OriginUpperLeft
+          %2 = OpTypeVoid
+               OpName %4 "main"
+          %3 = OpTypeFunction %2
+          %6 = OpTypeInt 32 1
+         %10 = OpTypeInt 32 2
+          %7 = OpConstant %6 1
+          %4 = OpFunction %2 None %3
+          %5 = OpLabel
+          %8 = OpIAdd %6 %9 %7
+          %9 = OpIAdd %6 %7 %7
+          %9 = OpIAdd %6 %7 %7
+               OpReturn
+               OpFunctionEnd
+`
+
+type ValidateTest struct {
+	opts ValidateOptions
+	want []Diagnostic
+}
+
+func TestValidate(t *testing.T) {
+	mod, err := Assemble(strings.NewReader(testValidateSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all := []Diagnostic{
+		{
+			Rule:    RuleInstruction,
+			Address: 8,
+			Opcode:  opcodeTypeInt,
+			Err:     verifyInstruction(mod.Code[8]),
+		},
+		{
+			Rule:    RuleLayoutOrder,
+			Address: 5,
+			Opcode:  opcodeName,
+			Err:     NewLayoutError(5, "OpName appears after type declarations, but belongs with debug names"),
+		},
+		{
+			Rule:    RuleUniqueId,
+			Address: 14,
+			Opcode:  opcodeIAdd,
+			Err:     NewLayoutError(14, "duplicate ResultId(9); previous definition at: $0000000d"),
+		},
+		{
+			Rule:    RuleDominance,
+			Address: 12,
+			Opcode:  opcodeIAdd,
+			Err:     NewLayoutError(12, "use of 9 is not dominated by its definition at $0000000d"),
+		},
+	}
+
+	for i, st := range []ValidateTest{
+		{
+			want: all,
+		},
+		{
+			opts: ValidateOptions{MaxDiagnostics: 2},
+			want: all[:2],
+		},
+		{
+			opts: ValidateOptions{Disable: []Rule{RuleInstruction, RuleDominance}},
+			want: all[1:3],
+		},
+	} {
+		have := Validate(mod, st.opts)
+		if !reflect.DeepEqual(have, st.want) {
+			t.Fatalf("case %d: diagnostics mismatch:\nHave: %v\nWant: %v", i, have, st.want)
+		}
+	}
+
+	err = mod.Verify()
+	if !reflect.DeepEqual(err, all[0].Err) {
+		t.Fatalf("Verify error mismatch:\nHave: %v\nWant: %v", err, all[0].Err)
+	}
+}
+
+func TestValidateValid(t *testing.T) {
+	mod, err := Assemble(strings.NewReader(testDefUseSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	have := Validate(mod, ValidateOptions{})
+	if have != nil {
+		t.Fatalf("unexpected diagnostics: %v", have)
+	}
+}
+
+// TestValidateMalformed checks that malformed modules are diagnosed,
+// rather than making any of the checks panic.
+func TestValidateMalformed(t *testing.T) {
+	for i, src := range []string{
+		// Unpaired OpFunction.
+		`
+			     OpCapability Shader
+			     OpMemoryModel Logical GLSL450
+			%1 = OpTypeVoid
+			%2 = OpTypeFunction %1
+			%3 = OpTypeInt 32 0
+			%10 = OpFunction %1 None %2
+			%11 = OpLabel
+			%12 = OpPhi %3 %3 %11
+			     OpReturn
+		`,
+		// OpPhi outside of a function.
+		`
+			     OpCapability Shader
+			     OpMemoryModel Logical GLSL450
+			%1 = OpTypeInt 32 0
+			%2 = OpConstant %1 1
+			%3 = OpPhi %1 %2 %4
+		`,
+	} {
+		mod, err := Assemble(strings.NewReader(src))
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Fatalf("case %d: panic: %v", i, r)
+				}
+			}()
+
+			if have := Validate(mod, ValidateOptions{}); len(have) == 0 {
+				t.Fatalf("case %d: expected diagnostics", i)
+			}
+		}()
+	}
+}
+
+// TestValidateBrokenFunction checks that the function-level rules are
+// skipped once a function has been found to be malformed.
+func TestValidateBrokenFunction(t *testing.T) {
+	mod, err := Assemble(strings.NewReader(`
+		     OpCapability Shader
+		     OpMemoryModel Logical GLSL450
+		     OpEntryPoint Fragment %10 "main"
+		%1 = OpTypeVoid
+		%2 = OpTypeFunction %1
+		%3 = OpTypeInt 32 0
+		%4 = OpTypePointer Function %3
+		%10 = OpFunction %1 None %2
+		%11 = OpLabel
+		%12 = OpIAdd %3 %14 %14
+		     OpBranch %13
+		%13 = OpLabel
+		%15 = OpVariable %4 Function
+		%14 = OpLoad %3 %15
+		     OpReturn
+		     OpFunctionEnd
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var have []Rule
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Fatalf("panic: %v", r)
+			}
+		}()
+
+		for _, d := range Validate(mod, ValidateOptions{MaxDiagnostics: 0}) {
+			have = append(have, d.Rule)
+		}
+	}()
+
+	want := []Rule{RuleFunctionLayout}
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("rule mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+}
+
+func TestDiagnosticError(t *testing.T) {
+	for i, st := range []struct {
+		in   Diagnostic
+		want string
+	}{
+		{
+			in: Diagnostic{
+				Rule:    RuleLayoutOrder,
+				Address: 42,
+				Opcode:  opcodeName,
+				Err:     NewLayoutError(42, "OpName appears after type declarations, but belongs with debug names"),
+			},
+			want: "error: at $0000002a: OpName: OpName appears after type declarations, but belongs with debug names [2.4/order]",
+		},
+		{
+			in: Diagnostic{
+				Rule:    RuleMemoryModel,
+				Address: -1,
+				Err:     ErrMemoryModel,
+			},
+			want: "error: a module must define one and only one OpMemoryModel [2.4/memory-model]",
+		},
+	} {
+		have := st.in.Error()
+		if have != st.want {
+			t.Fatalf("case %d: message mismatch:\nHave: %s\nWant: %s", i, have, st.want)
+		}
+	}
+}